@@ -20,13 +20,33 @@ insert into product_categories(id, name) values
 		, (3, 'Обувь')
 		;
 
+create table barcode_formats(
+	id smallint primary key,
+	name varchar not null
+);
+
+insert into barcode_formats(id, name) values
+		  (1, 'EAN-13')
+		, (2, 'Code 128')
+		, (3, 'Номер заказа')
+		;
+
 create table products(
 	id uuid primary key,
 	reception_id uuid not null,
 	creation_time_utc timestamp without time zone not null,
-	category smallint not null
+	category smallint not null,
+	barcode varchar null,
+	barcode_format smallint null,
+	deleted_at_utc timestamp without time zone null,
+	deleted_by uuid null,
+	accepted_barcode varchar null
 );
 
+create index products_barcode_index on products(barcode) where barcode is not null and deleted_at_utc is null;
+-- barcode is copied to accepted_barcode while product is in opened reception, so the same parcel could not be accepted twice
+create unique index products_accepted_barcode_index on products(accepted_barcode) where accepted_barcode is not null;
+
 create table receptions (
 	id uuid primary key,
 	pvz_id uuid not null,
@@ -34,6 +54,129 @@ create table receptions (
     status smallint not null
 );
 
+-- pvz has at most one opened reception, concurrent opening and reopening could not both succeed
+create unique index receptions_opened_pvz_index on receptions(pvz_id) where status = 1;
+
+-- manifest is uploaded for delivery expected at pvz and linked to reception later,
+-- category of parcel is at the same position in categories as its barcode in barcodes
+create table shipment_manifests(
+	id uuid primary key,
+	pvz_id uuid not null,
+	reception_id uuid null,
+	uploaded_by uuid not null,
+	creation_time_utc timestamp without time zone not null,
+	barcodes text[] not null,
+	categories smallint[] not null,
+
+	constraint shipment_manifests_reception_uq unique(reception_id)
+);
+
+create table discrepancy_reports(
+	reception_id uuid primary key,
+	manifest_id uuid not null,
+	creation_time_utc timestamp without time zone not null,
+	missing text[] not null,
+	unexpected text[] not null,
+	duplicates text[] not null,
+	unidentified_products integer not null,
+	category_mismatches jsonb not null,
+	categories jsonb not null
+);
+
+create table reception_acts(
+	reception_id uuid primary key,
+	creation_time_utc timestamp without time zone not null,
+	document bytea not null
+);
+
+create table reception_history(
+	id uuid primary key,
+	reception_id uuid not null,
+	action varchar(16) not null,
+	actor_id uuid not null,
+	reason text not null,
+	occurred_at_utc timestamp without time zone not null
+);
+
+create index reception_history_reception_index on reception_history(reception_id, occurred_at_utc);
+
+-- parcel scanned again while already accepted is refused, scan is kept for discrepancy report
+create table duplicate_scans(
+	id uuid primary key,
+	reception_id uuid not null,
+	barcode varchar not null,
+	barcode_format smallint not null,
+	scanned_by uuid not null,
+	occurred_at_utc timestamp without time zone not null
+);
+
+create index duplicate_scans_reception_index on duplicate_scans(reception_id, occurred_at_utc);
+
+create table outbox_events(
+	id uuid primary key,
+	event_type varchar(64) not null,
+	pvz_id uuid not null,
+	occurred_at_utc timestamp without time zone not null,
+	payload jsonb not null,
+	published_at_utc timestamp without time zone null
+);
+
+create index outbox_events_unpublished_index on outbox_events(occurred_at_utc, id) where published_at_utc is null;
+
+create table webhook_subscriptions(
+	id uuid primary key,
+	url varchar not null,
+	event_types text[] not null,
+	pvz_id uuid null,
+	city_id smallint null,
+	secret varchar not null,
+	created_by uuid not null,
+	creation_time_utc timestamp without time zone not null
+);
+
+create table webhook_delivery_attempts(
+	id uuid primary key,
+	subscription_id uuid not null references webhook_subscriptions(id) on delete cascade,
+	event_id uuid not null,
+	attempt integer not null,
+	attempt_time_utc timestamp without time zone not null,
+	status_code integer not null,
+	error text not null,
+	delivered boolean not null
+);
+
+create index webhook_delivery_attempts_subscription_index on webhook_delivery_attempts(subscription_id, attempt_time_utc desc);
+
+create table audit_log(
+	id uuid primary key,
+	actor_id uuid not null,
+	action varchar not null,
+	entity_type varchar not null,
+	entity_id uuid not null,
+	before jsonb null,
+	after jsonb null,
+	protocol varchar not null,
+	request_id varchar not null,
+	occurred_at_utc timestamp without time zone not null
+);
+
+create index audit_log_occurred_at_index on audit_log(occurred_at_utc desc);
+create index audit_log_entity_index on audit_log(entity_id);
+create index audit_log_actor_index on audit_log(actor_id);
+
+create table pvz_assignments(
+	id uuid primary key,
+	user_id uuid not null,
+	pvz_id uuid not null,
+	valid_from_utc timestamp without time zone null,
+	valid_to_utc timestamp without time zone null,
+	assigned_by uuid not null,
+	creation_time_utc timestamp without time zone not null
+);
+
+create index pvz_assignments_user_index on pvz_assignments(user_id);
+create index pvz_assignments_pvz_index on pvz_assignments(pvz_id);
+
 create view receptions_with_products_view as
 select 
     	r.id
@@ -46,8 +189,12 @@ select
 						, p.reception_id
                         , to_char(p.creation_time_utc at time zone 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS.MS"Z"') as creation_time_utc
 						, p.category
+						, case when p.barcode is null then null
+							   else json_build_object('value', p.barcode, 'format', p.barcode_format)
+						   end as barcode
                    from products p
                   where p.reception_id = r.id
+                    and p.deleted_at_utc is null
            ) p
         ) as products
   from receptions r;
@@ -61,27 +208,133 @@ create table pvzs(
 
 create unique index pvzs_pagination_index_uq on pvzs(pvz_record_number);
 
+-- identity starts after built-in roles, which are inserted with explicit ids
 create table user_roles(
-	id smallint primary key generated by default as identity,
-	name varchar not null
+	id smallint primary key generated by default as identity (start with 100),
+	name varchar not null,
+	-- users of the role have to enroll second factor
+	two_factor_required boolean not null default false
 );
 
+create unique index user_roles_name_uq on user_roles(lower(name));
+
 insert into user_roles(id, name) values
-	  (1, 'client')
+	  (1, 'employee')
 	, (2, 'moderator')
+	, (3, 'admin')
+	;
+
+create table role_permissions(
+	role_id smallint not null references user_roles(id) on delete cascade,
+	permission varchar not null,
+
+	primary key (role_id, permission)
+);
+
+insert into role_permissions(role_id, permission) values
+	  (1, 'pvz:read')
+	, (1, 'reception:open')
+	, (1, 'reception:close')
+	, (1, 'product:add')
+	, (1, 'product:remove')
+	, (1, 'report:read')
+	, (2, 'pvz:create')
+	, (2, 'pvz:read')
+	, (2, 'pvz:assign')
+	, (2, 'pvz:any')
+	, (2, 'reception:close')
+	, (2, 'reception:reopen')
+	, (2, 'manifest:upload')
+	, (2, 'report:read')
+	, (2, 'webhook:manage')
+	, (2, 'audit:read')
+	, (2, 'user:invite')
+	, (2, 'user:promote')
+	, (2, 'user:manage')
+	, (2, 'service_account:manage')
 	;
 
+-- admin has every permission
+insert into role_permissions(role_id, permission)
+select 3, unnest(array[
+	  'pvz:create', 'pvz:read', 'pvz:assign', 'pvz:any'
+	, 'reception:open', 'reception:close', 'reception:reopen'
+	, 'product:add', 'product:remove', 'manifest:upload', 'report:read'
+	, 'webhook:manage', 'audit:read', 'role:manage'
+	, 'user:invite', 'user:promote', 'user:manage', 'service_account:manage'
+]);
+
 create table users(
 	id uuid primary key,
 	user_role_id smallint not null,
 	email varchar not null,
 	password varchar not null,
+	deactivated boolean not null default false,
+	email_verified boolean not null default false,
+	totp_secret varchar null,
+	two_factor_enabled boolean not null default false,
+	-- hashes of recovery codes which are not spent yet
+	recovery_code_hashes varchar[] not null default '{}',
+	totp_last_used_step bigint not null default 0,
+	-- service account has no password, it is used with api keys
+	service_account boolean not null default false,
+	-- subject of user at identity provider, set for users of single sign-on
+	sso_subject varchar null,
+
+	constraint users_unique_email unique(email),
+	constraint users_unique_sso_subject unique(sso_subject)
+);
+
+create table invitations(
+	id uuid primary key,
+	token_hash varchar not null,
+	role_id smallint not null references user_roles(id),
+	email varchar null,
+	invited_by uuid not null,
+	creation_time_utc timestamp without time zone not null,
+	expires_at_utc timestamp without time zone not null,
+	accepted_at_utc timestamp without time zone null,
+	accepted_by uuid null,
 
-	constraint users_unique_email unique(email)
+	constraint invitations_token_hash_uq unique(token_hash)
 );
 
-insert into users(id, user_role_id, email, password) values 
-	  /* dummy user */						-- client role	
-	  ('0196521c-873e-77fd-b244-bdd0c13c72ab', 1, 'example@example.com', '')
-	  /* dummy moderator */					-- moderator role
-	, ('0196521c-b2a9-7a04-88be-16ec981d104b', 2,'example2@example.com', '');
+-- tokens of password reset and email verification, only hash of token is kept
+create table user_tokens(
+	id uuid primary key,
+	user_id uuid not null references users(id),
+	purpose varchar not null,
+	token_hash varchar not null,
+	creation_time_utc timestamp without time zone not null,
+	expires_at_utc timestamp without time zone not null,
+	used_at_utc timestamp without time zone null,
+
+	constraint user_tokens_token_hash_uq unique(token_hash)
+);
+
+-- api keys of service accounts, only hash of key is kept
+create table api_keys(
+	id uuid primary key,
+	service_account_id uuid not null references users(id),
+	name varchar not null,
+	hint varchar not null,
+	key_hash varchar not null,
+	scope varchar[] not null,
+	created_by uuid not null,
+	creation_time_utc timestamp without time zone not null,
+	revoked_at_utc timestamp without time zone null,
+	last_used_at_utc timestamp without time zone null,
+
+	constraint api_keys_key_hash_uq unique(key_hash)
+);
+
+create index api_keys_service_account_index on api_keys(service_account_id);
+
+-- single sign-on logins waiting for user to come back from identity provider, only hash of state is kept
+create table sso_logins(
+	state_hash varchar primary key,
+	nonce varchar not null,
+	code_verifier varchar not null,
+	creation_time_utc timestamp without time zone not null,
+	expires_at_utc timestamp without time zone not null
+);
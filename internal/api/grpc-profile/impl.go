@@ -5,8 +5,11 @@ import (
 	"avito/internal/domain"
 	"avito/internal/usecases"
 	"avito/internal/usecases/pvz"
+	"avito/internal/usecases/users"
 	jwt "avito/pkg/authorization"
+	"avito/pkg/ratelimit"
 	context "context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -19,13 +22,21 @@ import (
 type Dependencies struct {
 	domain.PVZRepository
 	domain.PVZReportAggregateRepository
+	domain.UserRepository
+	domain.RoleRepository
+	domain.UnitOfWork
 	domain.AuthorizationService[jwt.JWT]
 	jwt.JWTManager
+	IPLimiter *ratelimit.Limiter
+	// calls without credentials act as dummy employee only while dummy login is enabled
+	DummyLoginEnabled bool
 }
 
 type gRPCSerrverWrapper struct {
-	cfg  config.GRPCConfig
-	deps Dependencies
+	cfg      config.GRPCConfig
+	deps     Dependencies
+	server   *grpc.Server
+	listener net.Listener
 }
 
 type gRPCServer struct {
@@ -34,35 +45,81 @@ type gRPCServer struct {
 }
 
 func NewGRPCServer(deps Dependencies, cfg config.GRPCConfig) *gRPCSerrverWrapper {
-	return &gRPCSerrverWrapper{
-		cfg:  cfg,
+	interceptors := []grpc.UnaryServerInterceptor{RequestSourceInterceptor(), BearerTokenInterceptor()}
+	if deps.IPLimiter != nil {
+		interceptors = append(interceptors, RateLimitInterceptor(deps.IPLimiter))
+	}
+
+	serverRegistar := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	service := &gRPCServer{
 		deps: deps,
 	}
-}
 
-func (s *gRPCSerrverWrapper) Start() error {
+	RegisterPVZReportServiceServer(serverRegistar, service)
 
+	return &gRPCSerrverWrapper{
+		cfg:    cfg,
+		deps:   deps,
+		server: serverRegistar,
+	}
+}
+
+// Listen binds server port, zero port in config means any free port
+func (s *gRPCSerrverWrapper) Listen() error {
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.cfg.Port))
 	if err != nil {
 		return err
 	}
 
-	serverRegistar := grpc.NewServer()
-	service := &gRPCServer{
-		deps: s.deps,
+	s.listener = listener
+
+	return nil
+}
+
+func (s *gRPCSerrverWrapper) Start() error {
+	if s.listener == nil {
+		if err := s.Listen(); err != nil {
+			return err
+		}
 	}
 
-	RegisterPVZReportServiceServer(serverRegistar, service)
 	log.Println("starting grpc server")
-	if err := serverRegistar.Serve(listener); err != nil {
+	if err := s.server.Serve(s.listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
 		return err
 	}
 
 	return nil
 }
 
+func (s *gRPCSerrverWrapper) Addr() string {
+	if s.listener == nil {
+		return ""
+	}
+
+	return s.listener.Addr().String()
+}
+
+func (s *gRPCSerrverWrapper) Stop(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		return ctx.Err()
+	}
+}
+
 func (s *gRPCServer) GetPVZReport(ctx context.Context, request *PVZReportRequest) (*PVZReportResponse, error) {
-	token := byPassAuthorization(s.deps.JWTManager)
+	token := jwt.JWT(bearerToken(ctx))
+	if token == "" {
+		token = s.dummyEmployeeToken(ctx)
+	}
 
 	var startTime *time.Time
 	if request.StartDate != nil && request.StartDate.IsValid() {
@@ -149,10 +206,22 @@ func (s *gRPCServer) GetPVZReport(ctx context.Context, request *PVZReportRequest
 	}, nil
 }
 
-func byPassAuthorization(m jwt.JWTManager) jwt.JWT {
-	const clientId string = "0196521c-873e-77fd-b244-bdd0c13c72ab"
-
-	token, _ := m.GenerateToken(clientId)
+// dummyEmployeeToken is empty when dummy login is disabled, so the call is refused as unauthenticated
+func (s *gRPCServer) dummyEmployeeToken(ctx context.Context) jwt.JWT {
+	token, err := users.DummyLoginUseCase(ctx, users.DummyLoginArgs{
+		Enabled:        s.deps.DummyLoginEnabled,
+		JWTManager:     s.deps.JWTManager,
+		UserRepository: s.deps.UserRepository,
+		RoleRepository: s.deps.RoleRepository,
+		UnitOfWork:     s.deps.UnitOfWork,
+		Role:           domain.EmployeeUserRoleName,
+	})
+	if err != nil {
+		if msg := err.Error(); msg != users.DummyLoginIsDisabledError {
+			log.Println(msg)
+		}
+		return ""
+	}
 
 	return token
 }
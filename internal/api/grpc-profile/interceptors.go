@@ -0,0 +1,97 @@
+package grpc_profile
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"avito/pkg/ratelimit"
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	requestIDMetadataKey     string = "x-request-id"
+	authorizationMetadataKey string = "authorization"
+)
+
+type contextKey string
+
+const bearerTokenContextKey contextKey = "authorization:bearer"
+
+// RateLimitInterceptor limits unary calls from single peer ip
+func RateLimitInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := limiter.Allow(peerIP(ctx)); err != nil {
+			var limitErr *ratelimit.LimitExceededError
+			if errors.As(err, &limitErr) {
+				grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(limitErr.RetryAfterSeconds())))
+			}
+
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// RequestSourceInterceptor marks call with id taken from x-request-id metadata or generated one when metadata is missing or malformed
+func RequestSourceInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var requestID string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(requestIDMetadataKey); len(values) > 0 {
+				requestID = values[0]
+			}
+		}
+		source := domain.NewRequestSource(domain.GRPCRequestProtocol, requestID)
+		grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadataKey, source.RequestID))
+
+		ctx = usecases.WithRequestSource(ctx, source)
+
+		return handler(ctx, req)
+	}
+}
+
+// BearerTokenInterceptor passes access token or api key from authorization metadata to handlers
+func BearerTokenInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		token := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(authorizationMetadataKey); len(values) > 0 && strings.HasPrefix(values[0], "Bearer ") {
+				token = strings.TrimPrefix(values[0], "Bearer ")
+			}
+		}
+
+		return handler(context.WithValue(ctx, bearerTokenContextKey, token), req)
+	}
+}
+
+func bearerToken(ctx context.Context) string {
+	if token, ok := ctx.Value(bearerTokenContextKey).(string); ok {
+		return token
+	}
+
+	return ""
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
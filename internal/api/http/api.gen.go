@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -20,6 +21,67 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AuditAction.
+const (
+	AuditActionApiKeyIssue          AuditAction = "api_key.issue"
+	AuditActionApiKeyRevoke         AuditAction = "api_key.revoke"
+	AuditActionInvitationAccept     AuditAction = "invitation.accept"
+	AuditActionInvitationIssue      AuditAction = "invitation.issue"
+	AuditActionManifestLink         AuditAction = "manifest.link"
+	AuditActionManifestUpload       AuditAction = "manifest.upload"
+	AuditActionProductAdd           AuditAction = "product.add"
+	AuditActionProductRemove        AuditAction = "product.remove"
+	AuditActionProductRestore       AuditAction = "product.restore"
+	AuditActionPvzAssign            AuditAction = "pvz.assign"
+	AuditActionPvzCreate            AuditAction = "pvz.create"
+	AuditActionPvzUnassign          AuditAction = "pvz.unassign"
+	AuditActionReceptionClose       AuditAction = "reception.close"
+	AuditActionReceptionOpen        AuditAction = "reception.open"
+	AuditActionReceptionReopen      AuditAction = "reception.reopen"
+	AuditActionRoleCreate           AuditAction = "role.create"
+	AuditActionRoleUpdate           AuditAction = "role.update"
+	AuditActionServiceAccountCreate AuditAction = "service_account.create"
+	AuditActionUserActivate         AuditAction = "user.activate"
+	AuditActionUserDeactivate       AuditAction = "user.deactivate"
+	AuditActionUserEmailVerify      AuditAction = "user.email_verify"
+	AuditActionUserPasswordReset    AuditAction = "user.password_reset"
+	AuditActionUserRegister         AuditAction = "user.register"
+	AuditActionUserRoleChange       AuditAction = "user.role_change"
+	AuditActionUserSsoLink          AuditAction = "user.sso_link"
+	AuditActionUserSsoProvision     AuditAction = "user.sso_provision"
+	AuditActionUserTwoFactorDisable AuditAction = "user.two_factor_disable"
+	AuditActionUserTwoFactorEnable  AuditAction = "user.two_factor_enable"
+	AuditActionWebhookSubscribe     AuditAction = "webhook.subscribe"
+	AuditActionWebhookUnsubscribe   AuditAction = "webhook.unsubscribe"
+)
+
+// Defines values for AuditEntityType.
+const (
+	AuditEntityTypeApiKey              AuditEntityType = "api_key"
+	AuditEntityTypeInvitation          AuditEntityType = "invitation"
+	AuditEntityTypeManifest            AuditEntityType = "manifest"
+	AuditEntityTypeProduct             AuditEntityType = "product"
+	AuditEntityTypePvz                 AuditEntityType = "pvz"
+	AuditEntityTypePvzAssignment       AuditEntityType = "pvz_assignment"
+	AuditEntityTypeReception           AuditEntityType = "reception"
+	AuditEntityTypeRole                AuditEntityType = "role"
+	AuditEntityTypeUser                AuditEntityType = "user"
+	AuditEntityTypeWebhookSubscription AuditEntityType = "webhook_subscription"
+)
+
+// Defines values for AuditRecordProtocol.
+const (
+	Grpc AuditRecordProtocol = "grpc"
+	Http AuditRecordProtocol = "http"
+)
+
+// Defines values for BarcodeFormat.
+const (
+	Code128 BarcodeFormat = "code128"
+	Ean13   BarcodeFormat = "ean13"
+	Order   BarcodeFormat = "order"
+)
+
 // Defines values for PVZCity.
 const (
 	Казань         PVZCity = "Казань"
@@ -27,6 +89,28 @@ const (
 	СанктПетербург PVZCity = "Санкт-Петербург"
 )
 
+// Defines values for Permission.
+const (
+	PermissionAuditRead            Permission = "audit:read"
+	PermissionManifestUpload       Permission = "manifest:upload"
+	PermissionProductAdd           Permission = "product:add"
+	PermissionProductRemove        Permission = "product:remove"
+	PermissionPvzAny               Permission = "pvz:any"
+	PermissionPvzAssign            Permission = "pvz:assign"
+	PermissionPvzCreate            Permission = "pvz:create"
+	PermissionPvzRead              Permission = "pvz:read"
+	PermissionReceptionClose       Permission = "reception:close"
+	PermissionReceptionOpen        Permission = "reception:open"
+	PermissionReceptionReopen      Permission = "reception:reopen"
+	PermissionReportRead           Permission = "report:read"
+	PermissionRoleManage           Permission = "role:manage"
+	PermissionServiceAccountManage Permission = "service_account:manage"
+	PermissionUserInvite           Permission = "user:invite"
+	PermissionUserManage           Permission = "user:manage"
+	PermissionUserPromote          Permission = "user:promote"
+	PermissionWebhookManage        Permission = "webhook:manage"
+)
+
 // Defines values for ProductType.
 const (
 	ProductTypeОбувь       ProductType = "обувь"
@@ -34,22 +118,46 @@ const (
 	ProductTypeЭлектроника ProductType = "электроника"
 )
 
+// Defines values for ProductCategory.
+const (
+	ClothesCategory     ProductCategory = "одежда"
+	ElectronicsCategory ProductCategory = "электроника"
+	ShoesCategory       ProductCategory = "обувь"
+)
+
 // Defines values for ReceptionStatus.
 const (
 	Close      ReceptionStatus = "close"
 	InProgress ReceptionStatus = "in_progress"
 )
 
-// Defines values for UserRole.
+// Defines values for ReceptionHistoryEntryAction.
+const (
+	Closed                ReceptionHistoryEntryAction = "closed"
+	Flagged               ReceptionHistoryEntryAction = "flagged"
+	ManifestLinkedAction  ReceptionHistoryEntryAction = "manifest_linked"
+	Opened                ReceptionHistoryEntryAction = "opened"
+	ProductRestoredAction ReceptionHistoryEntryAction = "product_restored"
+	Reopened              ReceptionHistoryEntryAction = "reopened"
+)
+
+// Defines values for WebhookEventType.
 const (
-	UserRoleEmployee  UserRole = "employee"
-	UserRoleModerator UserRole = "moderator"
+	ProductAdded      WebhookEventType = "product.added"
+	ProductRemoved    WebhookEventType = "product.removed"
+	ProductRestored   WebhookEventType = "product.restored"
+	PvzCreated        WebhookEventType = "pvz.created"
+	ReceptionClosed   WebhookEventType = "reception.closed"
+	ReceptionOpened   WebhookEventType = "reception.opened"
+	ReceptionReopened WebhookEventType = "reception.reopened"
+	ReceptionStale    WebhookEventType = "reception.stale"
 )
 
 // Defines values for PostDummyLoginJSONBodyRole.
 const (
-	PostDummyLoginJSONBodyRoleEmployee  PostDummyLoginJSONBodyRole = "employee"
-	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
+	Admin     PostDummyLoginJSONBodyRole = "admin"
+	Employee  PostDummyLoginJSONBodyRole = "employee"
+	Moderator PostDummyLoginJSONBodyRole = "moderator"
 )
 
 // Defines values for PostProductsJSONBodyType.
@@ -59,17 +167,125 @@ const (
 	PostProductsJSONBodyTypeЭлектроника PostProductsJSONBodyType = "электроника"
 )
 
-// Defines values for PostRegisterJSONBodyRole.
-const (
-	Employee  PostRegisterJSONBodyRole = "employee"
-	Moderator PostRegisterJSONBodyRole = "moderator"
-)
+// ApiKey API-ключ сервисного аккаунта. Сам ключ показывается только при выпуске
+type ApiKey struct {
+	DateTime time.Time `json:"dateTime"`
+
+	// Hint Начало ключа, чтобы отличать ключи друг от друга
+	Hint string             `json:"hint"`
+	Id   openapi_types.UUID `json:"id"`
+	Key  *string            `json:"key,omitempty"`
+
+	// LastUsedAt Время последнего использования с точностью до минуты
+	LastUsedAt *time.Time   `json:"lastUsedAt,omitempty"`
+	Name       string       `json:"name"`
+	RevokedAt  *time.Time   `json:"revokedAt,omitempty"`
+	Scope      []Permission `json:"scope"`
+}
+
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditEntityType defines model for AuditEntityType.
+type AuditEntityType string
+
+// AuditRecord Изменение сущности пользователем
+type AuditRecord struct {
+	Action AuditAction `json:"action"`
+
+	// ActorId Нулевой идентификатор означает изменение, сделанное сервисом автоматически
+	ActorId openapi_types.UUID `json:"actorId"`
+
+	// After Состояние после изменения, отсутствует для удаленной сущности
+	After *map[string]interface{} `json:"after,omitempty"`
+
+	// Before Состояние до изменения, отсутствует для созданной сущности
+	Before     *map[string]interface{} `json:"before,omitempty"`
+	DateTime   time.Time               `json:"dateTime"`
+	EntityId   openapi_types.UUID      `json:"entityId"`
+	EntityType AuditEntityType         `json:"entityType"`
+	Id         openapi_types.UUID      `json:"id"`
+	Protocol   AuditRecordProtocol     `json:"protocol"`
+
+	// RequestId Идентификатор запроса, совпадает с заголовком X-Request-Id ответа
+	RequestId string `json:"requestId"`
+}
+
+// AuditRecordProtocol defines model for AuditRecord.Protocol.
+type AuditRecordProtocol string
+
+// Barcode defines model for Barcode.
+type Barcode struct {
+	Format BarcodeFormat `json:"format"`
+	Value  string        `json:"value"`
+}
+
+// BarcodeFormat defines model for Barcode.Format.
+type BarcodeFormat string
+
+// CategoryDiscrepancy defines model for CategoryDiscrepancy.
+type CategoryDiscrepancy struct {
+	Accepted int             `json:"accepted"`
+	Expected int             `json:"expected"`
+	Type     ProductCategory `json:"type"`
+}
+
+// CategoryMismatch defines model for CategoryMismatch.
+type CategoryMismatch struct {
+	Accepted ProductCategory `json:"accepted"`
+	Barcode  string          `json:"barcode"`
+	Expected ProductCategory `json:"expected"`
+}
+
+// DiscrepancyReport defines model for DiscrepancyReport.
+type DiscrepancyReport struct {
+	// Categories Категории, принятые в другом количестве, чем ожидалось (учитываются и товары без штрихкода)
+	Categories []CategoryDiscrepancy `json:"categories"`
+
+	// CategoryMismatches Ожидаемые посылки, принятые с другой категорией
+	CategoryMismatches []CategoryMismatch `json:"categoryMismatches"`
+	DateTime           time.Time          `json:"dateTime"`
+
+	// Duplicates Отсканированы повторно, когда посылка уже была принята; такой товар не добавляется, каждый штрихкод указан один раз
+	Duplicates []string           `json:"duplicates"`
+	ManifestId openapi_types.UUID `json:"manifestId"`
+
+	// Missing Ожидались, но не приняты (штрихкод повторяется для каждой недостающей посылки)
+	Missing     []string           `json:"missing"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+
+	// Unexpected Приняты, но не ожидались
+	Unexpected []string `json:"unexpected"`
+
+	// UnidentifiedProducts Товары, принятые без штрихкода
+	UnidentifiedProducts int `json:"unidentifiedProducts"`
+}
 
 // Error defines model for Error.
 type Error struct {
+	// Errors Поля запроса, не прошедшие валидацию
+	Errors  *[]FieldError `json:"errors,omitempty"`
+	Message string        `json:"message"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	Field   string `json:"field"`
 	Message string `json:"message"`
 }
 
+// Invitation Одноразовое приглашение. Токен показывается только при создании, в сервисе хранится лишь его хеш
+type Invitation struct {
+	DateTime time.Time `json:"dateTime"`
+
+	// Email Если указан, приглашение принимается только при регистрации с этим email
+	Email     *openapi_types.Email `json:"email,omitempty"`
+	ExpiresAt time.Time            `json:"expiresAt"`
+	Id        openapi_types.UUID   `json:"id"`
+	Role      string               `json:"role"`
+	Token     string               `json:"token"`
+}
+
 // PVZ defines model for PVZ.
 type PVZ struct {
 	City             PVZCity             `json:"city"`
@@ -77,11 +293,30 @@ type PVZ struct {
 	RegistrationDate *time.Time          `json:"registrationDate,omitempty"`
 }
 
-// PVZCity defines model for PVZ.City.
+// PVZAssignment Назначение сотрудника на ПВЗ. Сотрудник открывает приемки и принимает товары только на ПВЗ, где у него есть действующее назначение
+type PVZAssignment struct {
+	AssignedBy openapi_types.UUID `json:"assignedBy"`
+	DateTime   time.Time          `json:"dateTime"`
+	Id         openapi_types.UUID `json:"id"`
+	PvzId      openapi_types.UUID `json:"pvzId"`
+	UserId     openapi_types.UUID `json:"userId"`
+
+	// ValidFrom Начало действия, без него назначение действует сразу
+	ValidFrom *time.Time `json:"validFrom,omitempty"`
+
+	// ValidTo Окончание действия (не включая), без него назначение бессрочное
+	ValidTo *time.Time `json:"validTo,omitempty"`
+}
+
+// PVZCity defines model for PVZCity.
 type PVZCity string
 
+// Permission Действие, разрешенное роли. pvz:any позволяет работать на любом ПВЗ без назначения
+type Permission string
+
 // Product defines model for Product.
 type Product struct {
+	Barcode     *Barcode            `json:"barcode,omitempty"`
 	DateTime    *time.Time          `json:"dateTime,omitempty"`
 	Id          *openapi_types.UUID `json:"id,omitempty"`
 	ReceptionId openapi_types.UUID  `json:"receptionId"`
@@ -91,6 +326,9 @@ type Product struct {
 // ProductType defines model for Product.Type.
 type ProductType string
 
+// ProductCategory defines model for ProductCategory.
+type ProductCategory string
+
 // Reception defines model for Reception.
 type Reception struct {
 	DateTime time.Time           `json:"dateTime"`
@@ -102,18 +340,165 @@ type Reception struct {
 // ReceptionStatus defines model for Reception.Status.
 type ReceptionStatus string
 
+// ReceptionHistoryEntry defines model for ReceptionHistoryEntry.
+type ReceptionHistoryEntry struct {
+	// Action flagged - приемка давно не менялась и помечена забытой, но не закрыта;
+	// product_restored - возвращен последний удаленный товар;
+	// manifest_linked - к приемке привязан манифест поставки
+	Action ReceptionHistoryEntryAction `json:"action"`
+
+	// ActorId Нулевой идентификатор означает, что шаг сделан сервисом автоматически
+	ActorId  openapi_types.UUID `json:"actorId"`
+	DateTime time.Time          `json:"dateTime"`
+	Id       openapi_types.UUID `json:"id"`
+
+	// Reason Причина, обязательна для повторного открытия, automatic для автоматического закрытия забытой приемки
+	Reason      *string            `json:"reason,omitempty"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
+// ReceptionHistoryEntryAction flagged - приемка давно не менялась и помечена забытой, но не закрыта;
+// product_restored - возвращен последний удаленный товар;
+// manifest_linked - к приемке привязан манифест поставки
+type ReceptionHistoryEntryAction string
+
+// RecoveryCodes defines model for RecoveryCodes.
+type RecoveryCodes struct {
+	// RecoveryCodes Одноразовые резервные коды на случай потери приложения-аутентификатора
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// Role Роль пользователя, составленная из разрешений
+type Role struct {
+	Id          int          `json:"id"`
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions"`
+
+	// TwoFactorRequired Пользователи роли не могут ничего делать, пока не подключат двухфакторную аутентификацию, и не могут ее отключить
+	TwoFactorRequired bool `json:"twoFactorRequired"`
+}
+
+// RoleRequest defines model for RoleRequest.
+type RoleRequest struct {
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions"`
+
+	// TwoFactorRequired Требовать двухфакторную аутентификацию от пользователей роли, если не указано, требование не меняется (у новой роли не требуется)
+	TwoFactorRequired *bool `json:"twoFactorRequired,omitempty"`
+}
+
+// ShipmentManifest defines model for ShipmentManifest.
+type ShipmentManifest struct {
+	DateTime time.Time              `json:"dateTime"`
+	Id       openapi_types.UUID     `json:"id"`
+	Items    []ShipmentManifestItem `json:"items"`
+	PvzId    openapi_types.UUID     `json:"pvzId"`
+
+	// ReceptionId Приемка, к которой привязан манифест, отсутствует до привязки
+	ReceptionId *openapi_types.UUID `json:"receptionId,omitempty"`
+}
+
+// ShipmentManifestItem defines model for ShipmentManifestItem.
+type ShipmentManifestItem struct {
+	Barcode string          `json:"barcode"`
+	Type    ProductCategory `json:"type"`
+}
+
 // Token defines model for Token.
 type Token = string
 
+// TwoFactorChallenge Пароль верен, но пользователь использует двухфакторную аутентификацию. Токен выдается на втором шаге /login/two_factor
+type TwoFactorChallenge struct {
+	Challenge string    `json:"challenge"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// TwoFactorEnrollment defines model for TwoFactorEnrollment.
+type TwoFactorEnrollment struct {
+	// ProvisioningUri otpauth:// URI для QR-кода приложения-аутентификатора
+	ProvisioningUri string `json:"provisioningUri"`
+
+	// Secret Секрет TOTP в base32 для ручного ввода
+	Secret string `json:"secret"`
+}
+
 // User defines model for User.
 type User struct {
-	Email openapi_types.Email `json:"email"`
-	Id    *openapi_types.UUID `json:"id,omitempty"`
-	Role  UserRole            `json:"role"`
+	// Active Отключенный пользователь не может войти и пользоваться выданными токенами
+	Active bool                `json:"active"`
+	Email  openapi_types.Email `json:"email"`
+
+	// EmailVerified Пользователь подтвердил email токеном из письма
+	EmailVerified bool                `json:"emailVerified"`
+	Id            *openapi_types.UUID `json:"id,omitempty"`
+
+	// Role Название роли пользователя (employee, moderator, admin или роль, созданная администратором)
+	Role string `json:"role"`
+
+	// ServiceAccount Сервисный аккаунт интеграции, работает только по API-ключам
+	ServiceAccount bool `json:"serviceAccount"`
+
+	// Sso Пользователь входит через корпоративный провайдер, пароля у него нет
+	Sso bool `json:"sso"`
+
+	// TwoFactorEnabled Пользователь подключил двухфакторную аутентификацию
+	TwoFactorEnabled bool `json:"twoFactorEnabled"`
+}
+
+// WebhookDeliveryAttempt defines model for WebhookDeliveryAttempt.
+type WebhookDeliveryAttempt struct {
+	// Attempt Номер попытки доставки события
+	Attempt   int                `json:"attempt"`
+	DateTime  time.Time          `json:"dateTime"`
+	Delivered bool               `json:"delivered"`
+	Error     *string            `json:"error,omitempty"`
+	EventId   openapi_types.UUID `json:"eventId"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// StatusCode Код ответа подписчика, 0 если ответ не получен
+	StatusCode int `json:"statusCode"`
+}
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookSubscription Подписка на события ПВЗ. Тело запроса подписывается HMAC-SHA256 секретом подписки, подпись передается в заголовке X-Signature-256 в виде sha256=<hex>
+type WebhookSubscription struct {
+	City       *PVZCity           `json:"city,omitempty"`
+	DateTime   time.Time          `json:"dateTime"`
+	EventTypes []WebhookEventType `json:"eventTypes"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// PvzId События только этого ПВЗ
+	PvzId *openapi_types.UUID `json:"pvzId,omitempty"`
+	Url   string              `json:"url"`
 }
 
-// UserRole defines model for User.Role.
-type UserRole string
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = Error
+
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	// ActorId Пользователь, выполнивший изменение
+	ActorId    *openapi_types.UUID `form:"actorId,omitempty" json:"actorId,omitempty"`
+	Action     *AuditAction        `form:"action,omitempty" json:"action,omitempty"`
+	EntityType *AuditEntityType    `form:"entityType,omitempty" json:"entityType,omitempty"`
+
+	// EntityId Измененная сущность
+	EntityId *openapi_types.UUID `form:"entityId,omitempty" json:"entityId,omitempty"`
+
+	// StartDate Начальная дата диапазона
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
 
 // PostDummyLoginJSONBody defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBody struct {
@@ -123,16 +508,51 @@ type PostDummyLoginJSONBody struct {
 // PostDummyLoginJSONBodyRole defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBodyRole string
 
+// PostEmailVerificationConfirmJSONBody defines parameters for PostEmailVerificationConfirm.
+type PostEmailVerificationConfirmJSONBody struct {
+	Token string `json:"token"`
+}
+
+// PostInvitationsJSONBody defines parameters for PostInvitations.
+type PostInvitationsJSONBody struct {
+	Email *openapi_types.Email `json:"email,omitempty"`
+	Role  string               `json:"role"`
+}
+
 // PostLoginJSONBody defines parameters for PostLogin.
 type PostLoginJSONBody struct {
 	Email    openapi_types.Email `json:"email"`
 	Password string              `json:"password"`
 }
 
+// PostLoginTwoFactorJSONBody defines parameters for PostLoginTwoFactor.
+type PostLoginTwoFactorJSONBody struct {
+	Challenge string `json:"challenge"`
+	Code      string `json:"code"`
+}
+
+// PostPasswordResetJSONBody defines parameters for PostPasswordReset.
+type PostPasswordResetJSONBody struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// PostPasswordResetConfirmJSONBody defines parameters for PostPasswordResetConfirm.
+type PostPasswordResetConfirmJSONBody struct {
+	// Password Проверяется парольной политикой из конфига (длина, виды символов, список утекших паролей)
+	Password string `json:"password"`
+	Token    string `json:"token"`
+}
+
+// GetProductsParams defines parameters for GetProducts.
+type GetProductsParams struct {
+	Barcode string `form:"barcode" json:"barcode"`
+}
+
 // PostProductsJSONBody defines parameters for PostProducts.
 type PostProductsJSONBody struct {
-	PvzId openapi_types.UUID       `json:"pvzId"`
-	Type  PostProductsJSONBodyType `json:"type"`
+	Barcode *Barcode                 `json:"barcode,omitempty"`
+	PvzId   openapi_types.UUID       `json:"pvzId"`
+	Type    PostProductsJSONBodyType `json:"type"`
 }
 
 // PostProductsJSONBodyType defines parameters for PostProducts.
@@ -153,47 +573,239 @@ type GetPvzParams struct {
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// PostPvzPvzIdAssignmentsJSONBody defines parameters for PostPvzPvzIdAssignments.
+type PostPvzPvzIdAssignmentsJSONBody struct {
+	UserId    openapi_types.UUID `json:"userId"`
+	ValidFrom *time.Time         `json:"validFrom,omitempty"`
+	ValidTo   *time.Time         `json:"validTo,omitempty"`
+}
+
+// PostPvzPvzIdManifestsJSONBody defines parameters for PostPvzPvzIdManifests.
+type PostPvzPvzIdManifestsJSONBody struct {
+	Items []ShipmentManifestItem `json:"items"`
+}
+
 // PostReceptionsJSONBody defines parameters for PostReceptions.
 type PostReceptionsJSONBody struct {
 	PvzId openapi_types.UUID `json:"pvzId"`
 }
 
+// GetReceptionsStreamParams defines parameters for GetReceptionsStream.
+type GetReceptionsStreamParams struct {
+	// PvzId События этого ПВЗ, указывается pvzId или city
+	PvzId *openapi_types.UUID `form:"pvzId,omitempty" json:"pvzId,omitempty"`
+
+	// City События всех ПВЗ города, указывается pvzId или city
+	City *PVZCity `form:"city,omitempty" json:"city,omitempty"`
+}
+
+// PostReceptionsReceptionIdManifestJSONBody defines parameters for PostReceptionsReceptionIdManifest.
+type PostReceptionsReceptionIdManifestJSONBody struct {
+	ManifestId openapi_types.UUID `json:"manifestId"`
+}
+
+// PostReceptionsReceptionIdReopenJSONBody defines parameters for PostReceptionsReceptionIdReopen.
+type PostReceptionsReceptionIdReopenJSONBody struct {
+	// Reason Причина повторного открытия
+	Reason string `json:"reason"`
+}
+
 // PostRegisterJSONBody defines parameters for PostRegister.
 type PostRegisterJSONBody struct {
-	Email    openapi_types.Email      `json:"email"`
-	Password string                   `json:"password"`
-	Role     PostRegisterJSONBodyRole `json:"role"`
+	Email openapi_types.Email `json:"email"`
+
+	// InviteToken Токен одноразового приглашения, регистрирует пользователя с ролью из приглашения
+	InviteToken *string `json:"inviteToken,omitempty"`
+
+	// Password Проверяется парольной политикой из конфига (длина, виды символов, список утекших паролей)
+	Password string `json:"password"`
+}
+
+// PostServiceAccountsJSONBody defines parameters for PostServiceAccounts.
+type PostServiceAccountsJSONBody struct {
+	// Email Идентифицирует интеграцию, писем на него не отправляется
+	Email openapi_types.Email `json:"email"`
+	Role  string              `json:"role"`
+}
+
+// PostServiceAccountsUserIdApiKeysJSONBody defines parameters for PostServiceAccountsUserIdApiKeys.
+type PostServiceAccountsUserIdApiKeysJSONBody struct {
+	Name  string       `json:"name"`
+	Scope []Permission `json:"scope"`
+}
+
+// GetSsoCallbackParams defines parameters for GetSsoCallback.
+type GetSsoCallbackParams struct {
+	// Code Код авторизации провайдера
+	Code *string `form:"code,omitempty" json:"code,omitempty"`
+
+	// State Состояние, выданное при перенаправлении на провайдера
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Error Ошибка провайдера вместо кода
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+
+	// SsoState Хеш состояния, выданный браузеру при перенаправлении на провайдера, вход, начатый в другом браузере, не завершается
+	SsoState *string `form:"sso_state,omitempty" json:"sso_state,omitempty"`
+}
+
+// PostTwoFactorConfirmJSONBody defines parameters for PostTwoFactorConfirm.
+type PostTwoFactorConfirmJSONBody struct {
+	Code string `json:"code"`
+}
+
+// PostTwoFactorDisableJSONBody defines parameters for PostTwoFactorDisable.
+type PostTwoFactorDisableJSONBody struct {
+	Code string `json:"code"`
+}
+
+// GetUsersParams defines parameters for GetUsers.
+type GetUsersParams struct {
+	// Email Часть email, регистр не учитывается
+	Email *string `form:"email,omitempty" json:"email,omitempty"`
+
+	// Role Название роли
+	Role *string `form:"role,omitempty" json:"role,omitempty"`
+
+	// Active Только активные или только отключенные пользователи
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PatchUsersUserIdJSONBody defines parameters for PatchUsersUserId.
+type PatchUsersUserIdJSONBody struct {
+	Active *bool   `json:"active,omitempty"`
+	Role   *string `json:"role,omitempty"`
+}
+
+// PutUsersUserIdRoleJSONBody defines parameters for PutUsersUserIdRole.
+type PutUsersUserIdRoleJSONBody struct {
+	Role string `json:"role"`
+}
+
+// PostWebhooksJSONBody defines parameters for PostWebhooks.
+type PostWebhooksJSONBody struct {
+	City       *PVZCity            `json:"city,omitempty"`
+	EventTypes []WebhookEventType  `json:"eventTypes"`
+	PvzId      *openapi_types.UUID `json:"pvzId,omitempty"`
+	Secret     string              `json:"secret"`
+	Url        string              `json:"url"`
 }
 
-// PostRegisterJSONBodyRole defines parameters for PostRegister.
-type PostRegisterJSONBodyRole string
+// GetWebhooksSubscriptionIdDeliveriesParams defines parameters for GetWebhooksSubscriptionIdDeliveries.
+type GetWebhooksSubscriptionIdDeliveriesParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
 
 // PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
 type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
 
+// PostEmailVerificationConfirmJSONRequestBody defines body for PostEmailVerificationConfirm for application/json ContentType.
+type PostEmailVerificationConfirmJSONRequestBody PostEmailVerificationConfirmJSONBody
+
+// PostInvitationsJSONRequestBody defines body for PostInvitations for application/json ContentType.
+type PostInvitationsJSONRequestBody PostInvitationsJSONBody
+
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody PostLoginJSONBody
 
+// PostLoginTwoFactorJSONRequestBody defines body for PostLoginTwoFactor for application/json ContentType.
+type PostLoginTwoFactorJSONRequestBody PostLoginTwoFactorJSONBody
+
+// PostPasswordResetJSONRequestBody defines body for PostPasswordReset for application/json ContentType.
+type PostPasswordResetJSONRequestBody PostPasswordResetJSONBody
+
+// PostPasswordResetConfirmJSONRequestBody defines body for PostPasswordResetConfirm for application/json ContentType.
+type PostPasswordResetConfirmJSONRequestBody PostPasswordResetConfirmJSONBody
+
 // PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
 type PostProductsJSONRequestBody PostProductsJSONBody
 
 // PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
 type PostPvzJSONRequestBody = PVZ
 
+// PostPvzPvzIdAssignmentsJSONRequestBody defines body for PostPvzPvzIdAssignments for application/json ContentType.
+type PostPvzPvzIdAssignmentsJSONRequestBody PostPvzPvzIdAssignmentsJSONBody
+
+// PostPvzPvzIdManifestsJSONRequestBody defines body for PostPvzPvzIdManifests for application/json ContentType.
+type PostPvzPvzIdManifestsJSONRequestBody PostPvzPvzIdManifestsJSONBody
+
 // PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
 type PostReceptionsJSONRequestBody PostReceptionsJSONBody
 
+// PostReceptionsReceptionIdManifestJSONRequestBody defines body for PostReceptionsReceptionIdManifest for application/json ContentType.
+type PostReceptionsReceptionIdManifestJSONRequestBody PostReceptionsReceptionIdManifestJSONBody
+
+// PostReceptionsReceptionIdReopenJSONRequestBody defines body for PostReceptionsReceptionIdReopen for application/json ContentType.
+type PostReceptionsReceptionIdReopenJSONRequestBody PostReceptionsReceptionIdReopenJSONBody
+
 // PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
 type PostRegisterJSONRequestBody PostRegisterJSONBody
 
+// PostRolesJSONRequestBody defines body for PostRoles for application/json ContentType.
+type PostRolesJSONRequestBody = RoleRequest
+
+// PutRolesRoleIdJSONRequestBody defines body for PutRolesRoleId for application/json ContentType.
+type PutRolesRoleIdJSONRequestBody = RoleRequest
+
+// PostServiceAccountsJSONRequestBody defines body for PostServiceAccounts for application/json ContentType.
+type PostServiceAccountsJSONRequestBody PostServiceAccountsJSONBody
+
+// PostServiceAccountsUserIdApiKeysJSONRequestBody defines body for PostServiceAccountsUserIdApiKeys for application/json ContentType.
+type PostServiceAccountsUserIdApiKeysJSONRequestBody PostServiceAccountsUserIdApiKeysJSONBody
+
+// PostTwoFactorConfirmJSONRequestBody defines body for PostTwoFactorConfirm for application/json ContentType.
+type PostTwoFactorConfirmJSONRequestBody PostTwoFactorConfirmJSONBody
+
+// PostTwoFactorDisableJSONRequestBody defines body for PostTwoFactorDisable for application/json ContentType.
+type PostTwoFactorDisableJSONRequestBody PostTwoFactorDisableJSONBody
+
+// PatchUsersUserIdJSONRequestBody defines body for PatchUsersUserId for application/json ContentType.
+type PatchUsersUserIdJSONRequestBody PatchUsersUserIdJSONBody
+
+// PutUsersUserIdRoleJSONRequestBody defines body for PutUsersUserIdRole for application/json ContentType.
+type PutUsersUserIdRoleJSONRequestBody PutUsersUserIdRoleJSONBody
+
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody PostWebhooksJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Журнал изменений с фильтрацией и пагинацией (только для модераторов)
+	// (GET /audit)
+	GetAudit(ctx echo.Context, params GetAuditParams) error
 	// Получение тестового токена
 	// (POST /dummyLogin)
 	PostDummyLogin(ctx echo.Context) error
+	// Отправка письма для подтверждения email текущего пользователя
+	// (POST /email_verification)
+	PostEmailVerification(ctx echo.Context) error
+	// Подтверждение email по токену из письма
+	// (POST /email_verification/confirm)
+	PostEmailVerificationConfirm(ctx echo.Context) error
+	// Приглашение пользователя с ролью (разрешение user:invite, можно выдать только роль, все разрешения которой есть у приглашающего)
+	// (POST /invitations)
+	PostInvitations(ctx echo.Context) error
 	// Авторизация пользователя
 	// (POST /login)
 	PostLogin(ctx echo.Context) error
+	// Второй шаг авторизации пользователя с двухфакторной аутентификацией
+	// (POST /login/two_factor)
+	PostLoginTwoFactor(ctx echo.Context) error
+	// Запрос сброса пароля
+	// (POST /password_reset)
+	PostPasswordReset(ctx echo.Context) error
+	// Установка нового пароля по токену из письма
+	// (POST /password_reset/confirm)
+	PostPasswordResetConfirm(ctx echo.Context) error
+	// Поиск товаров по штрихкоду во всех приемках
+	// (GET /products)
+	GetProducts(ctx echo.Context, params GetProductsParams) error
 	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
 	// (POST /products)
 	PostProducts(ctx echo.Context) error
@@ -203,18 +815,108 @@ type ServerInterface interface {
 	// Создание ПВЗ (только для модераторов)
 	// (POST /pvz)
 	PostPvz(ctx echo.Context) error
+	// Назначения сотрудников на ПВЗ, включая истекшие и будущие (только для модераторов)
+	// (GET /pvz/{pvzId}/assignments)
+	GetPvzPvzIdAssignments(ctx echo.Context, pvzId openapi_types.UUID) error
+	// Назначение сотрудника на ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/assignments)
+	PostPvzPvzIdAssignments(ctx echo.Context, pvzId openapi_types.UUID) error
+	// Снятие сотрудника с ПВЗ (только для модераторов)
+	// (DELETE /pvz/{pvzId}/assignments/{assignmentId})
+	DeletePvzPvzIdAssignmentsAssignmentId(ctx echo.Context, pvzId openapi_types.UUID, assignmentId openapi_types.UUID) error
 	// Закрытие последней открытой приемки товаров в рамках ПВЗ
 	// (POST /pvz/{pvzId}/close_last_reception)
 	PostPvzPvzIdCloseLastReception(ctx echo.Context, pvzId openapi_types.UUID) error
 	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
 	// (POST /pvz/{pvzId}/delete_last_product)
 	PostPvzPvzIdDeleteLastProduct(ctx echo.Context, pvzId openapi_types.UUID) error
+	// Загрузка манифеста ожидаемой поставки в ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/manifests)
+	PostPvzPvzIdManifests(ctx echo.Context, pvzId openapi_types.UUID) error
+	// Отмена последнего удаления товара из текущей приемки (только для сотрудников ПВЗ, пока приемка не закрыта)
+	// (POST /pvz/{pvzId}/restore_last_product)
+	PostPvzPvzIdRestoreLastProduct(ctx echo.Context, pvzId openapi_types.UUID) error
 	// Создание новой приемки товаров (только для сотрудников ПВЗ)
 	// (POST /receptions)
 	PostReceptions(ctx echo.Context) error
+	// Поток событий приемок ПВЗ или города (Server-Sent Events)
+	// (GET /receptions/stream)
+	GetReceptionsStream(ctx echo.Context, params GetReceptionsStreamParams) error
+	// Акт приемки в PDF, формируется при закрытии приемки
+	// (GET /receptions/{receptionId}/act)
+	GetReceptionsReceptionIdAct(ctx echo.Context, receptionId openapi_types.UUID) error
+	// Отчет о расхождениях приемки с манифестом, формируется при закрытии приемки
+	// (GET /receptions/{receptionId}/discrepancies)
+	GetReceptionsReceptionIdDiscrepancies(ctx echo.Context, receptionId openapi_types.UUID) error
+	// История открытий и закрытий приемки
+	// (GET /receptions/{receptionId}/history)
+	GetReceptionsReceptionIdHistory(ctx echo.Context, receptionId openapi_types.UUID) error
+	// Привязка загруженного манифеста поставки к приемке (только для модераторов)
+	// (POST /receptions/{receptionId}/manifest)
+	PostReceptionsReceptionIdManifest(ctx echo.Context, receptionId openapi_types.UUID) error
+	// Повторное открытие закрытой приемки (только для модераторов ПВЗ)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(ctx echo.Context, receptionId openapi_types.UUID) error
 	// Регистрация пользователя
 	// (POST /register)
 	PostRegister(ctx echo.Context) error
+	// Роли с их разрешениями (только для администраторов)
+	// (GET /roles)
+	GetRoles(ctx echo.Context) error
+	// Создание роли (только для администраторов)
+	// (POST /roles)
+	PostRoles(ctx echo.Context) error
+	// Изменение названия и разрешений роли (только для администраторов, роль admin не меняется)
+	// (PUT /roles/{roleId})
+	PutRolesRoleId(ctx echo.Context, roleId int) error
+	// Создание сервисного аккаунта для интеграции (разрешение service_account:manage)
+	// (POST /service_accounts)
+	PostServiceAccounts(ctx echo.Context) error
+	// API-ключи сервисного аккаунта, включая отозванные (разрешение service_account:manage)
+	// (GET /service_accounts/{userId}/api_keys)
+	GetServiceAccountsUserIdApiKeys(ctx echo.Context, userId openapi_types.UUID) error
+	// Выпуск API-ключа сервисного аккаунта (разрешение service_account:manage)
+	// (POST /service_accounts/{userId}/api_keys)
+	PostServiceAccountsUserIdApiKeys(ctx echo.Context, userId openapi_types.UUID) error
+	// Отзыв API-ключа (разрешение service_account:manage)
+	// (DELETE /service_accounts/{userId}/api_keys/{keyId})
+	DeleteServiceAccountsUserIdApiKeysKeyId(ctx echo.Context, userId openapi_types.UUID, keyId openapi_types.UUID) error
+	// Завершение входа через корпоративный провайдер
+	// (GET /sso/callback)
+	GetSsoCallback(ctx echo.Context, params GetSsoCallbackParams) error
+	// Вход сотрудника через корпоративный провайдер (OpenID Connect)
+	// (GET /sso/login)
+	GetSsoLogin(ctx echo.Context) error
+	// Подтверждение подключения двухфакторной аутентификации первым кодом
+	// (POST /two_factor/confirm)
+	PostTwoFactorConfirm(ctx echo.Context) error
+	// Отключение двухфакторной аутентификации текущего пользователя
+	// (POST /two_factor/disable)
+	PostTwoFactorDisable(ctx echo.Context) error
+	// Начало подключения двухфакторной аутентификации текущего пользователя
+	// (POST /two_factor/enroll)
+	PostTwoFactorEnroll(ctx echo.Context) error
+	// Список пользователей с фильтрацией и пагинацией (разрешение user:manage)
+	// (GET /users)
+	GetUsers(ctx echo.Context, params GetUsersParams) error
+	// Изменение роли и активности пользователя
+	// (PATCH /users/{userId})
+	PatchUsersUserId(ctx echo.Context, userId openapi_types.UUID) error
+	// Повышение или понижение роли пользователя (разрешение user:promote)
+	// (PUT /users/{userId}/role)
+	PutUsersUserIdRole(ctx echo.Context, userId openapi_types.UUID) error
+	// Список подписок на события (только для модераторов)
+	// (GET /webhooks)
+	GetWebhooks(ctx echo.Context) error
+	// Создание подписки на события ПВЗ (только для модераторов)
+	// (POST /webhooks)
+	PostWebhooks(ctx echo.Context) error
+	// Удаление подписки вместе с историей доставок (только для модераторов)
+	// (DELETE /webhooks/{subscriptionId})
+	DeleteWebhooksSubscriptionId(ctx echo.Context, subscriptionId openapi_types.UUID) error
+	// Последние попытки доставки событий подписчику (только для модераторов)
+	// (GET /webhooks/{subscriptionId}/deliveries)
+	GetWebhooksSubscriptionIdDeliveries(ctx echo.Context, subscriptionId openapi_types.UUID, params GetWebhooksSubscriptionIdDeliveriesParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -222,43 +924,42 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// PostDummyLogin converts echo context to params.
-func (w *ServerInterfaceWrapper) PostDummyLogin(ctx echo.Context) error {
+// GetAudit converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAudit(ctx echo.Context) error {
 	var err error
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostDummyLogin(ctx)
-	return err
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-// PostLogin converts echo context to params.
-func (w *ServerInterfaceWrapper) PostLogin(ctx echo.Context) error {
-	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditParams
+	// ------------- Optional query parameter "actorId" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostLogin(ctx)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "actorId", ctx.QueryParams(), &params.ActorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actorId: %s", err))
+	}
 
-// PostProducts converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProducts(ctx echo.Context) error {
-	var err error
+	// ------------- Optional query parameter "action" -------------
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "action", ctx.QueryParams(), &params.Action)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProducts(ctx)
-	return err
-}
+	// ------------- Optional query parameter "entityType" -------------
 
-// GetPvz converts echo context to params.
-func (w *ServerInterfaceWrapper) GetPvz(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "entityType", ctx.QueryParams(), &params.EntityType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityType: %s", err))
+	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "entityId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entityId", ctx.QueryParams(), &params.EntityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityId: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPvzParams
 	// ------------- Optional query parameter "startDate" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "startDate", ctx.QueryParams(), &params.StartDate)
@@ -288,678 +989,3980 @@ func (w *ServerInterfaceWrapper) GetPvz(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPvz(ctx, params)
+	err = w.Handler.GetAudit(ctx, params)
 	return err
 }
 
-// PostPvz converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPvz(ctx echo.Context) error {
+// PostDummyLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) PostDummyLogin(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BearerAuthScopes, []string{})
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPvz(ctx)
+	err = w.Handler.PostDummyLogin(ctx)
 	return err
 }
 
-// PostPvzPvzIdCloseLastReception converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(ctx echo.Context) error {
+// PostEmailVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) PostEmailVerification(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", ctx.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
-	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPvzPvzIdCloseLastReception(ctx, pvzId)
+	err = w.Handler.PostEmailVerification(ctx)
 	return err
 }
 
-// PostPvzPvzIdDeleteLastProduct converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(ctx echo.Context) error {
+// PostEmailVerificationConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostEmailVerificationConfirm(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", ctx.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
-	}
-
-	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPvzPvzIdDeleteLastProduct(ctx, pvzId)
+	err = w.Handler.PostEmailVerificationConfirm(ctx)
 	return err
 }
 
-// PostReceptions converts echo context to params.
-func (w *ServerInterfaceWrapper) PostReceptions(ctx echo.Context) error {
+// PostInvitations converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInvitations(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostReceptions(ctx)
+	err = w.Handler.PostInvitations(ctx)
 	return err
 }
 
-// PostRegister converts echo context to params.
-func (w *ServerInterfaceWrapper) PostRegister(ctx echo.Context) error {
+// PostLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLogin(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostRegister(ctx)
+	err = w.Handler.PostLogin(ctx)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+// PostLoginTwoFactor converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLoginTwoFactor(ctx echo.Context) error {
+	var err error
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLoginTwoFactor(ctx)
+	return err
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
-
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
-
-	router.POST(baseURL+"/dummyLogin", wrapper.PostDummyLogin)
-	router.POST(baseURL+"/login", wrapper.PostLogin)
-	router.POST(baseURL+"/products", wrapper.PostProducts)
-	router.GET(baseURL+"/pvz", wrapper.GetPvz)
-	router.POST(baseURL+"/pvz", wrapper.PostPvz)
-	router.POST(baseURL+"/pvz/:pvzId/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
-	router.POST(baseURL+"/pvz/:pvzId/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
-	router.POST(baseURL+"/receptions", wrapper.PostReceptions)
-	router.POST(baseURL+"/register", wrapper.PostRegister)
+// PostPasswordReset converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPasswordReset(ctx echo.Context) error {
+	var err error
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPasswordReset(ctx)
+	return err
 }
 
-type PostDummyLoginRequestObject struct {
-	Body *PostDummyLoginJSONRequestBody
-}
+// PostPasswordResetConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPasswordResetConfirm(ctx echo.Context) error {
+	var err error
 
-type PostDummyLoginResponseObject interface {
-	VisitPostDummyLoginResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPasswordResetConfirm(ctx)
+	return err
 }
 
-type PostDummyLogin200JSONResponse Token
-
-func (response PostDummyLogin200JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetProducts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProducts(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostDummyLogin400JSONResponse Error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductsParams
+	// ------------- Required query parameter "barcode" -------------
 
-func (response PostDummyLogin400JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, true, "barcode", ctx.QueryParams(), &params.Barcode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter barcode: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProducts(ctx, params)
+	return err
 }
 
-type PostLoginRequestObject struct {
-	Body *PostLoginJSONRequestBody
-}
+// PostProducts converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProducts(ctx echo.Context) error {
+	var err error
 
-type PostLoginResponseObject interface {
-	VisitPostLoginResponse(w http.ResponseWriter) error
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProducts(ctx)
+	return err
 }
 
-type PostLogin200JSONResponse Token
+// GetPvz converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPvz(ctx echo.Context) error {
+	var err error
 
-func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzParams
+	// ------------- Optional query parameter "startDate" -------------
 
-type PostLogin401JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "startDate", ctx.QueryParams(), &params.StartDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter startDate: %s", err))
+	}
 
-func (response PostLogin401JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Optional query parameter "endDate" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "endDate", ctx.QueryParams(), &params.EndDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter endDate: %s", err))
+	}
 
-type PostProductsRequestObject struct {
-	Body *PostProductsJSONRequestBody
-}
+	// ------------- Optional query parameter "page" -------------
 
-type PostProductsResponseObject interface {
-	VisitPostProductsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
 
-type PostProducts201JSONResponse Product
+	// ------------- Optional query parameter "limit" -------------
 
-func (response PostProducts201JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPvz(ctx, params)
+	return err
 }
 
-type PostProducts400JSONResponse Error
+// PostPvz converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPvz(ctx echo.Context) error {
+	var err error
 
-func (response PostProducts400JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPvz(ctx)
+	return err
 }
 
-type PostProducts403JSONResponse Error
+// GetPvzPvzIdAssignments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPvzPvzIdAssignments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
 
-func (response PostProducts403JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", ctx.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetPvzRequestObject struct {
-	Params GetPvzParams
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPvzPvzIdAssignments(ctx, pvzId)
+	return err
 }
 
-type GetPvzResponseObject interface {
-	VisitGetPvzResponse(w http.ResponseWriter) error
-}
+// PostPvzPvzIdAssignments converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPvzPvzIdAssignments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
 
-type GetPvz200JSONResponse []struct {
-	Pvz        *PVZ `json:"pvz,omitempty"`
-	Receptions *[]struct {
-		Products  *[]Product `json:"products,omitempty"`
-		Reception *Reception `json:"reception,omitempty"`
-	} `json:"receptions,omitempty"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", ctx.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
 
-func (response GetPvz200JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPvzPvzIdAssignments(ctx, pvzId)
+	return err
 }
 
-type PostPvzRequestObject struct {
-	Body *PostPvzJSONRequestBody
-}
+// DeletePvzPvzIdAssignmentsAssignmentId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePvzPvzIdAssignmentsAssignmentId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
 
-type PostPvzResponseObject interface {
-	VisitPostPvzResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", ctx.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
 
-type PostPvz201JSONResponse PVZ
+	// ------------- Path parameter "assignmentId" -------------
+	var assignmentId openapi_types.UUID
 
-func (response PostPvz201JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindStyledParameterWithOptions("simple", "assignmentId", ctx.Param("assignmentId"), &assignmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignmentId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeletePvzPvzIdAssignmentsAssignmentId(ctx, pvzId, assignmentId)
+	return err
 }
 
-type PostPvz400JSONResponse Error
+// PostPvzPvzIdCloseLastReception converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
 
-func (response PostPvz400JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", ctx.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPvzPvzIdCloseLastReception(ctx, pvzId)
+	return err
 }
 
-type PostPvz403JSONResponse Error
+// PostPvzPvzIdDeleteLastProduct converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
 
-func (response PostPvz403JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", ctx.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPvzPvzIdDeleteLastProduct(ctx, pvzId)
+	return err
 }
 
-type PostPvzPvzIdCloseLastReceptionRequestObject struct {
+// PostPvzPvzIdManifests converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPvzPvzIdManifests(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", ctx.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPvzPvzIdManifests(ctx, pvzId)
+	return err
+}
+
+// PostPvzPvzIdRestoreLastProduct converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPvzPvzIdRestoreLastProduct(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", ctx.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPvzPvzIdRestoreLastProduct(ctx, pvzId)
+	return err
+}
+
+// PostReceptions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostReceptions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostReceptions(ctx)
+	return err
+}
+
+// GetReceptionsStream converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReceptionsStream(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReceptionsStreamParams
+	// ------------- Optional query parameter "pvzId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pvzId", ctx.QueryParams(), &params.PvzId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
+
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", ctx.QueryParams(), &params.City)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter city: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReceptionsStream(ctx, params)
+	return err
+}
+
+// GetReceptionsReceptionIdAct converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReceptionsReceptionIdAct(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", ctx.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter receptionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReceptionsReceptionIdAct(ctx, receptionId)
+	return err
+}
+
+// GetReceptionsReceptionIdDiscrepancies converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReceptionsReceptionIdDiscrepancies(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", ctx.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter receptionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReceptionsReceptionIdDiscrepancies(ctx, receptionId)
+	return err
+}
+
+// GetReceptionsReceptionIdHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReceptionsReceptionIdHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", ctx.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter receptionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReceptionsReceptionIdHistory(ctx, receptionId)
+	return err
+}
+
+// PostReceptionsReceptionIdManifest converts echo context to params.
+func (w *ServerInterfaceWrapper) PostReceptionsReceptionIdManifest(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", ctx.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter receptionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostReceptionsReceptionIdManifest(ctx, receptionId)
+	return err
+}
+
+// PostReceptionsReceptionIdReopen converts echo context to params.
+func (w *ServerInterfaceWrapper) PostReceptionsReceptionIdReopen(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", ctx.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter receptionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostReceptionsReceptionIdReopen(ctx, receptionId)
+	return err
+}
+
+// PostRegister converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRegister(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRegister(ctx)
+	return err
+}
+
+// GetRoles converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRoles(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRoles(ctx)
+	return err
+}
+
+// PostRoles converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRoles(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRoles(ctx)
+	return err
+}
+
+// PutRolesRoleId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutRolesRoleId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roleId" -------------
+	var roleId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roleId", ctx.Param("roleId"), &roleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roleId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutRolesRoleId(ctx, roleId)
+	return err
+}
+
+// PostServiceAccounts converts echo context to params.
+func (w *ServerInterfaceWrapper) PostServiceAccounts(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostServiceAccounts(ctx)
+	return err
+}
+
+// GetServiceAccountsUserIdApiKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) GetServiceAccountsUserIdApiKeys(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetServiceAccountsUserIdApiKeys(ctx, userId)
+	return err
+}
+
+// PostServiceAccountsUserIdApiKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) PostServiceAccountsUserIdApiKeys(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostServiceAccountsUserIdApiKeys(ctx, userId)
+	return err
+}
+
+// DeleteServiceAccountsUserIdApiKeysKeyId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteServiceAccountsUserIdApiKeysKeyId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	// ------------- Path parameter "keyId" -------------
+	var keyId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", ctx.Param("keyId"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keyId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteServiceAccountsUserIdApiKeysKeyId(ctx, userId, keyId)
+	return err
+}
+
+// GetSsoCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSsoCallback(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSsoCallbackParams
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", ctx.QueryParams(), &params.Code)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
+
+	// ------------- Optional query parameter "error" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error", ctx.QueryParams(), &params.Error)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter error: %s", err))
+	}
+
+	if cookie, err := ctx.Cookie("sso_state"); err == nil {
+
+		var value string
+		err = runtime.BindStyledParameterWithOptions("simple", "sso_state", cookie.Value, &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationCookie, Explode: true, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sso_state: %s", err))
+		}
+		params.SsoState = &value
+
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSsoCallback(ctx, params)
+	return err
+}
+
+// GetSsoLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSsoLogin(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSsoLogin(ctx)
+	return err
+}
+
+// PostTwoFactorConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTwoFactorConfirm(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTwoFactorConfirm(ctx)
+	return err
+}
+
+// PostTwoFactorDisable converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTwoFactorDisable(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTwoFactorDisable(ctx)
+	return err
+}
+
+// PostTwoFactorEnroll converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTwoFactorEnroll(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTwoFactorEnroll(ctx)
+	return err
+}
+
+// GetUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersParams
+	// ------------- Optional query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "email", ctx.QueryParams(), &params.Email)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter email: %s", err))
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", ctx.QueryParams(), &params.Role)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role: %s", err))
+	}
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", ctx.QueryParams(), &params.Active)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter active: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsers(ctx, params)
+	return err
+}
+
+// PatchUsersUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchUsersUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchUsersUserId(ctx, userId)
+	return err
+}
+
+// PutUsersUserIdRole converts echo context to params.
+func (w *ServerInterfaceWrapper) PutUsersUserIdRole(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutUsersUserIdRole(ctx, userId)
+	return err
+}
+
+// GetWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhooks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhooks(ctx)
+	return err
+}
+
+// PostWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooks(ctx)
+	return err
+}
+
+// DeleteWebhooksSubscriptionId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteWebhooksSubscriptionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", ctx.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subscriptionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteWebhooksSubscriptionId(ctx, subscriptionId)
+	return err
+}
+
+// GetWebhooksSubscriptionIdDeliveries converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhooksSubscriptionIdDeliveries(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", ctx.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subscriptionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksSubscriptionIdDeliveriesParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhooksSubscriptionIdDeliveries(ctx, subscriptionId, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/audit", wrapper.GetAudit)
+	router.POST(baseURL+"/dummyLogin", wrapper.PostDummyLogin)
+	router.POST(baseURL+"/email_verification", wrapper.PostEmailVerification)
+	router.POST(baseURL+"/email_verification/confirm", wrapper.PostEmailVerificationConfirm)
+	router.POST(baseURL+"/invitations", wrapper.PostInvitations)
+	router.POST(baseURL+"/login", wrapper.PostLogin)
+	router.POST(baseURL+"/login/two_factor", wrapper.PostLoginTwoFactor)
+	router.POST(baseURL+"/password_reset", wrapper.PostPasswordReset)
+	router.POST(baseURL+"/password_reset/confirm", wrapper.PostPasswordResetConfirm)
+	router.GET(baseURL+"/products", wrapper.GetProducts)
+	router.POST(baseURL+"/products", wrapper.PostProducts)
+	router.GET(baseURL+"/pvz", wrapper.GetPvz)
+	router.POST(baseURL+"/pvz", wrapper.PostPvz)
+	router.GET(baseURL+"/pvz/:pvzId/assignments", wrapper.GetPvzPvzIdAssignments)
+	router.POST(baseURL+"/pvz/:pvzId/assignments", wrapper.PostPvzPvzIdAssignments)
+	router.DELETE(baseURL+"/pvz/:pvzId/assignments/:assignmentId", wrapper.DeletePvzPvzIdAssignmentsAssignmentId)
+	router.POST(baseURL+"/pvz/:pvzId/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
+	router.POST(baseURL+"/pvz/:pvzId/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
+	router.POST(baseURL+"/pvz/:pvzId/manifests", wrapper.PostPvzPvzIdManifests)
+	router.POST(baseURL+"/pvz/:pvzId/restore_last_product", wrapper.PostPvzPvzIdRestoreLastProduct)
+	router.POST(baseURL+"/receptions", wrapper.PostReceptions)
+	router.GET(baseURL+"/receptions/stream", wrapper.GetReceptionsStream)
+	router.GET(baseURL+"/receptions/:receptionId/act", wrapper.GetReceptionsReceptionIdAct)
+	router.GET(baseURL+"/receptions/:receptionId/discrepancies", wrapper.GetReceptionsReceptionIdDiscrepancies)
+	router.GET(baseURL+"/receptions/:receptionId/history", wrapper.GetReceptionsReceptionIdHistory)
+	router.POST(baseURL+"/receptions/:receptionId/manifest", wrapper.PostReceptionsReceptionIdManifest)
+	router.POST(baseURL+"/receptions/:receptionId/reopen", wrapper.PostReceptionsReceptionIdReopen)
+	router.POST(baseURL+"/register", wrapper.PostRegister)
+	router.GET(baseURL+"/roles", wrapper.GetRoles)
+	router.POST(baseURL+"/roles", wrapper.PostRoles)
+	router.PUT(baseURL+"/roles/:roleId", wrapper.PutRolesRoleId)
+	router.POST(baseURL+"/service_accounts", wrapper.PostServiceAccounts)
+	router.GET(baseURL+"/service_accounts/:userId/api_keys", wrapper.GetServiceAccountsUserIdApiKeys)
+	router.POST(baseURL+"/service_accounts/:userId/api_keys", wrapper.PostServiceAccountsUserIdApiKeys)
+	router.DELETE(baseURL+"/service_accounts/:userId/api_keys/:keyId", wrapper.DeleteServiceAccountsUserIdApiKeysKeyId)
+	router.GET(baseURL+"/sso/callback", wrapper.GetSsoCallback)
+	router.GET(baseURL+"/sso/login", wrapper.GetSsoLogin)
+	router.POST(baseURL+"/two_factor/confirm", wrapper.PostTwoFactorConfirm)
+	router.POST(baseURL+"/two_factor/disable", wrapper.PostTwoFactorDisable)
+	router.POST(baseURL+"/two_factor/enroll", wrapper.PostTwoFactorEnroll)
+	router.GET(baseURL+"/users", wrapper.GetUsers)
+	router.PATCH(baseURL+"/users/:userId", wrapper.PatchUsersUserId)
+	router.PUT(baseURL+"/users/:userId/role", wrapper.PutUsersUserIdRole)
+	router.GET(baseURL+"/webhooks", wrapper.GetWebhooks)
+	router.POST(baseURL+"/webhooks", wrapper.PostWebhooks)
+	router.DELETE(baseURL+"/webhooks/:subscriptionId", wrapper.DeleteWebhooksSubscriptionId)
+	router.GET(baseURL+"/webhooks/:subscriptionId/deliveries", wrapper.GetWebhooksSubscriptionIdDeliveries)
+
+}
+
+type TooManyRequestsResponseHeaders struct {
+	RetryAfter int
+}
+type TooManyRequestsJSONResponse struct {
+	Body Error
+
+	Headers TooManyRequestsResponseHeaders
+}
+
+type GetAuditRequestObject struct {
+	Params GetAuditParams
+}
+
+type GetAuditResponseObject interface {
+	VisitGetAuditResponse(w http.ResponseWriter) error
+}
+
+type GetAudit200JSONResponse []AuditRecord
+
+func (response GetAudit200JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAudit400JSONResponse Error
+
+func (response GetAudit400JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAudit403JSONResponse Error
+
+func (response GetAudit403JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLoginRequestObject struct {
+	Body *PostDummyLoginJSONRequestBody
+}
+
+type PostDummyLoginResponseObject interface {
+	VisitPostDummyLoginResponse(w http.ResponseWriter) error
+}
+
+type PostDummyLogin200JSONResponse Token
+
+func (response PostDummyLogin200JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLogin400JSONResponse Error
+
+func (response PostDummyLogin400JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLogin403JSONResponse Error
+
+func (response PostDummyLogin403JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostEmailVerificationRequestObject struct {
+}
+
+type PostEmailVerificationResponseObject interface {
+	VisitPostEmailVerificationResponse(w http.ResponseWriter) error
+}
+
+type PostEmailVerification202Response struct {
+}
+
+func (response PostEmailVerification202Response) VisitPostEmailVerificationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type PostEmailVerification400JSONResponse Error
+
+func (response PostEmailVerification400JSONResponse) VisitPostEmailVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostEmailVerification403JSONResponse Error
+
+func (response PostEmailVerification403JSONResponse) VisitPostEmailVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostEmailVerificationConfirmRequestObject struct {
+	Body *PostEmailVerificationConfirmJSONRequestBody
+}
+
+type PostEmailVerificationConfirmResponseObject interface {
+	VisitPostEmailVerificationConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostEmailVerificationConfirm204Response struct {
+}
+
+func (response PostEmailVerificationConfirm204Response) VisitPostEmailVerificationConfirmResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostEmailVerificationConfirm400JSONResponse Error
+
+func (response PostEmailVerificationConfirm400JSONResponse) VisitPostEmailVerificationConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostInvitationsRequestObject struct {
+	Body *PostInvitationsJSONRequestBody
+}
+
+type PostInvitationsResponseObject interface {
+	VisitPostInvitationsResponse(w http.ResponseWriter) error
+}
+
+type PostInvitations201JSONResponse Invitation
+
+func (response PostInvitations201JSONResponse) VisitPostInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostInvitations400JSONResponse Error
+
+func (response PostInvitations400JSONResponse) VisitPostInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostInvitations403JSONResponse Error
+
+func (response PostInvitations403JSONResponse) VisitPostInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginRequestObject struct {
+	Body *PostLoginJSONRequestBody
+}
+
+type PostLoginResponseObject interface {
+	VisitPostLoginResponse(w http.ResponseWriter) error
+}
+
+type PostLogin200JSONResponse Token
+
+func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin202JSONResponse TwoFactorChallenge
+
+func (response PostLogin202JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin401JSONResponse Error
+
+func (response PostLogin401JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostLogin429JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostLoginTwoFactorRequestObject struct {
+	Body *PostLoginTwoFactorJSONRequestBody
+}
+
+type PostLoginTwoFactorResponseObject interface {
+	VisitPostLoginTwoFactorResponse(w http.ResponseWriter) error
+}
+
+type PostLoginTwoFactor200JSONResponse Token
+
+func (response PostLoginTwoFactor200JSONResponse) VisitPostLoginTwoFactorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginTwoFactor401JSONResponse Error
+
+func (response PostLoginTwoFactor401JSONResponse) VisitPostLoginTwoFactorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginTwoFactor429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostLoginTwoFactor429JSONResponse) VisitPostLoginTwoFactorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPasswordResetRequestObject struct {
+	Body *PostPasswordResetJSONRequestBody
+}
+
+type PostPasswordResetResponseObject interface {
+	VisitPostPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type PostPasswordReset202Response struct {
+}
+
+func (response PostPasswordReset202Response) VisitPostPasswordResetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type PostPasswordReset400JSONResponse Error
+
+func (response PostPasswordReset400JSONResponse) VisitPostPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPasswordReset429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostPasswordReset429JSONResponse) VisitPostPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPasswordResetConfirmRequestObject struct {
+	Body *PostPasswordResetConfirmJSONRequestBody
+}
+
+type PostPasswordResetConfirmResponseObject interface {
+	VisitPostPasswordResetConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostPasswordResetConfirm204Response struct {
+}
+
+func (response PostPasswordResetConfirm204Response) VisitPostPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostPasswordResetConfirm400JSONResponse Error
+
+func (response PostPasswordResetConfirm400JSONResponse) VisitPostPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPasswordResetConfirm429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostPasswordResetConfirm429JSONResponse) VisitPostPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetProductsRequestObject struct {
+	Params GetProductsParams
+}
+
+type GetProductsResponseObject interface {
+	VisitGetProductsResponse(w http.ResponseWriter) error
+}
+
+type GetProducts200JSONResponse []Product
+
+func (response GetProducts200JSONResponse) VisitGetProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProducts400JSONResponse Error
+
+func (response GetProducts400JSONResponse) VisitGetProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProducts403JSONResponse Error
+
+func (response GetProducts403JSONResponse) VisitGetProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsRequestObject struct {
+	Body *PostProductsJSONRequestBody
+}
+
+type PostProductsResponseObject interface {
+	VisitPostProductsResponse(w http.ResponseWriter) error
+}
+
+type PostProducts201JSONResponse Product
+
+func (response PostProducts201JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts400JSONResponse Error
+
+func (response PostProducts400JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts403JSONResponse Error
+
+func (response PostProducts403JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzRequestObject struct {
+	Params GetPvzParams
+}
+
+type GetPvzResponseObject interface {
+	VisitGetPvzResponse(w http.ResponseWriter) error
+}
+
+type GetPvz200JSONResponse []struct {
+	Pvz        *PVZ `json:"pvz,omitempty"`
+	Receptions *[]struct {
+		Products  *[]Product `json:"products,omitempty"`
+		Reception *Reception `json:"reception,omitempty"`
+	} `json:"receptions,omitempty"`
+}
+
+func (response GetPvz200JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvz400JSONResponse Error
+
+func (response GetPvz400JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzRequestObject struct {
+	Body *PostPvzJSONRequestBody
+}
+
+type PostPvzResponseObject interface {
+	VisitPostPvzResponse(w http.ResponseWriter) error
+}
+
+type PostPvz201JSONResponse PVZ
+
+func (response PostPvz201JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz400JSONResponse Error
+
+func (response PostPvz400JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz403JSONResponse Error
+
+func (response PostPvz403JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdAssignmentsRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type GetPvzPvzIdAssignmentsResponseObject interface {
+	VisitGetPvzPvzIdAssignmentsResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzIdAssignments200JSONResponse []PVZAssignment
+
+func (response GetPvzPvzIdAssignments200JSONResponse) VisitGetPvzPvzIdAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdAssignments400JSONResponse Error
+
+func (response GetPvzPvzIdAssignments400JSONResponse) VisitGetPvzPvzIdAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdAssignments403JSONResponse Error
+
+func (response GetPvzPvzIdAssignments403JSONResponse) VisitGetPvzPvzIdAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdAssignmentsRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PostPvzPvzIdAssignmentsJSONRequestBody
+}
+
+type PostPvzPvzIdAssignmentsResponseObject interface {
+	VisitPostPvzPvzIdAssignmentsResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdAssignments201JSONResponse PVZAssignment
+
+func (response PostPvzPvzIdAssignments201JSONResponse) VisitPostPvzPvzIdAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdAssignments400JSONResponse Error
+
+func (response PostPvzPvzIdAssignments400JSONResponse) VisitPostPvzPvzIdAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdAssignments403JSONResponse Error
+
+func (response PostPvzPvzIdAssignments403JSONResponse) VisitPostPvzPvzIdAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePvzPvzIdAssignmentsAssignmentIdRequestObject struct {
+	PvzId        openapi_types.UUID `json:"pvzId"`
+	AssignmentId openapi_types.UUID `json:"assignmentId"`
+}
+
+type DeletePvzPvzIdAssignmentsAssignmentIdResponseObject interface {
+	VisitDeletePvzPvzIdAssignmentsAssignmentIdResponse(w http.ResponseWriter) error
+}
+
+type DeletePvzPvzIdAssignmentsAssignmentId204Response struct {
+}
+
+func (response DeletePvzPvzIdAssignmentsAssignmentId204Response) VisitDeletePvzPvzIdAssignmentsAssignmentIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePvzPvzIdAssignmentsAssignmentId400JSONResponse Error
+
+func (response DeletePvzPvzIdAssignmentsAssignmentId400JSONResponse) VisitDeletePvzPvzIdAssignmentsAssignmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePvzPvzIdAssignmentsAssignmentId403JSONResponse Error
+
+func (response DeletePvzPvzIdAssignmentsAssignmentId403JSONResponse) VisitDeletePvzPvzIdAssignmentsAssignmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReceptionRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type PostPvzPvzIdCloseLastReceptionResponseObject interface {
+	VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdCloseLastReception200JSONResponse Reception
+
+func (response PostPvzPvzIdCloseLastReception200JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception400JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception400JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception403JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception403JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProductRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type PostPvzPvzIdDeleteLastProductResponseObject interface {
+	VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdDeleteLastProduct200Response struct {
+}
+
+func (response PostPvzPvzIdDeleteLastProduct200Response) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostPvzPvzIdDeleteLastProduct400JSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct400JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProduct403JSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct403JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdManifestsRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PostPvzPvzIdManifestsJSONRequestBody
+}
+
+type PostPvzPvzIdManifestsResponseObject interface {
+	VisitPostPvzPvzIdManifestsResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdManifests201JSONResponse ShipmentManifest
+
+func (response PostPvzPvzIdManifests201JSONResponse) VisitPostPvzPvzIdManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdManifests400JSONResponse Error
+
+func (response PostPvzPvzIdManifests400JSONResponse) VisitPostPvzPvzIdManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdManifests403JSONResponse Error
+
+func (response PostPvzPvzIdManifests403JSONResponse) VisitPostPvzPvzIdManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdRestoreLastProductRequestObject struct {
 	PvzId openapi_types.UUID `json:"pvzId"`
 }
 
-type PostPvzPvzIdCloseLastReceptionResponseObject interface {
-	VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error
+type PostPvzPvzIdRestoreLastProductResponseObject interface {
+	VisitPostPvzPvzIdRestoreLastProductResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdRestoreLastProduct200JSONResponse Product
+
+func (response PostPvzPvzIdRestoreLastProduct200JSONResponse) VisitPostPvzPvzIdRestoreLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdRestoreLastProduct400JSONResponse Error
+
+func (response PostPvzPvzIdRestoreLastProduct400JSONResponse) VisitPostPvzPvzIdRestoreLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdRestoreLastProduct403JSONResponse Error
+
+func (response PostPvzPvzIdRestoreLastProduct403JSONResponse) VisitPostPvzPvzIdRestoreLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsRequestObject struct {
+	Body *PostReceptionsJSONRequestBody
+}
+
+type PostReceptionsResponseObject interface {
+	VisitPostReceptionsResponse(w http.ResponseWriter) error
+}
+
+type PostReceptions201JSONResponse Reception
+
+func (response PostReceptions201JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions400JSONResponse Error
+
+func (response PostReceptions400JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions403JSONResponse Error
+
+func (response PostReceptions403JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsStreamRequestObject struct {
+	Params GetReceptionsStreamParams
+}
+
+type GetReceptionsStreamResponseObject interface {
+	VisitGetReceptionsStreamResponse(w http.ResponseWriter) error
+}
+
+type GetReceptionsStream200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetReceptionsStream200TexteventStreamResponse) VisitGetReceptionsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetReceptionsStream400JSONResponse Error
+
+func (response GetReceptionsStream400JSONResponse) VisitGetReceptionsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsStream403JSONResponse Error
+
+func (response GetReceptionsStream403JSONResponse) VisitGetReceptionsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdActRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
+type GetReceptionsReceptionIdActResponseObject interface {
+	VisitGetReceptionsReceptionIdActResponse(w http.ResponseWriter) error
+}
+
+type GetReceptionsReceptionIdAct200ApplicationpdfResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetReceptionsReceptionIdAct200ApplicationpdfResponse) VisitGetReceptionsReceptionIdActResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/pdf")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetReceptionsReceptionIdAct400JSONResponse Error
+
+func (response GetReceptionsReceptionIdAct400JSONResponse) VisitGetReceptionsReceptionIdActResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdAct403JSONResponse Error
+
+func (response GetReceptionsReceptionIdAct403JSONResponse) VisitGetReceptionsReceptionIdActResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdDiscrepanciesRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
+type GetReceptionsReceptionIdDiscrepanciesResponseObject interface {
+	VisitGetReceptionsReceptionIdDiscrepanciesResponse(w http.ResponseWriter) error
+}
+
+type GetReceptionsReceptionIdDiscrepancies200JSONResponse DiscrepancyReport
+
+func (response GetReceptionsReceptionIdDiscrepancies200JSONResponse) VisitGetReceptionsReceptionIdDiscrepanciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdDiscrepancies400JSONResponse Error
+
+func (response GetReceptionsReceptionIdDiscrepancies400JSONResponse) VisitGetReceptionsReceptionIdDiscrepanciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdDiscrepancies403JSONResponse Error
+
+func (response GetReceptionsReceptionIdDiscrepancies403JSONResponse) VisitGetReceptionsReceptionIdDiscrepanciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdHistoryRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
+type GetReceptionsReceptionIdHistoryResponseObject interface {
+	VisitGetReceptionsReceptionIdHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetReceptionsReceptionIdHistory200JSONResponse []ReceptionHistoryEntry
+
+func (response GetReceptionsReceptionIdHistory200JSONResponse) VisitGetReceptionsReceptionIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdHistory400JSONResponse Error
+
+func (response GetReceptionsReceptionIdHistory400JSONResponse) VisitGetReceptionsReceptionIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdHistory403JSONResponse Error
+
+func (response GetReceptionsReceptionIdHistory403JSONResponse) VisitGetReceptionsReceptionIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdManifestRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Body        *PostReceptionsReceptionIdManifestJSONRequestBody
+}
+
+type PostReceptionsReceptionIdManifestResponseObject interface {
+	VisitPostReceptionsReceptionIdManifestResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdManifest200JSONResponse ShipmentManifest
+
+func (response PostReceptionsReceptionIdManifest200JSONResponse) VisitPostReceptionsReceptionIdManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdManifest400JSONResponse Error
+
+func (response PostReceptionsReceptionIdManifest400JSONResponse) VisitPostReceptionsReceptionIdManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdManifest403JSONResponse Error
+
+func (response PostReceptionsReceptionIdManifest403JSONResponse) VisitPostReceptionsReceptionIdManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopenRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Body        *PostReceptionsReceptionIdReopenJSONRequestBody
+}
+
+type PostReceptionsReceptionIdReopenResponseObject interface {
+	VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdReopen200JSONResponse Reception
+
+func (response PostReceptionsReceptionIdReopen200JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen400JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen400JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen403JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen403JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegisterRequestObject struct {
+	Body *PostRegisterJSONRequestBody
+}
+
+type PostRegisterResponseObject interface {
+	VisitPostRegisterResponse(w http.ResponseWriter) error
+}
+
+type PostRegister201JSONResponse User
+
+func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister400JSONResponse Error
+
+func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostRegister429JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetRolesRequestObject struct {
+}
+
+type GetRolesResponseObject interface {
+	VisitGetRolesResponse(w http.ResponseWriter) error
+}
+
+type GetRoles200JSONResponse []Role
+
+func (response GetRoles200JSONResponse) VisitGetRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoles403JSONResponse Error
+
+func (response GetRoles403JSONResponse) VisitGetRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRolesRequestObject struct {
+	Body *PostRolesJSONRequestBody
+}
+
+type PostRolesResponseObject interface {
+	VisitPostRolesResponse(w http.ResponseWriter) error
+}
+
+type PostRoles201JSONResponse Role
+
+func (response PostRoles201JSONResponse) VisitPostRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRoles400JSONResponse Error
+
+func (response PostRoles400JSONResponse) VisitPostRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRoles403JSONResponse Error
+
+func (response PostRoles403JSONResponse) VisitPostRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutRolesRoleIdRequestObject struct {
+	RoleId int `json:"roleId"`
+	Body   *PutRolesRoleIdJSONRequestBody
+}
+
+type PutRolesRoleIdResponseObject interface {
+	VisitPutRolesRoleIdResponse(w http.ResponseWriter) error
+}
+
+type PutRolesRoleId200JSONResponse Role
+
+func (response PutRolesRoleId200JSONResponse) VisitPutRolesRoleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutRolesRoleId400JSONResponse Error
+
+func (response PutRolesRoleId400JSONResponse) VisitPutRolesRoleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutRolesRoleId403JSONResponse Error
+
+func (response PutRolesRoleId403JSONResponse) VisitPutRolesRoleIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServiceAccountsRequestObject struct {
+	Body *PostServiceAccountsJSONRequestBody
+}
+
+type PostServiceAccountsResponseObject interface {
+	VisitPostServiceAccountsResponse(w http.ResponseWriter) error
+}
+
+type PostServiceAccounts201JSONResponse User
+
+func (response PostServiceAccounts201JSONResponse) VisitPostServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServiceAccounts400JSONResponse Error
+
+func (response PostServiceAccounts400JSONResponse) VisitPostServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServiceAccounts403JSONResponse Error
+
+func (response PostServiceAccounts403JSONResponse) VisitPostServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServiceAccountsUserIdApiKeysRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type GetServiceAccountsUserIdApiKeysResponseObject interface {
+	VisitGetServiceAccountsUserIdApiKeysResponse(w http.ResponseWriter) error
+}
+
+type GetServiceAccountsUserIdApiKeys200JSONResponse []ApiKey
+
+func (response GetServiceAccountsUserIdApiKeys200JSONResponse) VisitGetServiceAccountsUserIdApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServiceAccountsUserIdApiKeys400JSONResponse Error
+
+func (response GetServiceAccountsUserIdApiKeys400JSONResponse) VisitGetServiceAccountsUserIdApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServiceAccountsUserIdApiKeys403JSONResponse Error
+
+func (response GetServiceAccountsUserIdApiKeys403JSONResponse) VisitGetServiceAccountsUserIdApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServiceAccountsUserIdApiKeysRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	Body   *PostServiceAccountsUserIdApiKeysJSONRequestBody
+}
+
+type PostServiceAccountsUserIdApiKeysResponseObject interface {
+	VisitPostServiceAccountsUserIdApiKeysResponse(w http.ResponseWriter) error
+}
+
+type PostServiceAccountsUserIdApiKeys201JSONResponse ApiKey
+
+func (response PostServiceAccountsUserIdApiKeys201JSONResponse) VisitPostServiceAccountsUserIdApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServiceAccountsUserIdApiKeys400JSONResponse Error
+
+func (response PostServiceAccountsUserIdApiKeys400JSONResponse) VisitPostServiceAccountsUserIdApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServiceAccountsUserIdApiKeys403JSONResponse Error
+
+func (response PostServiceAccountsUserIdApiKeys403JSONResponse) VisitPostServiceAccountsUserIdApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServiceAccountsUserIdApiKeysKeyIdRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	KeyId  openapi_types.UUID `json:"keyId"`
+}
+
+type DeleteServiceAccountsUserIdApiKeysKeyIdResponseObject interface {
+	VisitDeleteServiceAccountsUserIdApiKeysKeyIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteServiceAccountsUserIdApiKeysKeyId204Response struct {
+}
+
+func (response DeleteServiceAccountsUserIdApiKeysKeyId204Response) VisitDeleteServiceAccountsUserIdApiKeysKeyIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteServiceAccountsUserIdApiKeysKeyId400JSONResponse Error
+
+func (response DeleteServiceAccountsUserIdApiKeysKeyId400JSONResponse) VisitDeleteServiceAccountsUserIdApiKeysKeyIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServiceAccountsUserIdApiKeysKeyId403JSONResponse Error
+
+func (response DeleteServiceAccountsUserIdApiKeysKeyId403JSONResponse) VisitDeleteServiceAccountsUserIdApiKeysKeyIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSsoCallbackRequestObject struct {
+	Params GetSsoCallbackParams
+}
+
+type GetSsoCallbackResponseObject interface {
+	VisitGetSsoCallbackResponse(w http.ResponseWriter) error
+}
+
+type GetSsoCallback200JSONResponse Token
+
+func (response GetSsoCallback200JSONResponse) VisitGetSsoCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSsoCallback400JSONResponse Error
+
+func (response GetSsoCallback400JSONResponse) VisitGetSsoCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSsoCallback403JSONResponse Error
+
+func (response GetSsoCallback403JSONResponse) VisitGetSsoCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSsoCallback404JSONResponse Error
+
+func (response GetSsoCallback404JSONResponse) VisitGetSsoCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSsoCallback429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetSsoCallback429JSONResponse) VisitGetSsoCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSsoLoginRequestObject struct {
+}
+
+type GetSsoLoginResponseObject interface {
+	VisitGetSsoLoginResponse(w http.ResponseWriter) error
+}
+
+type GetSsoLogin302ResponseHeaders struct {
+	Location  string
+	SetCookie string
+}
+
+type GetSsoLogin302Response struct {
+	Headers GetSsoLogin302ResponseHeaders
+}
+
+func (response GetSsoLogin302Response) VisitGetSsoLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(302)
+	return nil
+}
+
+type GetSsoLogin404JSONResponse Error
+
+func (response GetSsoLogin404JSONResponse) VisitGetSsoLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSsoLogin429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetSsoLogin429JSONResponse) VisitGetSsoLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTwoFactorConfirmRequestObject struct {
+	Body *PostTwoFactorConfirmJSONRequestBody
+}
+
+type PostTwoFactorConfirmResponseObject interface {
+	VisitPostTwoFactorConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostTwoFactorConfirm200JSONResponse RecoveryCodes
+
+func (response PostTwoFactorConfirm200JSONResponse) VisitPostTwoFactorConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTwoFactorConfirm400JSONResponse Error
+
+func (response PostTwoFactorConfirm400JSONResponse) VisitPostTwoFactorConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTwoFactorConfirm403JSONResponse Error
+
+func (response PostTwoFactorConfirm403JSONResponse) VisitPostTwoFactorConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTwoFactorDisableRequestObject struct {
+	Body *PostTwoFactorDisableJSONRequestBody
+}
+
+type PostTwoFactorDisableResponseObject interface {
+	VisitPostTwoFactorDisableResponse(w http.ResponseWriter) error
+}
+
+type PostTwoFactorDisable204Response struct {
+}
+
+func (response PostTwoFactorDisable204Response) VisitPostTwoFactorDisableResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostTwoFactorDisable400JSONResponse Error
+
+func (response PostTwoFactorDisable400JSONResponse) VisitPostTwoFactorDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTwoFactorDisable403JSONResponse Error
+
+func (response PostTwoFactorDisable403JSONResponse) VisitPostTwoFactorDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTwoFactorEnrollRequestObject struct {
+}
+
+type PostTwoFactorEnrollResponseObject interface {
+	VisitPostTwoFactorEnrollResponse(w http.ResponseWriter) error
+}
+
+type PostTwoFactorEnroll200JSONResponse TwoFactorEnrollment
+
+func (response PostTwoFactorEnroll200JSONResponse) VisitPostTwoFactorEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTwoFactorEnroll400JSONResponse Error
+
+func (response PostTwoFactorEnroll400JSONResponse) VisitPostTwoFactorEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTwoFactorEnroll403JSONResponse Error
+
+func (response PostTwoFactorEnroll403JSONResponse) VisitPostTwoFactorEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersRequestObject struct {
+	Params GetUsersParams
+}
+
+type GetUsersResponseObject interface {
+	VisitGetUsersResponse(w http.ResponseWriter) error
+}
+
+type GetUsers200JSONResponse []User
+
+func (response GetUsers200JSONResponse) VisitGetUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsers400JSONResponse Error
+
+func (response GetUsers400JSONResponse) VisitGetUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsers403JSONResponse Error
+
+func (response GetUsers403JSONResponse) VisitGetUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserIdRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	Body   *PatchUsersUserIdJSONRequestBody
+}
+
+type PatchUsersUserIdResponseObject interface {
+	VisitPatchUsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type PatchUsersUserId200JSONResponse User
+
+func (response PatchUsersUserId200JSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserId400JSONResponse Error
+
+func (response PatchUsersUserId400JSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserId403JSONResponse Error
+
+func (response PatchUsersUserId403JSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdRoleRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	Body   *PutUsersUserIdRoleJSONRequestBody
+}
+
+type PutUsersUserIdRoleResponseObject interface {
+	VisitPutUsersUserIdRoleResponse(w http.ResponseWriter) error
+}
+
+type PutUsersUserIdRole200JSONResponse User
+
+func (response PutUsersUserIdRole200JSONResponse) VisitPutUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdRole400JSONResponse Error
+
+func (response PutUsersUserIdRole400JSONResponse) VisitPutUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdRole403JSONResponse Error
+
+func (response PutUsersUserIdRole403JSONResponse) VisitPutUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksRequestObject struct {
+}
+
+type GetWebhooksResponseObject interface {
+	VisitGetWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooks200JSONResponse []WebhookSubscription
+
+func (response GetWebhooks200JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks403JSONResponse Error
+
+func (response GetWebhooks403JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksRequestObject struct {
+	Body *PostWebhooksJSONRequestBody
+}
+
+type PostWebhooksResponseObject interface {
+	VisitPostWebhooksResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooks201JSONResponse WebhookSubscription
+
+func (response PostWebhooks201JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks400JSONResponse Error
+
+func (response PostWebhooks400JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks403JSONResponse Error
+
+func (response PostWebhooks403JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhooksSubscriptionIdRequestObject struct {
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+}
+
+type DeleteWebhooksSubscriptionIdResponseObject interface {
+	VisitDeleteWebhooksSubscriptionIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhooksSubscriptionId204Response struct {
+}
+
+func (response DeleteWebhooksSubscriptionId204Response) VisitDeleteWebhooksSubscriptionIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhooksSubscriptionId400JSONResponse Error
+
+func (response DeleteWebhooksSubscriptionId400JSONResponse) VisitDeleteWebhooksSubscriptionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhooksSubscriptionId403JSONResponse Error
+
+func (response DeleteWebhooksSubscriptionId403JSONResponse) VisitDeleteWebhooksSubscriptionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksSubscriptionIdDeliveriesRequestObject struct {
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+	Params         GetWebhooksSubscriptionIdDeliveriesParams
+}
+
+type GetWebhooksSubscriptionIdDeliveriesResponseObject interface {
+	VisitGetWebhooksSubscriptionIdDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooksSubscriptionIdDeliveries200JSONResponse []WebhookDeliveryAttempt
+
+func (response GetWebhooksSubscriptionIdDeliveries200JSONResponse) VisitGetWebhooksSubscriptionIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksSubscriptionIdDeliveries400JSONResponse Error
+
+func (response GetWebhooksSubscriptionIdDeliveries400JSONResponse) VisitGetWebhooksSubscriptionIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksSubscriptionIdDeliveries403JSONResponse Error
+
+func (response GetWebhooksSubscriptionIdDeliveries403JSONResponse) VisitGetWebhooksSubscriptionIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Журнал изменений с фильтрацией и пагинацией (только для модераторов)
+	// (GET /audit)
+	GetAudit(ctx context.Context, request GetAuditRequestObject) (GetAuditResponseObject, error)
+	// Получение тестового токена
+	// (POST /dummyLogin)
+	PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)
+	// Отправка письма для подтверждения email текущего пользователя
+	// (POST /email_verification)
+	PostEmailVerification(ctx context.Context, request PostEmailVerificationRequestObject) (PostEmailVerificationResponseObject, error)
+	// Подтверждение email по токену из письма
+	// (POST /email_verification/confirm)
+	PostEmailVerificationConfirm(ctx context.Context, request PostEmailVerificationConfirmRequestObject) (PostEmailVerificationConfirmResponseObject, error)
+	// Приглашение пользователя с ролью (разрешение user:invite, можно выдать только роль, все разрешения которой есть у приглашающего)
+	// (POST /invitations)
+	PostInvitations(ctx context.Context, request PostInvitationsRequestObject) (PostInvitationsResponseObject, error)
+	// Авторизация пользователя
+	// (POST /login)
+	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
+	// Второй шаг авторизации пользователя с двухфакторной аутентификацией
+	// (POST /login/two_factor)
+	PostLoginTwoFactor(ctx context.Context, request PostLoginTwoFactorRequestObject) (PostLoginTwoFactorResponseObject, error)
+	// Запрос сброса пароля
+	// (POST /password_reset)
+	PostPasswordReset(ctx context.Context, request PostPasswordResetRequestObject) (PostPasswordResetResponseObject, error)
+	// Установка нового пароля по токену из письма
+	// (POST /password_reset/confirm)
+	PostPasswordResetConfirm(ctx context.Context, request PostPasswordResetConfirmRequestObject) (PostPasswordResetConfirmResponseObject, error)
+	// Поиск товаров по штрихкоду во всех приемках
+	// (GET /products)
+	GetProducts(ctx context.Context, request GetProductsRequestObject) (GetProductsResponseObject, error)
+	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products)
+	PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)
+	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
+	// (GET /pvz)
+	GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)
+	// Создание ПВЗ (только для модераторов)
+	// (POST /pvz)
+	PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)
+	// Назначения сотрудников на ПВЗ, включая истекшие и будущие (только для модераторов)
+	// (GET /pvz/{pvzId}/assignments)
+	GetPvzPvzIdAssignments(ctx context.Context, request GetPvzPvzIdAssignmentsRequestObject) (GetPvzPvzIdAssignmentsResponseObject, error)
+	// Назначение сотрудника на ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/assignments)
+	PostPvzPvzIdAssignments(ctx context.Context, request PostPvzPvzIdAssignmentsRequestObject) (PostPvzPvzIdAssignmentsResponseObject, error)
+	// Снятие сотрудника с ПВЗ (только для модераторов)
+	// (DELETE /pvz/{pvzId}/assignments/{assignmentId})
+	DeletePvzPvzIdAssignmentsAssignmentId(ctx context.Context, request DeletePvzPvzIdAssignmentsAssignmentIdRequestObject) (DeletePvzPvzIdAssignmentsAssignmentIdResponseObject, error)
+	// Закрытие последней открытой приемки товаров в рамках ПВЗ
+	// (POST /pvz/{pvzId}/close_last_reception)
+	PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)
+	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/delete_last_product)
+	PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)
+	// Загрузка манифеста ожидаемой поставки в ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/manifests)
+	PostPvzPvzIdManifests(ctx context.Context, request PostPvzPvzIdManifestsRequestObject) (PostPvzPvzIdManifestsResponseObject, error)
+	// Отмена последнего удаления товара из текущей приемки (только для сотрудников ПВЗ, пока приемка не закрыта)
+	// (POST /pvz/{pvzId}/restore_last_product)
+	PostPvzPvzIdRestoreLastProduct(ctx context.Context, request PostPvzPvzIdRestoreLastProductRequestObject) (PostPvzPvzIdRestoreLastProductResponseObject, error)
+	// Создание новой приемки товаров (только для сотрудников ПВЗ)
+	// (POST /receptions)
+	PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)
+	// Поток событий приемок ПВЗ или города (Server-Sent Events)
+	// (GET /receptions/stream)
+	GetReceptionsStream(ctx context.Context, request GetReceptionsStreamRequestObject) (GetReceptionsStreamResponseObject, error)
+	// Акт приемки в PDF, формируется при закрытии приемки
+	// (GET /receptions/{receptionId}/act)
+	GetReceptionsReceptionIdAct(ctx context.Context, request GetReceptionsReceptionIdActRequestObject) (GetReceptionsReceptionIdActResponseObject, error)
+	// Отчет о расхождениях приемки с манифестом, формируется при закрытии приемки
+	// (GET /receptions/{receptionId}/discrepancies)
+	GetReceptionsReceptionIdDiscrepancies(ctx context.Context, request GetReceptionsReceptionIdDiscrepanciesRequestObject) (GetReceptionsReceptionIdDiscrepanciesResponseObject, error)
+	// История открытий и закрытий приемки
+	// (GET /receptions/{receptionId}/history)
+	GetReceptionsReceptionIdHistory(ctx context.Context, request GetReceptionsReceptionIdHistoryRequestObject) (GetReceptionsReceptionIdHistoryResponseObject, error)
+	// Привязка загруженного манифеста поставки к приемке (только для модераторов)
+	// (POST /receptions/{receptionId}/manifest)
+	PostReceptionsReceptionIdManifest(ctx context.Context, request PostReceptionsReceptionIdManifestRequestObject) (PostReceptionsReceptionIdManifestResponseObject, error)
+	// Повторное открытие закрытой приемки (только для модераторов ПВЗ)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(ctx context.Context, request PostReceptionsReceptionIdReopenRequestObject) (PostReceptionsReceptionIdReopenResponseObject, error)
+	// Регистрация пользователя
+	// (POST /register)
+	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
+	// Роли с их разрешениями (только для администраторов)
+	// (GET /roles)
+	GetRoles(ctx context.Context, request GetRolesRequestObject) (GetRolesResponseObject, error)
+	// Создание роли (только для администраторов)
+	// (POST /roles)
+	PostRoles(ctx context.Context, request PostRolesRequestObject) (PostRolesResponseObject, error)
+	// Изменение названия и разрешений роли (только для администраторов, роль admin не меняется)
+	// (PUT /roles/{roleId})
+	PutRolesRoleId(ctx context.Context, request PutRolesRoleIdRequestObject) (PutRolesRoleIdResponseObject, error)
+	// Создание сервисного аккаунта для интеграции (разрешение service_account:manage)
+	// (POST /service_accounts)
+	PostServiceAccounts(ctx context.Context, request PostServiceAccountsRequestObject) (PostServiceAccountsResponseObject, error)
+	// API-ключи сервисного аккаунта, включая отозванные (разрешение service_account:manage)
+	// (GET /service_accounts/{userId}/api_keys)
+	GetServiceAccountsUserIdApiKeys(ctx context.Context, request GetServiceAccountsUserIdApiKeysRequestObject) (GetServiceAccountsUserIdApiKeysResponseObject, error)
+	// Выпуск API-ключа сервисного аккаунта (разрешение service_account:manage)
+	// (POST /service_accounts/{userId}/api_keys)
+	PostServiceAccountsUserIdApiKeys(ctx context.Context, request PostServiceAccountsUserIdApiKeysRequestObject) (PostServiceAccountsUserIdApiKeysResponseObject, error)
+	// Отзыв API-ключа (разрешение service_account:manage)
+	// (DELETE /service_accounts/{userId}/api_keys/{keyId})
+	DeleteServiceAccountsUserIdApiKeysKeyId(ctx context.Context, request DeleteServiceAccountsUserIdApiKeysKeyIdRequestObject) (DeleteServiceAccountsUserIdApiKeysKeyIdResponseObject, error)
+	// Завершение входа через корпоративный провайдер
+	// (GET /sso/callback)
+	GetSsoCallback(ctx context.Context, request GetSsoCallbackRequestObject) (GetSsoCallbackResponseObject, error)
+	// Вход сотрудника через корпоративный провайдер (OpenID Connect)
+	// (GET /sso/login)
+	GetSsoLogin(ctx context.Context, request GetSsoLoginRequestObject) (GetSsoLoginResponseObject, error)
+	// Подтверждение подключения двухфакторной аутентификации первым кодом
+	// (POST /two_factor/confirm)
+	PostTwoFactorConfirm(ctx context.Context, request PostTwoFactorConfirmRequestObject) (PostTwoFactorConfirmResponseObject, error)
+	// Отключение двухфакторной аутентификации текущего пользователя
+	// (POST /two_factor/disable)
+	PostTwoFactorDisable(ctx context.Context, request PostTwoFactorDisableRequestObject) (PostTwoFactorDisableResponseObject, error)
+	// Начало подключения двухфакторной аутентификации текущего пользователя
+	// (POST /two_factor/enroll)
+	PostTwoFactorEnroll(ctx context.Context, request PostTwoFactorEnrollRequestObject) (PostTwoFactorEnrollResponseObject, error)
+	// Список пользователей с фильтрацией и пагинацией (разрешение user:manage)
+	// (GET /users)
+	GetUsers(ctx context.Context, request GetUsersRequestObject) (GetUsersResponseObject, error)
+	// Изменение роли и активности пользователя
+	// (PATCH /users/{userId})
+	PatchUsersUserId(ctx context.Context, request PatchUsersUserIdRequestObject) (PatchUsersUserIdResponseObject, error)
+	// Повышение или понижение роли пользователя (разрешение user:promote)
+	// (PUT /users/{userId}/role)
+	PutUsersUserIdRole(ctx context.Context, request PutUsersUserIdRoleRequestObject) (PutUsersUserIdRoleResponseObject, error)
+	// Список подписок на события (только для модераторов)
+	// (GET /webhooks)
+	GetWebhooks(ctx context.Context, request GetWebhooksRequestObject) (GetWebhooksResponseObject, error)
+	// Создание подписки на события ПВЗ (только для модераторов)
+	// (POST /webhooks)
+	PostWebhooks(ctx context.Context, request PostWebhooksRequestObject) (PostWebhooksResponseObject, error)
+	// Удаление подписки вместе с историей доставок (только для модераторов)
+	// (DELETE /webhooks/{subscriptionId})
+	DeleteWebhooksSubscriptionId(ctx context.Context, request DeleteWebhooksSubscriptionIdRequestObject) (DeleteWebhooksSubscriptionIdResponseObject, error)
+	// Последние попытки доставки событий подписчику (только для модераторов)
+	// (GET /webhooks/{subscriptionId}/deliveries)
+	GetWebhooksSubscriptionIdDeliveries(ctx context.Context, request GetWebhooksSubscriptionIdDeliveriesRequestObject) (GetWebhooksSubscriptionIdDeliveriesResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAudit operation middleware
+func (sh *strictHandler) GetAudit(ctx echo.Context, params GetAuditParams) error {
+	var request GetAuditRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAudit(ctx.Request().Context(), request.(GetAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAudit")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAuditResponseObject); ok {
+		return validResponse.VisitGetAuditResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostDummyLogin operation middleware
+func (sh *strictHandler) PostDummyLogin(ctx echo.Context) error {
+	var request PostDummyLoginRequestObject
+
+	var body PostDummyLoginJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDummyLogin(ctx.Request().Context(), request.(PostDummyLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDummyLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostDummyLoginResponseObject); ok {
+		return validResponse.VisitPostDummyLoginResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostEmailVerification operation middleware
+func (sh *strictHandler) PostEmailVerification(ctx echo.Context) error {
+	var request PostEmailVerificationRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostEmailVerification(ctx.Request().Context(), request.(PostEmailVerificationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostEmailVerification")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostEmailVerificationResponseObject); ok {
+		return validResponse.VisitPostEmailVerificationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostEmailVerificationConfirm operation middleware
+func (sh *strictHandler) PostEmailVerificationConfirm(ctx echo.Context) error {
+	var request PostEmailVerificationConfirmRequestObject
+
+	var body PostEmailVerificationConfirmJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostEmailVerificationConfirm(ctx.Request().Context(), request.(PostEmailVerificationConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostEmailVerificationConfirm")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostEmailVerificationConfirmResponseObject); ok {
+		return validResponse.VisitPostEmailVerificationConfirmResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostInvitations operation middleware
+func (sh *strictHandler) PostInvitations(ctx echo.Context) error {
+	var request PostInvitationsRequestObject
+
+	var body PostInvitationsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostInvitations(ctx.Request().Context(), request.(PostInvitationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostInvitations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostInvitationsResponseObject); ok {
+		return validResponse.VisitPostInvitationsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostLogin operation middleware
+func (sh *strictHandler) PostLogin(ctx echo.Context) error {
+	var request PostLoginRequestObject
+
+	var body PostLoginJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogin(ctx.Request().Context(), request.(PostLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
+		return validResponse.VisitPostLoginResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostLoginTwoFactor operation middleware
+func (sh *strictHandler) PostLoginTwoFactor(ctx echo.Context) error {
+	var request PostLoginTwoFactorRequestObject
+
+	var body PostLoginTwoFactorJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLoginTwoFactor(ctx.Request().Context(), request.(PostLoginTwoFactorRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLoginTwoFactor")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostLoginTwoFactorResponseObject); ok {
+		return validResponse.VisitPostLoginTwoFactorResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPasswordReset operation middleware
+func (sh *strictHandler) PostPasswordReset(ctx echo.Context) error {
+	var request PostPasswordResetRequestObject
+
+	var body PostPasswordResetJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPasswordReset(ctx.Request().Context(), request.(PostPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPasswordReset")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPasswordResetResponseObject); ok {
+		return validResponse.VisitPostPasswordResetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPasswordResetConfirm operation middleware
+func (sh *strictHandler) PostPasswordResetConfirm(ctx echo.Context) error {
+	var request PostPasswordResetConfirmRequestObject
+
+	var body PostPasswordResetConfirmJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPasswordResetConfirm(ctx.Request().Context(), request.(PostPasswordResetConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPasswordResetConfirm")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPasswordResetConfirmResponseObject); ok {
+		return validResponse.VisitPostPasswordResetConfirmResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProducts operation middleware
+func (sh *strictHandler) GetProducts(ctx echo.Context, params GetProductsParams) error {
+	var request GetProductsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProducts(ctx.Request().Context(), request.(GetProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProducts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProductsResponseObject); ok {
+		return validResponse.VisitGetProductsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProducts operation middleware
+func (sh *strictHandler) PostProducts(ctx echo.Context) error {
+	var request PostProductsRequestObject
+
+	var body PostProductsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProducts(ctx.Request().Context(), request.(PostProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProducts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProductsResponseObject); ok {
+		return validResponse.VisitPostProductsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPvz operation middleware
+func (sh *strictHandler) GetPvz(ctx echo.Context, params GetPvzParams) error {
+	var request GetPvzRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvz(ctx.Request().Context(), request.(GetPvzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvz")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPvzResponseObject); ok {
+		return validResponse.VisitGetPvzResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPvz operation middleware
+func (sh *strictHandler) PostPvz(ctx echo.Context) error {
+	var request PostPvzRequestObject
+
+	var body PostPvzJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvz(ctx.Request().Context(), request.(PostPvzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvz")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPvzResponseObject); ok {
+		return validResponse.VisitPostPvzResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPvzPvzIdAssignments operation middleware
+func (sh *strictHandler) GetPvzPvzIdAssignments(ctx echo.Context, pvzId openapi_types.UUID) error {
+	var request GetPvzPvzIdAssignmentsRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzPvzIdAssignments(ctx.Request().Context(), request.(GetPvzPvzIdAssignmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzPvzIdAssignments")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPvzPvzIdAssignmentsResponseObject); ok {
+		return validResponse.VisitGetPvzPvzIdAssignmentsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPvzPvzIdAssignments operation middleware
+func (sh *strictHandler) PostPvzPvzIdAssignments(ctx echo.Context, pvzId openapi_types.UUID) error {
+	var request PostPvzPvzIdAssignmentsRequestObject
+
+	request.PvzId = pvzId
+
+	var body PostPvzPvzIdAssignmentsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdAssignments(ctx.Request().Context(), request.(PostPvzPvzIdAssignmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdAssignments")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPvzPvzIdAssignmentsResponseObject); ok {
+		return validResponse.VisitPostPvzPvzIdAssignmentsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeletePvzPvzIdAssignmentsAssignmentId operation middleware
+func (sh *strictHandler) DeletePvzPvzIdAssignmentsAssignmentId(ctx echo.Context, pvzId openapi_types.UUID, assignmentId openapi_types.UUID) error {
+	var request DeletePvzPvzIdAssignmentsAssignmentIdRequestObject
+
+	request.PvzId = pvzId
+	request.AssignmentId = assignmentId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePvzPvzIdAssignmentsAssignmentId(ctx.Request().Context(), request.(DeletePvzPvzIdAssignmentsAssignmentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePvzPvzIdAssignmentsAssignmentId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeletePvzPvzIdAssignmentsAssignmentIdResponseObject); ok {
+		return validResponse.VisitDeletePvzPvzIdAssignmentsAssignmentIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPvzPvzIdCloseLastReception operation middleware
+func (sh *strictHandler) PostPvzPvzIdCloseLastReception(ctx echo.Context, pvzId openapi_types.UUID) error {
+	var request PostPvzPvzIdCloseLastReceptionRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdCloseLastReception(ctx.Request().Context(), request.(PostPvzPvzIdCloseLastReceptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdCloseLastReception")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPvzPvzIdCloseLastReceptionResponseObject); ok {
+		return validResponse.VisitPostPvzPvzIdCloseLastReceptionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPvzPvzIdDeleteLastProduct operation middleware
+func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(ctx echo.Context, pvzId openapi_types.UUID) error {
+	var request PostPvzPvzIdDeleteLastProductRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdDeleteLastProduct(ctx.Request().Context(), request.(PostPvzPvzIdDeleteLastProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdDeleteLastProduct")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPvzPvzIdDeleteLastProductResponseObject); ok {
+		return validResponse.VisitPostPvzPvzIdDeleteLastProductResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPvzPvzIdManifests operation middleware
+func (sh *strictHandler) PostPvzPvzIdManifests(ctx echo.Context, pvzId openapi_types.UUID) error {
+	var request PostPvzPvzIdManifestsRequestObject
+
+	request.PvzId = pvzId
+
+	var body PostPvzPvzIdManifestsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdManifests(ctx.Request().Context(), request.(PostPvzPvzIdManifestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdManifests")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPvzPvzIdManifestsResponseObject); ok {
+		return validResponse.VisitPostPvzPvzIdManifestsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPvzPvzIdRestoreLastProduct operation middleware
+func (sh *strictHandler) PostPvzPvzIdRestoreLastProduct(ctx echo.Context, pvzId openapi_types.UUID) error {
+	var request PostPvzPvzIdRestoreLastProductRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdRestoreLastProduct(ctx.Request().Context(), request.(PostPvzPvzIdRestoreLastProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdRestoreLastProduct")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPvzPvzIdRestoreLastProductResponseObject); ok {
+		return validResponse.VisitPostPvzPvzIdRestoreLastProductResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostReceptions operation middleware
+func (sh *strictHandler) PostReceptions(ctx echo.Context) error {
+	var request PostReceptionsRequestObject
+
+	var body PostReceptionsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptions(ctx.Request().Context(), request.(PostReceptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostReceptionsResponseObject); ok {
+		return validResponse.VisitPostReceptionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostPvzPvzIdCloseLastReception200JSONResponse Reception
+// GetReceptionsStream operation middleware
+func (sh *strictHandler) GetReceptionsStream(ctx echo.Context, params GetReceptionsStreamParams) error {
+	var request GetReceptionsStreamRequestObject
 
-func (response PostPvzPvzIdCloseLastReception200JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptionsStream(ctx.Request().Context(), request.(GetReceptionsStreamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptionsStream")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetReceptionsStreamResponseObject); ok {
+		return validResponse.VisitGetReceptionsStreamResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostPvzPvzIdCloseLastReception400JSONResponse Error
+// GetReceptionsReceptionIdAct operation middleware
+func (sh *strictHandler) GetReceptionsReceptionIdAct(ctx echo.Context, receptionId openapi_types.UUID) error {
+	var request GetReceptionsReceptionIdActRequestObject
 
-func (response PostPvzPvzIdCloseLastReception400JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.ReceptionId = receptionId
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptionsReceptionIdAct(ctx.Request().Context(), request.(GetReceptionsReceptionIdActRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptionsReceptionIdAct")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetReceptionsReceptionIdActResponseObject); ok {
+		return validResponse.VisitGetReceptionsReceptionIdActResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostPvzPvzIdCloseLastReception403JSONResponse Error
+// GetReceptionsReceptionIdDiscrepancies operation middleware
+func (sh *strictHandler) GetReceptionsReceptionIdDiscrepancies(ctx echo.Context, receptionId openapi_types.UUID) error {
+	var request GetReceptionsReceptionIdDiscrepanciesRequestObject
 
-func (response PostPvzPvzIdCloseLastReception403JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.ReceptionId = receptionId
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptionsReceptionIdDiscrepancies(ctx.Request().Context(), request.(GetReceptionsReceptionIdDiscrepanciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptionsReceptionIdDiscrepancies")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetReceptionsReceptionIdDiscrepanciesResponseObject); ok {
+		return validResponse.VisitGetReceptionsReceptionIdDiscrepanciesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostPvzPvzIdDeleteLastProductRequestObject struct {
-	PvzId openapi_types.UUID `json:"pvzId"`
+// GetReceptionsReceptionIdHistory operation middleware
+func (sh *strictHandler) GetReceptionsReceptionIdHistory(ctx echo.Context, receptionId openapi_types.UUID) error {
+	var request GetReceptionsReceptionIdHistoryRequestObject
+
+	request.ReceptionId = receptionId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptionsReceptionIdHistory(ctx.Request().Context(), request.(GetReceptionsReceptionIdHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptionsReceptionIdHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetReceptionsReceptionIdHistoryResponseObject); ok {
+		return validResponse.VisitGetReceptionsReceptionIdHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostPvzPvzIdDeleteLastProductResponseObject interface {
-	VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error
+// PostReceptionsReceptionIdManifest operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdManifest(ctx echo.Context, receptionId openapi_types.UUID) error {
+	var request PostReceptionsReceptionIdManifestRequestObject
+
+	request.ReceptionId = receptionId
+
+	var body PostReceptionsReceptionIdManifestJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptionsReceptionIdManifest(ctx.Request().Context(), request.(PostReceptionsReceptionIdManifestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptionsReceptionIdManifest")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdManifestResponseObject); ok {
+		return validResponse.VisitPostReceptionsReceptionIdManifestResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostPvzPvzIdDeleteLastProduct200Response struct {
+// PostReceptionsReceptionIdReopen operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdReopen(ctx echo.Context, receptionId openapi_types.UUID) error {
+	var request PostReceptionsReceptionIdReopenRequestObject
+
+	request.ReceptionId = receptionId
+
+	var body PostReceptionsReceptionIdReopenJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptionsReceptionIdReopen(ctx.Request().Context(), request.(PostReceptionsReceptionIdReopenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptionsReceptionIdReopen")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdReopenResponseObject); ok {
+		return validResponse.VisitPostReceptionsReceptionIdReopenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-func (response PostPvzPvzIdDeleteLastProduct200Response) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+// PostRegister operation middleware
+func (sh *strictHandler) PostRegister(ctx echo.Context) error {
+	var request PostRegisterRequestObject
+
+	var body PostRegisterJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRegister(ctx.Request().Context(), request.(PostRegisterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRegister")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostRegisterResponseObject); ok {
+		return validResponse.VisitPostRegisterResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PostPvzPvzIdDeleteLastProduct400JSONResponse Error
+// GetRoles operation middleware
+func (sh *strictHandler) GetRoles(ctx echo.Context) error {
+	var request GetRolesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRoles(ctx.Request().Context(), request.(GetRolesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRoles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetRolesResponseObject); ok {
+		return validResponse.VisitGetRolesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRoles operation middleware
+func (sh *strictHandler) PostRoles(ctx echo.Context) error {
+	var request PostRolesRequestObject
+
+	var body PostRolesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRoles(ctx.Request().Context(), request.(PostRolesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRoles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostRolesResponseObject); ok {
+		return validResponse.VisitPostRolesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutRolesRoleId operation middleware
+func (sh *strictHandler) PutRolesRoleId(ctx echo.Context, roleId int) error {
+	var request PutRolesRoleIdRequestObject
+
+	request.RoleId = roleId
+
+	var body PutRolesRoleIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutRolesRoleId(ctx.Request().Context(), request.(PutRolesRoleIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutRolesRoleId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutRolesRoleIdResponseObject); ok {
+		return validResponse.VisitPutRolesRoleIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostServiceAccounts operation middleware
+func (sh *strictHandler) PostServiceAccounts(ctx echo.Context) error {
+	var request PostServiceAccountsRequestObject
+
+	var body PostServiceAccountsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostServiceAccounts(ctx.Request().Context(), request.(PostServiceAccountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostServiceAccounts")
+	}
 
-func (response PostPvzPvzIdDeleteLastProduct400JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostServiceAccountsResponseObject); ok {
+		return validResponse.VisitPostServiceAccountsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostPvzPvzIdDeleteLastProduct403JSONResponse Error
+// GetServiceAccountsUserIdApiKeys operation middleware
+func (sh *strictHandler) GetServiceAccountsUserIdApiKeys(ctx echo.Context, userId openapi_types.UUID) error {
+	var request GetServiceAccountsUserIdApiKeysRequestObject
 
-func (response PostPvzPvzIdDeleteLastProduct403JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.UserId = userId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetServiceAccountsUserIdApiKeys(ctx.Request().Context(), request.(GetServiceAccountsUserIdApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetServiceAccountsUserIdApiKeys")
+	}
 
-type PostReceptionsRequestObject struct {
-	Body *PostReceptionsJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type PostReceptionsResponseObject interface {
-	VisitPostReceptionsResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetServiceAccountsUserIdApiKeysResponseObject); ok {
+		return validResponse.VisitGetServiceAccountsUserIdApiKeysResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostReceptions201JSONResponse Reception
+// PostServiceAccountsUserIdApiKeys operation middleware
+func (sh *strictHandler) PostServiceAccountsUserIdApiKeys(ctx echo.Context, userId openapi_types.UUID) error {
+	var request PostServiceAccountsUserIdApiKeysRequestObject
 
-func (response PostReceptions201JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	request.UserId = userId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostServiceAccountsUserIdApiKeysJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type PostReceptions400JSONResponse Error
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostServiceAccountsUserIdApiKeys(ctx.Request().Context(), request.(PostServiceAccountsUserIdApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostServiceAccountsUserIdApiKeys")
+	}
 
-func (response PostReceptions400JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostServiceAccountsUserIdApiKeysResponseObject); ok {
+		return validResponse.VisitPostServiceAccountsUserIdApiKeysResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostReceptions403JSONResponse Error
+// DeleteServiceAccountsUserIdApiKeysKeyId operation middleware
+func (sh *strictHandler) DeleteServiceAccountsUserIdApiKeysKeyId(ctx echo.Context, userId openapi_types.UUID, keyId openapi_types.UUID) error {
+	var request DeleteServiceAccountsUserIdApiKeysKeyIdRequestObject
 
-func (response PostReceptions403JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.UserId = userId
+	request.KeyId = keyId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteServiceAccountsUserIdApiKeysKeyId(ctx.Request().Context(), request.(DeleteServiceAccountsUserIdApiKeysKeyIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteServiceAccountsUserIdApiKeysKeyId")
+	}
 
-type PostRegisterRequestObject struct {
-	Body *PostRegisterJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type PostRegisterResponseObject interface {
-	VisitPostRegisterResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteServiceAccountsUserIdApiKeysKeyIdResponseObject); ok {
+		return validResponse.VisitDeleteServiceAccountsUserIdApiKeysKeyIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostRegister201JSONResponse User
-
-func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// GetSsoCallback operation middleware
+func (sh *strictHandler) GetSsoCallback(ctx echo.Context, params GetSsoCallbackParams) error {
+	var request GetSsoCallbackRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostRegister400JSONResponse Error
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSsoCallback(ctx.Request().Context(), request.(GetSsoCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSsoCallback")
+	}
 
-func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSsoCallbackResponseObject); ok {
+		return validResponse.VisitGetSsoCallbackResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Получение тестового токена
-	// (POST /dummyLogin)
-	PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)
-	// Авторизация пользователя
-	// (POST /login)
-	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
-	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
-	// (POST /products)
-	PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)
-	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
-	// (GET /pvz)
-	GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)
-	// Создание ПВЗ (только для модераторов)
-	// (POST /pvz)
-	PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)
-	// Закрытие последней открытой приемки товаров в рамках ПВЗ
-	// (POST /pvz/{pvzId}/close_last_reception)
-	PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)
-	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
-	// (POST /pvz/{pvzId}/delete_last_product)
-	PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)
-	// Создание новой приемки товаров (только для сотрудников ПВЗ)
-	// (POST /receptions)
-	PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)
-	// Регистрация пользователя
-	// (POST /register)
-	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
-}
+// GetSsoLogin operation middleware
+func (sh *strictHandler) GetSsoLogin(ctx echo.Context) error {
+	var request GetSsoLoginRequestObject
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSsoLogin(ctx.Request().Context(), request.(GetSsoLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSsoLogin")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	response, err := handler(ctx, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSsoLoginResponseObject); ok {
+		return validResponse.VisitGetSsoLoginResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// PostDummyLogin operation middleware
-func (sh *strictHandler) PostDummyLogin(ctx echo.Context) error {
-	var request PostDummyLoginRequestObject
+// PostTwoFactorConfirm operation middleware
+func (sh *strictHandler) PostTwoFactorConfirm(ctx echo.Context) error {
+	var request PostTwoFactorConfirmRequestObject
 
-	var body PostDummyLoginJSONRequestBody
+	var body PostTwoFactorConfirmJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostDummyLogin(ctx.Request().Context(), request.(PostDummyLoginRequestObject))
+		return sh.ssi.PostTwoFactorConfirm(ctx.Request().Context(), request.(PostTwoFactorConfirmRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostDummyLogin")
+		handler = middleware(handler, "PostTwoFactorConfirm")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostDummyLoginResponseObject); ok {
-		return validResponse.VisitPostDummyLoginResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTwoFactorConfirmResponseObject); ok {
+		return validResponse.VisitPostTwoFactorConfirmResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostLogin operation middleware
-func (sh *strictHandler) PostLogin(ctx echo.Context) error {
-	var request PostLoginRequestObject
+// PostTwoFactorDisable operation middleware
+func (sh *strictHandler) PostTwoFactorDisable(ctx echo.Context) error {
+	var request PostTwoFactorDisableRequestObject
 
-	var body PostLoginJSONRequestBody
+	var body PostTwoFactorDisableJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostLogin(ctx.Request().Context(), request.(PostLoginRequestObject))
+		return sh.ssi.PostTwoFactorDisable(ctx.Request().Context(), request.(PostTwoFactorDisableRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostLogin")
+		handler = middleware(handler, "PostTwoFactorDisable")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
-		return validResponse.VisitPostLoginResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTwoFactorDisableResponseObject); ok {
+		return validResponse.VisitPostTwoFactorDisableResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProducts operation middleware
-func (sh *strictHandler) PostProducts(ctx echo.Context) error {
-	var request PostProductsRequestObject
-
-	var body PostProductsJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+// PostTwoFactorEnroll operation middleware
+func (sh *strictHandler) PostTwoFactorEnroll(ctx echo.Context) error {
+	var request PostTwoFactorEnrollRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProducts(ctx.Request().Context(), request.(PostProductsRequestObject))
+		return sh.ssi.PostTwoFactorEnroll(ctx.Request().Context(), request.(PostTwoFactorEnrollRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProducts")
+		handler = middleware(handler, "PostTwoFactorEnroll")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProductsResponseObject); ok {
-		return validResponse.VisitPostProductsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTwoFactorEnrollResponseObject); ok {
+		return validResponse.VisitPostTwoFactorEnrollResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetPvz operation middleware
-func (sh *strictHandler) GetPvz(ctx echo.Context, params GetPvzParams) error {
-	var request GetPvzRequestObject
+// GetUsers operation middleware
+func (sh *strictHandler) GetUsers(ctx echo.Context, params GetUsersParams) error {
+	var request GetUsersRequestObject
 
 	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPvz(ctx.Request().Context(), request.(GetPvzRequestObject))
+		return sh.ssi.GetUsers(ctx.Request().Context(), request.(GetUsersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPvz")
+		handler = middleware(handler, "GetUsers")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetPvzResponseObject); ok {
-		return validResponse.VisitGetPvzResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetUsersResponseObject); ok {
+		return validResponse.VisitGetUsersResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostPvz operation middleware
-func (sh *strictHandler) PostPvz(ctx echo.Context) error {
-	var request PostPvzRequestObject
+// PatchUsersUserId operation middleware
+func (sh *strictHandler) PatchUsersUserId(ctx echo.Context, userId openapi_types.UUID) error {
+	var request PatchUsersUserIdRequestObject
 
-	var body PostPvzJSONRequestBody
+	request.UserId = userId
+
+	var body PatchUsersUserIdJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvz(ctx.Request().Context(), request.(PostPvzRequestObject))
+		return sh.ssi.PatchUsersUserId(ctx.Request().Context(), request.(PatchUsersUserIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvz")
+		handler = middleware(handler, "PatchUsersUserId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostPvzResponseObject); ok {
-		return validResponse.VisitPostPvzResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchUsersUserIdResponseObject); ok {
+		return validResponse.VisitPatchUsersUserIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostPvzPvzIdCloseLastReception operation middleware
-func (sh *strictHandler) PostPvzPvzIdCloseLastReception(ctx echo.Context, pvzId openapi_types.UUID) error {
-	var request PostPvzPvzIdCloseLastReceptionRequestObject
+// PutUsersUserIdRole operation middleware
+func (sh *strictHandler) PutUsersUserIdRole(ctx echo.Context, userId openapi_types.UUID) error {
+	var request PutUsersUserIdRoleRequestObject
 
-	request.PvzId = pvzId
+	request.UserId = userId
+
+	var body PutUsersUserIdRoleJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvzPvzIdCloseLastReception(ctx.Request().Context(), request.(PostPvzPvzIdCloseLastReceptionRequestObject))
+		return sh.ssi.PutUsersUserIdRole(ctx.Request().Context(), request.(PutUsersUserIdRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvzPvzIdCloseLastReception")
+		handler = middleware(handler, "PutUsersUserIdRole")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostPvzPvzIdCloseLastReceptionResponseObject); ok {
-		return validResponse.VisitPostPvzPvzIdCloseLastReceptionResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutUsersUserIdRoleResponseObject); ok {
+		return validResponse.VisitPutUsersUserIdRoleResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostPvzPvzIdDeleteLastProduct operation middleware
-func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(ctx echo.Context, pvzId openapi_types.UUID) error {
-	var request PostPvzPvzIdDeleteLastProductRequestObject
-
-	request.PvzId = pvzId
+// GetWebhooks operation middleware
+func (sh *strictHandler) GetWebhooks(ctx echo.Context) error {
+	var request GetWebhooksRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvzPvzIdDeleteLastProduct(ctx.Request().Context(), request.(PostPvzPvzIdDeleteLastProductRequestObject))
+		return sh.ssi.GetWebhooks(ctx.Request().Context(), request.(GetWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvzPvzIdDeleteLastProduct")
+		handler = middleware(handler, "GetWebhooks")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostPvzPvzIdDeleteLastProductResponseObject); ok {
-		return validResponse.VisitPostPvzPvzIdDeleteLastProductResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetWebhooksResponseObject); ok {
+		return validResponse.VisitGetWebhooksResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostReceptions operation middleware
-func (sh *strictHandler) PostReceptions(ctx echo.Context) error {
-	var request PostReceptionsRequestObject
+// PostWebhooks operation middleware
+func (sh *strictHandler) PostWebhooks(ctx echo.Context) error {
+	var request PostWebhooksRequestObject
 
-	var body PostReceptionsJSONRequestBody
+	var body PostWebhooksJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostReceptions(ctx.Request().Context(), request.(PostReceptionsRequestObject))
+		return sh.ssi.PostWebhooks(ctx.Request().Context(), request.(PostWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostReceptions")
+		handler = middleware(handler, "PostWebhooks")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostReceptionsResponseObject); ok {
-		return validResponse.VisitPostReceptionsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostWebhooksResponseObject); ok {
+		return validResponse.VisitPostWebhooksResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostRegister operation middleware
-func (sh *strictHandler) PostRegister(ctx echo.Context) error {
-	var request PostRegisterRequestObject
+// DeleteWebhooksSubscriptionId operation middleware
+func (sh *strictHandler) DeleteWebhooksSubscriptionId(ctx echo.Context, subscriptionId openapi_types.UUID) error {
+	var request DeleteWebhooksSubscriptionIdRequestObject
 
-	var body PostRegisterJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
+	request.SubscriptionId = subscriptionId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteWebhooksSubscriptionId(ctx.Request().Context(), request.(DeleteWebhooksSubscriptionIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteWebhooksSubscriptionId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		return err
+	} else if validResponse, ok := response.(DeleteWebhooksSubscriptionIdResponseObject); ok {
+		return validResponse.VisitDeleteWebhooksSubscriptionIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// GetWebhooksSubscriptionIdDeliveries operation middleware
+func (sh *strictHandler) GetWebhooksSubscriptionIdDeliveries(ctx echo.Context, subscriptionId openapi_types.UUID, params GetWebhooksSubscriptionIdDeliveriesParams) error {
+	var request GetWebhooksSubscriptionIdDeliveriesRequestObject
+
+	request.SubscriptionId = subscriptionId
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostRegister(ctx.Request().Context(), request.(PostRegisterRequestObject))
+		return sh.ssi.GetWebhooksSubscriptionIdDeliveries(ctx.Request().Context(), request.(GetWebhooksSubscriptionIdDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostRegister")
+		handler = middleware(handler, "GetWebhooksSubscriptionIdDeliveries")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostRegisterResponseObject); ok {
-		return validResponse.VisitPostRegisterResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetWebhooksSubscriptionIdDeliveriesResponseObject); ok {
+		return validResponse.VisitGetWebhooksSubscriptionIdDeliveriesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
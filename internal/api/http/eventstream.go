@@ -0,0 +1,96 @@
+package http_profile
+
+import (
+	"avito/internal/domain"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// proxies drop connections that are silent for too long, comment line keeps stream alive
+const eventStreamHeartbeat = 15 * time.Second
+
+// eventStream encodes events as Server-Sent Events, every message is flushed to client as soon as it is written
+type eventStream struct {
+	ctx         context.Context
+	events      <-chan domain.Event
+	unsubscribe func()
+	pending     bytes.Buffer
+}
+
+func newEventStream(ctx context.Context, events <-chan domain.Event, unsubscribe func()) *eventStream {
+	return &eventStream{ctx: ctx, events: events, unsubscribe: unsubscribe}
+}
+
+// WriteTo is used by io.Copy instead of Read, so messages are not held in response buffer
+func (s *eventStream) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+
+	// headers are sent right away, client knows subscription is accepted before the first event
+	s.pending.WriteString(": connected\n\n")
+	for {
+		n, err := s.pending.WriteTo(w)
+		written += n
+		if err != nil {
+			return written, err
+		}
+
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+
+		if err := s.next(); err != nil {
+			if err == io.EOF {
+				return written, nil
+			}
+
+			return written, err
+		}
+	}
+}
+
+func (s *eventStream) Read(p []byte) (int, error) {
+	for s.pending.Len() == 0 {
+		if err := s.next(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.pending.Read(p)
+}
+
+func (s *eventStream) Close() error {
+	s.unsubscribe()
+
+	return nil
+}
+
+// next waits for event or heartbeat and puts its message into pending, io.EOF means stream is over
+func (s *eventStream) next() error {
+	heartbeat := time.NewTimer(eventStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	select {
+	case <-s.ctx.Done():
+		return io.EOF
+	case <-heartbeat.C:
+		s.pending.WriteString(": heartbeat\n\n")
+	case event, ok := <-s.events:
+		if !ok {
+			return io.EOF
+		}
+
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(&s.pending, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	}
+
+	return nil
+}
@@ -4,13 +4,21 @@ import (
 	"avito/internal/config"
 	"avito/internal/domain"
 	"avito/internal/storage"
+	"avito/internal/usecases/audit"
 	pvz "avito/internal/usecases/pvz"
 	"avito/internal/usecases/reception"
+	"avito/internal/usecases/roles"
+	"avito/internal/usecases/serviceaccounts"
 	"avito/internal/usecases/users"
+	"avito/internal/usecases/webhooks"
 	jwt "avito/pkg/authorization"
+	"avito/pkg/ratelimit"
+	"bytes"
 	"context"
 	"embed"
+	"errors"
 	"fmt"
+	"net"
 	netHttp "net/http"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -26,7 +34,21 @@ type (
 	Dependencies struct {
 		domain.AuthorizationService[jwt.JWT]
 		storage.Repositories
+		domain.ReceptionActRenderer
+		domain.EventBus
+		domain.Mailer
 		jwt.JWTManager
+		users.Throttle
+		IPLimiter *ratelimit.Limiter
+		// POST /dummyLogin answers 403 unless it is enabled
+		DummyLoginEnabled bool
+		// webhook subscriptions to loopback, link-local and private addresses are refused unless allowed
+		WebhookPrivateNetworksAllowed bool
+		// nil when single sign-on is disabled, /sso routes answer 404 then
+		domain.IdentityProvider
+		domain.SSORoleMapping
+		// cookie binding single sign-on login to browser is sent only over https when user is sent back to https address
+		SSOStateCookieSecure bool
 	}
 	server struct {
 		e      *echo.Echo
@@ -37,9 +59,25 @@ type (
 	}
 )
 
-func NewHTTPServer(dependencies Dependencies, config config.HTTPConfig) *server {
+func NewHTTPServer(dependencies Dependencies, config config.HTTPConfig) (*server, error) {
+	validator, err := RequestValidatorMiddleware()
+	if err != nil {
+		return nil, err
+	}
+
+	ipExtractor, err := newIPExtractor(config.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
 	e := echo.New()
+	e.IPExtractor = ipExtractor
+	e.Use(RequestSourceMiddleware())
 	e.Use(BearerTokenMiddleware())
+	if dependencies.IPLimiter != nil {
+		e.Use(RateLimitMiddleware(dependencies.IPLimiter, "/login", "/login/two_factor", "/register", "/password_reset", "/password_reset/confirm", "/sso/login", "/sso/callback"))
+	}
+	e.Use(validator)
 	RegisterHandlers(e, NewStrictHandler(
 		httpRequestHandlers{deps: dependencies},
 		[]StrictMiddlewareFunc{},
@@ -52,16 +90,65 @@ func NewHTTPServer(dependencies Dependencies, config config.HTTPConfig) *server
 	return &server{
 		e:      e,
 		config: config,
+	}, nil
+}
+
+// ip is taken from connection unless it is one of trusted proxies, X-Forwarded-For sent by client directly
+// would let it pass rate limit with every request under another ip. Behind proxies the first address
+// from the right which is not a trusted proxy is used
+func newIPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, proxy := range trustedProxies {
+		_, ipRange, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
+// Listen binds server address, zero port in config means any free port
+func (s *server) Listen() error {
+	cfg := s.config
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", cfg.Host, cfg.Port))
+	if err != nil {
+		return err
 	}
+
+	s.e.Listener = listener
+
+	return nil
 }
 
 func (s *server) Start() error {
-	cfg := s.config
+	if s.e.Listener == nil {
+		if err := s.Listen(); err != nil {
+			return err
+		}
+	}
+
+	s.e.Logger.Info(fmt.Sprintf("Starting server on %s", s.Addr()))
+	err := s.e.Start("")
+	if errors.Is(err, netHttp.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
 
-	listenerURL := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+func (s *server) Addr() string {
+	if s.e.Listener == nil {
+		return ""
+	}
 
-	s.e.Logger.Info(fmt.Sprintf("Starting server on %s", listenerURL))
-	return s.e.Start(listenerURL)
+	return s.e.Listener.Addr().String()
 }
 
 func (s *server) Stop(ctx context.Context) error {
@@ -69,33 +156,37 @@ func (s *server) Stop(ctx context.Context) error {
 }
 
 func (h httpRequestHandlers) PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error) {
-	var (
-		dummyUserId string
-	)
-
-	switch request.Body.Role {
-	case PostDummyLoginJSONBodyRole(UserRoleModerator):
-		dummyUserId = "0196521c-b2a9-7a04-88be-16ec981d104b"
-	case PostDummyLoginJSONBodyRole(UserRoleEmployee):
-		dummyUserId = "0196521c-873e-77fd-b244-bdd0c13c72ab"
-	default:
-		return PostDummyLogin400JSONResponse{
-			Message: fmt.Sprintf("unknown role: %s", request.Body.Role),
-		}, nil
+	args := users.DummyLoginArgs{
+		Enabled:        h.deps.DummyLoginEnabled,
+		JWTManager:     h.deps.JWTManager,
+		UserRepository: h.deps.UserRepository,
+		RoleRepository: h.deps.RoleRepository,
+		UnitOfWork:     h.deps.UnitOfWork,
+		Role:           string(request.Body.Role),
 	}
 
-	// bypass authorization
-	jwt, err := h.deps.JWTManager.GenerateToken(dummyUserId)
+	token, err := users.DummyLoginUseCase(ctx, args)
+
 	if err != nil {
-		return nil, err
+		msg := err.Error()
+		if msg == users.DummyLoginIsDisabledError {
+			return PostDummyLogin403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostDummyLogin400JSONResponse{
+			Message: msg,
+		}, nil
 	}
 
-	return PostDummyLogin200JSONResponse(jwt), nil
+	return PostDummyLogin200JSONResponse(token), nil
 }
 
 func (h httpRequestHandlers) PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error) {
 	args := users.LoginUserUseCaseArgs{
 		AuthorizationService: h.deps.AuthorizationService,
+		Throttle:             h.deps.Throttle,
 		User: users.LoginUserDTO{
 			Email:    string(request.Body.Email),
 			Password: request.Body.Password,
@@ -105,6 +196,18 @@ func (h httpRequestHandlers) PostLogin(ctx context.Context, request PostLoginReq
 	token, err := users.LoginUserUseCase(ctx, args)
 
 	if err != nil {
+		if response, ok := tooManyRequests(err); ok {
+			return PostLogin429JSONResponse{response}, nil
+		}
+
+		var challenge *domain.TwoFactorRequiredError
+		if errors.As(err, &challenge) {
+			return PostLogin202JSONResponse{
+				Challenge: challenge.Challenge,
+				ExpiresAt: challenge.ExpiresAtUTC,
+			}, nil
+		}
+
 		return PostLogin401JSONResponse{
 			Message: domain.BadUserCredentialError,
 		}, nil
@@ -113,19 +216,274 @@ func (h httpRequestHandlers) PostLogin(ctx context.Context, request PostLoginReq
 	return PostLogin200JSONResponse(token), nil
 }
 
+func (h httpRequestHandlers) PostLoginTwoFactor(ctx context.Context, request PostLoginTwoFactorRequestObject) (PostLoginTwoFactorResponseObject, error) {
+	args := users.ConfirmLoginArgs{
+		AuthorizationService: h.deps.AuthorizationService,
+		Throttle:             h.deps.Throttle,
+		Login: users.ConfirmLoginDTO{
+			Challenge: request.Body.Challenge,
+			Code:      request.Body.Code,
+		},
+	}
+
+	token, err := users.ConfirmLoginUseCase(ctx, args)
+
+	if err != nil {
+		if response, ok := tooManyRequests(err); ok {
+			return PostLoginTwoFactor429JSONResponse{response}, nil
+		}
+
+		return PostLoginTwoFactor401JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return PostLoginTwoFactor200JSONResponse(token), nil
+}
+
+// ssoStateCookieName is cookie keeping hash of single sign-on state in browser until user comes back from provider
+const ssoStateCookieName string = "sso_state"
+
+func (h httpRequestHandlers) GetSsoLogin(ctx context.Context, request GetSsoLoginRequestObject) (GetSsoLoginResponseObject, error) {
+	args := users.StartSSOLoginArgs{
+		IdentityProvider:   h.deps.IdentityProvider,
+		SSOLoginRepository: h.deps.SSOLoginRepository,
+		UnitOfWork:         h.deps.UnitOfWork,
+	}
+
+	url, stateHash, err := users.StartSSOLoginUseCase(ctx, args)
+
+	if err != nil {
+		if msg := err.Error(); msg == users.SSOIsDisabledError {
+			return GetSsoLogin404JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	// only browser which started login could complete it, otherwise user could be signed in as someone else
+	// by following callback of login started by them
+	cookie := netHttp.Cookie{
+		Name:     ssoStateCookieName,
+		Value:    stateHash,
+		Path:     "/sso",
+		MaxAge:   int(domain.SSOLoginLifetime.Seconds()),
+		Secure:   h.deps.SSOStateCookieSecure,
+		HttpOnly: true,
+		// provider sends user back by top-level navigation from its own site, strict cookie would not be sent then
+		SameSite: netHttp.SameSiteLaxMode,
+	}
+
+	return GetSsoLogin302Response{
+		Headers: GetSsoLogin302ResponseHeaders{
+			Location:  url,
+			SetCookie: cookie.String(),
+		},
+	}, nil
+}
+
+func (h httpRequestHandlers) GetSsoCallback(ctx context.Context, request GetSsoCallbackRequestObject) (GetSsoCallbackResponseObject, error) {
+	args := users.CompleteSSOLoginArgs{
+		IdentityProvider:   h.deps.IdentityProvider,
+		SSORoleMapping:     h.deps.SSORoleMapping,
+		JWTManager:         h.deps.JWTManager,
+		SSOLoginRepository: h.deps.SSOLoginRepository,
+		UserRepository:     h.deps.UserRepository,
+		RoleRepository:     h.deps.RoleRepository,
+		AuditRepository:    h.deps.AuditRepository,
+		UnitOfWork:         h.deps.UnitOfWork,
+		Login: users.CompleteSSOLoginDTO{
+			Code:             queryValue(request.Params.Code),
+			State:            queryValue(request.Params.State),
+			Error:            queryValue(request.Params.Error),
+			BrowserStateHash: queryValue(request.Params.SsoState),
+		},
+	}
+
+	token, err := users.CompleteSSOLoginUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if msg == users.SSOIsDisabledError {
+			return GetSsoCallback404JSONResponse{
+				Message: msg,
+			}, nil
+		} else if domain.IsAccessError(err) {
+			return GetSsoCallback403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return GetSsoCallback400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return GetSsoCallback200JSONResponse(token), nil
+}
+
+func (h httpRequestHandlers) GetAudit(ctx context.Context, request GetAuditRequestObject) (GetAuditResponseObject, error) {
+	params := request.Params
+
+	filter := audit.ListAuditRecordsDTO{
+		ActorID:      params.ActorId,
+		EntityID:     params.EntityId,
+		StartTimeUTC: params.StartDate,
+		EndTimeUTC:   params.EndDate,
+	}
+
+	if params.Action != nil {
+		filter.Action = string(*params.Action)
+	}
+
+	if params.EntityType != nil {
+		filter.EntityType = string(*params.EntityType)
+	}
+
+	if params.Page != nil {
+		filter.Page = *params.Page
+	}
+
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+
+	args := audit.ListAuditRecordsArgs{
+		AuthenticationArgs: h.authArgs(ctx),
+		AuditRepository:    h.deps.AuditRepository,
+		Filter:             filter,
+	}
+
+	records, err := audit.ListAuditRecordsUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return GetAudit403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return GetAudit400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	response := make(GetAudit200JSONResponse, 0, len(records))
+	for _, record := range records {
+		response = append(response, auditRecord(record))
+	}
+
+	return response, nil
+}
+
+func (h httpRequestHandlers) GetRoles(ctx context.Context, request GetRolesRequestObject) (GetRolesResponseObject, error) {
+	args := roles.ListRolesArgs{
+		AuthenticationArgs: h.authArgs(ctx),
+		RoleRepository:     h.deps.RoleRepository,
+	}
+
+	found, err := roles.ListRolesUseCase(ctx, args)
+
+	if err != nil {
+		if domain.IsAccessError(err) {
+			return GetRoles403JSONResponse{
+				Message: err.Error(),
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	response := make(GetRoles200JSONResponse, 0, len(found))
+	for _, userRole := range found {
+		response = append(response, role(userRole))
+	}
+
+	return response, nil
+}
+
+func (h httpRequestHandlers) PostRoles(ctx context.Context, request PostRolesRequestObject) (PostRolesResponseObject, error) {
+	args := roles.CreateRoleArgs{
+		AuthenticationArgs: h.authArgs(ctx),
+		RoleRepository:     h.deps.RoleRepository,
+		AuditRepository:    h.deps.AuditRepository,
+		UnitOfWork:         h.deps.UnitOfWork,
+		Role:               roleDTO(*request.Body),
+	}
+
+	created, err := roles.CreateRoleUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostRoles403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostRoles400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostRoles201JSONResponse(role(created)), nil
+}
+
+func (h httpRequestHandlers) PutRolesRoleId(ctx context.Context, request PutRolesRoleIdRequestObject) (PutRolesRoleIdResponseObject, error) {
+	args := roles.UpdateRoleArgs{
+		AuthenticationArgs: h.authArgs(ctx),
+		RoleRepository:     h.deps.RoleRepository,
+		AuditRepository:    h.deps.AuditRepository,
+		UnitOfWork:         h.deps.UnitOfWork,
+		RoleID:             domain.UserRoleID(request.RoleId),
+		Role:               roleDTO(*request.Body),
+	}
+
+	updated, err := roles.UpdateRoleUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PutRolesRoleId403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PutRolesRoleId400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PutRolesRoleId200JSONResponse(role(updated)), nil
+}
+
 func (h httpRequestHandlers) PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error) {
 	args := reception.AddProductToCurrentReceptionAtPVZArgs{
 		AuthenticationArgs:      h.authArgs(ctx),
+		PVZAssignmentRepository: h.deps.PVZAssignmentRepository,
 		ReceptionInfoRepository: h.deps.ReceptionInfoRepository,
 		PVZRepository:           h.deps.PVZRepository,
 		ProductRepository:       h.deps.ProductRepository,
+		DuplicateScanRepository: h.deps.DuplicateScanRepository,
+		EventOutboxRepository:   h.deps.EventOutboxRepository,
+		AuditRepository:         h.deps.AuditRepository,
+		UnitOfWork:              h.deps.UnitOfWork,
+		EventBus:                h.deps.EventBus,
 		PVZ: reception.AddProductToCurrentReceptionAtPVZDTO{
 			PVZID:           request.Body.PvzId,
 			ProductCategory: string(request.Body.Type),
 		},
 	}
 
-	product, err := reception.AddProductToCurrentReceptinoAtPVZUseCase(ctx, args)
+	if request.Body.Barcode != nil {
+		args.PVZ.Barcode = request.Body.Barcode.Value
+		args.PVZ.BarcodeFormat = string(request.Body.Barcode.Format)
+	}
+
+	addedProduct, err := reception.AddProductToCurrentReceptinoAtPVZUseCase(ctx, args)
 
 	if err != nil {
 		if domain.IsAccessError(err) {
@@ -139,12 +497,38 @@ func (h httpRequestHandlers) PostProducts(ctx context.Context, request PostProdu
 		}, nil
 	}
 
-	return PostProducts201JSONResponse{
-		DateTime:    &product.CreationTimeUTC,
-		Id:          &product.ID,
-		ReceptionId: product.ReceptionID,
-		Type:        productType(product.Category),
-	}, nil
+	return PostProducts201JSONResponse(product(&addedProduct)), nil
+}
+
+func (h httpRequestHandlers) GetProducts(ctx context.Context, request GetProductsRequestObject) (GetProductsResponseObject, error) {
+	args := reception.FindProductsByBarcodeArgs{
+		AuthenticationArgs:      h.authArgs(ctx),
+		ProductRepository:       h.deps.ProductRepository,
+		ReceptionInfoRepository: h.deps.ReceptionInfoRepository,
+		PVZAssignmentRepository: h.deps.PVZAssignmentRepository,
+		Barcode:                 request.Params.Barcode,
+	}
+
+	products, err := reception.FindProductsByBarcodeUseCase(ctx, args)
+
+	if err != nil {
+		if domain.IsAccessError(err) {
+			return GetProducts403JSONResponse{
+				Message: err.Error(),
+			}, nil
+		}
+
+		return GetProducts400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	response := make(GetProducts200JSONResponse, len(products))
+	for i, found := range products {
+		response[i] = product(found)
+	}
+
+	return response, nil
 }
 
 func (h httpRequestHandlers) GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error) {
@@ -194,13 +578,8 @@ func (h httpRequestHandlers) GetPvz(ctx context.Context, request GetPvzRequestOb
 		}, len(report.Receptions))
 		for j, reception := range report.Receptions {
 			products := make([]Product, len(reception.Products))
-			for k, product := range reception.Products {
-				products[k] = Product{
-					DateTime:    &product.CreationTimeUTC,
-					Id:          &product.ID,
-					ReceptionId: reception.Information.ID,
-					Type:        productType(product.Category),
-				}
+			for k, receptionProduct := range reception.Products {
+				products[k] = product(receptionProduct)
 			}
 			receptions[j] = struct {
 				Products  *[]Product "json:\"products,omitempty\""
@@ -237,8 +616,11 @@ func (h httpRequestHandlers) GetPvz(ctx context.Context, request GetPvzRequestOb
 
 func (h httpRequestHandlers) PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error) {
 	args := pvz.CreatePVZUseCaseArgs{
-		AuthenticationArgs: h.authArgs(ctx),
-		PVZRepository:      h.deps.PVZRepository,
+		AuthenticationArgs:    h.authArgs(ctx),
+		PVZRepository:         h.deps.PVZRepository,
+		EventOutboxRepository: h.deps.EventOutboxRepository,
+		AuditRepository:       h.deps.AuditRepository,
+		UnitOfWork:            h.deps.UnitOfWork,
 		PVZ: pvz.CreatePVZDTO{
 			PVZCity:          string(request.Body.City),
 			PVZID:            request.Body.Id,
@@ -268,120 +650,1085 @@ func (h httpRequestHandlers) PostPvz(ctx context.Context, request PostPvzRequest
 	}, nil
 }
 
-func (h httpRequestHandlers) PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error) {
-	args := reception.CloseLastOpenedReceptionAtPVZArgs{
+func (h httpRequestHandlers) PostPvzPvzIdAssignments(ctx context.Context, request PostPvzPvzIdAssignmentsRequestObject) (PostPvzPvzIdAssignmentsResponseObject, error) {
+	args := pvz.AssignEmployeeToPVZArgs{
 		AuthenticationArgs:      h.authArgs(ctx),
-		ReceptionInfoRepository: h.deps.ReceptionInfoRepository,
+		UserRepository:          h.deps.UserRepository,
 		PVZRepository:           h.deps.PVZRepository,
-		PVZ: reception.CloseLastOpenedReceptionAtPVZDTO{
-			PVZID: request.PvzId,
+		PVZAssignmentRepository: h.deps.PVZAssignmentRepository,
+		AuditRepository:         h.deps.AuditRepository,
+		UnitOfWork:              h.deps.UnitOfWork,
+		Assignment: pvz.AssignEmployeeToPVZDTO{
+			PVZID:        request.PvzId,
+			UserID:       request.Body.UserId,
+			ValidFromUTC: request.Body.ValidFrom,
+			ValidToUTC:   request.Body.ValidTo,
 		},
 	}
 
-	reception, err := reception.CloseLastOpenedReceptionUseCase(ctx, args)
+	assignment, err := pvz.AssignEmployeeToPVZUseCase(ctx, args)
 
 	if err != nil {
 		msg := err.Error()
 		if domain.IsAccessError(err) {
-			return PostPvzPvzIdCloseLastReception403JSONResponse{
+			return PostPvzPvzIdAssignments403JSONResponse{
 				Message: msg,
 			}, nil
 		}
 
-		return PostPvzPvzIdCloseLastReception400JSONResponse{
+		return PostPvzPvzIdAssignments400JSONResponse{
 			Message: msg,
 		}, nil
 	}
 
-	return PostPvzPvzIdCloseLastReception200JSONResponse{
-		Id:       &reception.ID,
-		DateTime: reception.CreationTimeUTC,
-		PvzId:    reception.PVZID,
-		Status:   receptionStatus(reception.Status),
-	}, nil
+	return PostPvzPvzIdAssignments201JSONResponse(pvzAssignment(assignment)), nil
 }
 
-func (h httpRequestHandlers) PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error) {
-	args := reception.DeleteLastProductFromCurrentReceptionAtPVZArgs{
+func (h httpRequestHandlers) GetPvzPvzIdAssignments(ctx context.Context, request GetPvzPvzIdAssignmentsRequestObject) (GetPvzPvzIdAssignmentsResponseObject, error) {
+	args := pvz.ListPVZAssignmentsArgs{
 		AuthenticationArgs:      h.authArgs(ctx),
-		ReceptionInfoRepository: h.deps.ReceptionInfoRepository,
 		PVZRepository:           h.deps.PVZRepository,
-		ProductRepository:       h.deps.ProductRepository,
-		PVZ: reception.DeleteLastProductFromCurrentReceptionAtPVZDTO{
-			PVZID: request.PvzId,
-		},
+		PVZAssignmentRepository: h.deps.PVZAssignmentRepository,
+		PVZID:                   request.PvzId,
 	}
 
-	err := reception.DeleteLastProductFromCurrentReceptionAtPVZUseCase(ctx, args)
+	assignments, err := pvz.ListPVZAssignmentsUseCase(ctx, args)
 
 	if err != nil {
+		msg := err.Error()
 		if domain.IsAccessError(err) {
-			return PostPvzPvzIdDeleteLastProduct403JSONResponse{
-				Message: err.Error(),
+			return GetPvzPvzIdAssignments403JSONResponse{
+				Message: msg,
 			}, nil
 		}
 
-		return PostPvzPvzIdDeleteLastProduct400JSONResponse{
-			Message: err.Error(),
+		return GetPvzPvzIdAssignments400JSONResponse{
+			Message: msg,
 		}, nil
 	}
 
-	return PostPvzPvzIdDeleteLastProduct200Response{}, nil
+	response := make(GetPvzPvzIdAssignments200JSONResponse, 0, len(assignments))
+	for _, assignment := range assignments {
+		response = append(response, pvzAssignment(assignment))
+	}
+
+	return response, nil
 }
 
-func (h httpRequestHandlers) PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error) {
-	args := reception.CreateNewReceptionArgs{
+func (h httpRequestHandlers) DeletePvzPvzIdAssignmentsAssignmentId(ctx context.Context, request DeletePvzPvzIdAssignmentsAssignmentIdRequestObject) (DeletePvzPvzIdAssignmentsAssignmentIdResponseObject, error) {
+	args := pvz.UnassignEmployeeFromPVZArgs{
 		AuthenticationArgs:      h.authArgs(ctx),
-		ReceptionInfoRepository: h.deps.ReceptionInfoRepository,
-		PVZRepository:           h.deps.PVZRepository,
-		PVZ: reception.CreateNewReceptionAtPVZDTO{
-			PVZID: request.Body.PvzId,
-		},
+		PVZAssignmentRepository: h.deps.PVZAssignmentRepository,
+		AuditRepository:         h.deps.AuditRepository,
+		UnitOfWork:              h.deps.UnitOfWork,
+		PVZID:                   request.PvzId,
+		AssignmentID:            request.AssignmentId,
 	}
 
-	reception, err := reception.CreateNewReceptionUseCase(ctx, args)
+	err := pvz.UnassignEmployeeFromPVZUseCase(ctx, args)
 
 	if err != nil {
 		msg := err.Error()
 		if domain.IsAccessError(err) {
-			return PostReceptions403JSONResponse{
+			return DeletePvzPvzIdAssignmentsAssignmentId403JSONResponse{
 				Message: msg,
 			}, nil
 		}
 
-		return PostReceptions400JSONResponse{
+		return DeletePvzPvzIdAssignmentsAssignmentId400JSONResponse{
 			Message: msg,
 		}, nil
 	}
 
-	return PostReceptions201JSONResponse{
-		Id:       &reception.ID,
+	return DeletePvzPvzIdAssignmentsAssignmentId204Response{}, nil
+}
+
+func (h httpRequestHandlers) PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error) {
+	args := reception.CloseLastOpenedReceptionAtPVZArgs{
+		AuthenticationArgs:      h.authArgs(ctx),
+		PVZAssignmentRepository: h.deps.PVZAssignmentRepository,
+		ReceptionClosingArgs: reception.ReceptionClosingArgs{
+			ReceptionInfoRepository:     h.deps.ReceptionInfoRepository,
+			ProductRepository:           h.deps.ProductRepository,
+			ShipmentManifestRepository:  h.deps.ShipmentManifestRepository,
+			DuplicateScanRepository:     h.deps.DuplicateScanRepository,
+			DiscrepancyReportRepository: h.deps.DiscrepancyReportRepository,
+			ReceptionActRepository:      h.deps.ReceptionActRepository,
+			ReceptionActRenderer:        h.deps.ReceptionActRenderer,
+			ReceptionHistoryRepository:  h.deps.ReceptionHistoryRepository,
+			EventOutboxRepository:       h.deps.EventOutboxRepository,
+			AuditRepository:             h.deps.AuditRepository,
+		},
+		PVZRepository: h.deps.PVZRepository,
+		UnitOfWork:    h.deps.UnitOfWork,
+		EventBus:      h.deps.EventBus,
+		PVZ: reception.CloseLastOpenedReceptionAtPVZDTO{
+			PVZID: request.PvzId,
+		},
+	}
+
+	reception, err := reception.CloseLastOpenedReceptionUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostPvzPvzIdCloseLastReception403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostPvzPvzIdCloseLastReception400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostPvzPvzIdCloseLastReception200JSONResponse{
+		Id:       &reception.ID,
+		DateTime: reception.CreationTimeUTC,
+		PvzId:    reception.PVZID,
+		Status:   receptionStatus(reception.Status),
+	}, nil
+}
+
+func (h httpRequestHandlers) PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error) {
+	args := reception.DeleteLastProductFromCurrentReceptionAtPVZArgs{
+		AuthenticationArgs:      h.authArgs(ctx),
+		PVZAssignmentRepository: h.deps.PVZAssignmentRepository,
+		ReceptionInfoRepository: h.deps.ReceptionInfoRepository,
+		PVZRepository:           h.deps.PVZRepository,
+		ProductRepository:       h.deps.ProductRepository,
+		EventOutboxRepository:   h.deps.EventOutboxRepository,
+		AuditRepository:         h.deps.AuditRepository,
+		UnitOfWork:              h.deps.UnitOfWork,
+		EventBus:                h.deps.EventBus,
+		PVZ: reception.DeleteLastProductFromCurrentReceptionAtPVZDTO{
+			PVZID: request.PvzId,
+		},
+	}
+
+	err := reception.DeleteLastProductFromCurrentReceptionAtPVZUseCase(ctx, args)
+
+	if err != nil {
+		if domain.IsAccessError(err) {
+			return PostPvzPvzIdDeleteLastProduct403JSONResponse{
+				Message: err.Error(),
+			}, nil
+		}
+
+		return PostPvzPvzIdDeleteLastProduct400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return PostPvzPvzIdDeleteLastProduct200Response{}, nil
+}
+
+func (h httpRequestHandlers) PostPvzPvzIdRestoreLastProduct(ctx context.Context, request PostPvzPvzIdRestoreLastProductRequestObject) (PostPvzPvzIdRestoreLastProductResponseObject, error) {
+	args := reception.RestoreLastRemovedProductAtPVZArgs{
+		AuthenticationArgs:         h.authArgs(ctx),
+		PVZAssignmentRepository:    h.deps.PVZAssignmentRepository,
+		ReceptionInfoRepository:    h.deps.ReceptionInfoRepository,
+		PVZRepository:              h.deps.PVZRepository,
+		ProductRepository:          h.deps.ProductRepository,
+		ReceptionHistoryRepository: h.deps.ReceptionHistoryRepository,
+		EventOutboxRepository:      h.deps.EventOutboxRepository,
+		AuditRepository:            h.deps.AuditRepository,
+		UnitOfWork:                 h.deps.UnitOfWork,
+		EventBus:                   h.deps.EventBus,
+		PVZ: reception.RestoreLastRemovedProductAtPVZDTO{
+			PVZID: request.PvzId,
+		},
+	}
+
+	restored, err := reception.RestoreLastRemovedProductAtPVZUseCase(ctx, args)
+
+	if err != nil {
+		if domain.IsAccessError(err) {
+			return PostPvzPvzIdRestoreLastProduct403JSONResponse{
+				Message: err.Error(),
+			}, nil
+		}
+
+		return PostPvzPvzIdRestoreLastProduct400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return PostPvzPvzIdRestoreLastProduct200JSONResponse(product(&restored)), nil
+}
+
+func (h httpRequestHandlers) PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error) {
+	args := reception.CreateNewReceptionArgs{
+		AuthenticationArgs:         h.authArgs(ctx),
+		PVZAssignmentRepository:    h.deps.PVZAssignmentRepository,
+		ReceptionInfoRepository:    h.deps.ReceptionInfoRepository,
+		ReceptionHistoryRepository: h.deps.ReceptionHistoryRepository,
+		PVZRepository:              h.deps.PVZRepository,
+		EventOutboxRepository:      h.deps.EventOutboxRepository,
+		AuditRepository:            h.deps.AuditRepository,
+		UnitOfWork:                 h.deps.UnitOfWork,
+		EventBus:                   h.deps.EventBus,
+		PVZ: reception.CreateNewReceptionAtPVZDTO{
+			PVZID: request.Body.PvzId,
+		},
+	}
+
+	reception, err := reception.CreateNewReceptionUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostReceptions403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostReceptions400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostReceptions201JSONResponse{
+		Id:       &reception.ID,
 		DateTime: reception.CreationTimeUTC,
 		PvzId:    reception.PVZID,
 		Status:   receptionStatus(reception.Status),
 	}, nil
 }
 
+func (h httpRequestHandlers) PostPvzPvzIdManifests(ctx context.Context, request PostPvzPvzIdManifestsRequestObject) (PostPvzPvzIdManifestsResponseObject, error) {
+	items := make([]reception.ShipmentManifestItemDTO, 0, len(request.Body.Items))
+	for _, item := range request.Body.Items {
+		items = append(items, reception.ShipmentManifestItemDTO{Barcode: item.Barcode, ProductCategory: string(item.Type)})
+	}
+
+	args := reception.UploadShipmentManifestArgs{
+		AuthenticationArgs:         h.authArgs(ctx),
+		PVZRepository:              h.deps.PVZRepository,
+		PVZAssignmentRepository:    h.deps.PVZAssignmentRepository,
+		ShipmentManifestRepository: h.deps.ShipmentManifestRepository,
+		AuditRepository:            h.deps.AuditRepository,
+		UnitOfWork:                 h.deps.UnitOfWork,
+		Manifest: reception.UploadShipmentManifestDTO{
+			PVZID: request.PvzId,
+			Items: items,
+		},
+	}
+
+	manifest, err := reception.UploadShipmentManifestUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostPvzPvzIdManifests403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostPvzPvzIdManifests400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostPvzPvzIdManifests201JSONResponse(shipmentManifest(manifest)), nil
+}
+
+func (h httpRequestHandlers) PostReceptionsReceptionIdManifest(ctx context.Context, request PostReceptionsReceptionIdManifestRequestObject) (PostReceptionsReceptionIdManifestResponseObject, error) {
+	args := reception.LinkShipmentManifestArgs{
+		AuthenticationArgs:         h.authArgs(ctx),
+		ReceptionInfoRepository:    h.deps.ReceptionInfoRepository,
+		PVZAssignmentRepository:    h.deps.PVZAssignmentRepository,
+		ShipmentManifestRepository: h.deps.ShipmentManifestRepository,
+		ReceptionHistoryRepository: h.deps.ReceptionHistoryRepository,
+		AuditRepository:            h.deps.AuditRepository,
+		UnitOfWork:                 h.deps.UnitOfWork,
+		Link: reception.LinkShipmentManifestDTO{
+			ReceptionID: request.ReceptionId,
+			ManifestID:  request.Body.ManifestId,
+		},
+	}
+
+	manifest, err := reception.LinkShipmentManifestUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostReceptionsReceptionIdManifest403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostReceptionsReceptionIdManifest400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostReceptionsReceptionIdManifest200JSONResponse(shipmentManifest(manifest)), nil
+}
+
+func (h httpRequestHandlers) GetReceptionsReceptionIdDiscrepancies(ctx context.Context, request GetReceptionsReceptionIdDiscrepanciesRequestObject) (GetReceptionsReceptionIdDiscrepanciesResponseObject, error) {
+	args := reception.GetDiscrepancyReportArgs{
+		AuthenticationArgs:          h.authArgs(ctx),
+		ReceptionInfoRepository:     h.deps.ReceptionInfoRepository,
+		PVZAssignmentRepository:     h.deps.PVZAssignmentRepository,
+		DiscrepancyReportRepository: h.deps.DiscrepancyReportRepository,
+		ReceptionID:                 request.ReceptionId,
+	}
+
+	report, err := reception.GetDiscrepancyReportUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return GetReceptionsReceptionIdDiscrepancies403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return GetReceptionsReceptionIdDiscrepancies400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return GetReceptionsReceptionIdDiscrepancies200JSONResponse{
+		ReceptionId:          report.ReceptionID,
+		ManifestId:           report.ManifestID,
+		DateTime:             report.CreationTimeUTC,
+		Missing:              report.Missing,
+		Unexpected:           report.Unexpected,
+		Duplicates:           report.Duplicates,
+		UnidentifiedProducts: report.UnidentifiedProducts,
+		CategoryMismatches:   categoryMismatches(report.CategoryMismatches),
+		Categories:           categoryDiscrepancies(report.Categories),
+	}, nil
+}
+
+func (h httpRequestHandlers) PostReceptionsReceptionIdReopen(ctx context.Context, request PostReceptionsReceptionIdReopenRequestObject) (PostReceptionsReceptionIdReopenResponseObject, error) {
+	args := reception.ReopenReceptionArgs{
+		AuthenticationArgs:          h.authArgs(ctx),
+		ReceptionInfoRepository:     h.deps.ReceptionInfoRepository,
+		PVZAssignmentRepository:     h.deps.PVZAssignmentRepository,
+		ReceptionHistoryRepository:  h.deps.ReceptionHistoryRepository,
+		ReceptionActRepository:      h.deps.ReceptionActRepository,
+		DiscrepancyReportRepository: h.deps.DiscrepancyReportRepository,
+		EventOutboxRepository:       h.deps.EventOutboxRepository,
+		AuditRepository:             h.deps.AuditRepository,
+		UnitOfWork:                  h.deps.UnitOfWork,
+		EventBus:                    h.deps.EventBus,
+		Reception: reception.ReopenReceptionDTO{
+			ReceptionID: request.ReceptionId,
+			Reason:      request.Body.Reason,
+		},
+	}
+
+	reopened, err := reception.ReopenReceptionUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostReceptionsReceptionIdReopen403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostReceptionsReceptionIdReopen400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostReceptionsReceptionIdReopen200JSONResponse{
+		Id:       &reopened.ID,
+		DateTime: reopened.CreationTimeUTC,
+		PvzId:    reopened.PVZID,
+		Status:   receptionStatus(reopened.Status),
+	}, nil
+}
+
+func (h httpRequestHandlers) GetReceptionsReceptionIdHistory(ctx context.Context, request GetReceptionsReceptionIdHistoryRequestObject) (GetReceptionsReceptionIdHistoryResponseObject, error) {
+	args := reception.GetReceptionHistoryArgs{
+		AuthenticationArgs:         h.authArgs(ctx),
+		PVZAssignmentRepository:    h.deps.PVZAssignmentRepository,
+		ReceptionInfoRepository:    h.deps.ReceptionInfoRepository,
+		ReceptionHistoryRepository: h.deps.ReceptionHistoryRepository,
+		ReceptionID:                request.ReceptionId,
+	}
+
+	entries, err := reception.GetReceptionHistoryUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return GetReceptionsReceptionIdHistory403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return GetReceptionsReceptionIdHistory400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	response := make(GetReceptionsReceptionIdHistory200JSONResponse, 0, len(entries))
+	for _, entry := range entries {
+		response = append(response, receptionHistoryEntry(entry))
+	}
+
+	return response, nil
+}
+
+func (h httpRequestHandlers) GetReceptionsReceptionIdAct(ctx context.Context, request GetReceptionsReceptionIdActRequestObject) (GetReceptionsReceptionIdActResponseObject, error) {
+	args := reception.GetReceptionActArgs{
+		AuthenticationArgs:      h.authArgs(ctx),
+		ReceptionInfoRepository: h.deps.ReceptionInfoRepository,
+		PVZAssignmentRepository: h.deps.PVZAssignmentRepository,
+		ReceptionActRepository:  h.deps.ReceptionActRepository,
+		ReceptionID:             request.ReceptionId,
+	}
+
+	act, err := reception.GetReceptionActUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return GetReceptionsReceptionIdAct403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return GetReceptionsReceptionIdAct400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return GetReceptionsReceptionIdAct200ApplicationpdfResponse{
+		Body:          bytes.NewReader(act.Document),
+		ContentLength: int64(len(act.Document)),
+	}, nil
+}
+
+func (h httpRequestHandlers) GetReceptionsStream(ctx context.Context, request GetReceptionsStreamRequestObject) (GetReceptionsStreamResponseObject, error) {
+	args := reception.SubscribeToReceptionFeedArgs{
+		AuthenticationArgs:      h.authArgs(ctx),
+		PVZRepository:           h.deps.PVZRepository,
+		PVZAssignmentRepository: h.deps.PVZAssignmentRepository,
+		EventBus:                h.deps.EventBus,
+		Feed: reception.SubscribeToReceptionFeedDTO{
+			PVZID: request.Params.PvzId,
+			City:  (*string)(request.Params.City),
+		},
+	}
+
+	events, unsubscribe, err := reception.SubscribeToReceptionFeedUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return GetReceptionsStream403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return GetReceptionsStream400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return GetReceptionsStream200TexteventStreamResponse{
+		Body: newEventStream(ctx, events, unsubscribe),
+	}, nil
+}
+
 func (h httpRequestHandlers) PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error) {
 	args := users.RegisterUserUseCaseArgs{
 		AuthorizationService: h.deps.AuthorizationService,
+		Throttle:             h.deps.Throttle,
+		RoleRepository:       h.deps.RoleRepository,
+		InvitationRepository: h.deps.InvitationRepository,
+		UserTokenRepository:  h.deps.UserTokenRepository,
+		Mailer:               h.deps.Mailer,
+		AuditRepository:      h.deps.AuditRepository,
+		UnitOfWork:           h.deps.UnitOfWork,
 		User: users.RegisterUserDTO{
 			Email:    string(request.Body.Email),
 			Password: request.Body.Password,
-			Role:     string(request.Body.Role),
 		},
 	}
 
+	if request.Body.InviteToken != nil {
+		args.User.InviteToken = *request.Body.InviteToken
+	}
+
 	user, err := users.RegisterUserUseCase(ctx, args)
 
 	if err != nil {
+		if response, ok := tooManyRequests(err); ok {
+			return PostRegister429JSONResponse{response}, nil
+		}
+
 		return PostRegister400JSONResponse{
 			Message: err.Error(),
 		}, nil
 	}
-	return PostRegister201JSONResponse{
-		Email: openapi_types.Email(user.Email),
-		Id:    &user.ID,
-		Role:  role(user.UserRole.ID),
-	}, nil
+	return PostRegister201JSONResponse(userResponse(*user)), nil
+}
+
+func (h httpRequestHandlers) PostPasswordReset(ctx context.Context, request PostPasswordResetRequestObject) (PostPasswordResetResponseObject, error) {
+	args := users.RequestPasswordResetArgs{
+		Throttle:            h.deps.Throttle,
+		UserRepository:      h.deps.UserRepository,
+		UserTokenRepository: h.deps.UserTokenRepository,
+		Mailer:              h.deps.Mailer,
+		UnitOfWork:          h.deps.UnitOfWork,
+		Email:               string(request.Body.Email),
+	}
+
+	err := users.RequestPasswordResetUseCase(ctx, args)
+
+	if err != nil {
+		if response, ok := tooManyRequests(err); ok {
+			return PostPasswordReset429JSONResponse{response}, nil
+		}
+
+		return PostPasswordReset400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return PostPasswordReset202Response{}, nil
+}
+
+func (h httpRequestHandlers) PostPasswordResetConfirm(ctx context.Context, request PostPasswordResetConfirmRequestObject) (PostPasswordResetConfirmResponseObject, error) {
+	args := users.ResetPasswordArgs{
+		AuthorizationService: h.deps.AuthorizationService,
+		Throttle:             h.deps.Throttle,
+		UserRepository:       h.deps.UserRepository,
+		UserTokenRepository:  h.deps.UserTokenRepository,
+		AuditRepository:      h.deps.AuditRepository,
+		UnitOfWork:           h.deps.UnitOfWork,
+		Reset: users.ResetPasswordDTO{
+			Token:    request.Body.Token,
+			Password: request.Body.Password,
+		},
+	}
+
+	if err := users.ResetPasswordUseCase(ctx, args); err != nil {
+		return PostPasswordResetConfirm400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return PostPasswordResetConfirm204Response{}, nil
+}
+
+func (h httpRequestHandlers) PostEmailVerification(ctx context.Context, request PostEmailVerificationRequestObject) (PostEmailVerificationResponseObject, error) {
+	args := users.RequestEmailVerificationArgs{
+		AuthenticationArgs:  h.authArgs(ctx),
+		UserTokenRepository: h.deps.UserTokenRepository,
+		Mailer:              h.deps.Mailer,
+		UnitOfWork:          h.deps.UnitOfWork,
+	}
+
+	err := users.RequestEmailVerificationUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostEmailVerification403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostEmailVerification400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostEmailVerification202Response{}, nil
+}
+
+func (h httpRequestHandlers) PostEmailVerificationConfirm(ctx context.Context, request PostEmailVerificationConfirmRequestObject) (PostEmailVerificationConfirmResponseObject, error) {
+	args := users.VerifyEmailArgs{
+		UserRepository:      h.deps.UserRepository,
+		UserTokenRepository: h.deps.UserTokenRepository,
+		AuditRepository:     h.deps.AuditRepository,
+		UnitOfWork:          h.deps.UnitOfWork,
+		Token:               request.Body.Token,
+	}
+
+	if err := users.VerifyEmailUseCase(ctx, args); err != nil {
+		return PostEmailVerificationConfirm400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return PostEmailVerificationConfirm204Response{}, nil
+}
+
+func (h httpRequestHandlers) twoFactorArgs(ctx context.Context) users.TwoFactorArgs {
+	return users.TwoFactorArgs{
+		AuthenticationArgs: h.authArgs(ctx),
+		UserRepository:     h.deps.UserRepository,
+		AuditRepository:    h.deps.AuditRepository,
+		UnitOfWork:         h.deps.UnitOfWork,
+	}
+}
+
+func (h httpRequestHandlers) PostTwoFactorEnroll(ctx context.Context, request PostTwoFactorEnrollRequestObject) (PostTwoFactorEnrollResponseObject, error) {
+	enrollment, err := users.EnrollTwoFactorUseCase(ctx, h.twoFactorArgs(ctx))
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostTwoFactorEnroll403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostTwoFactorEnroll400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostTwoFactorEnroll200JSONResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningUri: enrollment.ProvisioningURI,
+	}, nil
+}
+
+func (h httpRequestHandlers) PostTwoFactorConfirm(ctx context.Context, request PostTwoFactorConfirmRequestObject) (PostTwoFactorConfirmResponseObject, error) {
+	recoveryCodes, err := users.ConfirmTwoFactorUseCase(ctx, h.twoFactorArgs(ctx), request.Body.Code)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostTwoFactorConfirm403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostTwoFactorConfirm400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostTwoFactorConfirm200JSONResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (h httpRequestHandlers) PostTwoFactorDisable(ctx context.Context, request PostTwoFactorDisableRequestObject) (PostTwoFactorDisableResponseObject, error) {
+	if err := users.DisableTwoFactorUseCase(ctx, h.twoFactorArgs(ctx), request.Body.Code); err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostTwoFactorDisable403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostTwoFactorDisable400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostTwoFactorDisable204Response{}, nil
+}
+
+func (h httpRequestHandlers) PostInvitations(ctx context.Context, request PostInvitationsRequestObject) (PostInvitationsResponseObject, error) {
+	args := users.InviteUserArgs{
+		AuthenticationArgs:   h.authArgs(ctx),
+		RoleRepository:       h.deps.RoleRepository,
+		InvitationRepository: h.deps.InvitationRepository,
+		AuditRepository:      h.deps.AuditRepository,
+		UnitOfWork:           h.deps.UnitOfWork,
+		Invitation: users.InviteUserDTO{
+			Role: request.Body.Role,
+		},
+	}
+
+	if request.Body.Email != nil {
+		email := string(*request.Body.Email)
+		args.Invitation.Email = &email
+	}
+
+	issued, err := users.InviteUserUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostInvitations403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostInvitations400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostInvitations201JSONResponse(invitation(issued)), nil
+}
+
+func (h httpRequestHandlers) GetUsers(ctx context.Context, request GetUsersRequestObject) (GetUsersResponseObject, error) {
+	params := request.Params
+
+	filter := users.ListUsersDTO{
+		Active: params.Active,
+	}
+
+	if params.Email != nil {
+		filter.Email = *params.Email
+	}
+
+	if params.Role != nil {
+		filter.Role = *params.Role
+	}
+
+	if params.Page != nil {
+		filter.Page = *params.Page
+	}
+
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+
+	args := users.ListUsersArgs{
+		AuthenticationArgs: h.authArgs(ctx),
+		UserRepository:     h.deps.UserRepository,
+		RoleRepository:     h.deps.RoleRepository,
+		Filter:             filter,
+	}
+
+	found, err := users.ListUsersUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return GetUsers403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return GetUsers400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	response := make(GetUsers200JSONResponse, 0, len(found))
+	for _, user := range found {
+		response = append(response, userResponse(user))
+	}
+
+	return response, nil
+}
+
+func (h httpRequestHandlers) PatchUsersUserId(ctx context.Context, request PatchUsersUserIdRequestObject) (PatchUsersUserIdResponseObject, error) {
+	args := users.UpdateUserArgs{
+		AuthenticationArgs: h.authArgs(ctx),
+		UserRepository:     h.deps.UserRepository,
+		RoleRepository:     h.deps.RoleRepository,
+		AuditRepository:    h.deps.AuditRepository,
+		UnitOfWork:         h.deps.UnitOfWork,
+		UserID:             request.UserId,
+		Changes: users.UpdateUserDTO{
+			Role:   request.Body.Role,
+			Active: request.Body.Active,
+		},
+	}
+
+	user, err := users.UpdateUserUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PatchUsersUserId403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PatchUsersUserId400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PatchUsersUserId200JSONResponse(userResponse(user)), nil
+}
+
+func (h httpRequestHandlers) PutUsersUserIdRole(ctx context.Context, request PutUsersUserIdRoleRequestObject) (PutUsersUserIdRoleResponseObject, error) {
+	args := users.ChangeUserRoleArgs{
+		AuthenticationArgs: h.authArgs(ctx),
+		UserRepository:     h.deps.UserRepository,
+		RoleRepository:     h.deps.RoleRepository,
+		AuditRepository:    h.deps.AuditRepository,
+		UnitOfWork:         h.deps.UnitOfWork,
+		UserID:             request.UserId,
+		Role:               request.Body.Role,
+	}
+
+	user, err := users.ChangeUserRoleUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PutUsersUserIdRole403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PutUsersUserIdRole400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PutUsersUserIdRole200JSONResponse(userResponse(user)), nil
+}
+
+func (h httpRequestHandlers) PostServiceAccounts(ctx context.Context, request PostServiceAccountsRequestObject) (PostServiceAccountsResponseObject, error) {
+	args := serviceaccounts.CreateServiceAccountArgs{
+		AuthenticationArgs: h.authArgs(ctx),
+		UserRepository:     h.deps.UserRepository,
+		RoleRepository:     h.deps.RoleRepository,
+		AuditRepository:    h.deps.AuditRepository,
+		UnitOfWork:         h.deps.UnitOfWork,
+		Account: serviceaccounts.CreateServiceAccountDTO{
+			Email: string(request.Body.Email),
+			Role:  request.Body.Role,
+		},
+	}
+
+	account, err := serviceaccounts.CreateServiceAccountUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostServiceAccounts403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostServiceAccounts400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostServiceAccounts201JSONResponse(userResponse(account)), nil
+}
+
+func (h httpRequestHandlers) PostServiceAccountsUserIdApiKeys(ctx context.Context, request PostServiceAccountsUserIdApiKeysRequestObject) (PostServiceAccountsUserIdApiKeysResponseObject, error) {
+	args := serviceaccounts.IssueAPIKeyArgs{
+		AuthenticationArgs: h.authArgs(ctx),
+		UserRepository:     h.deps.UserRepository,
+		APIKeyRepository:   h.deps.APIKeyRepository,
+		AuditRepository:    h.deps.AuditRepository,
+		UnitOfWork:         h.deps.UnitOfWork,
+		ServiceAccountID:   request.UserId,
+		Key: serviceaccounts.IssueAPIKeyDTO{
+			Name: request.Body.Name,
+		},
+	}
+
+	for _, permission := range request.Body.Scope {
+		args.Key.Scope = append(args.Key.Scope, string(permission))
+	}
+
+	issued, err := serviceaccounts.IssueAPIKeyUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostServiceAccountsUserIdApiKeys403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostServiceAccountsUserIdApiKeys400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostServiceAccountsUserIdApiKeys201JSONResponse(issuedAPIKey(issued)), nil
+}
+
+func (h httpRequestHandlers) GetServiceAccountsUserIdApiKeys(ctx context.Context, request GetServiceAccountsUserIdApiKeysRequestObject) (GetServiceAccountsUserIdApiKeysResponseObject, error) {
+	args := serviceaccounts.ListAPIKeysArgs{
+		AuthenticationArgs: h.authArgs(ctx),
+		UserRepository:     h.deps.UserRepository,
+		APIKeyRepository:   h.deps.APIKeyRepository,
+		ServiceAccountID:   request.UserId,
+	}
+
+	keys, err := serviceaccounts.ListAPIKeysUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return GetServiceAccountsUserIdApiKeys403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return GetServiceAccountsUserIdApiKeys400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	response := make(GetServiceAccountsUserIdApiKeys200JSONResponse, 0, len(keys))
+	for _, key := range keys {
+		response = append(response, apiKey(key))
+	}
+
+	return response, nil
+}
+
+func (h httpRequestHandlers) DeleteServiceAccountsUserIdApiKeysKeyId(ctx context.Context, request DeleteServiceAccountsUserIdApiKeysKeyIdRequestObject) (DeleteServiceAccountsUserIdApiKeysKeyIdResponseObject, error) {
+	args := serviceaccounts.RevokeAPIKeyArgs{
+		AuthenticationArgs: h.authArgs(ctx),
+		UserRepository:     h.deps.UserRepository,
+		APIKeyRepository:   h.deps.APIKeyRepository,
+		AuditRepository:    h.deps.AuditRepository,
+		UnitOfWork:         h.deps.UnitOfWork,
+		ServiceAccountID:   request.UserId,
+		KeyID:              request.KeyId,
+	}
+
+	err := serviceaccounts.RevokeAPIKeyUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return DeleteServiceAccountsUserIdApiKeysKeyId403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return DeleteServiceAccountsUserIdApiKeysKeyId400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return DeleteServiceAccountsUserIdApiKeysKeyId204Response{}, nil
+}
+
+func (h httpRequestHandlers) PostWebhooks(ctx context.Context, request PostWebhooksRequestObject) (PostWebhooksResponseObject, error) {
+	args := webhooks.CreateWebhookSubscriptionArgs{
+		AuthenticationArgs:            h.authArgs(ctx),
+		PVZRepository:                 h.deps.PVZRepository,
+		WebhookSubscriptionRepository: h.deps.WebhookSubscriptionRepository,
+		AuditRepository:               h.deps.AuditRepository,
+		UnitOfWork:                    h.deps.UnitOfWork,
+		PrivateNetworksAllowed:        h.deps.WebhookPrivateNetworksAllowed,
+		Subscription: webhooks.CreateWebhookSubscriptionDTO{
+			URL:    request.Body.Url,
+			PVZID:  request.Body.PvzId,
+			Secret: request.Body.Secret,
+		},
+	}
+
+	for _, eventType := range request.Body.EventTypes {
+		args.Subscription.EventTypes = append(args.Subscription.EventTypes, string(eventType))
+	}
+
+	if request.Body.City != nil {
+		city := string(*request.Body.City)
+		args.Subscription.City = &city
+	}
+
+	subscription, err := webhooks.CreateWebhookSubscriptionUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return PostWebhooks403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return PostWebhooks400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return PostWebhooks201JSONResponse(webhookSubscription(subscription)), nil
+}
+
+func (h httpRequestHandlers) GetWebhooks(ctx context.Context, request GetWebhooksRequestObject) (GetWebhooksResponseObject, error) {
+	args := webhooks.ListWebhookSubscriptionsArgs{
+		AuthenticationArgs:            h.authArgs(ctx),
+		WebhookSubscriptionRepository: h.deps.WebhookSubscriptionRepository,
+	}
+
+	subscriptions, err := webhooks.ListWebhookSubscriptionsUseCase(ctx, args)
+
+	if err != nil {
+		if domain.IsAccessError(err) {
+			return GetWebhooks403JSONResponse{
+				Message: err.Error(),
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	response := make(GetWebhooks200JSONResponse, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		response = append(response, webhookSubscription(subscription))
+	}
+
+	return response, nil
+}
+
+func (h httpRequestHandlers) DeleteWebhooksSubscriptionId(ctx context.Context, request DeleteWebhooksSubscriptionIdRequestObject) (DeleteWebhooksSubscriptionIdResponseObject, error) {
+	args := webhooks.RemoveWebhookSubscriptionArgs{
+		AuthenticationArgs:            h.authArgs(ctx),
+		WebhookSubscriptionRepository: h.deps.WebhookSubscriptionRepository,
+		AuditRepository:               h.deps.AuditRepository,
+		UnitOfWork:                    h.deps.UnitOfWork,
+		SubscriptionID:                request.SubscriptionId,
+	}
+
+	err := webhooks.RemoveWebhookSubscriptionUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return DeleteWebhooksSubscriptionId403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return DeleteWebhooksSubscriptionId400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	return DeleteWebhooksSubscriptionId204Response{}, nil
+}
+
+func (h httpRequestHandlers) GetWebhooksSubscriptionIdDeliveries(ctx context.Context, request GetWebhooksSubscriptionIdDeliveriesRequestObject) (GetWebhooksSubscriptionIdDeliveriesResponseObject, error) {
+	args := webhooks.ListWebhookDeliveryAttemptsArgs{
+		AuthenticationArgs:               h.authArgs(ctx),
+		WebhookSubscriptionRepository:    h.deps.WebhookSubscriptionRepository,
+		WebhookDeliveryAttemptRepository: h.deps.WebhookDeliveryAttemptRepository,
+		SubscriptionID:                   request.SubscriptionId,
+		Limit:                            request.Params.Limit,
+	}
+
+	attempts, err := webhooks.ListWebhookDeliveryAttemptsUseCase(ctx, args)
+
+	if err != nil {
+		msg := err.Error()
+		if domain.IsAccessError(err) {
+			return GetWebhooksSubscriptionIdDeliveries403JSONResponse{
+				Message: msg,
+			}, nil
+		}
+
+		return GetWebhooksSubscriptionIdDeliveries400JSONResponse{
+			Message: msg,
+		}, nil
+	}
+
+	response := make(GetWebhooksSubscriptionIdDeliveries200JSONResponse, 0, len(attempts))
+	for _, attempt := range attempts {
+		response = append(response, webhookDeliveryAttempt(attempt))
+	}
+
+	return response, nil
 }
@@ -2,7 +2,13 @@ package http_profile
 
 import (
 	"avito/internal/domain"
+	"avito/internal/usecases/roles"
+	"avito/internal/usecases/serviceaccounts"
+	"avito/internal/usecases/users"
+	"encoding/json"
 	"log"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 func receptionStatus(status domain.ReceptionStatus) ReceptionStatus {
@@ -31,14 +37,270 @@ func productType(category domain.ProductCategory) ProductType {
 	}
 }
 
-func role(roleId domain.UserRoleID) UserRole {
-	switch roleId {
-	case domain.ClientUserRoleID:
-		return UserRoleEmployee
-	case domain.ModeratorUserRoleID:
-		return UserRoleModerator
+func productCategory(category domain.ProductCategory) ProductCategory {
+	return ProductCategory(productType(category))
+}
+
+func shipmentManifest(manifest domain.ShipmentManifest) ShipmentManifest {
+	items := make([]ShipmentManifestItem, 0, len(manifest.Items))
+	for _, item := range manifest.Items {
+		items = append(items, ShipmentManifestItem{Barcode: item.Barcode, Type: productCategory(item.Category)})
+	}
+
+	return ShipmentManifest{
+		Id:          manifest.ID,
+		PvzId:       manifest.PVZID,
+		ReceptionId: manifest.ReceptionID,
+		DateTime:    manifest.CreationTimeUTC,
+		Items:       items,
+	}
+}
+
+func categoryMismatches(mismatches []domain.CategoryMismatch) []CategoryMismatch {
+	response := make([]CategoryMismatch, 0, len(mismatches))
+	for _, mismatch := range mismatches {
+		response = append(response, CategoryMismatch{
+			Barcode:  mismatch.Barcode,
+			Expected: productCategory(mismatch.Expected),
+			Accepted: productCategory(mismatch.Accepted),
+		})
+	}
+
+	return response
+}
+
+func categoryDiscrepancies(discrepancies []domain.CategoryDiscrepancy) []CategoryDiscrepancy {
+	response := make([]CategoryDiscrepancy, 0, len(discrepancies))
+	for _, discrepancy := range discrepancies {
+		response = append(response, CategoryDiscrepancy{
+			Type:     productCategory(discrepancy.Category),
+			Expected: discrepancy.Expected,
+			Accepted: discrepancy.Accepted,
+		})
+	}
+
+	return response
+}
+
+func barcode(barcode *domain.Barcode) *Barcode {
+	if barcode == nil {
+		return nil
+	}
+
+	var format BarcodeFormat
+	switch barcode.Format {
+	case domain.EAN13BarcodeFormat:
+		format = Ean13
+	case domain.Code128BarcodeFormat:
+		format = Code128
+	case domain.OrderNumberBarcodeFormat:
+		format = Order
 	default:
-		log.Println("fall out of known user roles")
-		return ""
+		log.Println("fall out of known barcode formats")
+	}
+
+	return &Barcode{
+		Value:  barcode.Value,
+		Format: format,
+	}
+}
+
+func product(product *domain.Product) Product {
+	return Product{
+		DateTime:    &product.CreationTimeUTC,
+		Id:          &product.ID,
+		ReceptionId: product.ReceptionID,
+		Type:        productType(product.Category),
+		Barcode:     barcode(product.Barcode),
+	}
+}
+
+func role(userRole domain.UserRole) Role {
+	permissions := make([]Permission, 0, len(userRole.Permissions))
+	for _, permission := range userRole.Permissions {
+		permissions = append(permissions, Permission(permission))
+	}
+
+	return Role{
+		Id:                int(userRole.ID),
+		Name:              userRole.Name,
+		Permissions:       permissions,
+		TwoFactorRequired: userRole.TwoFactorRequired,
+	}
+}
+
+func userResponse(user domain.User) User {
+	return User{
+		Email:            openapi_types.Email(user.Email),
+		Id:               &user.ID,
+		Role:             user.UserRole.Name,
+		Active:           !user.Deactivated,
+		EmailVerified:    user.EmailVerified,
+		TwoFactorEnabled: user.TwoFactor.Enabled,
+		ServiceAccount:   user.ServiceAccount,
+		Sso:              user.SSOSubject != nil,
+	}
+}
+
+func invitation(issued users.IssuedInvitation) Invitation {
+	response := Invitation{
+		Id:        issued.ID,
+		Token:     issued.Token,
+		Role:      issued.Role.Name,
+		ExpiresAt: issued.ExpiresAtUTC,
+		DateTime:  issued.CreationTimeUTC,
+	}
+
+	if issued.Email != nil {
+		email := openapi_types.Email(*issued.Email)
+		response.Email = &email
+	}
+
+	return response
+}
+
+// apiKey maps key without the key itself, it is shown only when key is issued
+func apiKey(key domain.APIKey) ApiKey {
+	scope := make([]Permission, 0, len(key.Scope))
+	for _, permission := range key.Scope {
+		scope = append(scope, Permission(permission))
+	}
+
+	return ApiKey{
+		Id:         key.ID,
+		Hint:       key.Hint,
+		Name:       key.Name,
+		Scope:      scope,
+		DateTime:   key.CreationTimeUTC,
+		RevokedAt:  key.RevokedAtUTC,
+		LastUsedAt: key.LastUsedAtUTC,
 	}
 }
+
+func issuedAPIKey(issued serviceaccounts.IssuedAPIKey) ApiKey {
+	response := apiKey(issued.APIKey)
+	response.Key = &issued.Key
+
+	return response
+}
+
+func roleDTO(request RoleRequest) roles.RoleDTO {
+	dto := roles.RoleDTO{Name: request.Name, TwoFactorRequired: request.TwoFactorRequired}
+	for _, permission := range request.Permissions {
+		dto.Permissions = append(dto.Permissions, string(permission))
+	}
+
+	return dto
+}
+
+func webhookCity(cityID *domain.CityID) *PVZCity {
+	if cityID == nil {
+		return nil
+	}
+
+	var city PVZCity
+	switch *cityID {
+	case domain.KazanCityID:
+		city = Казань
+	case domain.MoscowCityID:
+		city = Москва
+	case domain.SaintPetersburgID:
+		city = СанктПетербург
+	default:
+		log.Println("fall out of known cities")
+	}
+
+	return &city
+}
+
+func webhookSubscription(subscription domain.WebhookSubscription) WebhookSubscription {
+	eventTypes := make([]WebhookEventType, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, WebhookEventType(eventType))
+	}
+
+	// secret is never sent back, moderator shares it with partner once
+	return WebhookSubscription{
+		Id:         subscription.ID,
+		Url:        subscription.URL,
+		EventTypes: eventTypes,
+		PvzId:      subscription.PVZID,
+		City:       webhookCity(subscription.CityID),
+		DateTime:   subscription.CreationTimeUTC,
+	}
+}
+
+func pvzAssignment(assignment domain.PVZAssignment) PVZAssignment {
+	return PVZAssignment{
+		Id:         assignment.ID,
+		UserId:     assignment.UserID,
+		PvzId:      assignment.PVZID,
+		ValidFrom:  assignment.ValidFromUTC,
+		ValidTo:    assignment.ValidToUTC,
+		AssignedBy: assignment.AssignedBy,
+		DateTime:   assignment.CreationTimeUTC,
+	}
+}
+
+func webhookDeliveryAttempt(attempt domain.WebhookDeliveryAttempt) WebhookDeliveryAttempt {
+	response := WebhookDeliveryAttempt{
+		Id:         attempt.ID,
+		EventId:    attempt.EventID,
+		Attempt:    attempt.Attempt,
+		DateTime:   attempt.AttemptTimeUTC,
+		StatusCode: attempt.StatusCode,
+		Delivered:  attempt.Delivered,
+	}
+
+	if attempt.Error != "" {
+		response.Error = &attempt.Error
+	}
+
+	return response
+}
+
+func auditRecord(record domain.AuditRecord) AuditRecord {
+	return AuditRecord{
+		Id:         record.ID,
+		ActorId:    record.ActorID,
+		Action:     AuditAction(record.Action),
+		EntityType: AuditEntityType(record.EntityType),
+		EntityId:   record.EntityID,
+		Before:     auditState(record.Before),
+		After:      auditState(record.After),
+		Protocol:   AuditRecordProtocol(record.Protocol),
+		RequestId:  record.RequestID,
+		DateTime:   record.OccurredAtUTC,
+	}
+}
+
+// absent state stays absent in response, state of every audited entity is a json object
+func auditState(state json.RawMessage) *map[string]interface{} {
+	if len(state) == 0 {
+		return nil
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal(state, &object); err != nil {
+		log.Printf("invalid audit state: %v", err)
+		return nil
+	}
+
+	return &object
+}
+
+func receptionHistoryEntry(entry domain.ReceptionHistoryEntry) ReceptionHistoryEntry {
+	response := ReceptionHistoryEntry{
+		Id:          entry.ID,
+		ReceptionId: entry.ReceptionID,
+		Action:      ReceptionHistoryEntryAction(entry.Action),
+		ActorId:     entry.ActorID,
+		DateTime:    entry.OccurredAtUTC,
+	}
+
+	if entry.Reason != "" {
+		response.Reason = &entry.Reason
+	}
+
+	return response
+}
@@ -1,8 +1,14 @@
 package http_profile
 
 import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"avito/pkg/ratelimit"
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/labstack/echo/v4"
@@ -14,7 +20,27 @@ func (c contextKey) String() string {
 	return fmt.Sprintf("app context key %s", string(c))
 }
 
-const bearerTokenContextKey contextKey = "authorization:bearer"
+const (
+	bearerTokenContextKey contextKey = "authorization:bearer"
+
+	RequestIDHeader string = "X-Request-Id"
+)
+
+// RequestSourceMiddleware marks request with id taken from X-Request-Id header or generated one when header is missing or malformed,
+// id is sent back in the same header so client can find the request in audit log
+func RequestSourceMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			source := domain.NewRequestSource(domain.HTTPRequestProtocol, c.Request().Header.Get(RequestIDHeader))
+			c.Response().Header().Set(RequestIDHeader, source.RequestID)
+
+			newCtx := usecases.WithRequestSource(c.Request().Context(), source)
+			c.SetRequest(c.Request().WithContext(newCtx))
+
+			return next(c)
+		}
+	}
+}
 
 func BearerTokenMiddleware() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
@@ -34,3 +60,32 @@ func BearerTokenMiddleware() echo.MiddlewareFunc {
 		}
 	}
 }
+
+// RateLimitMiddleware limits requests from single ip to listed routes
+func RateLimitMiddleware(limiter *ratelimit.Limiter, routes ...string) echo.MiddlewareFunc {
+	limited := make(map[string]struct{}, len(routes))
+	for _, route := range routes {
+		limited[route] = struct{}{}
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if _, ok := limited[c.Path()]; !ok {
+				return next(c)
+			}
+
+			if err := limiter.Allow(c.RealIP()); err != nil {
+				var limitErr *ratelimit.LimitExceededError
+				if errors.As(err, &limitErr) {
+					c.Response().Header().Set("Retry-After", strconv.Itoa(limitErr.RetryAfterSeconds()))
+				}
+
+				return c.JSON(http.StatusTooManyRequests, Error{
+					Message: err.Error(),
+				})
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -3,7 +3,9 @@ package http_profile
 import (
 	"avito/internal/usecases"
 	jwt "avito/pkg/authorization"
+	"avito/pkg/ratelimit"
 	"context"
+	"errors"
 )
 
 func (h *httpRequestHandlers) authArgs(ctx context.Context) usecases.AuthenticationArgs {
@@ -21,3 +23,28 @@ func authToken(ctx context.Context) jwt.JWT {
 
 	return ""
 }
+
+// queryValue treats absent optional query parameter as empty
+func queryValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+func tooManyRequests(err error) (TooManyRequestsJSONResponse, bool) {
+	var limitErr *ratelimit.LimitExceededError
+	if !errors.As(err, &limitErr) {
+		return TooManyRequestsJSONResponse{}, false
+	}
+
+	return TooManyRequestsJSONResponse{
+		Body: Error{
+			Message: limitErr.Error(),
+		},
+		Headers: TooManyRequestsResponseHeaders{
+			RetryAfter: limitErr.RetryAfterSeconds(),
+		},
+	}, true
+}
@@ -0,0 +1,131 @@
+package http_profile
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	RequestValidationError string = "request does not match api specification"
+
+	specFile string = "openapi.codegen_input.yaml"
+)
+
+// kin-openapi keeps string formats globally, they are defined when the first middleware is made
+var stringFormatsDefinition sync.Once
+
+func defineStringFormats() {
+	// kin-openapi knows only rfc4122 uuid versions, ids in service are uuid v7
+	openapi3.DefineStringFormatValidator("uuid", openapi3.NewCallbackValidator(func(value string) error {
+		_, err := uuid.Parse(value)
+		return err
+	}))
+	openapi3.DefineStringFormat("email", openapi3.FormatOfStringForEmail)
+}
+
+// RequestValidatorMiddleware validates requests against embedded api specification.
+// Routes which are not described in specification (swagger ui) are passed as is.
+// Authorization is checked by use cases, so security requirements are not validated here.
+func RequestValidatorMiddleware() (echo.MiddlewareFunc, error) {
+	stringFormatsDefinition.Do(defineStringFormats)
+
+	data, err := swaggerUI.ReadFile(specFile)
+	if err != nil {
+		return nil, err
+	}
+
+	loader := openapi3.NewLoader()
+	spec, err := loader.LoadFromData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := spec.Validate(loader.Context); err != nil {
+		return nil, err
+	}
+
+	router, err := legacy.NewRouter(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &openapi3filter.Options{
+		MultiError:          true,
+		AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+		SkipSettingDefaults: true,
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			request := c.Request()
+
+			route, pathParams, err := router.FindRoute(request)
+			if err != nil {
+				return next(c)
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    request,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			}
+
+			if err := openapi3filter.ValidateRequest(request.Context(), input); err != nil {
+				fields := fieldErrors("", err)
+
+				return c.JSON(http.StatusBadRequest, Error{
+					Message: RequestValidationError,
+					Errors:  &fields,
+				})
+			}
+
+			return next(c)
+		}
+	}, nil
+}
+
+// fieldErrors flattens validation errors into one entry per failing field
+func fieldErrors(field string, err error) []FieldError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var result []FieldError
+		for _, inner := range e {
+			result = append(result, fieldErrors(field, inner)...)
+		}
+
+		sort.SliceStable(result, func(i, j int) bool {
+			return result[i].Field < result[j].Field
+		})
+
+		return result
+	case *openapi3filter.RequestError:
+		if e.Parameter != nil {
+			field = e.Parameter.Name
+		} else if e.RequestBody != nil && field == "" {
+			field = "body"
+		}
+
+		if e.Err == nil {
+			return []FieldError{{Field: field, Message: e.Reason}}
+		}
+
+		return fieldErrors(field, e.Err)
+	case *openapi3.SchemaError:
+		if pointer := e.JSONPointer(); len(pointer) > 0 {
+			field = strings.Join(pointer, ".")
+		}
+
+		return []FieldError{{Field: field, Message: e.Reason}}
+	default:
+		return []FieldError{{Field: field, Message: err.Error()}}
+	}
+}
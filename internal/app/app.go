@@ -4,15 +4,53 @@ import (
 	grpc_profile "avito/internal/api/grpc-profile"
 	http_profile "avito/internal/api/http"
 	"avito/internal/config"
+	"avito/internal/domain"
 	services "avito/internal/services"
 	"avito/internal/storage"
+	"avito/internal/storage/inmemory"
+	"avito/internal/usecases/reception"
+	"avito/internal/usecases/users"
 	jwt "avito/pkg/authorization"
 	postgresql "avito/pkg/database"
+	"avito/pkg/netguard"
+	"avito/pkg/oidc"
+	"avito/pkg/ratelimit"
 	"context"
+	"errors"
 	"log"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
+	"sync"
 	"syscall"
+	"time"
+
+	"github.com/alexedwards/argon2id"
+	"github.com/google/uuid"
+)
+
+type (
+	App struct {
+		httpServer server
+		grpcServer server
+		bus        *services.EventBus
+		relay      *services.OutboxRelay
+		webhooks   *services.WebhookDispatcher
+		// nil when automatic closing of stale receptions is disabled
+		autoClose      *services.Scheduler
+		stopWorkers    context.CancelFunc
+		workersStopped chan struct{}
+		closeStorage   func()
+	}
+
+	server interface {
+		Listen() error
+		Start() error
+		Stop(ctx context.Context) error
+		Addr() string
+	}
 )
 
 func Run(configPath string) {
@@ -22,57 +60,348 @@ func Run(configPath string) {
 		return
 	}
 
-	postgresClient, err := postgresql.NewClient(cfg.PostgresConfig)
+	app, err := New(cfg)
 	if err != nil {
 		log.Fatalln(err)
 		return
 	}
 
-	repositories := storage.NewRepositories(postgresClient)
+	if err := app.Start(); err != nil {
+		log.Fatalln(err)
+		return
+	}
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
+
+	<-quit
+	app.Stop(context.TODO())
+}
+
+func New(cfg config.Config) (*App, error) {
+	repositories, closeStorage, err := newRepositories(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	passwordPolicy, err := newPasswordPolicy(cfg.AuthConfig.PasswordConfig)
+	if err != nil {
+		closeStorage()
+		return nil, err
+	}
+
 	jwtManager := jwt.NewJWTManager(cfg.AuthConfig.JWTConfig.Sign, cfg.AuthConfig.JWTConfig.Issuer, cfg.AuthConfig.JWTConfig.TokenTTL)
-	authService := services.NewAuthorizationService(*jwtManager, repositories.UserRepository)
+	authService := services.NewAuthorizationService(*jwtManager, repositories.UserRepository, repositories.APIKeyRepository, passwordPolicy, hashParams(cfg.AuthConfig.PasswordConfig.Argon2id))
+	throttle := newThrottling(cfg.AuthConfig.RateLimitConfig)
+	bus := services.NewEventBus()
+	if cfg.AuthConfig.DummyLoginConfig.Enabled {
+		log.Println("dummy login is enabled, anyone could get token of dummy user of any built-in role")
+	}
+
+	mailer, err := newMailer(cfg.MailConfig)
+	if err != nil {
+		closeStorage()
+		return nil, err
+	}
 
 	httpDeps := http_profile.Dependencies{
-		AuthorizationService: authService,
-		JWTManager:           *jwtManager,
-		Repositories:         repositories,
+		AuthorizationService:          authService,
+		JWTManager:                    *jwtManager,
+		Repositories:                  repositories,
+		ReceptionActRenderer:          services.NewPDFReceptionActRenderer(),
+		EventBus:                      bus,
+		Mailer:                        mailer,
+		Throttle:                      throttle,
+		IPLimiter:                     newIPLimiter(cfg.AuthConfig.RateLimitConfig),
+		DummyLoginEnabled:             cfg.AuthConfig.DummyLoginConfig.Enabled,
+		WebhookPrivateNetworksAllowed: cfg.EventsConfig.WebhookDelivery.AllowPrivateNetworks,
 	}
+	httpDeps.IdentityProvider, httpDeps.SSORoleMapping = newIdentityProvider(cfg.AuthConfig.SSOConfig)
+	httpDeps.SSOStateCookieSecure = strings.HasPrefix(cfg.AuthConfig.SSOConfig.RedirectURL, "https://")
 
 	grpcDeps := grpc_profile.Dependencies{
 		AuthorizationService:         authService,
 		PVZRepository:                repositories.PVZRepository,
 		PVZReportAggregateRepository: repositories.PVZReportAggregateRepository,
+		UserRepository:               repositories.UserRepository,
+		RoleRepository:               repositories.RoleRepository,
+		UnitOfWork:                   repositories.UnitOfWork,
 		JWTManager:                   *jwtManager,
+		IPLimiter:                    newIPLimiter(cfg.AuthConfig.RateLimitConfig),
+		DummyLoginEnabled:            cfg.AuthConfig.DummyLoginConfig.Enabled,
 	}
 
-	httpServer := http_profile.NewHTTPServer(httpDeps, cfg.HTTPConfig)
-	grpcServer := grpc_profile.NewGRPCServer(grpcDeps, cfg.GRPCConfig)
+	httpServer, err := http_profile.NewHTTPServer(httpDeps, cfg.HTTPConfig)
+	if err != nil {
+		closeStorage()
+		return nil, err
+	}
 
-	go func() {
-		err := httpServer.Start()
-		if err != nil {
-			log.Fatalln(err)
+	sink, err := newEventSink(cfg.EventsConfig)
+	if err != nil {
+		closeStorage()
+		return nil, err
+	}
+
+	delivery := cfg.EventsConfig.WebhookDelivery
+	webhooks := services.NewWebhookDispatcher(
+		repositories.WebhookSubscriptionRepository,
+		repositories.WebhookDeliveryAttemptRepository,
+		repositories.PVZRepository,
+		newWebhookClient(delivery),
+		delivery.Tries,
+		delivery.RetryDelay,
+	)
+
+	return &App{
+		httpServer:     httpServer,
+		grpcServer:     grpc_profile.NewGRPCServer(grpcDeps, cfg.GRPCConfig),
+		bus:            bus,
+		relay:          services.NewOutboxRelay(repositories.EventOutboxRepository, services.NewFanOutEventSink(sink, webhooks), cfg.EventsConfig.BatchSize, cfg.EventsConfig.PollInterval),
+		webhooks:       webhooks,
+		autoClose:      newAutoCloseScheduler(cfg.ReceptionsConfig.AutoClose, repositories, bus),
+		workersStopped: make(chan struct{}),
+		closeStorage:   closeStorage,
+	}, nil
+}
+
+// Start binds both servers and serves them in background
+func (a *App) Start() error {
+	for _, s := range []server{a.httpServer, a.grpcServer} {
+		if err := s.Listen(); err != nil {
+			return err
 		}
-	}()
+	}
 
+	for _, s := range []server{a.httpServer, a.grpcServer} {
+		go func() {
+			if err := s.Start(); err != nil {
+				log.Fatalln(err)
+			}
+		}()
+	}
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	a.stopWorkers = stopWorkers
 	go func() {
-		err := grpcServer.Start()
-		if err != nil {
-			log.Fatalln(err)
+		var workers sync.WaitGroup
+		workers.Add(2)
+		go func() {
+			defer workers.Done()
+			a.relay.Run(workersCtx)
+		}()
+		go func() {
+			defer workers.Done()
+			a.webhooks.Run(workersCtx)
+		}()
+
+		if a.autoClose != nil {
+			workers.Add(1)
+			go func() {
+				defer workers.Done()
+				a.autoClose.Run(workersCtx)
+			}()
 		}
+
+		workers.Wait()
+		close(a.workersStopped)
 	}()
 
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
+	return nil
+}
+
+func (a *App) Stop(ctx context.Context) {
+	// event streams never become idle, server waits for them until they are ended
+	a.bus.Close()
 
-	<-quit
 	log.Println("stopping server")
-	err = httpServer.Stop(context.TODO())
-	if err != nil {
-		log.Println(err)
+	for _, s := range []server{a.httpServer, a.grpcServer} {
+		if err := s.Stop(ctx); err != nil {
+			log.Println(err)
+		}
+	}
+
+	if a.stopWorkers != nil {
+		log.Println("stopping background workers")
+		a.stopWorkers()
+		select {
+		case <-a.workersStopped:
+		case <-ctx.Done():
+		}
 	}
 
 	log.Println("closing connections")
-	postgresClient.Close()
+	a.closeStorage()
 	log.Println("shutting down")
 }
+
+func (a *App) HTTPAddr() string {
+	return a.httpServer.Addr()
+}
+
+func (a *App) GRPCAddr() string {
+	return a.grpcServer.Addr()
+}
+
+func newRepositories(cfg config.Config) (storage.Repositories, func(), error) {
+	if cfg.StorageConfig.Driver == config.InMemoryStorageDriver {
+		log.Println("using in-memory storage, all data will be lost on shutdown")
+		return inmemory.NewRepositories(inmemory.NewStore()), func() {}, nil
+	}
+
+	postgresClient, err := postgresql.NewClient(cfg.PostgresConfig)
+	if err != nil {
+		return storage.Repositories{}, nil, err
+	}
+
+	return storage.NewRepositories(postgresClient), postgresClient.Close, nil
+}
+
+func newEventSink(cfg config.EventsConfig) (domain.EventSink, error) {
+	switch cfg.Sink {
+	case config.LogEventSink:
+		return services.NewLogEventSink(log.Default()), nil
+	case config.WebhookEventSink:
+		return services.NewWebhookEventSink(cfg.Webhook.URL, &http.Client{Timeout: cfg.Webhook.Timeout}), nil
+	case config.BrokerEventSink:
+		return services.NewLocalBroker(), nil
+	default:
+		return nil, errors.New(config.UnknownEventSinkError)
+	}
+}
+
+// newWebhookClient connects only to public addresses unless private networks are allowed, urls of subscriptions
+// are given by moderators. Proxy is not used, otherwise address of proxy would be checked instead of subscriber's
+func newWebhookClient(cfg config.WebhookDeliveryConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: cfg.Timeout}
+	if !cfg.AllowPrivateNetworks {
+		dialer.Control = netguard.Control
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Timeout: cfg.Timeout, Transport: transport}
+}
+
+func newMailer(cfg config.MailConfig) (domain.Mailer, error) {
+	switch cfg.Sender {
+	case config.LogMailSender:
+		return services.NewLogMailer(log.Default()), nil
+	case config.FileMailSender:
+		return services.NewFileMailer(cfg.File, cfg.From), nil
+	case config.SMTPMailSender:
+		smtp := cfg.SMTP
+		return services.NewSMTPMailer(smtp.Host, smtp.Port, smtp.Username, smtp.Password, cfg.From, smtp.Timeout), nil
+	default:
+		return nil, errors.New(config.UnknownMailSenderError)
+	}
+}
+
+// newIdentityProvider returns nil provider when single sign-on is disabled
+func newIdentityProvider(cfg config.SSOConfig) (domain.IdentityProvider, domain.SSORoleMapping) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	mapping := make(domain.SSORoleMapping, 0, len(cfg.RoleMapping))
+	for _, groupRole := range cfg.RoleMapping {
+		mapping = append(mapping, domain.SSOGroupRole{Group: groupRole.Group, Role: groupRole.Role})
+	}
+
+	provider := services.NewOIDCIdentityProvider(oidc.Config{
+		Issuer:       cfg.Issuer,
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		RedirectURL:  cfg.RedirectURL,
+		Scopes:       cfg.Scopes,
+	}, cfg.GroupsClaim)
+
+	return provider, mapping
+}
+
+func newAutoCloseScheduler(cfg config.AutoCloseConfig, repositories storage.Repositories, bus domain.EventBus) *services.Scheduler {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	timeouts := make(map[domain.PVZID]time.Duration, len(cfg.PVZIdleTimeouts))
+	for pvzID, timeout := range cfg.PVZIdleTimeouts {
+		// ids are validated with config
+		timeouts[uuid.MustParse(pvzID)] = timeout
+	}
+
+	return services.NewScheduler("stale receptions closing", cfg.CheckInterval, func(ctx context.Context) error {
+		handled, err := reception.CloseStaleReceptionsUseCase(ctx, reception.CloseStaleReceptionsArgs{
+			ReceptionClosingArgs: reception.ReceptionClosingArgs{
+				ReceptionInfoRepository:     repositories.ReceptionInfoRepository,
+				ProductRepository:           repositories.ProductRepository,
+				ShipmentManifestRepository:  repositories.ShipmentManifestRepository,
+				DuplicateScanRepository:     repositories.DuplicateScanRepository,
+				DiscrepancyReportRepository: repositories.DiscrepancyReportRepository,
+				ReceptionActRepository:      repositories.ReceptionActRepository,
+				ReceptionActRenderer:        services.NewPDFReceptionActRenderer(),
+				ReceptionHistoryRepository:  repositories.ReceptionHistoryRepository,
+				EventOutboxRepository:       repositories.EventOutboxRepository,
+				AuditRepository:             repositories.AuditRepository,
+			},
+			PVZRepository: repositories.PVZRepository,
+			UnitOfWork:    repositories.UnitOfWork,
+			EventBus:      bus,
+			Policy: reception.StaleReceptionPolicy{
+				IdleTimeout:     cfg.IdleTimeout,
+				PVZIdleTimeouts: timeouts,
+				FlagOnly:        cfg.Action == config.FlagStaleReceptionAction,
+				NowUTC:          time.Now().UTC(),
+			},
+		})
+		for _, stale := range handled {
+			log.Printf("stale reception %s of pvz %s was handled automatically (%s)", stale.ID, stale.PVZID, cfg.Action)
+		}
+
+		return err
+	})
+}
+
+func newPasswordPolicy(cfg config.PasswordConfig) (domain.PasswordPolicy, error) {
+	var breached []string
+	if cfg.BreachedListFile != "" {
+		var err error
+		if breached, err = services.ReadBreachedPasswords(cfg.BreachedListFile); err != nil {
+			return domain.PasswordPolicy{}, err
+		}
+	}
+
+	return domain.NewPasswordPolicy(cfg.MinLength, cfg.MinCharacterClasses, breached), nil
+}
+
+func hashParams(cfg config.Argon2idConfig) *argon2id.Params {
+	return &argon2id.Params{
+		Memory:      cfg.Memory,
+		Iterations:  cfg.Iterations,
+		Parallelism: cfg.Parallelism,
+		SaltLength:  cfg.SaltLength,
+		KeyLength:   cfg.KeyLength,
+	}
+}
+
+func newThrottling(cfg config.RateLimitConfig) users.Throttle {
+	if !cfg.Enabled {
+		return users.Throttle{}
+	}
+
+	return users.Throttle{
+		EmailLimiter: ratelimit.NewLimiter(cfg.PerEmail.Requests, cfg.PerEmail.Window),
+		Lockout:      ratelimit.NewLockout(cfg.Lockout.Threshold, cfg.Lockout.Duration, cfg.Lockout.MaxDuration),
+	}
+}
+
+// newIPLimiter is called for every api, so reports of grpc api do not spend budget of login of http api
+func newIPLimiter(cfg config.RateLimitConfig) *ratelimit.Limiter {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	return ratelimit.NewLimiter(cfg.PerIP.Requests, cfg.PerIP.Window)
+}
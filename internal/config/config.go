@@ -2,13 +2,62 @@ package config
 
 import (
 	"errors"
+	"net"
 	"path/filepath"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/spf13/viper"
 )
 
 const (
+	DevelopmentEnvironment string = "development"
+	ProductionEnvironment  string = "production"
+)
+
+const (
+	PostgresStorageDriver string = "postgres"
+	InMemoryStorageDriver string = "memory"
+)
+
+const (
+	LogEventSink     string = "log"
+	WebhookEventSink string = "webhook"
+	BrokerEventSink  string = "broker"
+)
+
+const (
+	LogMailSender  string = "log"
+	FileMailSender string = "file"
+	SMTPMailSender string = "smtp"
+)
+
+const (
+	CloseStaleReceptionAction string = "close"
+	FlagStaleReceptionAction  string = "flag"
+)
+
+const (
+	UnknownEnvironmentError            string = "unknown environment"
+	DummyLoginInProductionError        string = "dummy login could not be enabled in production environment"
+	PlainTextMailInProductionError     string = "mail could be sent only over smtp in production environment"
+	UnknownStorageDriverError          string = "unknown storage driver"
+	UnknownEventSinkError              string = "unknown event sink"
+	WebhookURLIsRequiredError          string = "webhook url is required for webhook event sink"
+	UnknownStaleReceptionActionError   string = "unknown stale reception action"
+	InvalidIdleTimeoutError            string = "idle timeout of reception must be positive"
+	InvalidIdleTimeoutPVZIDError       string = "idle timeout key must be pvz id"
+	UnknownMailSenderError             string = "unknown mail sender"
+	MailFromIsRequiredError            string = "mail from address is required"
+	MailFileIsRequiredError            string = "mail file is required for file mail sender"
+	SMTPHostIsRequiredError            string = "smtp host is required for smtp mail sender"
+	InvalidPasswordMinLengthError      string = "password min length must be positive"
+	InvalidPasswordCharacterClassError string = "password min character classes must be from 0 to 4"
+	InvalidTrustedProxyError           string = "trusted proxy must be ip range in cidr notation"
+	InvalidArgon2idParamsError         string = "argon2id memory, iterations, parallelism, salt and key length must be positive"
+	SSOProviderIsRequiredError         string = "sso issuer, client id and redirect url are required when sso is enabled"
+	SSORoleMappingIsRequiredError      string = "sso role mapping must map at least one group to a role"
+	InvalidSSORoleMappingError         string = "sso role mapping needs both group and role"
 	MustBeYmlFileError                 string = "config must be in yaml format"
 	FailedToReadConfigPrefixError      string = "failed to read config"
 	FailedToUnMarshalConfigPrefixError string = "failed to unmarshal config"
@@ -16,10 +65,20 @@ const (
 
 type (
 	Config struct {
-		PostgresConfig `mapstructure:"postgres"`
-		HTTPConfig     `mapstructure:"http-profile"`
-		GRPCConfig     `mapstructure:"grpc-profile"`
-		AuthConfig     `mapstructure:"auth"`
+		// development or production, production refuses settings meant only for development
+		Environment      string `mapstructure:"environment"`
+		StorageConfig    `mapstructure:"storage"`
+		PostgresConfig   `mapstructure:"postgres"`
+		HTTPConfig       `mapstructure:"http-profile"`
+		GRPCConfig       `mapstructure:"grpc-profile"`
+		AuthConfig       `mapstructure:"auth"`
+		EventsConfig     `mapstructure:"events"`
+		ReceptionsConfig `mapstructure:"receptions"`
+		MailConfig       `mapstructure:"mail"`
+	}
+
+	StorageConfig struct {
+		Driver string `mapstructure:"driver"`
 	}
 
 	PostgresConfig struct {
@@ -36,10 +95,41 @@ type (
 		Host           string `mapstructure:"host"`
 		Port           int    `mapstructure:"port"`
 		IncludeSwagger bool   `mapstructure:"include-swagger"`
+		// ip ranges of reverse proxies in cidr notation, client ip is taken from X-Forwarded-For set by them,
+		// without them it is the address of connection
+		TrustedProxies []string `mapstructure:"trusted-proxies"`
 	}
 
 	AuthConfig struct {
-		JWTConfig `mapstructure:"jwt"`
+		JWTConfig        `mapstructure:"jwt"`
+		RateLimitConfig  `mapstructure:"rate-limit"`
+		PasswordConfig   `mapstructure:"password"`
+		DummyLoginConfig `mapstructure:"dummy-login"`
+		SSOConfig        `mapstructure:"sso"`
+	}
+
+	// SSOConfig configures OpenID Connect login of staff, users are provisioned on the first login
+	SSOConfig struct {
+		Enabled      bool     `mapstructure:"enabled"`
+		Issuer       string   `mapstructure:"issuer"`
+		ClientID     string   `mapstructure:"client-id"`
+		ClientSecret string   `mapstructure:"client-secret"`
+		RedirectURL  string   `mapstructure:"redirect-url"`
+		Scopes       []string `mapstructure:"scopes"`
+		// claim of id token with groups of user
+		GroupsClaim string `mapstructure:"groups-claim"`
+		// ordered, user gets role of the first group they are member of
+		RoleMapping []SSORoleMappingConfig `mapstructure:"role-mapping"`
+	}
+
+	SSORoleMappingConfig struct {
+		Group string `mapstructure:"group"`
+		Role  string `mapstructure:"role"`
+	}
+
+	// DummyLoginConfig lets anyone get token of dummy user with built-in role, it is meant for development and tests only
+	DummyLoginConfig struct {
+		Enabled bool `mapstructure:"enabled"`
 	}
 
 	JWTConfig struct {
@@ -48,9 +138,100 @@ type (
 		Issuer   string        `mapstructure:"issuer"`
 	}
 
+	RateLimitConfig struct {
+		Enabled  bool          `mapstructure:"enabled"`
+		PerIP    RateConfig    `mapstructure:"per-ip"`
+		PerEmail RateConfig    `mapstructure:"per-email"`
+		Lockout  LockoutConfig `mapstructure:"lockout"`
+	}
+
+	RateConfig struct {
+		Requests int           `mapstructure:"requests"`
+		Window   time.Duration `mapstructure:"window"`
+	}
+
+	LockoutConfig struct {
+		Threshold   int           `mapstructure:"threshold"`
+		Duration    time.Duration `mapstructure:"duration"`
+		MaxDuration time.Duration `mapstructure:"max-duration"`
+	}
+
+	// PasswordConfig configures policy of new passwords and their hashing,
+	// stored hashes made with weaker parameters are upgraded when users sign in
+	PasswordConfig struct {
+		MinLength int `mapstructure:"min-length"`
+		// of lowercase letters, uppercase letters, digits and other characters
+		MinCharacterClasses int `mapstructure:"min-character-classes"`
+		// optional, one breached password per line
+		BreachedListFile string         `mapstructure:"breached-list-file"`
+		Argon2id         Argon2idConfig `mapstructure:"argon2id"`
+	}
+
+	Argon2idConfig struct {
+		// in KiB
+		Memory      uint32 `mapstructure:"memory"`
+		Iterations  uint32 `mapstructure:"iterations"`
+		Parallelism uint8  `mapstructure:"parallelism"`
+		SaltLength  uint32 `mapstructure:"salt-length"`
+		KeyLength   uint32 `mapstructure:"key-length"`
+	}
+
 	GRPCConfig struct {
 		Port int `mapstructure:"port"`
 	}
+
+	// EventsConfig configures relay of outbox events
+	EventsConfig struct {
+		Sink         string             `mapstructure:"sink"`
+		PollInterval time.Duration      `mapstructure:"poll-interval"`
+		BatchSize    int                `mapstructure:"batch-size"`
+		Webhook      EventWebhookConfig `mapstructure:"webhook"`
+		// delivery to webhook subscriptions created by moderators
+		WebhookDelivery WebhookDeliveryConfig `mapstructure:"webhook-delivery"`
+	}
+
+	EventWebhookConfig struct {
+		URL     string        `mapstructure:"url"`
+		Timeout time.Duration `mapstructure:"timeout"`
+	}
+
+	WebhookDeliveryConfig struct {
+		Tries      int           `mapstructure:"tries"`
+		RetryDelay time.Duration `mapstructure:"retry-delay"`
+		Timeout    time.Duration `mapstructure:"timeout"`
+		// subscriptions to loopback, link-local and private addresses are refused unless allowed
+		AllowPrivateNetworks bool `mapstructure:"allow-private-networks"`
+	}
+
+	// MailConfig configures delivery of letters with password reset and email verification tokens
+	MailConfig struct {
+		Sender string     `mapstructure:"sender"`
+		From   string     `mapstructure:"from"`
+		File   string     `mapstructure:"file"`
+		SMTP   SMTPConfig `mapstructure:"smtp"`
+	}
+
+	SMTPConfig struct {
+		Host     string        `mapstructure:"host"`
+		Port     int           `mapstructure:"port"`
+		Username string        `mapstructure:"username"`
+		Password string        `mapstructure:"password"`
+		Timeout  time.Duration `mapstructure:"timeout"`
+	}
+
+	ReceptionsConfig struct {
+		AutoClose AutoCloseConfig `mapstructure:"auto-close"`
+	}
+
+	// AutoCloseConfig configures background closing of receptions forgotten by employees
+	AutoCloseConfig struct {
+		Enabled       bool          `mapstructure:"enabled"`
+		Action        string        `mapstructure:"action"`
+		CheckInterval time.Duration `mapstructure:"check-interval"`
+		IdleTimeout   time.Duration `mapstructure:"idle-timeout"`
+		// keys are pvz ids, timeout of pvz overrides IdleTimeout
+		PVZIdleTimeouts map[string]time.Duration `mapstructure:"pvz-idle-timeouts"`
+	}
 )
 
 func InitConfig(yamlConfigPath string) (Config, error) {
@@ -63,6 +244,35 @@ func InitConfig(yamlConfigPath string) (Config, error) {
 
 	v.SetConfigFile(yamlConfigPath)
 	v.SetConfigType("yaml")
+	v.SetDefault("environment", DevelopmentEnvironment)
+	v.SetDefault("storage.driver", PostgresStorageDriver)
+	v.SetDefault("auth.dummy-login.enabled", false)
+	v.SetDefault("auth.sso.enabled", false)
+	v.SetDefault("auth.sso.scopes", []string{"openid", "email", "profile"})
+	v.SetDefault("auth.sso.groups-claim", "groups")
+	v.SetDefault("auth.password.min-length", 8)
+	v.SetDefault("auth.password.min-character-classes", 1)
+	v.SetDefault("auth.password.argon2id.memory", 64*1024)
+	v.SetDefault("auth.password.argon2id.iterations", 1)
+	v.SetDefault("auth.password.argon2id.parallelism", 2)
+	v.SetDefault("auth.password.argon2id.salt-length", 16)
+	v.SetDefault("auth.password.argon2id.key-length", 32)
+	v.SetDefault("events.sink", LogEventSink)
+	v.SetDefault("events.poll-interval", time.Second)
+	v.SetDefault("events.batch-size", 100)
+	v.SetDefault("events.webhook.timeout", 5*time.Second)
+	v.SetDefault("events.webhook-delivery.tries", 3)
+	v.SetDefault("events.webhook-delivery.retry-delay", time.Second)
+	v.SetDefault("events.webhook-delivery.timeout", 5*time.Second)
+	v.SetDefault("events.webhook-delivery.allow-private-networks", false)
+	v.SetDefault("receptions.auto-close.enabled", false)
+	v.SetDefault("receptions.auto-close.action", CloseStaleReceptionAction)
+	v.SetDefault("receptions.auto-close.check-interval", time.Minute)
+	v.SetDefault("receptions.auto-close.idle-timeout", 12*time.Hour)
+	v.SetDefault("mail.sender", LogMailSender)
+	v.SetDefault("mail.from", "noreply@localhost")
+	v.SetDefault("mail.smtp.port", 587)
+	v.SetDefault("mail.smtp.timeout", 10*time.Second)
 
 	if err := v.ReadInConfig(); err != nil {
 		return Config{}, errors.Join(errors.New(FailedToReadConfigPrefixError), err)
@@ -73,6 +283,16 @@ func InitConfig(yamlConfigPath string) (Config, error) {
 	v.BindEnv("postgres.password", "POSTGRES_PASSWORD")
 	v.BindEnv("postgres.db", "POSTGRES_DB")
 	v.BindEnv("auth.jwt.sign", "JWT_SIGN")
+	v.BindEnv("environment", "APP_ENVIRONMENT")
+	v.BindEnv("auth.dummy-login.enabled", "DUMMY_LOGIN_ENABLED")
+	v.BindEnv("auth.sso.enabled", "SSO_ENABLED")
+	v.BindEnv("auth.sso.client-secret", "SSO_CLIENT_SECRET")
+	v.BindEnv("storage.driver", "STORAGE_DRIVER")
+	v.BindEnv("events.sink", "EVENTS_SINK")
+	v.BindEnv("events.webhook.url", "EVENTS_WEBHOOK_URL")
+	v.BindEnv("mail.sender", "MAIL_SENDER")
+	v.BindEnv("mail.smtp.username", "SMTP_USERNAME")
+	v.BindEnv("mail.smtp.password", "SMTP_PASSWORD")
 
 	cfg := Config{}
 
@@ -80,5 +300,128 @@ func InitConfig(yamlConfigPath string) (Config, error) {
 		return Config{}, errors.Join(errors.New(FailedToUnMarshalConfigPrefixError), err)
 	}
 
+	switch cfg.Environment {
+	case DevelopmentEnvironment:
+	case ProductionEnvironment:
+		if cfg.AuthConfig.DummyLoginConfig.Enabled {
+			return Config{}, errors.New(DummyLoginInProductionError)
+		}
+		// log and file senders keep password reset and verification tokens in plain text
+		if sender := cfg.MailConfig.Sender; sender == LogMailSender || sender == FileMailSender {
+			return Config{}, errors.New(PlainTextMailInProductionError)
+		}
+	default:
+		return Config{}, errors.New(UnknownEnvironmentError)
+	}
+
+	if driver := cfg.StorageConfig.Driver; driver != PostgresStorageDriver && driver != InMemoryStorageDriver {
+		return Config{}, errors.New(UnknownStorageDriverError)
+	}
+
+	switch cfg.EventsConfig.Sink {
+	case LogEventSink, BrokerEventSink:
+	case WebhookEventSink:
+		if cfg.EventsConfig.Webhook.URL == "" {
+			return Config{}, errors.New(WebhookURLIsRequiredError)
+		}
+	default:
+		return Config{}, errors.New(UnknownEventSinkError)
+	}
+
+	for _, proxy := range cfg.HTTPConfig.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil {
+			return Config{}, errors.New(InvalidTrustedProxyError)
+		}
+	}
+
+	if err := validateAutoClose(cfg.ReceptionsConfig.AutoClose); err != nil {
+		return Config{}, err
+	}
+
+	if err := validateMail(cfg.MailConfig); err != nil {
+		return Config{}, err
+	}
+
+	if err := validatePassword(cfg.AuthConfig.PasswordConfig); err != nil {
+		return Config{}, err
+	}
+
+	if err := validateSSO(cfg.AuthConfig.SSOConfig); err != nil {
+		return Config{}, err
+	}
+
 	return cfg, nil
 }
+
+func validateAutoClose(cfg AutoCloseConfig) error {
+	if cfg.Action != CloseStaleReceptionAction && cfg.Action != FlagStaleReceptionAction {
+		return errors.New(UnknownStaleReceptionActionError)
+	} else if cfg.IdleTimeout <= 0 {
+		return errors.New(InvalidIdleTimeoutError)
+	}
+
+	for pvzID, timeout := range cfg.PVZIdleTimeouts {
+		if _, err := uuid.Parse(pvzID); err != nil {
+			return errors.New(InvalidIdleTimeoutPVZIDError)
+		} else if timeout <= 0 {
+			return errors.New(InvalidIdleTimeoutError)
+		}
+	}
+
+	return nil
+}
+
+func validateMail(cfg MailConfig) error {
+	if cfg.From == "" {
+		return errors.New(MailFromIsRequiredError)
+	}
+
+	switch cfg.Sender {
+	case LogMailSender:
+	case FileMailSender:
+		if cfg.File == "" {
+			return errors.New(MailFileIsRequiredError)
+		}
+	case SMTPMailSender:
+		if cfg.SMTP.Host == "" {
+			return errors.New(SMTPHostIsRequiredError)
+		}
+	default:
+		return errors.New(UnknownMailSenderError)
+	}
+
+	return nil
+}
+
+func validatePassword(cfg PasswordConfig) error {
+	if cfg.MinLength <= 0 {
+		return errors.New(InvalidPasswordMinLengthError)
+	} else if cfg.MinCharacterClasses < 0 || cfg.MinCharacterClasses > 4 {
+		return errors.New(InvalidPasswordCharacterClassError)
+	}
+
+	argon := cfg.Argon2id
+	if argon.Memory == 0 || argon.Iterations == 0 || argon.Parallelism == 0 || argon.SaltLength == 0 || argon.KeyLength == 0 {
+		return errors.New(InvalidArgon2idParamsError)
+	}
+
+	return nil
+}
+
+func validateSSO(cfg SSOConfig) error {
+	if !cfg.Enabled {
+		return nil
+	} else if cfg.Issuer == "" || cfg.ClientID == "" || cfg.RedirectURL == "" {
+		return errors.New(SSOProviderIsRequiredError)
+	} else if len(cfg.RoleMapping) == 0 {
+		return errors.New(SSORoleMappingIsRequiredError)
+	}
+
+	for _, mapping := range cfg.RoleMapping {
+		if mapping.Group == "" || mapping.Role == "" {
+			return errors.New(InvalidSSORoleMappingError)
+		}
+	}
+
+	return nil
+}
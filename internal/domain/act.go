@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// ReceptionAct is document issued when reception is closed
+type ReceptionAct struct {
+	ReceptionID     ReceptionID `json:"reception_id"`
+	CreationTimeUTC time.Time   `json:"creation_time_utc"`
+	Document        []byte      `json:"document"`
+}
+
+// ReceptionActContent is everything printed in act, renderer must not use any other input
+type ReceptionActContent struct {
+	PVZ         PVZ
+	Reception   ReceptionInfo
+	Products    []*Product
+	ClosedAtUTC time.Time
+}
+
+type CategoryCount struct {
+	Category ProductCategory
+	Count    int
+}
+
+func NewReceptionActContent(pvz PVZ, reception ReceptionInfo, products []*Product, closedAtUTC time.Time) (ReceptionActContent, error) {
+	if !reception.IsCompleted() {
+		return ReceptionActContent{}, errors.New(ReceptionIsNotClosedError)
+	}
+
+	sorted := append([]*Product(nil), products...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreationTimeUTC.Before(sorted[j].CreationTimeUTC)
+	})
+
+	return ReceptionActContent{
+		PVZ:         pvz,
+		Reception:   reception,
+		Products:    sorted,
+		ClosedAtUTC: closedAtUTC,
+	}, nil
+}
+
+// CategoryCounts is ordered by category
+func (c *ReceptionActContent) CategoryCounts() []CategoryCount {
+	counts := make(map[ProductCategory]int)
+	for _, product := range c.Products {
+		counts[product.Category]++
+	}
+
+	result := make([]CategoryCount, 0, len(counts))
+	for category, count := range counts {
+		result = append(result, CategoryCount{Category: category, Count: count})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Category < result[j].Category
+	})
+
+	return result
+}
+
+func IssueReceptionAct(ctx context.Context, content ReceptionActContent, renderer ReceptionActRenderer, acts ReceptionActRepository) (ReceptionAct, error) {
+	document, err := renderer.Render(content)
+	if err != nil {
+		return ReceptionAct{}, err
+	}
+
+	act := ReceptionAct{
+		ReceptionID:     content.Reception.ID,
+		CreationTimeUTC: content.ClosedAtUTC,
+		Document:        document,
+	}
+
+	err = acts.Add(ctx, act)
+
+	return act, err
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyPrefix tells api key from access token, both are sent as bearer credentials
+const APIKeyPrefix = "pvzk_"
+
+// APIKeyLastUseResolution is how often time of last use is saved, saving it on every request is not worth it
+const APIKeyLastUseResolution = time.Minute
+
+// APIKey lets integration act as service account within scope of the key, only hash of the key is kept,
+// the key itself is shown to its creator once
+type APIKey struct {
+	ID               APIKeyID `json:"id"`
+	ServiceAccountID UserID   `json:"service_account_id"`
+	Name             string   `json:"name"`
+	// beginning of the key, it is shown to tell keys apart
+	Hint    string `json:"hint"`
+	KeyHash string `json:"-"`
+	// key is let through only where both scope and role of service account have the permission
+	Scope           []Permission `json:"scope"`
+	CreatedBy       UserID       `json:"created_by"`
+	CreationTimeUTC time.Time    `json:"creation_time_utc"`
+	RevokedAtUTC    *time.Time   `json:"revoked_at_utc"`
+	LastUsedAtUTC   *time.Time   `json:"last_used_at_utc"`
+}
+
+// NewServiceAccount creates account for integration, creator could not give it more than they have
+func NewServiceAccount(creator User, email Email, role UserRole) (User, error) {
+	if err := EnsureCanGrant(creator, role); err != nil {
+		return User{}, err
+	}
+
+	account, err := NewUser(email, "")
+	if err != nil {
+		return User{}, err
+	}
+
+	account.UserRole, account.ServiceAccount = role, true
+
+	return account, nil
+}
+
+// NewAPIKey issues key of service account and returns the key itself, scope is limited to role of the account
+func NewAPIKey(creator User, account User, name string, scope []Permission) (APIKey, string, error) {
+	if !account.ServiceAccount {
+		return APIKey{}, "", errors.New(NotServiceAccountError)
+	} else if err := EnsureCanGrant(creator, account.UserRole); err != nil {
+		return APIKey{}, "", err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return APIKey{}, "", errors.New(APIKeyNameIsRequiredError)
+	}
+
+	normalized, err := normalizePermissions(scope)
+	if err != nil {
+		return APIKey{}, "", err
+	} else if len(normalized) == 0 {
+		return APIKey{}, "", errors.New(APIKeyScopeIsRequiredError)
+	}
+
+	for _, permission := range normalized {
+		if !account.HasPermission(permission) {
+			return APIKey{}, "", errors.New(APIKeyScopeIsBeyondRoleError)
+		}
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return APIKey{}, "", err
+	}
+
+	secret, err := newSecretToken()
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	key := APIKeyPrefix + secret
+
+	return APIKey{
+		ID:               id,
+		ServiceAccountID: account.ID,
+		Name:             name,
+		Hint:             key[:len(APIKeyPrefix)+6],
+		KeyHash:          HashToken(key),
+		Scope:            normalized,
+		CreatedBy:        creator.ID,
+		CreationTimeUTC:  time.Now().UTC(),
+	}, key, nil
+}
+
+// IsAPIKey tells api key from access token without looking the key up
+func IsAPIKey(credentials string) bool {
+	return strings.HasPrefix(credentials, APIKeyPrefix)
+}
+
+// Revoke stops the key from working, revoked key is kept to show when it was used
+func (k *APIKey) Revoke(moment time.Time) error {
+	if k.RevokedAtUTC != nil {
+		return errors.New(APIKeyIsAlreadyRevokedError)
+	}
+
+	revokedAt := moment.UTC()
+	k.RevokedAtUTC = &revokedAt
+
+	return nil
+}
+
+// Use limits permissions of service account to scope of the key and tells whether time of use has to be saved
+func (k *APIKey) Use(account *User, moment time.Time) (bool, error) {
+	if k.RevokedAtUTC != nil {
+		return false, errors.New(InsufficientPrivilegesError)
+	}
+
+	// new slice, role of account could be shared with other copies of user
+	account.UserRole.Permissions = slices.DeleteFunc(slices.Clone(account.UserRole.Permissions), func(permission Permission) bool {
+		return !slices.Contains(k.Scope, permission)
+	})
+
+	if k.LastUsedAtUTC != nil && moment.Sub(*k.LastUsedAtUTC) < APIKeyLastUseResolution {
+		return false, nil
+	}
+
+	usedAt := moment.UTC()
+	k.LastUsedAtUTC = &usedAt
+
+	return true, nil
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PVZAssignment lets employee work at pvz, nil bounds of validity period are open,
+// so assignment without them never expires
+type PVZAssignment struct {
+	ID              PVZAssignmentID `json:"id"`
+	UserID          UserID          `json:"user_id"`
+	PVZID           PVZID           `json:"pvz_id"`
+	ValidFromUTC    *time.Time      `json:"valid_from_utc"`
+	ValidToUTC      *time.Time      `json:"valid_to_utc"`
+	AssignedBy      UserID          `json:"assigned_by"`
+	CreationTimeUTC time.Time       `json:"creation_time_utc"`
+}
+
+// NewPVZAssignment assigns employee to pvz, only users without AnyPVZPermission are limited by assignments
+func NewPVZAssignment(user User, pvzID PVZID, validFromUTC *time.Time, validToUTC *time.Time, assignedBy UserID) (PVZAssignment, error) {
+	if user.HasPermission(AnyPVZPermission) {
+		return PVZAssignment{}, errors.New(AssigneeIsNotEmployeeError)
+	} else if pvzID == uuid.Nil {
+		return PVZAssignment{}, errors.New(InvalidIdStateError)
+	} else if validFromUTC != nil && validToUTC != nil && !validToUTC.After(*validFromUTC) {
+		return PVZAssignment{}, errors.New(InvalidAssignmentPeriodError)
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return PVZAssignment{}, err
+	}
+
+	return PVZAssignment{
+		ID:              id,
+		UserID:          user.ID,
+		PVZID:           pvzID,
+		ValidFromUTC:    utcTime(validFromUTC),
+		ValidToUTC:      utcTime(validToUTC),
+		AssignedBy:      assignedBy,
+		CreationTimeUTC: time.Now().UTC(),
+	}, nil
+}
+
+// IsValidAt tells whether assignment is in force at given moment, the end of period is excluded
+func (a *PVZAssignment) IsValidAt(moment time.Time) bool {
+	if a.ValidFromUTC != nil && moment.Before(*a.ValidFromUTC) {
+		return false
+	}
+
+	return a.ValidToUTC == nil || moment.Before(*a.ValidToUTC)
+}
+
+// EnsureAssignedToPVZ lets employee act only at pvz they are assigned to at given moment,
+// users with AnyPVZPermission are not limited by assignments
+func EnsureAssignedToPVZ(ctx context.Context, user User, pvzID PVZID, moment time.Time, assignments PVZAssignmentRepository) error {
+	if user.HasPermission(AnyPVZPermission) {
+		return nil
+	}
+
+	userAssignments, err := assignments.FindAllByUserID(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, assignment := range userAssignments {
+		if assignment.PVZID == pvzID && assignment.IsValidAt(moment) {
+			return nil
+		}
+	}
+
+	return errors.New(NotAssignedToPVZError)
+}
+
+func utcTime(moment *time.Time) *time.Time {
+	if moment == nil {
+		return nil
+	}
+
+	utc := moment.UTC()
+
+	return &utc
+}
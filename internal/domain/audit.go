@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"encoding/json"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PVZCreatedAuditAction            AuditAction = "pvz.create"
+	ReceptionOpenedAuditAction       AuditAction = "reception.open"
+	ReceptionClosedAuditAction       AuditAction = "reception.close"
+	ReceptionReopenedAuditAction     AuditAction = "reception.reopen"
+	ProductAddedAuditAction          AuditAction = "product.add"
+	ProductRemovedAuditAction        AuditAction = "product.remove"
+	ProductRestoredAuditAction       AuditAction = "product.restore"
+	ManifestUploadedAuditAction      AuditAction = "manifest.upload"
+	ManifestLinkedAuditAction        AuditAction = "manifest.link"
+	WebhookSubscribedAuditAction     AuditAction = "webhook.subscribe"
+	WebhookUnsubscribedAuditAction   AuditAction = "webhook.unsubscribe"
+	UserRegisteredAuditAction        AuditAction = "user.register"
+	PVZAssignedAuditAction           AuditAction = "pvz.assign"
+	PVZUnassignedAuditAction         AuditAction = "pvz.unassign"
+	RoleCreatedAuditAction           AuditAction = "role.create"
+	RoleUpdatedAuditAction           AuditAction = "role.update"
+	InvitationIssuedAuditAction      AuditAction = "invitation.issue"
+	InvitationAcceptedAuditAction    AuditAction = "invitation.accept"
+	UserRoleChangedAuditAction       AuditAction = "user.role_change"
+	UserDeactivatedAuditAction       AuditAction = "user.deactivate"
+	UserActivatedAuditAction         AuditAction = "user.activate"
+	UserPasswordResetAuditAction     AuditAction = "user.password_reset"
+	UserEmailVerifiedAuditAction     AuditAction = "user.email_verify"
+	UserTwoFactorEnabledAuditAction  AuditAction = "user.two_factor_enable"
+	UserTwoFactorDisabledAuditAction AuditAction = "user.two_factor_disable"
+	ServiceAccountCreatedAuditAction AuditAction = "service_account.create"
+	APIKeyIssuedAuditAction          AuditAction = "api_key.issue"
+	APIKeyRevokedAuditAction         AuditAction = "api_key.revoke"
+	UserSSOProvisionedAuditAction    AuditAction = "user.sso_provision"
+	UserSSOLinkedAuditAction         AuditAction = "user.sso_link"
+)
+
+const (
+	PVZAuditEntityType                 AuditEntityType = "pvz"
+	ReceptionAuditEntityType           AuditEntityType = "reception"
+	ProductAuditEntityType             AuditEntityType = "product"
+	ShipmentManifestAuditEntityType    AuditEntityType = "manifest"
+	WebhookSubscriptionAuditEntityType AuditEntityType = "webhook_subscription"
+	UserAuditEntityType                AuditEntityType = "user"
+	PVZAssignmentAuditEntityType       AuditEntityType = "pvz_assignment"
+	RoleAuditEntityType                AuditEntityType = "role"
+	InvitationAuditEntityType          AuditEntityType = "invitation"
+	APIKeyAuditEntityType              AuditEntityType = "api_key"
+)
+
+const (
+	HTTPRequestProtocol string = "http"
+	GRPCRequestProtocol string = "grpc"
+)
+
+// RequestSource tells which request made the change, RequestID is the same in logs of every protocol
+type RequestSource struct {
+	Protocol  string
+	RequestID string
+}
+
+// requestIDPattern allows uuid and other short ids, anything else could forge lines of logs or fill audit log up
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9-]{1,64}$`)
+
+// NewRequestSource keeps request id given by client when it matches requestIDPattern and generates new one otherwise
+func NewRequestSource(protocol string, requestID string) RequestSource {
+	if !requestIDPattern.MatchString(requestID) {
+		requestID = uuid.NewString()
+	}
+
+	return RequestSource{
+		Protocol:  protocol,
+		RequestID: requestID,
+	}
+}
+
+// AuditRecord keeps state of entity before and after change made by actor,
+// Before is empty for created entity and After is empty for removed one
+type AuditRecord struct {
+	ID            AuditRecordID
+	ActorID       UserID
+	Action        AuditAction
+	EntityType    AuditEntityType
+	EntityID      uuid.UUID
+	Before        json.RawMessage
+	After         json.RawMessage
+	Protocol      string
+	RequestID     string
+	OccurredAtUTC time.Time
+}
+
+// NewAuditRecord snapshots before and after states as json, nil state means entity did not exist
+func NewAuditRecord(actorID UserID, action AuditAction, entityType AuditEntityType, entityID uuid.UUID, before any, after any, source RequestSource) (AuditRecord, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return AuditRecord{}, err
+	}
+
+	record := AuditRecord{
+		ID:            id,
+		ActorID:       actorID,
+		Action:        action,
+		EntityType:    entityType,
+		EntityID:      entityID,
+		Protocol:      source.Protocol,
+		RequestID:     source.RequestID,
+		OccurredAtUTC: time.Now().UTC(),
+	}
+
+	if record.Before, err = auditState(before); err != nil {
+		return AuditRecord{}, err
+	}
+
+	if record.After, err = auditState(after); err != nil {
+		return AuditRecord{}, err
+	}
+
+	return record, nil
+}
+
+func auditState(state any) (json.RawMessage, error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(state)
+}
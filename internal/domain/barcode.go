@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+)
+
+const (
+	EAN13BarcodeFormat       BarcodeFormat = 1
+	Code128BarcodeFormat     BarcodeFormat = 2
+	OrderNumberBarcodeFormat BarcodeFormat = 3
+)
+
+const code128MaxLength int = 48
+
+// order number of marketplace optionally followed by parcel number, e.g. 1234567890-2
+var orderNumberPattern = regexp.MustCompile(`^[0-9]{6,20}(-[0-9]{1,4})?$`)
+
+// Barcode identifies physical parcel scanned at acceptance
+type Barcode struct {
+	Value  string        `json:"value"`
+	Format BarcodeFormat `json:"format"`
+}
+
+func NewBarcode(format BarcodeFormat, value string) (Barcode, error) {
+	var valid bool
+
+	switch format {
+	case EAN13BarcodeFormat:
+		valid = isEAN13(value)
+	case Code128BarcodeFormat:
+		valid = isCode128(value)
+	case OrderNumberBarcodeFormat:
+		valid = orderNumberPattern.MatchString(value)
+	default:
+		return Barcode{}, errors.New(UnknownBarcodeFormatError)
+	}
+
+	if !valid {
+		return Barcode{}, errors.New(InvalidBarcodeError)
+	}
+
+	return Barcode{
+		Value:  value,
+		Format: format,
+	}, nil
+}
+
+// 13 digits where the last one is checksum: digits on even positions have weight 3
+func isEAN13(value string) bool {
+	if len(value) != 13 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 12; i++ {
+		digit := value[i]
+		if digit < '0' || digit > '9' {
+			return false
+		}
+
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+
+		sum += int(digit-'0') * weight
+	}
+
+	checksum := value[12]
+	if checksum < '0' || checksum > '9' {
+		return false
+	}
+
+	return (10-sum%10)%10 == int(checksum-'0')
+}
+
+// code set B of Code 128 covers printable ascii
+func isCode128(value string) bool {
+	if len(value) == 0 || len(value) > code128MaxLength {
+		return false
+	}
+
+	for i := 0; i < len(value); i++ {
+		if value[i] < ' ' || value[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DuplicateScan is parcel scanned at reception while the same barcode was already accepted in opened reception.
+// Product is not added, the scan is kept so discrepancy report could tell which parcels came twice
+type DuplicateScan struct {
+	ID            DuplicateScanID
+	ReceptionID   ReceptionID
+	Barcode       Barcode
+	ScannedBy     UserID
+	OccurredAtUTC time.Time
+}
+
+func NewDuplicateScan(receptionID ReceptionID, barcode Barcode, scannedBy UserID) (DuplicateScan, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return DuplicateScan{}, err
+	}
+
+	return DuplicateScan{
+		ID:            id,
+		ReceptionID:   receptionID,
+		Barcode:       barcode,
+		ScannedBy:     scannedBy,
+		OccurredAtUTC: time.Now().UTC(),
+	}, nil
+}
@@ -3,6 +3,11 @@ package domain
 const (
 	InsufficientPrivilegesError string = "user has insufficient privileges"
 	BadUserCredentialError      string = "bad user credentials"
+	NotAssignedToPVZError       string = "user is not assigned to pvz"
+	RoleIsBeyondGranterError    string = "role has permissions the granter does not have"
+	UserIsDeactivatedError      string = "user is deactivated"
+	// role of user requires second factor, user has to enroll before doing anything else
+	TwoFactorEnrollmentRequiredError string = "two-factor authentication enrollment is required"
 )
 
 const (
@@ -14,6 +19,14 @@ const (
 	AllReceptionsAreClosed        string = "all receptions are closed at this pvz"
 	ReceptionIsAlreadyClosedError string = "reception is already closed"
 	ReceptionIsEmptyError         string = "no products in reception"
+	DuplicateBarcodeError         string = "product with this barcode is already accepted in opened reception"
+	ShipmentManifestIsEmptyError  string = "shipment manifest has no parcels"
+	// manifest describes delivery of one pvz accepted with one reception
+	ShipmentManifestIsForAnotherPVZError string = "shipment manifest is expected at another pvz"
+	ShipmentManifestIsAlreadyLinkedError string = "shipment manifest is already linked to another reception"
+	ReceptionIsNotClosedError            string = "reception is not closed yet"
+	ReopenReasonIsRequiredError          string = "reason is required to reopen reception"
+	NewerReceptionExistsError            string = "pvz already has newer reception"
 )
 
 const (
@@ -25,11 +38,81 @@ const (
 	UnknownProductCategoryError string = "unknown product category"
 	PVZDoesNotExistError        string = "pvz was not found"
 	UnknownRoleNameError        string = "unknown user role"
+	UnknownPermissionError      string = "unknown permission"
+	UnknownBarcodeFormatError   string = "unknown barcode format"
+	InvalidBarcodeError         string = "barcode does not match its format"
+	BarcodeIsRequiredError      string = "barcode is required"
+	UnknownEventTypeError       string = "unknown event type"
+)
+
+const (
+	InvalidWebhookURLError            string = "webhook url must be absolute http or https url"
+	WebhookURLIsNotPublicError        string = "webhook url must point to public address"
+	WebhookEventTypesAreRequiredError string = "webhook must subscribe at least one event type"
+	WebhookSecretIsTooShortError      string = "webhook secret must be at least 16 characters long"
+)
+
+const (
+	AssigneeIsNotEmployeeError   string = "only employees could be assigned to pvz"
+	InvalidAssignmentPeriodError string = "assignment must end after it starts"
+)
+
+const (
+	RoleNameIsRequiredError   string = "role name is required"
+	RoleNameIsTakenError      string = "role with this name already exists"
+	AdminRoleIsImmutableError string = "admin role could not be changed"
+)
+
+const (
+	InvitationIsAlreadyAcceptedError string = "invitation is already accepted"
+	InvitationIsExpiredError         string = "invitation is expired"
+	InvitationEmailMismatchError     string = "invitation was issued for another email"
+	OwnRoleChangeError               string = "user could not change their own role"
+	OwnActiveStatusChangeError       string = "user could not deactivate or activate themselves"
+)
+
+const (
+	UserTokenIsAlreadyUsedError string = "token is already used"
+	UserTokenIsExpiredError     string = "token is expired"
+	EmailIsAlreadyVerifiedError string = "email is already verified"
+)
+
+const (
+	TwoFactorIsRequiredError       string = "second login step with two-factor authentication code is required"
+	BadTwoFactorCodeError          string = "bad two-factor authentication code"
+	TwoFactorIsAlreadyEnabledError string = "two-factor authentication is already enabled"
+	TwoFactorIsNotEnrolledError    string = "two-factor authentication is not enrolled"
+	TwoFactorIsRequiredByRoleError string = "two-factor authentication is required by role"
+	TwoFactorCodeIsRequiredError   string = "two-factor authentication code is required"
+)
+
+const (
+	PasswordIsTooShortError  string = "password is too short"
+	PasswordIsTooSimpleError string = "password has too few kinds of characters"
+	PasswordIsBreachedError  string = "password is found in list of breached passwords"
+)
+
+const (
+	NotServiceAccountError            string = "api keys are issued only to service accounts"
+	APIKeyNameIsRequiredError         string = "api key name is required"
+	APIKeyScopeIsRequiredError        string = "api key must be scoped to at least one permission"
+	APIKeyScopeIsBeyondRoleError      string = "api key scope has permissions the service account role does not have"
+	APIKeyIsAlreadyRevokedError       string = "api key is already revoked"
+	ServiceAccountCouldNotSignInError string = "service account could not sign in, it uses api keys"
+)
+
+const (
+	SSOLoginIsInvalidError   string = "single sign-on login is unknown, expired or already completed"
+	SSOLoginIsRefusedError   string = "identity provider refused single sign-on login"
+	SSOEmailIsRequiredError  string = "identity provider did not share email of user"
+	SSOEmailIsTakenError     string = "user with this email exists and identity provider did not verify the email"
+	SSOGroupsHaveNoRoleError string = "none of identity provider groups of user is mapped to a role"
 )
 
 func IsAccessError(err error) bool {
 	switch err.Error() {
-	case InsufficientPrivilegesError, BadUserCredentialError:
+	case InsufficientPrivilegesError, BadUserCredentialError, NotAssignedToPVZError, RoleIsBeyondGranterError, UserIsDeactivatedError, TwoFactorEnrollmentRequiredError,
+		SSOLoginIsRefusedError, SSOGroupsHaveNoRoleError:
 		return true
 	default:
 		return false
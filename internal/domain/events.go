@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PVZCreatedEventType        EventType = "pvz.created"
+	ReceptionOpenedEventType   EventType = "reception.opened"
+	ReceptionClosedEventType   EventType = "reception.closed"
+	ReceptionReopenedEventType EventType = "reception.reopened"
+	ProductAddedEventType      EventType = "product.added"
+	ProductRemovedEventType    EventType = "product.removed"
+	ProductRestoredEventType   EventType = "product.restored"
+	ReceptionStaleEventType    EventType = "reception.stale"
+)
+
+const (
+	ManualReceptionCloseReason    ReceptionCloseReason = "manual"
+	AutomaticReceptionCloseReason ReceptionCloseReason = "automatic"
+)
+
+func IsKnownEventType(eventType EventType) bool {
+	switch eventType {
+	case PVZCreatedEventType, ReceptionOpenedEventType, ReceptionClosedEventType, ReceptionReopenedEventType, ProductAddedEventType, ProductRemovedEventType, ProductRestoredEventType, ReceptionStaleEventType:
+		return true
+	default:
+		return false
+	}
+}
+
+// Event is a fact about state change of PVZ or its receptions,
+// payload is json of the changed entity
+type Event struct {
+	ID            EventID         `json:"id"`
+	Type          EventType       `json:"type"`
+	PVZID         PVZID           `json:"pvz_id"`
+	OccurredAtUTC time.Time       `json:"occurred_at_utc"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+type ReceptionClosedEventPayload struct {
+	ReceptionInfo
+	AcceptedProducts int                  `json:"accepted_products"`
+	CloseReason      ReceptionCloseReason `json:"close_reason"`
+}
+
+type ReceptionReopenedEventPayload struct {
+	ReceptionInfo
+	Reason string `json:"reason"`
+}
+
+// reception stays opened, it is only flagged as forgotten
+type ReceptionStaleEventPayload struct {
+	ReceptionInfo
+	IdleSinceUTC time.Time `json:"idle_since_utc"`
+}
+
+func newEvent(eventType EventType, pvzId PVZID, payload any) (Event, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return Event{}, err
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, err
+	}
+
+	return Event{
+		ID:            id,
+		Type:          eventType,
+		PVZID:         pvzId,
+		OccurredAtUTC: time.Now().UTC(),
+		Payload:       data,
+	}, nil
+}
+
+// events are kept by aggregate until use case pulls them into outbox,
+// they must be pulled before aggregate is saved, otherwise stored copy keeps them too
+type events []Event
+
+func (e *events) record(eventType EventType, pvzId PVZID, payload any) error {
+	event, err := newEvent(eventType, pvzId, payload)
+	if err != nil {
+		return err
+	}
+
+	*e = append(*e, event)
+
+	return nil
+}
+
+// PullEvents returns events raised since the last pull and forgets them
+func (e *events) PullEvents() []Event {
+	pulled := *e
+	*e = nil
+
+	return pulled
+}
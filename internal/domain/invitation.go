@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InvitationLifetime is how long invitation could be accepted after it was issued
+const InvitationLifetime = 7 * 24 * time.Hour
+
+// Invitation lets the one who holds its token register with role granted by inviter,
+// only hash of the token is kept, the token itself is shown to inviter once
+type Invitation struct {
+	ID        InvitationID `json:"id"`
+	TokenHash string       `json:"-"`
+	RoleID    UserRoleID   `json:"role_id"`
+	// optional, invitation bound to email could be accepted only by user registering with it
+	Email           *Email     `json:"email"`
+	InvitedBy       UserID     `json:"invited_by"`
+	CreationTimeUTC time.Time  `json:"creation_time_utc"`
+	ExpiresAtUTC    time.Time  `json:"expires_at_utc"`
+	AcceptedAtUTC   *time.Time `json:"accepted_at_utc"`
+	AcceptedBy      *UserID    `json:"accepted_by"`
+}
+
+// NewInvitation issues invitation to role and returns its token, inviter could not grant more than they have
+func NewInvitation(inviter User, role UserRole, email *Email) (Invitation, string, error) {
+	if err := EnsureCanGrant(inviter, role); err != nil {
+		return Invitation{}, "", err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return Invitation{}, "", err
+	}
+
+	token, err := newSecretToken()
+	if err != nil {
+		return Invitation{}, "", err
+	}
+
+	now := time.Now().UTC()
+
+	return Invitation{
+		ID:              id,
+		TokenHash:       HashToken(token),
+		RoleID:          role.ID,
+		Email:           normalizedEmail(email),
+		InvitedBy:       inviter.ID,
+		CreationTimeUTC: now,
+		ExpiresAtUTC:    now.Add(InvitationLifetime),
+	}, token, nil
+}
+
+// Accept spends invitation on registration of user, invitation is accepted once
+func (i *Invitation) Accept(user User, moment time.Time) error {
+	if i.AcceptedAtUTC != nil {
+		return errors.New(InvitationIsAlreadyAcceptedError)
+	} else if !moment.Before(i.ExpiresAtUTC) {
+		return errors.New(InvitationIsExpiredError)
+	} else if i.Email != nil && !strings.EqualFold(string(*i.Email), string(user.Email)) {
+		return errors.New(InvitationEmailMismatchError)
+	}
+
+	acceptedAt := moment.UTC()
+	i.AcceptedAtUTC, i.AcceptedBy = &acceptedAt, &user.ID
+
+	return nil
+}
+
+// EnsureCanGrant lets granter give only roles which permissions they have themselves,
+// so nobody could make user more powerful than they are. Permissions of employee are
+// given to anyone by registration, so they are never an escalation
+func EnsureCanGrant(granter User, role UserRole) error {
+	public := EmployeeRole()
+	for _, permission := range role.Permissions {
+		if !granter.HasPermission(permission) && !public.HasPermission(permission) {
+			return errors.New(RoleIsBeyondGranterError)
+		}
+	}
+
+	return nil
+}
+
+// ChangeRole promotes or demotes user, granter could neither touch users more powerful than they are
+// nor change their own role
+func ChangeRole(granter User, user *User, role UserRole) error {
+	if granter.ID == user.ID {
+		return errors.New(OwnRoleChangeError)
+	}
+
+	if err := EnsureCanGrant(granter, user.UserRole); err != nil {
+		return err
+	}
+
+	if err := EnsureCanGrant(granter, role); err != nil {
+		return err
+	}
+
+	GrantRole(user, role)
+
+	return nil
+}
+
+func normalizedEmail(email *Email) *Email {
+	if email == nil {
+		return nil
+	}
+
+	normalized := Email(strings.ToLower(strings.TrimSpace(string(*email))))
+
+	return &normalized
+}
@@ -0,0 +1,215 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// productCategories are ordered as categories are listed in reports
+var productCategories = []ProductCategory{ElectronicsProductCategory, ClothesProductCategory, ShoesProductCategory}
+
+// ShipmentManifestItem is parcel expected to arrive, category is what parcel should be accepted as
+type ShipmentManifestItem struct {
+	Barcode  string          `json:"barcode"`
+	Category ProductCategory `json:"category"`
+}
+
+// ShipmentManifest lists parcels of delivery expected at pvz. It is uploaded before delivery arrives
+// and linked to reception the delivery is accepted with
+type ShipmentManifest struct {
+	ID    ShipmentManifestID `json:"id"`
+	PVZID PVZID              `json:"pvz_id"`
+	// nil until manifest is linked to reception
+	ReceptionID     *ReceptionID           `json:"reception_id"`
+	UploadedBy      UserID                 `json:"uploaded_by"`
+	CreationTimeUTC time.Time              `json:"creation_time_utc"`
+	Items           []ShipmentManifestItem `json:"items"`
+}
+
+// NewShipmentManifest creates manifest of delivery expected at pvz, it is not linked to any reception yet
+func NewShipmentManifest(pvz PVZ, uploadedBy UserID, items []ShipmentManifestItem) (ShipmentManifest, error) {
+	if len(items) == 0 {
+		return ShipmentManifest{}, errors.New(ShipmentManifestIsEmptyError)
+	}
+
+	for _, item := range items {
+		if item.Barcode == "" {
+			return ShipmentManifest{}, errors.New(BarcodeIsRequiredError)
+		} else if !isKnownProductCategory(item.Category) {
+			return ShipmentManifest{}, errors.New(UnknownProductCategoryError)
+		}
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return ShipmentManifest{}, err
+	}
+
+	return ShipmentManifest{
+		ID:              id,
+		PVZID:           pvz.ID,
+		UploadedBy:      uploadedBy,
+		CreationTimeUTC: time.Now().UTC(),
+		Items:           append([]ShipmentManifestItem(nil), items...),
+	}, nil
+}
+
+// LinkTo makes manifest expected contents of reception in progress at the same pvz,
+// manifest describes one delivery, so it could not be linked to another reception later
+func (m *ShipmentManifest) LinkTo(reception ReceptionInfo) error {
+	if reception.IsCompleted() {
+		return errors.New(ReceptionIsAlreadyClosedError)
+	} else if reception.PVZID != m.PVZID {
+		return errors.New(ShipmentManifestIsForAnotherPVZError)
+	} else if m.ReceptionID != nil && *m.ReceptionID != reception.ID {
+		return errors.New(ShipmentManifestIsAlreadyLinkedError)
+	}
+
+	m.ReceptionID = &reception.ID
+
+	return nil
+}
+
+// DiscrepancyReport compares manifest of closed reception with products actually accepted
+type DiscrepancyReport struct {
+	ReceptionID     ReceptionID        `json:"reception_id"`
+	ManifestID      ShipmentManifestID `json:"manifest_id"`
+	CreationTimeUTC time.Time          `json:"creation_time_utc"`
+	// expected but not accepted, barcode is repeated for every missing parcel
+	Missing []string `json:"missing"`
+	// accepted but not expected
+	Unexpected []string `json:"unexpected"`
+	// scanned again while already accepted, every barcode is listed once
+	Duplicates []string `json:"duplicates"`
+	// accepted without barcode, so could not be matched with manifest
+	UnidentifiedProducts int `json:"unidentified_products"`
+	// expected parcels accepted as another category
+	CategoryMismatches []CategoryMismatch `json:"category_mismatches"`
+	// categories accepted in other quantity than expected, products without barcode are counted too
+	Categories []CategoryDiscrepancy `json:"categories"`
+}
+
+// CategoryMismatch is parcel found by barcode which was accepted as category other than expected one
+type CategoryMismatch struct {
+	Barcode  string          `json:"barcode"`
+	Expected ProductCategory `json:"expected"`
+	Accepted ProductCategory `json:"accepted"`
+}
+
+type CategoryDiscrepancy struct {
+	Category ProductCategory `json:"category"`
+	Expected int             `json:"expected"`
+	Accepted int             `json:"accepted"`
+}
+
+func (r *DiscrepancyReport) HasDiscrepancies() bool {
+	return len(r.Missing) > 0 || len(r.Unexpected) > 0 || len(r.Duplicates) > 0 || r.UnidentifiedProducts > 0 ||
+		len(r.CategoryMismatches) > 0 || len(r.Categories) > 0
+}
+
+// Reconcile matches products accepted with reception against manifest by barcode and then by category,
+// duplicate scans were refused at acceptance, so they are reported from the scans kept for reception
+func (m *ShipmentManifest) Reconcile(reception ReceptionInfo, products []*Product, duplicateScans []DuplicateScan) DiscrepancyReport {
+	expected := make(map[string][]ProductCategory, len(m.Items))
+	expectedByCategory := make(map[ProductCategory]int)
+	for _, item := range m.Items {
+		expected[item.Barcode] = append(expected[item.Barcode], item.Category)
+		expectedByCategory[item.Category]++
+	}
+
+	accepted := make(map[string][]ProductCategory, len(products))
+	acceptedByCategory := make(map[ProductCategory]int)
+	unidentified := 0
+	for _, product := range products {
+		acceptedByCategory[product.Category]++
+		if product.Barcode == nil {
+			unidentified++
+			continue
+		}
+
+		accepted[product.Barcode.Value] = append(accepted[product.Barcode.Value], product.Category)
+	}
+
+	report := DiscrepancyReport{
+		ReceptionID:          reception.ID,
+		ManifestID:           m.ID,
+		CreationTimeUTC:      time.Now().UTC(),
+		Missing:              make([]string, 0),
+		Unexpected:           make([]string, 0),
+		Duplicates:           make([]string, 0),
+		UnidentifiedProducts: unidentified,
+		CategoryMismatches:   make([]CategoryMismatch, 0),
+		Categories:           make([]CategoryDiscrepancy, 0),
+	}
+
+	for barcode, expectedCategories := range expected {
+		for i := len(accepted[barcode]); i < len(expectedCategories); i++ {
+			report.Missing = append(report.Missing, barcode)
+		}
+	}
+
+	for barcode, acceptedCategories := range accepted {
+		expectedCategories, isExpected := expected[barcode]
+		if !isExpected {
+			report.Unexpected = append(report.Unexpected, barcode)
+		}
+
+		report.CategoryMismatches = append(report.CategoryMismatches, categoryMismatches(barcode, expectedCategories, acceptedCategories)...)
+	}
+
+	for _, scan := range duplicateScans {
+		if !slices.Contains(report.Duplicates, scan.Barcode.Value) {
+			report.Duplicates = append(report.Duplicates, scan.Barcode.Value)
+		}
+	}
+
+	for _, category := range productCategories {
+		if expectedCount, acceptedCount := expectedByCategory[category], acceptedByCategory[category]; expectedCount != acceptedCount {
+			report.Categories = append(report.Categories, CategoryDiscrepancy{Category: category, Expected: expectedCount, Accepted: acceptedCount})
+		}
+	}
+
+	sort.Strings(report.Missing)
+	sort.Strings(report.Unexpected)
+	sort.Strings(report.Duplicates)
+	sort.Slice(report.CategoryMismatches, func(i, j int) bool {
+		left, right := report.CategoryMismatches[i], report.CategoryMismatches[j]
+		if left.Barcode != right.Barcode {
+			return left.Barcode < right.Barcode
+		} else if left.Expected != right.Expected {
+			return left.Expected < right.Expected
+		}
+		return left.Accepted < right.Accepted
+	})
+
+	return report
+}
+
+// categoryMismatches pairs parcels of the same barcode which were not accepted as any of expected categories
+// with expected categories nothing was accepted as, parcels left without pair are missing or unexpected
+func categoryMismatches(barcode string, expected []ProductCategory, accepted []ProductCategory) []CategoryMismatch {
+	unmatched := slices.Clone(expected)
+	mismatched := make([]ProductCategory, 0)
+	for _, category := range accepted {
+		if i := slices.Index(unmatched, category); i >= 0 {
+			unmatched = slices.Delete(unmatched, i, i+1)
+		} else {
+			mismatched = append(mismatched, category)
+		}
+	}
+
+	mismatches := make([]CategoryMismatch, 0)
+	for i := 0; i < len(mismatched) && i < len(unmatched); i++ {
+		mismatches = append(mismatches, CategoryMismatch{Barcode: barcode, Expected: unmatched[i], Accepted: mismatched[i]})
+	}
+
+	return mismatches
+}
+
+func isKnownProductCategory(category ProductCategory) bool {
+	return slices.Contains(productCategories, category)
+}
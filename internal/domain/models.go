@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,8 +13,28 @@ type PVZ struct {
 	ID              PVZID     `json:"id"`
 	CreationTimeUTC time.Time `json:"creation_time_utc"`
 	City            `json:"city"`
+
+	events
+}
+
+func NewPVZ(id PVZID, registrationTimeUTC time.Time, city City) (pvz PVZ, err error) {
+	if id == uuid.Nil {
+		err = errors.New(InvalidIdStateError)
+		return
+	}
+
+	pvz = PVZ{
+		ID:              id,
+		CreationTimeUTC: registrationTimeUTC,
+		City:            city,
+	}
+	err = pvz.record(PVZCreatedEventType, pvz.ID, pvz)
+
+	return
 }
 
+// CurrentReception returns opened reception of pvz locked until the end of transaction,
+// so reception is not closed while it is being changed
 func (p *PVZ) CurrentReception(ctx context.Context, receptions ReceptionInfoRepository) (ReceptionInfo, error) {
 	filter := SearchReceptionInfoFilter{
 		PVZID:                  p.ID,
@@ -30,7 +51,15 @@ func (p *PVZ) CurrentReception(ctx context.Context, receptions ReceptionInfoRepo
 		return ReceptionInfo{}, errors.New(AllReceptionsAreClosed)
 	}
 
-	return receptionList[0], nil
+	// reception could be closed while waiting for the lock
+	reception, err := receptions.FindByIDForUpdate(ctx, receptionList[0].ID)
+	if err != nil {
+		return ReceptionInfo{}, err
+	} else if reception.IsCompleted() {
+		return ReceptionInfo{}, errors.New(AllReceptionsAreClosed)
+	}
+
+	return reception, nil
 }
 
 func (p *PVZ) CreateNewReception(ctx context.Context, receptions ReceptionInfoRepository) (reception ReceptionInfo, err error) {
@@ -54,6 +83,10 @@ func (p *PVZ) CreateNewReception(ctx context.Context, receptions ReceptionInfoRe
 		return
 	}
 
+	if err = p.record(ReceptionOpenedEventType, p.ID, reception); err != nil {
+		return
+	}
+
 	err = receptions.Add(ctx, reception)
 	return
 }
@@ -69,9 +102,17 @@ type Product struct {
 	ReceptionID     ReceptionID     `json:"reception_id"`
 	CreationTimeUTC time.Time       `json:"creation_time_utc"`
 	Category        ProductCategory `json:"category"`
+	Barcode         *Barcode        `json:"barcode"`
+	// removed product is kept for history and could be restored while reception is opened
+	DeletedAtUTC *time.Time `json:"deleted_at_utc,omitempty"`
+	DeletedBy    *UserID    `json:"deleted_by,omitempty"`
+}
+
+func (p *Product) IsRemoved() bool {
+	return p.DeletedAtUTC != nil
 }
 
-func newProduct(parentReceptionId ReceptionID, category ProductCategory) (product Product, err error) {
+func newProduct(parentReceptionId ReceptionID, category ProductCategory, barcode *Barcode) (product Product, err error) {
 	id, err := uuid.NewV7()
 	if err != nil {
 		return
@@ -82,6 +123,7 @@ func newProduct(parentReceptionId ReceptionID, category ProductCategory) (produc
 		ReceptionID:     parentReceptionId,
 		CreationTimeUTC: time.Now().UTC(),
 		Category:        category,
+		Barcode:         barcode,
 	}
 	return
 }
@@ -96,6 +138,8 @@ type ReceptionInfo struct {
 	PVZID           PVZID           `json:"pvz_id"`
 	CreationTimeUTC time.Time       `json:"creation_time_utc"`
 	Status          ReceptionStatus `json:"status"`
+
+	events
 }
 
 func newReception(pvzId PVZID) (reception ReceptionInfo, err error) {
@@ -120,31 +164,156 @@ func (r *ReceptionInfo) IsCompleted() bool {
 	return r.Status == CloseProductAcceptanceStatus
 }
 
-func (r *ReceptionInfo) Close() error {
+// Close finishes acceptance, products are needed to tell how many of them were accepted
+func (r *ReceptionInfo) Close(ctx context.Context, products ProductRepository) error {
+	return r.close(ctx, ManualReceptionCloseReason, products)
+}
+
+// CloseAutomatically finishes acceptance forgotten by employee, it differs from Close only by the reason
+func (r *ReceptionInfo) CloseAutomatically(ctx context.Context, products ProductRepository) error {
+	return r.close(ctx, AutomaticReceptionCloseReason, products)
+}
+
+func (r *ReceptionInfo) close(ctx context.Context, reason ReceptionCloseReason, products ProductRepository) error {
 	if r.Status == CloseProductAcceptanceStatus {
 		return errors.New(ReceptionIsAlreadyClosedError)
 	}
 
+	accepted, err := products.FindAllByReceptionID(ctx, r.ID)
+	if err != nil {
+		return err
+	}
+
 	r.Status = CloseProductAcceptanceStatus
 
-	return nil
+	return r.record(ReceptionClosedEventType, r.PVZID, ReceptionClosedEventPayload{
+		ReceptionInfo:    *r,
+		AcceptedProducts: len(accepted),
+		CloseReason:      reason,
+	})
 }
 
-func (r *ReceptionInfo) AddNewProduct(ctx context.Context, category ProductCategory, products ProductRepository) (product Product, err error) {
+// FlagStale warns that opened reception is idle since given time without closing it
+func (r *ReceptionInfo) FlagStale(idleSinceUTC time.Time) error {
+	if r.IsCompleted() {
+		return errors.New(ReceptionIsAlreadyClosedError)
+	}
+
+	return r.record(ReceptionStaleEventType, r.PVZID, ReceptionStaleEventPayload{
+		ReceptionInfo: *r,
+		IdleSinceUTC:  idleSinceUTC,
+	})
+}
+
+// LastActivityUTC is the latest time reception was opened, reopened, got manifest linked or its products were changed,
+// flagging made by service itself is not activity
+func (r *ReceptionInfo) LastActivityUTC(ctx context.Context, products ProductRepository, history ReceptionHistoryRepository) (time.Time, error) {
+	last := r.CreationTimeUTC
+
+	accepted, err := products.FindAllByReceptionID(ctx, r.ID)
+	if err != nil {
+		return last, err
+	}
+
+	for _, product := range accepted {
+		if product.CreationTimeUTC.After(last) {
+			last = product.CreationTimeUTC
+		}
+	}
+
+	removed, err := products.FindLastRemovedByReceptionID(ctx, r.ID)
+	if err != nil && err.Error() != RemovedProductDoesNotExistError {
+		return last, err
+	} else if err == nil && removed.DeletedAtUTC.After(last) {
+		last = *removed.DeletedAtUTC
+	}
+
+	entries, err := history.FindAllByReceptionID(ctx, r.ID)
+	if err != nil {
+		return last, err
+	}
+
+	for _, entry := range entries {
+		if entry.IsActivity() && entry.OccurredAtUTC.After(last) {
+			last = entry.OccurredAtUTC
+		}
+	}
+
+	return last, nil
+}
+
+// Reopen continues acceptance of closed reception, it is allowed only for the latest reception of pvz,
+// so products never get into reception older than the current one
+func (r *ReceptionInfo) Reopen(ctx context.Context, reason string, receptions ReceptionInfoRepository) error {
+	if !r.IsCompleted() {
+		return errors.New(ReceptionIsNotClosedError)
+	} else if strings.TrimSpace(reason) == "" {
+		return errors.New(ReopenReasonIsRequiredError)
+	}
+
+	latest, err := receptions.FindAllByFilter(ctx, SearchReceptionInfoFilter{
+		PVZID:                  r.PVZID,
+		DescendingDateOrdering: true,
+		Limit:                  1,
+	})
+	if err != nil {
+		return err
+	} else if len(latest) > 0 && latest[0].ID != r.ID {
+		return errors.New(NewerReceptionExistsError)
+	}
+
+	r.Status = InProggressProductAcceptanceStatus
+
+	return r.record(ReceptionReopenedEventType, r.PVZID, ReceptionReopenedEventPayload{
+		ReceptionInfo: *r,
+		Reason:        reason,
+	})
+}
+
+// AddNewProduct accepts product into reception, barcode is optional for products accepted without scanning
+func (r *ReceptionInfo) AddNewProduct(ctx context.Context, category ProductCategory, barcode *Barcode, products ProductRepository) (product Product, err error) {
 	if r.IsCompleted() {
 		return Product{}, errors.New(ReceptionIsAlreadyClosedError)
 	}
 
-	product, err = newProduct(r.ID, category)
+	if barcode != nil {
+		if err = ensureBarcodeIsNotAccepted(ctx, *barcode, products); err != nil {
+			return Product{}, err
+		}
+	}
+
+	product, err = newProduct(r.ID, category, barcode)
 	if err != nil {
 		return
 	}
 
+	if err = r.record(ProductAddedEventType, r.PVZID, product); err != nil {
+		return Product{}, err
+	}
+
 	err = products.Add(ctx, product)
 	return
 }
 
-func (r *ReceptionInfo) RemoveLastProduct(ctx context.Context, products ProductRepository) (removedProduct Product, err error) {
+// the same parcel scanned twice must not be accepted while any reception holding it is opened
+func ensureBarcodeIsNotAccepted(ctx context.Context, barcode Barcode, products ProductRepository) error {
+	filter := SearchProductFilter{
+		Barcode:         barcode.Value,
+		ReceptionStatus: InProggressProductAcceptanceStatus,
+	}
+
+	accepted, err := products.FindAllByFilter(ctx, filter)
+	if err != nil {
+		return err
+	} else if len(accepted) > 0 {
+		return errors.New(DuplicateBarcodeError)
+	}
+
+	return nil
+}
+
+// RemoveLastProduct marks the youngest product as removed by user, it stays in storage until reception is closed and after it
+func (r *ReceptionInfo) RemoveLastProduct(ctx context.Context, removedBy UserID, products ProductRepository) (removedProduct Product, err error) {
 	if r.IsCompleted() {
 		return Product{}, errors.New(ReceptionIsAlreadyClosedError)
 	}
@@ -167,6 +336,12 @@ func (r *ReceptionInfo) RemoveLastProduct(ctx context.Context, products ProductR
 	}
 
 	removedProduct = *youngest
+	deletedAt := time.Now().UTC()
+	removedProduct.DeletedAtUTC, removedProduct.DeletedBy = &deletedAt, &removedBy
+	if err = r.record(ProductRemovedEventType, r.PVZID, removedProduct); err != nil {
+		return Product{}, err
+	}
+
 	err = products.Remove(ctx, removedProduct)
 
 	if err != nil {
@@ -176,14 +351,53 @@ func (r *ReceptionInfo) RemoveLastProduct(ctx context.Context, products ProductR
 	return removedProduct, nil
 }
 
+// RestoreLastRemovedProduct undoes the latest removal, product comes back with its original creation time
+func (r *ReceptionInfo) RestoreLastRemovedProduct(ctx context.Context, products ProductRepository) (restoredProduct Product, err error) {
+	if r.IsCompleted() {
+		return Product{}, errors.New(ReceptionIsAlreadyClosedError)
+	}
+
+	restoredProduct, err = products.FindLastRemovedByReceptionID(ctx, r.ID)
+	if err != nil {
+		return Product{}, err
+	}
+
+	// the same parcel could be scanned again after removal
+	if restoredProduct.Barcode != nil {
+		if err = ensureBarcodeIsNotAccepted(ctx, *restoredProduct.Barcode, products); err != nil {
+			return Product{}, err
+		}
+	}
+
+	restoredProduct.DeletedAtUTC, restoredProduct.DeletedBy = nil, nil
+	if err = r.record(ProductRestoredEventType, r.PVZID, restoredProduct); err != nil {
+		return Product{}, err
+	}
+
+	if err = products.Restore(ctx, restoredProduct); err != nil {
+		return Product{}, err
+	}
+
+	return restoredProduct, nil
+}
+
 type User struct {
-	ID       UserID
-	Email    Email
-	Password string
-	UserRole
+	ID       UserID `json:"id"`
+	Email    Email  `json:"email"`
+	Password string `json:"-"`
+	UserRole `json:"role"`
+	// deactivated user could neither sign in nor use tokens issued before
+	Deactivated bool `json:"deactivated"`
+	// user proved they own email by token mailed to it
+	EmailVerified bool      `json:"email_verified"`
+	TwoFactor     TwoFactor `json:"two_factor"`
+	// service account has no password, integrations act as it with api keys
+	ServiceAccount bool `json:"service_account"`
+	// subject of user at identity provider, user who signs in by single sign-on has no password
+	SSOSubject *string `json:"sso_subject"`
 }
 
-// new user is always a client
+// new user is always an employee
 func NewUser(email Email, password string) (User, error) {
 	userId, err := uuid.NewV7()
 
@@ -195,41 +409,32 @@ func NewUser(email Email, password string) (User, error) {
 		ID:       userId,
 		Email:    email,
 		Password: password,
-		UserRole: clientRole(),
+		UserRole: EmployeeRole(),
 	}, nil
 }
 
 func GrantModeratorRole(u *User) {
-	u.UserRole = moderatorRole()
+	u.UserRole = ModeratorRole()
 }
 
-const (
-	ClientUserRoleID    UserRoleID = 1
-	ModeratorUserRoleID UserRoleID = 2
-)
-
-const (
-	ClientUserRoleName    string = "client"
-	ModeratorUserRoleName string = "moderator"
-)
-
-type UserRole struct {
-	ID   UserRoleID
-	Name string
+func GrantRole(u *User, role UserRole) {
+	u.UserRole = role
 }
 
-func clientRole() UserRole {
-	return UserRole{
-		ID:   ClientUserRoleID,
-		Name: ClientUserRoleName,
+// SetActive deactivates or activates user, granter could neither switch off themselves
+// nor touch users more powerful than they are
+func SetActive(granter User, user *User, active bool) error {
+	if granter.ID == user.ID {
+		return errors.New(OwnActiveStatusChangeError)
 	}
-}
 
-func moderatorRole() UserRole {
-	return UserRole{
-		ID:   ModeratorUserRoleID,
-		Name: ModeratorUserRoleName,
+	if err := EnsureCanGrant(granter, user.UserRole); err != nil {
+		return err
 	}
+
+	user.Deactivated = !active
+
+	return nil
 }
 
 const (
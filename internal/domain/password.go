@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordPolicy is checked when user chooses password, passwords chosen before are not checked on sign in
+type PasswordPolicy struct {
+	MinLength int
+	// kinds are lowercase letters, uppercase letters, digits and other characters
+	MinCharacterClasses int
+	breached            map[string]struct{}
+}
+
+// NewPasswordPolicy makes policy which also refuses breached passwords, they are compared ignoring case
+func NewPasswordPolicy(minLength int, minCharacterClasses int, breached []string) PasswordPolicy {
+	policy := PasswordPolicy{
+		MinLength:           minLength,
+		MinCharacterClasses: minCharacterClasses,
+		breached:            make(map[string]struct{}, len(breached)),
+	}
+
+	for _, password := range breached {
+		policy.breached[strings.ToLower(password)] = struct{}{}
+	}
+
+	return policy
+}
+
+func (p PasswordPolicy) Check(password string) error {
+	if utf8.RuneCountInString(password) < p.MinLength {
+		return errors.New(PasswordIsTooShortError)
+	} else if characterClasses(password) < p.MinCharacterClasses {
+		return errors.New(PasswordIsTooSimpleError)
+	} else if _, found := p.breached[strings.ToLower(password)]; found {
+		return errors.New(PasswordIsBreachedError)
+	}
+
+	return nil
+}
+
+func characterClasses(password string) int {
+	var lower, upper, digit, other bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+
+	classes := 0
+	for _, present := range []bool{lower, upper, digit, other} {
+		if present {
+			classes++
+		}
+	}
+
+	return classes
+}
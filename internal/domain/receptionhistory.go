@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ReceptionOpenedHistoryAction   ReceptionHistoryAction = "opened"
+	ReceptionClosedHistoryAction   ReceptionHistoryAction = "closed"
+	ReceptionReopenedHistoryAction ReceptionHistoryAction = "reopened"
+	ReceptionFlaggedHistoryAction  ReceptionHistoryAction = "flagged"
+
+	ReceptionProductRestoredHistoryAction ReceptionHistoryAction = "product_restored"
+	ReceptionManifestLinkedHistoryAction  ReceptionHistoryAction = "manifest_linked"
+)
+
+// ReceptionHistoryEntry is a step of reception lifecycle, reason is given when the step needs explanation,
+// zero actor means the step was made by service itself
+type ReceptionHistoryEntry struct {
+	ID            ReceptionHistoryEntryID
+	ReceptionID   ReceptionID
+	Action        ReceptionHistoryAction
+	ActorID       UserID
+	Reason        string
+	OccurredAtUTC time.Time
+}
+
+func NewReceptionHistoryEntry(receptionID ReceptionID, action ReceptionHistoryAction, actorID UserID, reason string) (ReceptionHistoryEntry, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return ReceptionHistoryEntry{}, err
+	}
+
+	return ReceptionHistoryEntry{
+		ID:            id,
+		ReceptionID:   receptionID,
+		Action:        action,
+		ActorID:       actorID,
+		Reason:        reason,
+		OccurredAtUTC: time.Now().UTC(),
+	}, nil
+}
+
+// IsActivity tells whether the step was made by employee working with reception, opening and closing are
+// not counted since creation and completion times of reception tell about them
+func (e ReceptionHistoryEntry) IsActivity() bool {
+	switch e.Action {
+	case ReceptionReopenedHistoryAction, ReceptionProductRestoredHistoryAction, ReceptionManifestLinkedHistoryAction:
+		return true
+	default:
+		return false
+	}
+}
@@ -3,16 +3,108 @@ package domain
 import (
 	"context"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 const (
-	UserDoesNotExistsError string = "user does not exists"
+	UserDoesNotExistsError   string = "user does not exists"
+	PasswordWasChangedError  string = "password of user was changed"
+	TwoFactorWasChangedError string = "second factor of user was changed"
 )
 
 type UserRepository interface {
 	FindByID(ctx context.Context, id UserID) (User, error)
 	FindByEmail(ctx context.Context, email Email) (User, error)
+	FindBySSOSubject(ctx context.Context, subject string) (User, error)
 	Add(ctx context.Context, user User) error
+	Update(ctx context.Context, user User) error
+	// UpdatePasswordHash replaces password hash only while it is still oldHash, otherwise PasswordWasChangedError is returned
+	UpdatePasswordHash(ctx context.Context, id UserID, oldHash string, newHash string) error
+	// UpdateTwoFactor replaces second factor only while it is enabled and has secret as expected,
+	// otherwise TwoFactorWasChangedError is returned
+	UpdateTwoFactor(ctx context.Context, id UserID, expected TwoFactor, twoFactor TwoFactor) error
+	// SpendTOTPStep saves step as the last used one only while it is later than the last used one,
+	// SpendRecoveryCode removes recovery code only while it is still kept. Both return BadTwoFactorCodeError
+	// otherwise, so concurrent requests could not spend the same code twice
+	SpendTOTPStep(ctx context.Context, id UserID, step int64) error
+	SpendRecoveryCode(ctx context.Context, id UserID, codeHash string) error
+	FindAllByFilter(ctx context.Context, filter SearchUserFilter) ([]User, error)
+}
+
+// nil and empty fields match users with any value
+type SearchUserFilter struct {
+	// part of email, case is ignored
+	Email       string
+	RoleID      *UserRoleID
+	Deactivated *bool
+	Page        int
+	Limit       int
+}
+
+// UserTokenRepository keeps tokens mailed to users
+type UserTokenRepository interface {
+	Add(ctx context.Context, token UserToken) error
+	// FindByTokenHash finds only token of given purpose
+	FindByTokenHash(ctx context.Context, purpose UserTokenPurpose, tokenHash string) (UserToken, error)
+	// Use saves usage of token, token that is already used could not be used again
+	Use(ctx context.Context, token UserToken) error
+}
+
+const (
+	InvitationDoesNotExistError string = "invitation does not exist"
+	UserTokenDoesNotExistError  string = "token does not exist"
+)
+
+type InvitationRepository interface {
+	Add(ctx context.Context, invitation Invitation) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (Invitation, error)
+	// Accept saves acceptance of invitation, it fails with InvitationIsAlreadyAcceptedError
+	// when invitation was accepted since it was found, so token could not be used twice
+	Accept(ctx context.Context, invitation Invitation) error
+}
+
+const (
+	APIKeyDoesNotExistError string = "api key does not exist"
+)
+
+type APIKeyRepository interface {
+	Add(ctx context.Context, key APIKey) error
+	FindByID(ctx context.Context, id APIKeyID) (APIKey, error)
+	FindByKeyHash(ctx context.Context, keyHash string) (APIKey, error)
+	// FindAllByServiceAccountID returns keys from the oldest to the newest
+	FindAllByServiceAccountID(ctx context.Context, serviceAccountID UserID) ([]APIKey, error)
+	// Revoke saves revocation of key, key that is already revoked could not be revoked again
+	Revoke(ctx context.Context, key APIKey) error
+	// MarkUsed saves only time of last use, so it never undoes revocation made meanwhile
+	MarkUsed(ctx context.Context, key APIKey) error
+}
+
+const (
+	SSOLoginDoesNotExistError string = "sso login does not exist"
+)
+
+// SSOLoginRepository keeps single sign-on logins between redirect to identity provider and return from it
+type SSOLoginRepository interface {
+	Add(ctx context.Context, login SSOLogin) error
+	// Take removes login and returns it, so state of login could not be used twice
+	Take(ctx context.Context, stateHash string) (SSOLogin, error)
+	// DeleteExpired removes logins that were never completed
+	DeleteExpired(ctx context.Context, moment time.Time) error
+}
+
+const (
+	RoleDoesNotExistError string = "role does not exist"
+)
+
+// RoleRepository keeps roles with their permissions, users refer to roles by id
+type RoleRepository interface {
+	FindAll(ctx context.Context) ([]UserRole, error)
+	FindByID(ctx context.Context, id UserRoleID) (UserRole, error)
+	FindByName(ctx context.Context, name string) (UserRole, error)
+	// Add stores new role and returns it with id given by storage
+	Add(ctx context.Context, role UserRole) (UserRole, error)
+	Update(ctx context.Context, role UserRole) error
 }
 
 type (
@@ -41,10 +133,16 @@ const (
 type (
 	ReceptionInfoRepository interface {
 		FindAllByFilter(ctx context.Context, filter SearchReceptionInfoFilter) ([]ReceptionInfo, error)
+		FindByID(ctx context.Context, id ReceptionID) (ReceptionInfo, error)
+		// FindByIDForUpdate locks reception until the end of transaction, so concurrent changes of it wait for each other
+		FindByIDForUpdate(ctx context.Context, id ReceptionID) (ReceptionInfo, error)
+		// FindAllInProgress returns opened receptions of every pvz
+		FindAllInProgress(ctx context.Context) ([]ReceptionInfo, error)
 		Update(ctx context.Context, reception ReceptionInfo) error
 		Add(ctx context.Context, reception ReceptionInfo) error
 	}
 
+	// zero Status matches receptions in any status
 	SearchReceptionInfoFilter struct {
 		PVZID                  PVZID
 		DescendingDateOrdering bool
@@ -57,6 +155,127 @@ type (
 	ProductRepository interface {
 		Add(ctx context.Context, product Product) error
 		FindAllByReceptionID(ctx context.Context, receptionId ReceptionID) ([]*Product, error)
+		FindAllByFilter(ctx context.Context, filter SearchProductFilter) ([]*Product, error)
+		// Remove keeps product in storage marked with DeletedAtUTC and DeletedBy,
+		// removed products are left out of every search and report
 		Remove(ctx context.Context, product Product) error
+		FindLastRemovedByReceptionID(ctx context.Context, receptionId ReceptionID) (Product, error)
+		Restore(ctx context.Context, product Product) error
+	}
+
+	// zero ReceptionStatus matches products of receptions in any status
+	SearchProductFilter struct {
+		Barcode         string
+		ReceptionStatus ReceptionStatus
+	}
+)
+
+const (
+	ShipmentManifestDoesNotExistError    string = "shipment manifest was not found"
+	DiscrepancyReportDoesNotExistError   string = "discrepancy report was not found"
+	ReceptionActDoesNotExistError        string = "reception act was not found"
+	EventDoesNotExistError               string = "event was not found"
+	RemovedProductDoesNotExistError      string = "no removed products in reception"
+	WebhookSubscriptionDoesNotExistError string = "webhook subscription was not found"
+	PVZAssignmentDoesNotExistError       string = "pvz assignment was not found"
+)
+
+type (
+	ShipmentManifestRepository interface {
+		Add(ctx context.Context, manifest ShipmentManifest) error
+		FindByID(ctx context.Context, id ShipmentManifestID) (ShipmentManifest, error)
+		// FindByReceptionID returns manifest linked to reception, reception has at most one
+		FindByReceptionID(ctx context.Context, receptionId ReceptionID) (ShipmentManifest, error)
+		// Link makes manifest the only one linked to reception, manifest linked to it before is unlinked.
+		// Manifest already linked to another reception is refused, caller is expected to run it within transaction
+		Link(ctx context.Context, id ShipmentManifestID, receptionId ReceptionID) error
+	}
+
+	DiscrepancyReportRepository interface {
+		Add(ctx context.Context, report DiscrepancyReport) error
+		FindByReceptionID(ctx context.Context, receptionId ReceptionID) (DiscrepancyReport, error)
+		// Remove withdraws report of reopened reception, a new one is issued on close
+		Remove(ctx context.Context, receptionId ReceptionID) error
+	}
+
+	ReceptionActRepository interface {
+		Add(ctx context.Context, act ReceptionAct) error
+		FindByReceptionID(ctx context.Context, receptionId ReceptionID) (ReceptionAct, error)
+		// Remove withdraws act of reopened reception, a new one is issued on close
+		Remove(ctx context.Context, receptionId ReceptionID) error
+	}
+
+	// ReceptionHistoryRepository returns entries in the order they occurred
+	ReceptionHistoryRepository interface {
+		Add(ctx context.Context, entry ReceptionHistoryEntry) error
+		FindAllByReceptionID(ctx context.Context, receptionId ReceptionID) ([]ReceptionHistoryEntry, error)
+	}
+
+	// DuplicateScanRepository returns scans in the order they occurred
+	DuplicateScanRepository interface {
+		Add(ctx context.Context, scan DuplicateScan) error
+		FindAllByReceptionID(ctx context.Context, receptionId ReceptionID) ([]DuplicateScan, error)
+	}
+)
+
+// PVZAssignmentRepository returns assignments ordered by creation time
+type PVZAssignmentRepository interface {
+	Add(ctx context.Context, assignment PVZAssignment) error
+	FindByID(ctx context.Context, id PVZAssignmentID) (PVZAssignment, error)
+	FindAllByPVZID(ctx context.Context, pvzId PVZID) ([]PVZAssignment, error)
+	FindAllByUserID(ctx context.Context, userId UserID) ([]PVZAssignment, error)
+	Remove(ctx context.Context, id PVZAssignmentID) error
+}
+
+// UnitOfWork runs fn in a single transaction, repositories called with ctx passed to fn take part in it.
+// Transaction is rolled back when fn returns error.
+type UnitOfWork interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// EventOutboxRepository keeps raised events until relay publishes them,
+// events must be added in the same transaction as the state change they describe
+type EventOutboxRepository interface {
+	Add(ctx context.Context, events ...Event) error
+	// FindUnpublished returns at most limit events in order they occurred
+	FindUnpublished(ctx context.Context, limit int) ([]Event, error)
+	MarkPublished(ctx context.Context, id EventID) error
+}
+
+type (
+	WebhookSubscriptionRepository interface {
+		Add(ctx context.Context, subscription WebhookSubscription) error
+		FindByID(ctx context.Context, id WebhookSubscriptionID) (WebhookSubscription, error)
+		// FindAll returns subscriptions from the oldest to the newest
+		FindAll(ctx context.Context) ([]WebhookSubscription, error)
+		FindAllByEventType(ctx context.Context, eventType EventType) ([]WebhookSubscription, error)
+		Remove(ctx context.Context, id WebhookSubscriptionID) error
+	}
+
+	WebhookDeliveryAttemptRepository interface {
+		Add(ctx context.Context, attempt WebhookDeliveryAttempt) error
+		// FindAllBySubscriptionID returns attempts from the newest to the oldest
+		FindAllBySubscriptionID(ctx context.Context, subscriptionID WebhookSubscriptionID, limit int) ([]WebhookDeliveryAttempt, error)
+	}
+)
+
+type (
+	// AuditRepository keeps records of changes, record must be added in the same transaction as the change
+	AuditRepository interface {
+		Add(ctx context.Context, record AuditRecord) error
+		// FindAllByFilter returns records from the newest to the oldest
+		FindAllByFilter(ctx context.Context, filter SearchAuditRecordFilter) ([]AuditRecord, error)
+	}
+
+	// nil and empty fields match records with any value
+	SearchAuditRecordFilter struct {
+		ActorID      *UserID
+		Action       AuditAction
+		EntityType   AuditEntityType
+		EntityID     *uuid.UUID
+		StartTimeUTC *time.Time
+		EndTimeUTC   *time.Time
+		Page         int
+		Limit        int
 	}
 )
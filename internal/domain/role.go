@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// Permission names an action user may take, roles are composed of permissions
+type Permission = string
+
+const (
+	PVZCreatePermission Permission = "pvz:create"
+	PVZReadPermission   Permission = "pvz:read"
+	PVZAssignPermission Permission = "pvz:assign"
+	// user with this permission works at every pvz, others are limited to pvz they are assigned to
+	AnyPVZPermission          Permission = "pvz:any"
+	ReceptionOpenPermission   Permission = "reception:open"
+	ReceptionClosePermission  Permission = "reception:close"
+	ReceptionReopenPermission Permission = "reception:reopen"
+	ProductAddPermission      Permission = "product:add"
+	ProductRemovePermission   Permission = "product:remove"
+	ManifestUploadPermission  Permission = "manifest:upload"
+	ReportReadPermission      Permission = "report:read"
+	WebhookManagePermission   Permission = "webhook:manage"
+	AuditReadPermission       Permission = "audit:read"
+	RoleManagePermission      Permission = "role:manage"
+	UserInvitePermission      Permission = "user:invite"
+	UserPromotePermission     Permission = "user:promote"
+	// lets list users and deactivate their accounts
+	UserManagePermission Permission = "user:manage"
+	// lets create service accounts and issue and revoke their api keys
+	ServiceAccountManagePermission Permission = "service_account:manage"
+)
+
+// AllPermissions lists every known permission in stable order
+func AllPermissions() []Permission {
+	return []Permission{
+		PVZCreatePermission,
+		PVZReadPermission,
+		PVZAssignPermission,
+		AnyPVZPermission,
+		ReceptionOpenPermission,
+		ReceptionClosePermission,
+		ReceptionReopenPermission,
+		ProductAddPermission,
+		ProductRemovePermission,
+		ManifestUploadPermission,
+		ReportReadPermission,
+		WebhookManagePermission,
+		AuditReadPermission,
+		RoleManagePermission,
+		UserInvitePermission,
+		UserPromotePermission,
+		UserManagePermission,
+		ServiceAccountManagePermission,
+	}
+}
+
+const (
+	EmployeeUserRoleID  UserRoleID = 1
+	ModeratorUserRoleID UserRoleID = 2
+	AdminUserRoleID     UserRoleID = 3
+)
+
+const (
+	EmployeeUserRoleName  string = "employee"
+	ModeratorUserRoleName string = "moderator"
+	AdminUserRoleName     string = "admin"
+)
+
+type UserRole struct {
+	ID          UserRoleID   `json:"id"`
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions"`
+	// users of the role could not act until they enroll second factor and could not disable it
+	TwoFactorRequired bool `json:"two_factor_required"`
+}
+
+// NewUserRole composes role of known permissions, id is given by repository
+func NewUserRole(name string, permissions []Permission) (UserRole, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return UserRole{}, errors.New(RoleNameIsRequiredError)
+	}
+
+	normalized, err := normalizePermissions(permissions)
+	if err != nil {
+		return UserRole{}, err
+	}
+
+	return UserRole{
+		Name:        name,
+		Permissions: normalized,
+	}, nil
+}
+
+func (r UserRole) HasPermission(permission Permission) bool {
+	return slices.Contains(r.Permissions, permission)
+}
+
+// Change renames role and replaces its permissions, admin role is left intact,
+// so there is always someone to manage roles
+func (r *UserRole) Change(name string, permissions []Permission) error {
+	if r.ID == AdminUserRoleID {
+		return errors.New(AdminRoleIsImmutableError)
+	}
+
+	changed, err := NewUserRole(name, permissions)
+	if err != nil {
+		return err
+	}
+
+	r.Name, r.Permissions = changed.Name, changed.Permissions
+
+	return nil
+}
+
+// permissions are kept sorted and without repeats, so roles are compared and stored the same way everywhere
+func normalizePermissions(permissions []Permission) ([]Permission, error) {
+	known := AllPermissions()
+	normalized := make([]Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		if !slices.Contains(known, permission) {
+			return nil, errors.New(UnknownPermissionError)
+		}
+
+		normalized = append(normalized, permission)
+	}
+
+	slices.Sort(normalized)
+
+	return slices.Compact(normalized), nil
+}
+
+func EmployeeRole() UserRole {
+	return UserRole{
+		ID:   EmployeeUserRoleID,
+		Name: EmployeeUserRoleName,
+		Permissions: mustNormalizePermissions(
+			PVZReadPermission,
+			ReceptionOpenPermission,
+			ReceptionClosePermission,
+			ProductAddPermission,
+			ProductRemovePermission,
+			ReportReadPermission,
+		),
+	}
+}
+
+func ModeratorRole() UserRole {
+	return UserRole{
+		ID:   ModeratorUserRoleID,
+		Name: ModeratorUserRoleName,
+		Permissions: mustNormalizePermissions(
+			PVZCreatePermission,
+			PVZReadPermission,
+			PVZAssignPermission,
+			AnyPVZPermission,
+			ReceptionClosePermission,
+			ReceptionReopenPermission,
+			ManifestUploadPermission,
+			ReportReadPermission,
+			WebhookManagePermission,
+			AuditReadPermission,
+			UserInvitePermission,
+			UserPromotePermission,
+			UserManagePermission,
+			ServiceAccountManagePermission,
+		),
+	}
+}
+
+func AdminRole() UserRole {
+	return UserRole{
+		ID:          AdminUserRoleID,
+		Name:        AdminUserRoleName,
+		Permissions: mustNormalizePermissions(AllPermissions()...),
+	}
+}
+
+// BuiltInRoles are seeded into storage, see init_db.sql
+func BuiltInRoles() []UserRole {
+	return []UserRole{EmployeeRole(), ModeratorRole(), AdminRole()}
+}
+
+func mustNormalizePermissions(permissions ...Permission) []Permission {
+	normalized, err := normalizePermissions(permissions)
+	if err != nil {
+		panic(err)
+	}
+
+	return normalized
+}
@@ -3,7 +3,62 @@ package domain
 import "context"
 
 type AuthorizationService[TCredentials any] interface {
+	// SignIn fails with *TwoFactorRequiredError for user with second factor,
+	// credentials are then given by SignInSecondFactor
 	SignIn(ctx context.Context, email Email, password string) (TCredentials, error)
-	SignUp(ctx context.Context, email Email, password string, role UserRoleID) (*User, error)
+	// SignInSecondFactor exchanges challenge of SignIn and totp or recovery code for credentials
+	SignInSecondFactor(ctx context.Context, challenge string, code string) (TCredentials, error)
+	// ChallengedUserID tells whom challenge of SignIn was given to, it fails for challenge which is not valid
+	ChallengedUserID(challenge string) (UserID, error)
+	SignUp(ctx context.Context, email Email, password string, role UserRole) (*User, error)
 	UserFromCredentials(ctx context.Context, credentials TCredentials) (*User, error)
+	// SetPassword replaces password of user with hash of the new one, user is not saved
+	SetPassword(user *User, password string) error
+	// BeginTwoFactorEnrollment gives user new totp secret and returns it with provisioning uri, user is not saved
+	BeginTwoFactorEnrollment(user *User) (secret string, provisioningURI string, err error)
+	// ConfirmTwoFactorEnrollment enables second factor by first totp code and returns recovery codes, user is not saved
+	ConfirmTwoFactorEnrollment(user *User, code string) ([]string, error)
+	// VerifySecondFactor spends totp or recovery code of user, the code is spent in storage right away,
+	// so it is accepted once even for concurrent requests
+	VerifySecondFactor(ctx context.Context, user *User, code string) error
+}
+
+// ReceptionActRenderer renders act into printable document,
+// the same content must always produce the same document
+type ReceptionActRenderer interface {
+	Render(content ReceptionActContent) ([]byte, error)
+}
+
+// EventSink publishes events outside of service, publishing the same event twice must be harmless for sink consumers
+type EventSink interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// EventBus delivers committed events to in-process subscribers right away,
+// unlike EventSink delivery is best effort and subscriber that falls behind is dropped
+type EventBus interface {
+	Publish(ctx context.Context, events ...Event)
+	// Subscribe returns channel with capacity of buffer events, channel is closed
+	// when subscription is cancelled or dropped
+	Subscribe(buffer int) (<-chan Event, func())
+}
+
+// MailMessage is plain text letter to user
+type MailMessage struct {
+	To      Email
+	Subject string
+	Body    string
+}
+
+// Mailer delivers letters to users, the letter is either accepted for delivery or error is returned
+type Mailer interface {
+	Send(ctx context.Context, message MailMessage) error
+}
+
+// IdentityProvider signs users in by OpenID Connect authorization code flow with PKCE
+type IdentityProvider interface {
+	// AuthorizationURL is where user is redirected to sign in, provider sends user back with code and state
+	AuthorizationURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error)
+	// Exchange redeems code for identity of user, id token must carry nonce of the login
+	Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (ExternalIdentity, error)
 }
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"crypto/subtle"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+// SSOLoginLifetime is how long user has to sign in at identity provider
+const SSOLoginLifetime = 10 * time.Minute
+
+// ExternalIdentity is user as identity provider knows them
+type ExternalIdentity struct {
+	Subject       string
+	Email         Email
+	EmailVerified bool
+	Groups        []string
+}
+
+// SSOLogin keeps secrets of login started by redirect to identity provider until user comes back,
+// user brings state back, so only hash of the state is kept
+type SSOLogin struct {
+	StateHash string `json:"-"`
+	// nonce is expected in id token, so token issued for another login is not accepted
+	Nonce string `json:"-"`
+	// verifier of PKCE, provider gives token only to whoever sent challenge of it
+	CodeVerifier    string    `json:"-"`
+	CreationTimeUTC time.Time `json:"creation_time_utc"`
+	ExpiresAtUTC    time.Time `json:"expires_at_utc"`
+}
+
+// NewSSOLogin starts login and returns state to be sent to identity provider
+func NewSSOLogin() (SSOLogin, string, error) {
+	state, err := newSecretToken()
+	if err != nil {
+		return SSOLogin{}, "", err
+	}
+
+	nonce, err := newSecretToken()
+	if err != nil {
+		return SSOLogin{}, "", err
+	}
+
+	codeVerifier, err := newSecretToken()
+	if err != nil {
+		return SSOLogin{}, "", err
+	}
+
+	now := time.Now().UTC()
+
+	return SSOLogin{
+		StateHash:       HashToken(state),
+		Nonce:           nonce,
+		CodeVerifier:    codeVerifier,
+		CreationTimeUTC: now,
+		ExpiresAtUTC:    now.Add(SSOLoginLifetime),
+	}, state, nil
+}
+
+// EnsureNotExpired refuses login user did not complete in time
+func (l SSOLogin) EnsureNotExpired(moment time.Time) error {
+	if !moment.Before(l.ExpiresAtUTC) {
+		return errors.New(SSOLoginIsInvalidError)
+	}
+
+	return nil
+}
+
+// EnsureStateOfBrowser refuses state brought by browser which was not given hash of it on redirect to identity provider,
+// so user could not be signed in by following login started by someone else
+func EnsureStateOfBrowser(state string, browserStateHash string) error {
+	if subtle.ConstantTimeCompare([]byte(HashToken(state)), []byte(browserStateHash)) != 1 {
+		return errors.New(SSOLoginIsInvalidError)
+	}
+
+	return nil
+}
+
+// SSOGroupRole gives role to members of identity provider group
+type SSOGroupRole struct {
+	Group string
+	Role  string
+}
+
+// SSORoleMapping is ordered, user who is member of several groups gets role of the first mapped one
+type SSORoleMapping []SSOGroupRole
+
+// RoleFor returns name of role for member of groups, user of no mapped group is not let in
+func (m SSORoleMapping) RoleFor(groups []string) (string, error) {
+	for _, mapping := range m {
+		if slices.Contains(groups, mapping.Group) {
+			return mapping.Role, nil
+		}
+	}
+
+	return "", errors.New(SSOGroupsHaveNoRoleError)
+}
+
+// NewSSOUser provisions user on the first single sign-on, user has no password
+func NewSSOUser(identity ExternalIdentity, role UserRole) (User, error) {
+	if strings.TrimSpace(identity.Email) == "" {
+		return User{}, errors.New(SSOEmailIsRequiredError)
+	}
+
+	user, err := NewUser(identity.Email, "")
+	if err != nil {
+		return User{}, err
+	}
+
+	subject := identity.Subject
+	user.UserRole, user.EmailVerified, user.SSOSubject = role, identity.EmailVerified, &subject
+
+	return user, nil
+}
+
+// LinkSSO lets existing user sign in by single sign-on instead of password, email is trusted only when
+// identity provider verified it, otherwise anyone could take account by registering its email at provider.
+// Service account is never linked, it is used only with api keys
+func (u *User) LinkSSO(identity ExternalIdentity) error {
+	if !identity.EmailVerified || u.ServiceAccount {
+		return errors.New(SSOEmailIsTakenError)
+	}
+
+	subject := identity.Subject
+	u.SSOSubject, u.Password, u.EmailVerified = &subject, "", true
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+const (
+	RecoveryCodesCount = 10
+	// challenge of second login step is short-lived, user is expected to type the code right away
+	TwoFactorChallengeLifetime = 5 * time.Minute
+)
+
+// TwoFactor is totp second factor of user, it is enabled only after user confirms enrollment with first code
+type TwoFactor struct {
+	// secret of authenticator app, nil until user starts enrollment
+	Secret  *string `json:"-"`
+	Enabled bool    `json:"enabled"`
+	// recovery code is spent instead of totp code when authenticator is lost, only hashes are kept
+	RecoveryCodeHashes []string `json:"-"`
+	// totp code is not accepted twice, so codes of this and earlier steps are refused
+	LastUsedStep int64 `json:"-"`
+}
+
+// TwoFactorRequiredError is returned by sign in of user with two-factor authentication instead of credentials,
+// the challenge is exchanged for credentials together with the code
+type TwoFactorRequiredError struct {
+	Challenge    string
+	ExpiresAtUTC time.Time
+}
+
+func (e *TwoFactorRequiredError) Error() string {
+	return TwoFactorIsRequiredError
+}
+
+// NeedsTwoFactorEnrollment tells that role of user requires second factor the user does not have yet,
+// such user could do nothing but enroll. Service account never signs in, so it is not required to enroll,
+// and user of single sign-on proves second factor to identity provider
+func (u User) NeedsTwoFactorEnrollment() bool {
+	return u.UserRole.TwoFactorRequired && !u.TwoFactor.Enabled && !u.ServiceAccount && u.SSOSubject == nil
+}
+
+// BeginTwoFactorEnrollment keeps new secret until user confirms it, enrollment could be restarted until then
+func (u *User) BeginTwoFactorEnrollment(secret string) error {
+	if u.TwoFactor.Enabled {
+		return errors.New(TwoFactorIsAlreadyEnabledError)
+	}
+
+	u.TwoFactor = TwoFactor{Secret: &secret}
+
+	return nil
+}
+
+// EnableTwoFactor completes enrollment confirmed by code of step and returns recovery codes to be shown once
+func (u *User) EnableTwoFactor(step int64) ([]string, error) {
+	if u.TwoFactor.Enabled {
+		return nil, errors.New(TwoFactorIsAlreadyEnabledError)
+	} else if u.TwoFactor.Secret == nil {
+		return nil, errors.New(TwoFactorIsNotEnrolledError)
+	}
+
+	codes := make([]string, 0, RecoveryCodesCount)
+	hashes := make([]string, 0, RecoveryCodesCount)
+	for range RecoveryCodesCount {
+		code, err := newSecretToken()
+		if err != nil {
+			return nil, err
+		}
+
+		// shorter than other tokens, user may have to type it
+		code = code[:16]
+		codes, hashes = append(codes, code), append(hashes, HashToken(code))
+	}
+
+	u.TwoFactor.Enabled = true
+	u.TwoFactor.RecoveryCodeHashes = hashes
+	u.TwoFactor.LastUsedStep = step
+
+	return codes, nil
+}
+
+// DisableTwoFactor removes second factor, it is kept when role of user requires it
+func (u *User) DisableTwoFactor() error {
+	if !u.TwoFactor.Enabled {
+		return errors.New(TwoFactorIsNotEnrolledError)
+	} else if u.UserRole.TwoFactorRequired {
+		return errors.New(TwoFactorIsRequiredByRoleError)
+	}
+
+	u.TwoFactor = TwoFactor{}
+
+	return nil
+}
+
+// UseTOTPStep spends code of step, code of the same or earlier step could not be replayed
+func (u *User) UseTOTPStep(step int64) error {
+	if step <= u.TwoFactor.LastUsedStep {
+		return errors.New(BadTwoFactorCodeError)
+	}
+
+	u.TwoFactor.LastUsedStep = step
+
+	return nil
+}
+
+// UseRecoveryCode spends recovery code, every code is accepted once
+func (u *User) UseRecoveryCode(code string) error {
+	hash := HashToken(code)
+	if !slices.Contains(u.TwoFactor.RecoveryCodeHashes, hash) {
+		return errors.New(BadTwoFactorCodeError)
+	}
+
+	// new slice, so copies of user taken before keep their codes
+	u.TwoFactor.RecoveryCodeHashes = slices.DeleteFunc(slices.Clone(u.TwoFactor.RecoveryCodeHashes), func(stored string) bool {
+		return stored == hash
+	})
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserTokenPurpose tells what the token could be spent on, token of one purpose is never accepted for another
+type UserTokenPurpose = string
+
+const (
+	PasswordResetTokenPurpose     UserTokenPurpose = "password_reset"
+	EmailVerificationTokenPurpose UserTokenPurpose = "email_verification"
+)
+
+const (
+	PasswordResetTokenLifetime     = time.Hour
+	EmailVerificationTokenLifetime = 24 * time.Hour
+)
+
+// UserToken is mailed to user to prove they own the email, only hash of the token is kept
+type UserToken struct {
+	ID              UserTokenID      `json:"id"`
+	UserID          UserID           `json:"user_id"`
+	Purpose         UserTokenPurpose `json:"purpose"`
+	TokenHash       string           `json:"-"`
+	CreationTimeUTC time.Time        `json:"creation_time_utc"`
+	ExpiresAtUTC    time.Time        `json:"expires_at_utc"`
+	UsedAtUTC       *time.Time       `json:"used_at_utc"`
+}
+
+// NewUserToken issues token of purpose to user and returns the token itself to be mailed
+func NewUserToken(user User, purpose UserTokenPurpose, lifetime time.Duration) (UserToken, string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return UserToken{}, "", err
+	}
+
+	token, err := newSecretToken()
+	if err != nil {
+		return UserToken{}, "", err
+	}
+
+	now := time.Now().UTC()
+
+	return UserToken{
+		ID:              id,
+		UserID:          user.ID,
+		Purpose:         purpose,
+		TokenHash:       HashToken(token),
+		CreationTimeUTC: now,
+		ExpiresAtUTC:    now.Add(lifetime),
+	}, token, nil
+}
+
+// Use spends token, token is used once
+func (t *UserToken) Use(moment time.Time) error {
+	if t.UsedAtUTC != nil {
+		return errors.New(UserTokenIsAlreadyUsedError)
+	} else if !moment.Before(t.ExpiresAtUTC) {
+		return errors.New(UserTokenIsExpiredError)
+	}
+
+	usedAt := moment.UTC()
+	t.UsedAtUTC = &usedAt
+
+	return nil
+}
+
+// HashToken is what is stored instead of secret token, so leaked storage does not let anyone use it
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func newSecretToken() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
@@ -12,12 +12,46 @@ type ProductID = uuid.UUID
 
 type ProductCategory = int16
 
+type BarcodeFormat = int8
+
 type ReceptionStatus = int8
 
 type ReceptionID = uuid.UUID
 
+type ShipmentManifestID = uuid.UUID
+
+type EventID = uuid.UUID
+
+type EventType = string
+
+type WebhookSubscriptionID = uuid.UUID
+
+type WebhookDeliveryAttemptID = uuid.UUID
+
+type ReceptionHistoryEntryID = uuid.UUID
+
+type DuplicateScanID = uuid.UUID
+
+type ReceptionHistoryAction = string
+
+type ReceptionCloseReason = string
+
+type PVZAssignmentID = uuid.UUID
+
+type InvitationID = uuid.UUID
+
+type UserTokenID = uuid.UUID
+
+type APIKeyID = uuid.UUID
+
+type AuditRecordID = uuid.UUID
+
+type AuditAction = string
+
+type AuditEntityType = string
+
 type UserID = uuid.UUID
 
-type UserRoleID = int8
+type UserRoleID = int16
 
 type CityID = int16
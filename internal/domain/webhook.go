@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"errors"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// secret is shared with partner to verify signatures, short secrets are easy to brute force
+const minWebhookSecretLength int = 16
+
+// WebhookSubscription asks to deliver events of given types to URL,
+// nil PVZID and CityID mean events of every PVZ and every city
+type WebhookSubscription struct {
+	ID              WebhookSubscriptionID `json:"id"`
+	URL             string                `json:"url"`
+	EventTypes      []EventType           `json:"event_types"`
+	PVZID           *PVZID                `json:"pvz_id"`
+	CityID          *CityID               `json:"city_id"`
+	Secret          string                `json:"-"`
+	CreatedBy       UserID                `json:"created_by"`
+	CreationTimeUTC time.Time             `json:"creation_time_utc"`
+}
+
+func NewWebhookSubscription(rawURL string, eventTypes []EventType, pvzID *PVZID, cityID *CityID, secret string, createdBy UserID) (WebhookSubscription, error) {
+	if endpoint, err := url.Parse(rawURL); err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return WebhookSubscription{}, errors.New(InvalidWebhookURLError)
+	}
+
+	if len(eventTypes) == 0 {
+		return WebhookSubscription{}, errors.New(WebhookEventTypesAreRequiredError)
+	}
+
+	for _, eventType := range eventTypes {
+		if !IsKnownEventType(eventType) {
+			return WebhookSubscription{}, errors.New(UnknownEventTypeError)
+		}
+	}
+
+	if len(secret) < minWebhookSecretLength {
+		return WebhookSubscription{}, errors.New(WebhookSecretIsTooShortError)
+	}
+
+	if cityID != nil {
+		if _, err := NewCity(*cityID); err != nil {
+			return WebhookSubscription{}, err
+		}
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return WebhookSubscription{}, err
+	}
+
+	types := slices.Clone(eventTypes)
+	slices.Sort(types)
+
+	return WebhookSubscription{
+		ID:              id,
+		URL:             rawURL,
+		EventTypes:      slices.Compact(types),
+		PVZID:           pvzID,
+		CityID:          cityID,
+		Secret:          secret,
+		CreatedBy:       createdBy,
+		CreationTimeUTC: time.Now().UTC(),
+	}, nil
+}
+
+// Matches tells whether event raised at pvz must be delivered to subscriber
+func (s *WebhookSubscription) Matches(event Event, pvz PVZ) bool {
+	if !slices.Contains(s.EventTypes, event.Type) {
+		return false
+	} else if s.PVZID != nil && *s.PVZID != event.PVZID {
+		return false
+	} else if s.CityID != nil && *s.CityID != pvz.City.ID {
+		return false
+	}
+
+	return true
+}
+
+// WebhookDeliveryAttempt is a result of single try to deliver event to subscriber,
+// zero StatusCode means that subscriber did not respond at all
+type WebhookDeliveryAttempt struct {
+	ID             WebhookDeliveryAttemptID
+	SubscriptionID WebhookSubscriptionID
+	EventID        EventID
+	Attempt        int
+	AttemptTimeUTC time.Time
+	StatusCode     int
+	Error          string
+	Delivered      bool
+}
+
+func NewWebhookDeliveryAttempt(subscriptionID WebhookSubscriptionID, eventID EventID, attempt int, statusCode int, deliveryErr error) (WebhookDeliveryAttempt, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return WebhookDeliveryAttempt{}, err
+	}
+
+	result := WebhookDeliveryAttempt{
+		ID:             id,
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		Attempt:        attempt,
+		AttemptTimeUTC: time.Now().UTC(),
+		StatusCode:     statusCode,
+		Delivered:      deliveryErr == nil,
+	}
+
+	if deliveryErr != nil {
+		result.Error = deliveryErr.Error()
+	}
+
+	return result, nil
+}
@@ -3,8 +3,10 @@ package services
 import (
 	domain "avito/internal/domain"
 	jwt "avito/pkg/authorization"
+	"avito/pkg/totp"
 	"errors"
 	"log"
+	"time"
 
 	"github.com/alexedwards/argon2id"
 	"github.com/google/uuid"
@@ -13,15 +15,23 @@ import (
 
 type (
 	authroizationServiceImpl struct {
-		userRepository domain.UserRepository
-		jwtManager     jwt.JWTManager
+		userRepository   domain.UserRepository
+		apiKeyRepository domain.APIKeyRepository
+		jwtManager       jwt.JWTManager
+		passwordPolicy   domain.PasswordPolicy
+		hashParams       *argon2id.Params
 	}
 )
 
-func NewAuthorizationService(jwtManager jwt.JWTManager, userRepository domain.UserRepository) domain.AuthorizationService[jwt.JWT] {
+// NewAuthorizationService hashes new passwords with hashParams,
+// hashes of users made with weaker parameters are replaced when users sign in
+func NewAuthorizationService(jwtManager jwt.JWTManager, userRepository domain.UserRepository, apiKeyRepository domain.APIKeyRepository, passwordPolicy domain.PasswordPolicy, hashParams *argon2id.Params) domain.AuthorizationService[jwt.JWT] {
 	return authroizationServiceImpl{
-		userRepository: userRepository,
-		jwtManager:     jwtManager,
+		userRepository:   userRepository,
+		apiKeyRepository: apiKeyRepository,
+		jwtManager:       jwtManager,
+		passwordPolicy:   passwordPolicy,
+		hashParams:       hashParams,
 	}
 }
 
@@ -29,15 +39,109 @@ func (s authroizationServiceImpl) SignIn(ctx context.Context, email domain.Email
 	user, err := s.userRepository.FindByEmail(ctx, email)
 	if err != nil {
 		return "", err
-	} else if match, err := argon2id.ComparePasswordAndHash(password, user.Password); err != nil || !match {
+	} else if user.ServiceAccount {
+		return "", errors.New(domain.ServiceAccountCouldNotSignInError)
+	}
+
+	match, params, err := argon2id.CheckHash(password, user.Password)
+	if err != nil || !match {
 		if err != nil {
 			log.Println(err)
 		}
 		return "", errors.New(domain.BadUserCredentialError)
+	} else if user.Deactivated {
+		return "", errors.New(domain.UserIsDeactivatedError)
 	}
 
-	token, err := s.jwtManager.GenerateToken(user.ID.String())
+	if isWeakerHash(params, s.hashParams) {
+		s.rehashPassword(ctx, user, password)
+	}
+
+	if user.TwoFactor.Enabled {
+		return "", s.twoFactorChallenge(user)
+	}
+
+	return s.issueToken(user)
+}
+
+// isWeakerHash tells whether hash made with params is cheaper to brute force than hash made with configured ones,
+// parallelism is not compared as it does not change amount of work
+func isWeakerHash(params *argon2id.Params, configured *argon2id.Params) bool {
+	return params.Memory < configured.Memory ||
+		params.Iterations < configured.Iterations ||
+		params.SaltLength < configured.SaltLength ||
+		params.KeyLength < configured.KeyLength
+}
+
+// rehashPassword is the only chance to upgrade hash while password is known, user signs in even when it fails.
+// Password is not checked by policy, it was chosen before and user keeps it. Only hash is written, and only while
+// it is the one checked, so changes made to user meanwhile are kept
+func (s authroizationServiceImpl) rehashPassword(ctx context.Context, user domain.User, password string) {
+	passwordHash, err := argon2id.CreateHash(password, s.hashParams)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	err = s.userRepository.UpdatePasswordHash(ctx, user.ID, user.Password, passwordHash)
+	if err != nil && err.Error() != domain.PasswordWasChangedError {
+		log.Println(err)
+	}
+}
+
+const twoFactorChallengePurpose string = "two_factor_challenge"
+
+// challenge is signed like access token but has its own purpose, so it is not accepted instead of access token
+func (s authroizationServiceImpl) twoFactorChallenge(user domain.User) error {
+	challenge, err := s.jwtManager.GeneratePurposeToken(user.ID.String(), twoFactorChallengePurpose, domain.TwoFactorChallengeLifetime)
+	if err != nil {
+		log.Println(err)
+		return errors.New("could not authorize user")
+	}
+
+	return &domain.TwoFactorRequiredError{
+		Challenge:    challenge,
+		ExpiresAtUTC: time.Now().UTC().Add(domain.TwoFactorChallengeLifetime),
+	}
+}
+
+func (s authroizationServiceImpl) ChallengedUserID(challenge string) (domain.UserID, error) {
+	claims, err := s.jwtManager.ExtractClaimsFrom(challenge)
+	if err != nil || claims.Purpose != twoFactorChallengePurpose {
+		return uuid.Nil, errors.New(domain.BadUserCredentialError)
+	}
 
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return uuid.Nil, errors.New(domain.BadUserCredentialError)
+	}
+
+	return userID, nil
+}
+
+func (s authroizationServiceImpl) SignInSecondFactor(ctx context.Context, challenge string, code string) (jwt.JWT, error) {
+	userID, err := s.ChallengedUserID(challenge)
+	if err != nil {
+		return "", err
+	}
+
+	user, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return "", err
+	} else if user.Deactivated {
+		return "", errors.New(domain.UserIsDeactivatedError)
+	}
+
+	// spent code is saved before credentials are given, so it could not be replayed
+	if err = s.VerifySecondFactor(ctx, &user, code); err != nil {
+		return "", err
+	}
+
+	return s.issueToken(user)
+}
+
+func (s authroizationServiceImpl) issueToken(user domain.User) (jwt.JWT, error) {
+	token, err := s.jwtManager.GenerateToken(user.ID.String())
 	if err != nil {
 		log.Println(err)
 		return "", errors.New("could not authorize user")
@@ -46,7 +150,56 @@ func (s authroizationServiceImpl) SignIn(ctx context.Context, email domain.Email
 	return token, nil
 }
 
-func (s authroizationServiceImpl) SignUp(ctx context.Context, email domain.Email, password string, role domain.UserRoleID) (*domain.User, error) {
+func (s authroizationServiceImpl) BeginTwoFactorEnrollment(user *domain.User) (string, string, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", "", err
+	}
+
+	if err = user.BeginTwoFactorEnrollment(secret); err != nil {
+		return "", "", err
+	}
+
+	return secret, totp.ProvisioningURI(s.jwtManager.Issuer(), string(user.Email), secret), nil
+}
+
+func (s authroizationServiceImpl) ConfirmTwoFactorEnrollment(user *domain.User, code string) ([]string, error) {
+	if user.TwoFactor.Enabled {
+		return nil, errors.New(domain.TwoFactorIsAlreadyEnabledError)
+	} else if user.TwoFactor.Secret == nil {
+		return nil, errors.New(domain.TwoFactorIsNotEnrolledError)
+	}
+
+	step, ok := totp.Validate(*user.TwoFactor.Secret, code, time.Now())
+	if !ok {
+		return nil, errors.New(domain.BadTwoFactorCodeError)
+	}
+
+	return user.EnableTwoFactor(step)
+}
+
+func (s authroizationServiceImpl) VerifySecondFactor(ctx context.Context, user *domain.User, code string) error {
+	if !user.TwoFactor.Enabled || user.TwoFactor.Secret == nil {
+		return errors.New(domain.TwoFactorIsNotEnrolledError)
+	}
+
+	// code which is not valid totp code is tried as recovery code
+	if step, ok := totp.Validate(*user.TwoFactor.Secret, code, time.Now()); ok {
+		if err := user.UseTOTPStep(step); err != nil {
+			return err
+		}
+
+		return s.userRepository.SpendTOTPStep(ctx, user.ID, step)
+	}
+
+	if err := user.UseRecoveryCode(code); err != nil {
+		return err
+	}
+
+	return s.userRepository.SpendRecoveryCode(ctx, user.ID, domain.HashToken(code))
+}
+
+func (s authroizationServiceImpl) SignUp(ctx context.Context, email domain.Email, password string, role domain.UserRole) (*domain.User, error) {
 	_, err := s.userRepository.FindByEmail(ctx, email)
 	if err != nil {
 		if err.Error() != domain.UserDoesNotExistsError {
@@ -54,7 +207,11 @@ func (s authroizationServiceImpl) SignUp(ctx context.Context, email domain.Email
 		}
 	}
 
-	passwordHash, err := argon2id.CreateHash(password, argon2id.DefaultParams)
+	if err := s.passwordPolicy.Check(password); err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := argon2id.CreateHash(password, s.hashParams)
 	if err != nil {
 		return nil, err
 	}
@@ -64,24 +221,47 @@ func (s authroizationServiceImpl) SignUp(ctx context.Context, email domain.Email
 		return nil, err
 	}
 
-	if role == domain.ModeratorUserRoleID {
-		domain.GrantModeratorRole(&newUser)
+	domain.GrantRole(&newUser, role)
+
+	if err = s.userRepository.Add(ctx, newUser); err != nil {
+		return nil, err
+	}
+
+	return &newUser, nil
+}
+
+func (s authroizationServiceImpl) SetPassword(user *domain.User, password string) error {
+	if err := s.passwordPolicy.Check(password); err != nil {
+		return err
 	}
 
-	err = s.userRepository.Add(ctx, newUser)
+	passwordHash, err := argon2id.CreateHash(password, s.hashParams)
+	if err != nil {
+		return err
+	}
 
-	return &newUser, err
+	user.Password = passwordHash
+
+	return nil
 }
 
+// UserFromCredentials accepts access token of user or api key of service account,
+// user authenticated by api key gets only permissions within scope of the key
 func (s authroizationServiceImpl) UserFromCredentials(ctx context.Context, credentials jwt.JWT) (*domain.User, error) {
 	if credentials == "" {
 		return nil, errors.New(domain.InsufficientPrivilegesError)
 	}
 
+	if domain.IsAPIKey(credentials) {
+		return s.userFromAPIKey(ctx, credentials)
+	}
+
 	claims, err := s.jwtManager.ExtractClaimsFrom(credentials)
 	if err != nil {
 		log.Println(err)
 		return nil, errors.New(domain.InsufficientPrivilegesError)
+	} else if claims.Purpose != "" {
+		return nil, errors.New(domain.InsufficientPrivilegesError)
 	}
 
 	userId, err := uuid.Parse(claims.UserID)
@@ -90,15 +270,55 @@ func (s authroizationServiceImpl) UserFromCredentials(ctx context.Context, crede
 		return nil, errors.New(domain.InsufficientPrivilegesError)
 	}
 
-	user, err := s.userRepository.FindByID(ctx, userId)
+	return s.activeUser(ctx, userId)
+}
 
+func (s authroizationServiceImpl) userFromAPIKey(ctx context.Context, apiKey string) (*domain.User, error) {
+	key, err := s.apiKeyRepository.FindByKeyHash(ctx, domain.HashToken(apiKey))
 	if err != nil {
-		if msg := err.Error(); msg != domain.UserDoesNotExistsError {
-			log.Panicln(msg)
+		if msg := err.Error(); msg != domain.APIKeyDoesNotExistError {
+			log.Println(msg)
 		}
 
 		return nil, errors.New(domain.InsufficientPrivilegesError)
 	}
 
+	account, err := s.activeUser(ctx, key.ServiceAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	markUsed, err := key.Use(account, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// failure to track use does not deny access
+	if markUsed {
+		if err = s.apiKeyRepository.MarkUsed(ctx, key); err != nil {
+			log.Println(err)
+		}
+	}
+
+	return account, nil
+}
+
+func (s authroizationServiceImpl) activeUser(ctx context.Context, userId domain.UserID) (*domain.User, error) {
+	user, err := s.userRepository.FindByID(ctx, userId)
+
+	if err != nil {
+		if err.Error() == domain.UserDoesNotExistsError {
+			return nil, errors.New(domain.InsufficientPrivilegesError)
+		}
+
+		log.Println(err)
+		return nil, err
+	}
+
+	// checked on every request, so tokens of deactivated user stop working right away
+	if user.Deactivated {
+		return nil, errors.New(domain.UserIsDeactivatedError)
+	}
+
 	return &user, nil
 }
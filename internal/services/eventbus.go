@@ -0,0 +1,73 @@
+package services
+
+import (
+	"avito/internal/domain"
+	"context"
+	"sync"
+)
+
+// EventBus fans committed events out to in-process subscribers such as live dashboards.
+// Publisher is never blocked: subscriber whose buffer is full is dropped and has to subscribe again.
+type EventBus struct {
+	mu          sync.Mutex
+	closed      bool
+	subscribers map[chan domain.Event]struct{}
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{subscribers: make(map[chan domain.Event]struct{})}
+}
+
+func (b *EventBus) Subscribe(buffer int) (<-chan domain.Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	events := make(chan domain.Event, buffer)
+	if b.closed {
+		close(events)
+		return events, func() {}
+	}
+	b.subscribers[events] = struct{}{}
+
+	return events, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		b.drop(events)
+	}
+}
+
+func (b *EventBus) Publish(ctx context.Context, events ...domain.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, event := range events {
+		for subscriber := range b.subscribers {
+			select {
+			case subscriber <- event:
+			default:
+				b.drop(subscriber)
+			}
+		}
+	}
+}
+
+// Close drops every subscriber, so streams built on the bus end before server shuts down
+func (b *EventBus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for subscriber := range b.subscribers {
+		b.drop(subscriber)
+	}
+}
+
+func (b *EventBus) drop(subscriber chan domain.Event) {
+	if _, ok := b.subscribers[subscriber]; !ok {
+		return
+	}
+
+	delete(b.subscribers, subscriber)
+	close(subscriber)
+}
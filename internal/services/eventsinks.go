@@ -0,0 +1,120 @@
+package services
+
+import (
+	"avito/internal/domain"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+const (
+	BrokerSubscriberIsFullError string = "broker subscriber queue is full"
+)
+
+type logEventSink struct {
+	logger *log.Logger
+}
+
+// NewLogEventSink writes every event into logger, it is useful when nobody consumes events yet
+func NewLogEventSink(logger *log.Logger) domain.EventSink {
+	return logEventSink{logger: logger}
+}
+
+func (s logEventSink) Publish(ctx context.Context, event domain.Event) error {
+	s.logger.Printf("event %s %s at pvz %s: %s", event.Type, event.ID, event.PVZID, event.Payload)
+
+	return nil
+}
+
+type webhookEventSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookEventSink posts every event as json to url, any response other than 2xx is a failed delivery
+func NewWebhookEventSink(url string, client *http.Client) domain.EventSink {
+	return webhookEventSink{url: url, client: client}
+}
+
+func (s webhookEventSink) Publish(ctx context.Context, event domain.Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	// receivers deduplicate redelivered events by id
+	request.Header.Set("X-Event-Id", event.ID.String())
+	request.Header.Set("X-Event-Type", event.Type)
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// LocalBroker is an in-process stand-in for message broker, every subscriber receives every published event
+type LocalBroker struct {
+	mu          sync.RWMutex
+	subscribers []chan domain.Event
+}
+
+func NewLocalBroker() *LocalBroker {
+	return &LocalBroker{}
+}
+
+// Subscribe returns channel with capacity of buffer events and function that cancels subscription
+func (b *LocalBroker) Subscribe(buffer int) (<-chan domain.Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	events := make(chan domain.Event, buffer)
+	b.subscribers = append(b.subscribers, events)
+
+	unsubscribe := sync.OnceFunc(func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		b.subscribers = slices.DeleteFunc(b.subscribers, func(subscriber chan domain.Event) bool {
+			return subscriber == events
+		})
+		close(events)
+	})
+
+	return events, unsubscribe
+}
+
+// Publish never blocks, when some subscriber is full the event is reported as not delivered
+// and will be published again, so other subscribers may receive it twice
+func (b *LocalBroker) Publish(ctx context.Context, event domain.Event) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	var err error
+	for _, subscriber := range b.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			err = errors.New(BrokerSubscriberIsFullError)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"avito/internal/domain"
+	"avito/pkg/oidc"
+	"context"
+	"net/http"
+	"time"
+)
+
+type oidcIdentityProvider struct {
+	provider    *oidc.Provider
+	groupsClaim string
+}
+
+// NewOIDCIdentityProvider signs users in at OpenID provider, groups of user are read from groupsClaim of id token
+func NewOIDCIdentityProvider(cfg oidc.Config, groupsClaim string) domain.IdentityProvider {
+	return oidcIdentityProvider{
+		provider:    oidc.NewProvider(cfg, &http.Client{Timeout: 10 * time.Second}),
+		groupsClaim: groupsClaim,
+	}
+}
+
+func (p oidcIdentityProvider) AuthorizationURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error) {
+	return p.provider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+}
+
+func (p oidcIdentityProvider) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (domain.ExternalIdentity, error) {
+	token, err := p.provider.Exchange(ctx, code, codeVerifier, nonce)
+	if err != nil {
+		return domain.ExternalIdentity{}, err
+	}
+
+	return domain.ExternalIdentity{
+		Subject:       token.Subject,
+		Email:         token.Email,
+		EmailVerified: token.EmailVerified,
+		Groups:        token.Strings(p.groupsClaim),
+	}, nil
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"avito/internal/domain"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type logMailer struct {
+	logger *log.Logger
+}
+
+// NewLogMailer writes every letter into logger instead of sending it, it is meant for local development
+func NewLogMailer(logger *log.Logger) domain.Mailer {
+	return logMailer{logger: logger}
+}
+
+func (m logMailer) Send(ctx context.Context, message domain.MailMessage) error {
+	m.logger.Printf("mail to %s: %s\n%s", message.To, message.Subject, message.Body)
+
+	return nil
+}
+
+type fileMailer struct {
+	mu   sync.Mutex
+	path string
+	from string
+}
+
+// NewFileMailer appends every letter to file at path as it would be sent, file is created when it does not exist
+func NewFileMailer(path string, from string) domain.Mailer {
+	return &fileMailer{path: path, from: from}
+}
+
+func (m *fileMailer) Send(ctx context.Context, message domain.MailMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(append(composeMail(m.from, message, time.Now()), '\n'))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+type smtpMailer struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+	timeout  time.Duration
+}
+
+// NewSMTPMailer sends letters through smtp server, connection is upgraded with STARTTLS when server supports it
+// and credentials are sent only when username is set
+func NewSMTPMailer(host string, port int, username, password, from string, timeout time.Duration) domain.Mailer {
+	return smtpMailer{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+		timeout:  timeout,
+	}
+}
+
+func (m smtpMailer) Send(ctx context.Context, message domain.MailMessage) error {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(m.host, strconv.Itoa(m.port)))
+	if err != nil {
+		return err
+	}
+
+	// smtp client knows nothing of context, deadline of connection stops it instead
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return err
+		}
+	}
+
+	if m.username != "" {
+		if err = client.Auth(smtp.PlainAuth("", m.username, m.password, m.host)); err != nil {
+			return err
+		}
+	}
+
+	if err = client.Mail(m.from); err != nil {
+		return err
+	}
+
+	if err = client.Rcpt(string(message.To)); err != nil {
+		return err
+	}
+
+	data, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err = data.Write(composeMail(m.from, message, time.Now())); err != nil {
+		data.Close()
+		return err
+	}
+
+	if err = data.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// composeMail renders letter in internet message format with utf-8 plain text body
+func composeMail(from string, message domain.MailMessage, moment time.Time) []byte {
+	var letter bytes.Buffer
+
+	fmt.Fprintf(&letter, "From: %s\r\n", from)
+	fmt.Fprintf(&letter, "To: %s\r\n", message.To)
+	fmt.Fprintf(&letter, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&letter, "Date: %s\r\n", moment.Format(time.RFC1123Z))
+	letter.WriteString("MIME-Version: 1.0\r\n")
+	letter.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	letter.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	letter.WriteString("\r\n")
+	letter.WriteString(message.Body)
+	letter.WriteString("\r\n")
+
+	return letter.Bytes()
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"avito/internal/domain"
+	"context"
+	"log"
+	"time"
+)
+
+// OutboxRelay moves events from outbox to sink. Event is marked as published only after sink accepted it,
+// so event is delivered at least once and may be delivered again when relay stops between the two steps.
+type OutboxRelay struct {
+	outbox       domain.EventOutboxRepository
+	sink         domain.EventSink
+	batchSize    int
+	pollInterval time.Duration
+}
+
+func NewOutboxRelay(outbox domain.EventOutboxRepository, sink domain.EventSink, batchSize int, pollInterval time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		outbox:       outbox,
+		sink:         sink,
+		batchSize:    batchSize,
+		pollInterval: pollInterval,
+	}
+}
+
+// Run relays pending events every poll interval until ctx is cancelled
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.RelayPending(ctx); err != nil && ctx.Err() == nil {
+			log.Println("could not relay events:", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayPending publishes one batch of pending events in order they occurred.
+// Publishing stops on the first failure, so the failed event and the rest are retried in order on the next call.
+func (r *OutboxRelay) RelayPending(ctx context.Context) (relayed int, err error) {
+	events, err := r.outbox.FindUnpublished(ctx, r.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		if err = r.sink.Publish(ctx, event); err != nil {
+			return relayed, err
+		}
+
+		if err = r.outbox.MarkPublished(ctx, event.ID); err != nil {
+			return relayed, err
+		}
+
+		relayed++
+	}
+
+	return relayed, nil
+}
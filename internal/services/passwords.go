@@ -0,0 +1,29 @@
+package services
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// ReadBreachedPasswords reads list of breached passwords, one per line, empty lines and lines starting with # are skipped
+func ReadBreachedPasswords(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var passwords []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		passwords = append(passwords, line)
+	}
+
+	return passwords, scanner.Err()
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"avito/internal/domain"
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+const (
+	actFontFamily  string  = "Go"
+	actTimeLayout  string  = "02.01.2006 15:04:05 UTC"
+	actLineHeight  float64 = 7
+	actLabelWidth  float64 = 55
+	actPageWidth   float64 = 180
+	actProducerTag string  = "avito pvz service"
+)
+
+type pdfReceptionActRendererImpl struct{}
+
+// NewPDFReceptionActRenderer renders acts with embedded Go fonts, so no system fonts are required.
+// Document dates are taken from act content, the same act is always rendered into the same bytes.
+func NewPDFReceptionActRenderer() domain.ReceptionActRenderer {
+	return pdfReceptionActRendererImpl{}
+}
+
+func (r pdfReceptionActRendererImpl) Render(content domain.ReceptionActContent) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetCreationDate(content.ClosedAtUTC)
+	pdf.SetModificationDate(content.ClosedAtUTC)
+	pdf.SetCatalogSort(true)
+	pdf.SetProducer(actProducerTag, false)
+	pdf.SetTitle(fmt.Sprintf("Акт приемки %s", content.Reception.ID), true)
+	pdf.AddUTF8FontFromBytes(actFontFamily, "", goregular.TTF)
+	pdf.AddUTF8FontFromBytes(actFontFamily, "B", gobold.TTF)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AddPage()
+
+	pdf.SetFont(actFontFamily, "B", 16)
+	pdf.CellFormat(actPageWidth, 10, "Акт приемки товаров", "", 1, "C", false, 0, "")
+	pdf.Ln(4)
+
+	pdf.SetFont(actFontFamily, "", 11)
+	field := func(label, value string) {
+		pdf.CellFormat(actLabelWidth, actLineHeight, label, "", 0, "L", false, 0, "")
+		pdf.CellFormat(actPageWidth-actLabelWidth, actLineHeight, value, "", 1, "L", false, 0, "")
+	}
+	field("ПВЗ", content.PVZ.ID.String())
+	field("Город", content.PVZ.City.Name)
+	field("Приемка", content.Reception.ID.String())
+	field("Начало приемки", formatActTime(content.Reception.CreationTimeUTC))
+	field("Закрытие приемки", formatActTime(content.ClosedAtUTC))
+	pdf.Ln(4)
+
+	pdf.SetFont(actFontFamily, "B", 12)
+	pdf.CellFormat(actPageWidth, actLineHeight, "Количество товаров по категориям", "", 1, "L", false, 0, "")
+	pdf.SetFont(actFontFamily, "", 11)
+	for _, count := range content.CategoryCounts() {
+		pdf.CellFormat(120, actLineHeight, actCategoryName(count.Category), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(60, actLineHeight, strconv.Itoa(count.Count), "1", 1, "R", false, 0, "")
+	}
+	pdf.SetFont(actFontFamily, "B", 11)
+	pdf.CellFormat(120, actLineHeight, "Всего", "1", 0, "L", false, 0, "")
+	pdf.CellFormat(60, actLineHeight, strconv.Itoa(len(content.Products)), "1", 1, "R", false, 0, "")
+	pdf.Ln(4)
+
+	pdf.SetFont(actFontFamily, "B", 12)
+	pdf.CellFormat(actPageWidth, actLineHeight, "Список товаров", "", 1, "L", false, 0, "")
+
+	columns := []struct {
+		title string
+		width float64
+	}{
+		{"№", 10},
+		{"Товар", 70},
+		{"Категория", 26},
+		{"Штрихкод", 38},
+		{"Принят", 36},
+	}
+	pdf.SetFont(actFontFamily, "B", 9)
+	for _, column := range columns {
+		pdf.CellFormat(column.width, actLineHeight, column.title, "1", 0, "C", false, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont(actFontFamily, "", 9)
+	for i, product := range content.Products {
+		barcode := "—"
+		if product.Barcode != nil {
+			barcode = product.Barcode.Value
+		}
+
+		values := []string{
+			strconv.Itoa(i + 1),
+			product.ID.String(),
+			actCategoryName(product.Category),
+			barcode,
+			product.CreationTimeUTC.UTC().Format("02.01.2006 15:04:05"),
+		}
+		for j, column := range columns {
+			pdf.CellFormat(column.width, actLineHeight, values[j], "1", 0, "L", false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+
+	var document bytes.Buffer
+	if err := pdf.Output(&document); err != nil {
+		return nil, err
+	}
+
+	return document.Bytes(), nil
+}
+
+func formatActTime(value time.Time) string {
+	return value.UTC().Format(actTimeLayout)
+}
+
+// names are the same as in product_categories dictionary
+func actCategoryName(category domain.ProductCategory) string {
+	switch category {
+	case domain.ElectronicsProductCategory:
+		return "Электроника"
+	case domain.ClothesProductCategory:
+		return "Одежда"
+	case domain.ShoesProductCategory:
+		return "Обувь"
+	default:
+		return strconv.Itoa(int(category))
+	}
+}
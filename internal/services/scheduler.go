@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Scheduler runs job every interval, failed run is logged and the job is tried again on the next tick
+type Scheduler struct {
+	name     string
+	interval time.Duration
+	job      func(ctx context.Context) error
+}
+
+func NewScheduler(name string, interval time.Duration, job func(ctx context.Context) error) *Scheduler {
+	return &Scheduler{
+		name:     name,
+		interval: interval,
+		job:      job,
+	}
+}
+
+// Run runs job right away and then every interval until ctx is cancelled
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.job(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("could not run %s: %v", s.name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
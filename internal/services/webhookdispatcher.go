@@ -0,0 +1,301 @@
+package services
+
+import (
+	"avito/internal/domain"
+	repeatable "avito/pkg/utils"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	WebhookSignatureHeader      string = "X-Signature-256"
+	WebhookSubscriptionIDHeader string = "X-Webhook-Subscription-Id"
+
+	// relay waits for room in the queue only while events can not be handed to subscription workers
+	webhookQueueSize int = 1024
+	// events that do not fit into queue of subscription are given up for that subscription only
+	webhookSubscriptionQueueSize int = 256
+	// worker of subscription that got no events for that long stops, next event starts it again
+	webhookWorkerIdleTimeout = time.Minute
+)
+
+var errWebhookQueueFull = errors.New("subscription is too far behind, event was not queued")
+
+// WebhookDispatcher delivers events to matching webhook subscriptions.
+// Every subscription has its own queue and worker that retries its deliveries, so subscriber
+// that is slow or down delays only its own events. Every try is recorded, subscriber that failed
+// every try is given up for this event. Queues live in memory, events queued when Run stops are lost.
+type WebhookDispatcher struct {
+	subscriptions domain.WebhookSubscriptionRepository
+	attempts      domain.WebhookDeliveryAttemptRepository
+	pvzs          domain.PVZRepository
+	client        *http.Client
+	tries         int
+	retryDelay    time.Duration
+	queue         chan domain.Event
+
+	mu      sync.Mutex
+	workers map[domain.WebhookSubscriptionID]chan webhookDelivery
+	running sync.WaitGroup
+}
+
+type webhookDelivery struct {
+	subscription domain.WebhookSubscription
+	event        domain.Event
+	body         []byte
+}
+
+func NewWebhookDispatcher(subscriptions domain.WebhookSubscriptionRepository, attempts domain.WebhookDeliveryAttemptRepository, pvzs domain.PVZRepository, client *http.Client, tries int, retryDelay time.Duration) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		subscriptions: subscriptions,
+		attempts:      attempts,
+		pvzs:          pvzs,
+		client:        client,
+		tries:         tries,
+		retryDelay:    retryDelay,
+		queue:         make(chan domain.Event, webhookQueueSize),
+		workers:       make(map[domain.WebhookSubscriptionID]chan webhookDelivery),
+	}
+}
+
+// Publish queues event for Run and returns right away, so relay does not wait for subscribers and their retries.
+// Delivery is best effort: events queued when Run stops are not delivered, as are events no try delivered
+// and events that did not fit into queue of subscription
+func (d *WebhookDispatcher) Publish(ctx context.Context, event domain.Event) error {
+	select {
+	case d.queue <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Run hands queued events to workers of matching subscriptions until ctx is cancelled and waits for workers to stop.
+// Subscription gets events in order they occurred
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	defer d.running.Wait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-d.queue:
+			if err := d.dispatch(ctx, event); err != nil && ctx.Err() == nil {
+				log.Printf("could not dispatch event %s to webhooks: %v", event.ID, err)
+			}
+		}
+	}
+}
+
+// Deliver posts event to every matching subscription and waits for all of them, it fails when subscriptions
+// could not be found or attempts could not be recorded
+func (d *WebhookDispatcher) Deliver(ctx context.Context, event domain.Event) error {
+	deliveries, err := d.deliveriesOf(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	errs := make([]error, len(deliveries))
+	var wg sync.WaitGroup
+	for i, delivery := range deliveries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = d.deliver(ctx, delivery.subscription, delivery.event, delivery.body)
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (d *WebhookDispatcher) deliveriesOf(ctx context.Context, event domain.Event) ([]webhookDelivery, error) {
+	subscriptions, err := d.subscriptions.FindAllByEventType(ctx, event.Type)
+	if err != nil || len(subscriptions) == 0 {
+		return nil, err
+	}
+
+	pvz, err := d.pvzs.FindById(ctx, event.PVZID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []webhookDelivery
+	for _, subscription := range subscriptions {
+		if subscription.Matches(event, pvz) {
+			deliveries = append(deliveries, webhookDelivery{subscription: subscription, event: event, body: body})
+		}
+	}
+
+	return deliveries, nil
+}
+
+func (d *WebhookDispatcher) dispatch(ctx context.Context, event domain.Event) error {
+	deliveries, err := d.deliveriesOf(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, delivery := range deliveries {
+		if d.enqueue(ctx, delivery) {
+			continue
+		}
+
+		attempt, err := domain.NewWebhookDeliveryAttempt(delivery.subscription.ID, event.ID, 0, 0, errWebhookQueueFull)
+		if err == nil {
+			err = d.attempts.Add(ctx, attempt)
+		}
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// enqueue hands delivery to worker of its subscription, starting one when needed,
+// and reports false when the queue of subscription is full
+func (d *WebhookDispatcher) enqueue(ctx context.Context, delivery webhookDelivery) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	queue, ok := d.workers[delivery.subscription.ID]
+	if !ok {
+		queue = make(chan webhookDelivery, webhookSubscriptionQueueSize)
+		d.workers[delivery.subscription.ID] = queue
+		d.running.Add(1)
+		go d.work(ctx, delivery.subscription.ID, queue)
+	}
+
+	select {
+	case queue <- delivery:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *WebhookDispatcher) work(ctx context.Context, subscriptionID domain.WebhookSubscriptionID, queue chan webhookDelivery) {
+	defer d.running.Done()
+
+	idle := time.NewTimer(webhookWorkerIdleTimeout)
+	defer idle.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case delivery := <-queue:
+			if err := d.deliver(ctx, delivery.subscription, delivery.event, delivery.body); err != nil && ctx.Err() == nil {
+				log.Printf("could not deliver event %s to webhook %s: %v", delivery.event.ID, subscriptionID, err)
+			}
+			idle.Reset(webhookWorkerIdleTimeout)
+		case <-idle.C:
+			if d.stopIdle(subscriptionID, queue) {
+				return
+			}
+			idle.Reset(webhookWorkerIdleTimeout)
+		}
+	}
+}
+
+// stopIdle forgets worker of subscription unless an event was queued for it meanwhile
+func (d *WebhookDispatcher) stopIdle(subscriptionID domain.WebhookSubscriptionID, queue chan webhookDelivery) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(queue) > 0 {
+		return false
+	}
+	delete(d.workers, subscriptionID)
+
+	return true
+}
+
+func (d *WebhookDispatcher) deliver(ctx context.Context, subscription domain.WebhookSubscription, event domain.Event, body []byte) error {
+	var (
+		try       int
+		recordErr error
+	)
+
+	repeatable.DoWithTriesContext(ctx, func() error {
+		try++
+		statusCode, deliveryErr := d.post(ctx, subscription, event, body)
+
+		attempt, err := domain.NewWebhookDeliveryAttempt(subscription.ID, event.ID, try, statusCode, deliveryErr)
+		if err == nil {
+			err = d.attempts.Add(ctx, attempt)
+		}
+		recordErr = errors.Join(recordErr, err)
+
+		return deliveryErr
+	}, d.tries, d.retryDelay)
+
+	return recordErr
+}
+
+func (d *WebhookDispatcher) post(ctx context.Context, subscription domain.WebhookSubscription, event domain.Event, body []byte) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Event-Id", event.ID.String())
+	request.Header.Set("X-Event-Type", event.Type)
+	request.Header.Set(WebhookSubscriptionIDHeader, subscription.ID.String())
+	request.Header.Set(WebhookSignatureHeader, SignWebhookPayload(subscription.Secret, body))
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	}
+
+	return response.StatusCode, nil
+}
+
+// SignWebhookPayload returns hex encoded HMAC-SHA256 of body prefixed with algorithm name,
+// subscriber computes the same value with its secret to make sure the request came from us
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type fanOutEventSink struct {
+	sinks []domain.EventSink
+}
+
+// NewFanOutEventSink publishes every event into each of sinks, event is published again into all of them
+// when any fails, so sinks must tolerate duplicates
+func NewFanOutEventSink(sinks ...domain.EventSink) domain.EventSink {
+	return fanOutEventSink{sinks: sinks}
+}
+
+func (s fanOutEventSink) Publish(ctx context.Context, event domain.Event) error {
+	var errs []error
+	for _, sink := range s.sinks {
+		errs = append(errs, sink.Publish(ctx, event))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+)
+
+type apiKeyRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewAPIKeyRepository(client postgresql.Client) domain.APIKeyRepository {
+	return apiKeyRepositoryImpl{client: client}
+}
+
+const selectAPIKeyBaseQuery string = `
+	select
+			  id
+			, service_account_id
+			, name
+			, hint
+			, key_hash
+			, scope
+			, created_by
+			, creation_time_utc
+			, revoked_at_utc
+			, last_used_at_utc
+	  from api_keys
+	`
+
+func scanAPIKey(row pgx.Row) (domain.APIKey, error) {
+	var key domain.APIKey
+	err := row.Scan(
+		&key.ID,
+		&key.ServiceAccountID,
+		&key.Name,
+		&key.Hint,
+		&key.KeyHash,
+		&key.Scope,
+		&key.CreatedBy,
+		&key.CreationTimeUTC,
+		&key.RevokedAtUTC,
+		&key.LastUsedAtUTC,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.APIKey{}, errors.New(domain.APIKeyDoesNotExistError)
+	}
+
+	return key, err
+}
+
+func (r apiKeyRepositoryImpl) Add(ctx context.Context, key domain.APIKey) error {
+	const query string = `
+	insert into api_keys(id, service_account_id, name, hint, key_hash, scope, created_by, creation_time_utc, revoked_at_utc, last_used_at_utc)
+	values($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+	`
+
+	_, err := r.client.Exec(ctx, query,
+		key.ID,
+		key.ServiceAccountID,
+		key.Name,
+		key.Hint,
+		key.KeyHash,
+		key.Scope,
+		key.CreatedBy,
+		key.CreationTimeUTC,
+		key.RevokedAtUTC,
+		key.LastUsedAtUTC,
+	)
+
+	return err
+}
+
+func (r apiKeyRepositoryImpl) FindByID(ctx context.Context, id domain.APIKeyID) (domain.APIKey, error) {
+	const query string = selectAPIKeyBaseQuery + " where id = $1;"
+
+	return scanAPIKey(r.client.QueryRow(ctx, query, id))
+}
+
+func (r apiKeyRepositoryImpl) FindByKeyHash(ctx context.Context, keyHash string) (domain.APIKey, error) {
+	const query string = selectAPIKeyBaseQuery + " where key_hash = $1;"
+
+	return scanAPIKey(r.client.QueryRow(ctx, query, keyHash))
+}
+
+func (r apiKeyRepositoryImpl) FindAllByServiceAccountID(ctx context.Context, serviceAccountID domain.UserID) ([]domain.APIKey, error) {
+	const query string = selectAPIKeyBaseQuery + " where service_account_id = $1 order by creation_time_utc, id;"
+
+	rows, err := r.client.Query(ctx, query, serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]domain.APIKey, 0)
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// revocation is conditional, so of two requests racing for the same key only one succeeds
+func (r apiKeyRepositoryImpl) Revoke(ctx context.Context, key domain.APIKey) error {
+	const query string = `
+	update api_keys
+	   set revoked_at_utc = $2
+	 where id = $1
+	   and revoked_at_utc is null;
+	`
+
+	tag, err := r.client.Exec(ctx, query, key.ID, key.RevokedAtUTC)
+	if err != nil {
+		return err
+	} else if tag.RowsAffected() == 0 {
+		return errors.New(domain.APIKeyIsAlreadyRevokedError)
+	}
+
+	return nil
+}
+
+func (r apiKeyRepositoryImpl) MarkUsed(ctx context.Context, key domain.APIKey) error {
+	const query string = `
+	update api_keys
+	   set last_used_at_utc = $2
+	 where id = $1;
+	`
+
+	_, err := r.client.Exec(ctx, query, key.ID, key.LastUsedAtUTC)
+
+	return err
+}
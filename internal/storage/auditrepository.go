@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+)
+
+type auditRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewAuditRepository(client postgresql.Client) domain.AuditRepository {
+	return auditRepositoryImpl{client: client}
+}
+
+func (a auditRepositoryImpl) Add(ctx context.Context, record domain.AuditRecord) error {
+	const query string = `
+	insert into audit_log(id, actor_id, action, entity_type, entity_id, before, after, protocol, request_id, occurred_at_utc)
+	values($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+	`
+
+	_, err := a.client.Exec(ctx, query,
+		record.ID,
+		record.ActorID,
+		record.Action,
+		record.EntityType,
+		record.EntityID,
+		nullableJSON(record.Before),
+		nullableJSON(record.After),
+		record.Protocol,
+		record.RequestID,
+		record.OccurredAtUTC,
+	)
+
+	return err
+}
+
+func (a auditRepositoryImpl) FindAllByFilter(ctx context.Context, filter domain.SearchAuditRecordFilter) ([]domain.AuditRecord, error) {
+	const query string = `
+	select
+			  id
+			, actor_id
+			, action
+			, entity_type
+			, entity_id
+			, before
+			, after
+			, protocol
+			, request_id
+			, occurred_at_utc
+	  from audit_log
+	 where ($1::uuid is null or actor_id = $1)
+	   and ($2 = '' or action = $2)
+	   and ($3 = '' or entity_type = $3)
+	   and ($4::uuid is null or entity_id = $4)
+	   and ($5::timestamp is null or occurred_at_utc >= $5)
+	   and ($6::timestamp is null or occurred_at_utc <= $6)
+	 order by occurred_at_utc desc, id desc
+	 offset $7
+	 limit $8;
+	`
+
+	rows, err := a.client.Query(ctx, query,
+		filter.ActorID,
+		filter.Action,
+		filter.EntityType,
+		filter.EntityID,
+		filter.StartTimeUTC,
+		filter.EndTimeUTC,
+		(filter.Page-1)*filter.Limit,
+		filter.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]domain.AuditRecord, 0)
+	for rows.Next() {
+		var (
+			record        domain.AuditRecord
+			before, after []byte
+		)
+		err := rows.Scan(
+			&record.ID,
+			&record.ActorID,
+			&record.Action,
+			&record.EntityType,
+			&record.EntityID,
+			&before,
+			&after,
+			&record.Protocol,
+			&record.RequestID,
+			&record.OccurredAtUTC,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		record.Before, record.After = before, after
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
+
+// empty state is stored as sql null instead of invalid json
+func nullableJSON(state []byte) any {
+	if len(state) == 0 {
+		return nil
+	}
+
+	return string(state)
+}
@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+)
+
+type discrepancyReportRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewDiscrepancyReportRepository(client postgresql.Client) domain.DiscrepancyReportRepository {
+	return discrepancyReportRepositoryImpl{client: client}
+}
+
+func (d discrepancyReportRepositoryImpl) Add(ctx context.Context, report domain.DiscrepancyReport) error {
+	const query string = `
+	insert into discrepancy_reports(reception_id, manifest_id, creation_time_utc, missing, unexpected, duplicates, unidentified_products, category_mismatches, categories)
+	values($1, $2, $3, $4, $5, $6, $7, $8, $9);
+	`
+
+	categoryMismatches, err := json.Marshal(report.CategoryMismatches)
+	if err != nil {
+		return err
+	}
+
+	categories, err := json.Marshal(report.Categories)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.client.Exec(ctx, query, report.ReceptionID, report.ManifestID, report.CreationTimeUTC,
+		report.Missing, report.Unexpected, report.Duplicates, report.UnidentifiedProducts, categoryMismatches, categories)
+
+	return err
+}
+
+func (d discrepancyReportRepositoryImpl) FindByReceptionID(ctx context.Context, receptionId domain.ReceptionID) (domain.DiscrepancyReport, error) {
+	const query string = `
+	select
+			  reception_id
+			, manifest_id
+			, creation_time_utc
+			, missing
+			, unexpected
+			, duplicates
+			, unidentified_products
+			, category_mismatches
+			, categories
+	  from discrepancy_reports
+	 where reception_id = $1;
+	`
+
+	var (
+		report             domain.DiscrepancyReport
+		categoryMismatches []byte
+		categories         []byte
+	)
+	err := d.client.QueryRow(ctx, query, receptionId).Scan(&report.ReceptionID, &report.ManifestID, &report.CreationTimeUTC,
+		&report.Missing, &report.Unexpected, &report.Duplicates, &report.UnidentifiedProducts, &categoryMismatches, &categories)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.DiscrepancyReport{}, errors.New(domain.DiscrepancyReportDoesNotExistError)
+		}
+
+		return domain.DiscrepancyReport{}, err
+	}
+
+	if err = json.Unmarshal(categoryMismatches, &report.CategoryMismatches); err != nil {
+		return domain.DiscrepancyReport{}, err
+	}
+
+	if err = json.Unmarshal(categories, &report.Categories); err != nil {
+		return domain.DiscrepancyReport{}, err
+	}
+
+	return report, nil
+}
+
+func (d discrepancyReportRepositoryImpl) Remove(ctx context.Context, receptionId domain.ReceptionID) error {
+	const query string = "delete from discrepancy_reports where reception_id = $1;"
+
+	_, err := d.client.Exec(ctx, query, receptionId)
+
+	return err
+}
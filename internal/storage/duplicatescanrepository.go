@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+)
+
+type duplicateScanRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewDuplicateScanRepository(client postgresql.Client) domain.DuplicateScanRepository {
+	return duplicateScanRepositoryImpl{client: client}
+}
+
+func (r duplicateScanRepositoryImpl) Add(ctx context.Context, scan domain.DuplicateScan) error {
+	const query string = `
+	insert into duplicate_scans(id, reception_id, barcode, barcode_format, scanned_by, occurred_at_utc)
+	values($1, $2, $3, $4, $5, $6);
+	`
+
+	_, err := r.client.Exec(ctx, query,
+		scan.ID,
+		scan.ReceptionID,
+		scan.Barcode.Value,
+		scan.Barcode.Format,
+		scan.ScannedBy,
+		scan.OccurredAtUTC,
+	)
+
+	return err
+}
+
+func (r duplicateScanRepositoryImpl) FindAllByReceptionID(ctx context.Context, receptionId domain.ReceptionID) ([]domain.DuplicateScan, error) {
+	const query string = `
+	select
+			  id
+			, reception_id
+			, barcode
+			, barcode_format
+			, scanned_by
+			, occurred_at_utc
+	  from duplicate_scans
+	 where reception_id = $1
+	 order by occurred_at_utc, id;
+	`
+
+	rows, err := r.client.Query(ctx, query, receptionId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	scans := make([]domain.DuplicateScan, 0)
+	for rows.Next() {
+		var scan domain.DuplicateScan
+		err := rows.Scan(
+			&scan.ID,
+			&scan.ReceptionID,
+			&scan.Barcode.Value,
+			&scan.Barcode.Format,
+			&scan.ScannedBy,
+			&scan.OccurredAtUTC,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		scans = append(scans, scan)
+	}
+
+	return scans, rows.Err()
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+	"errors"
+	"time"
+)
+
+type eventOutboxRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewEventOutboxRepository(client postgresql.Client) domain.EventOutboxRepository {
+	return eventOutboxRepositoryImpl{client: client}
+}
+
+func (r eventOutboxRepositoryImpl) Add(ctx context.Context, events ...domain.Event) error {
+	const query string = `
+	insert into outbox_events(id, event_type, pvz_id, occurred_at_utc, payload)
+	values($1, $2, $3, $4, $5);
+	`
+
+	for _, event := range events {
+		if _, err := r.client.Exec(ctx, query, event.ID, event.Type, event.PVZID, event.OccurredAtUTC, []byte(event.Payload)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r eventOutboxRepositoryImpl) FindUnpublished(ctx context.Context, limit int) ([]domain.Event, error) {
+	const query string = `
+	select
+			  id
+			, event_type
+			, pvz_id
+			, occurred_at_utc
+			, payload
+	  from outbox_events
+	 where published_at_utc is null
+	 order by occurred_at_utc, id
+	 limit $1;
+	`
+
+	rows, err := r.client.Query(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]domain.Event, 0, limit)
+	for rows.Next() {
+		var (
+			event   domain.Event
+			payload []byte
+		)
+		if err := rows.Scan(&event.ID, &event.Type, &event.PVZID, &event.OccurredAtUTC, &payload); err != nil {
+			return nil, err
+		}
+
+		event.Payload = payload
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+func (r eventOutboxRepositoryImpl) MarkPublished(ctx context.Context, id domain.EventID) error {
+	const query string = "update outbox_events set published_at_utc = $2 where id = $1;"
+
+	tag, err := r.client.Exec(ctx, query, id, time.Now().UTC())
+	if err != nil {
+		return err
+	} else if tag.RowsAffected() == 0 {
+		return errors.New(domain.EventDoesNotExistError)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+)
+
+type apiKeyRepositoryImpl struct {
+	store *Store
+}
+
+func NewAPIKeyRepository(store *Store) domain.APIKeyRepository {
+	return apiKeyRepositoryImpl{store: store}
+}
+
+func (r apiKeyRepositoryImpl) Add(ctx context.Context, key domain.APIKey) error {
+	defer r.store.lock(ctx)()
+
+	if _, exists := r.store.users[key.ServiceAccountID]; !exists {
+		return errors.New("could not save api key")
+	}
+
+	for _, stored := range r.store.apiKeys {
+		if stored.ID == key.ID || stored.KeyHash == key.KeyHash {
+			return errors.New("could not save api key")
+		}
+	}
+
+	key.Scope = slices.Clone(key.Scope)
+	r.store.apiKeys[key.ID] = key
+
+	return nil
+}
+
+func (r apiKeyRepositoryImpl) FindByID(ctx context.Context, id domain.APIKeyID) (domain.APIKey, error) {
+	defer r.store.rlock(ctx)()
+
+	key, exists := r.store.apiKeys[id]
+	if !exists {
+		return domain.APIKey{}, errors.New(domain.APIKeyDoesNotExistError)
+	}
+
+	return key, nil
+}
+
+func (r apiKeyRepositoryImpl) FindByKeyHash(ctx context.Context, keyHash string) (domain.APIKey, error) {
+	defer r.store.rlock(ctx)()
+
+	for _, key := range r.store.apiKeys {
+		if key.KeyHash == keyHash {
+			return key, nil
+		}
+	}
+
+	return domain.APIKey{}, errors.New(domain.APIKeyDoesNotExistError)
+}
+
+func (r apiKeyRepositoryImpl) FindAllByServiceAccountID(ctx context.Context, serviceAccountID domain.UserID) ([]domain.APIKey, error) {
+	defer r.store.rlock(ctx)()
+
+	keys := make([]domain.APIKey, 0)
+	for _, key := range r.store.apiKeys {
+		if key.ServiceAccountID == serviceAccountID {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b domain.APIKey) int {
+		if byTime := a.CreationTimeUTC.Compare(b.CreationTimeUTC); byTime != 0 {
+			return byTime
+		}
+
+		return bytes.Compare(a.ID[:], b.ID[:])
+	})
+
+	return keys, nil
+}
+
+func (r apiKeyRepositoryImpl) Revoke(ctx context.Context, key domain.APIKey) error {
+	defer r.store.lock(ctx)()
+
+	stored, exists := r.store.apiKeys[key.ID]
+	if !exists {
+		return errors.New(domain.APIKeyDoesNotExistError)
+	} else if stored.RevokedAtUTC != nil {
+		return errors.New(domain.APIKeyIsAlreadyRevokedError)
+	}
+
+	stored.RevokedAtUTC = key.RevokedAtUTC
+	r.store.apiKeys[key.ID] = stored
+
+	return nil
+}
+
+func (r apiKeyRepositoryImpl) MarkUsed(ctx context.Context, key domain.APIKey) error {
+	defer r.store.lock(ctx)()
+
+	stored, exists := r.store.apiKeys[key.ID]
+	if !exists {
+		return errors.New(domain.APIKeyDoesNotExistError)
+	}
+
+	stored.LastUsedAtUTC = key.LastUsedAtUTC
+	r.store.apiKeys[key.ID] = stored
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+)
+
+type auditRepositoryImpl struct {
+	store *Store
+}
+
+func NewAuditRepository(store *Store) domain.AuditRepository {
+	return auditRepositoryImpl{store: store}
+}
+
+func (a auditRepositoryImpl) Add(ctx context.Context, record domain.AuditRecord) error {
+	defer a.store.lock(ctx)()
+
+	if slices.ContainsFunc(a.store.audit, func(existing domain.AuditRecord) bool { return existing.ID == record.ID }) {
+		return errors.New("could not save audit record")
+	}
+
+	a.store.audit = append(a.store.audit, record)
+
+	return nil
+}
+
+func (a auditRepositoryImpl) FindAllByFilter(ctx context.Context, filter domain.SearchAuditRecordFilter) ([]domain.AuditRecord, error) {
+	defer a.store.rlock(ctx)()
+
+	records := make([]domain.AuditRecord, 0)
+	for _, record := range a.store.audit {
+		if matchesAuditFilter(record, filter) {
+			records = append(records, record)
+		}
+	}
+
+	slices.SortFunc(records, func(a, b domain.AuditRecord) int {
+		if byTime := b.OccurredAtUTC.Compare(a.OccurredAtUTC); byTime != 0 {
+			return byTime
+		}
+
+		return bytes.Compare(b.ID[:], a.ID[:])
+	})
+
+	offset := min(len(records), max(0, (filter.Page-1)*filter.Limit))
+
+	return limit(records[offset:], filter.Limit), nil
+}
+
+func matchesAuditFilter(record domain.AuditRecord, filter domain.SearchAuditRecordFilter) bool {
+	switch {
+	case filter.ActorID != nil && *filter.ActorID != record.ActorID:
+		return false
+	case filter.Action != "" && filter.Action != record.Action:
+		return false
+	case filter.EntityType != "" && filter.EntityType != record.EntityType:
+		return false
+	case filter.EntityID != nil && *filter.EntityID != record.EntityID:
+		return false
+	case filter.StartTimeUTC != nil && record.OccurredAtUTC.Before(*filter.StartTimeUTC):
+		return false
+	case filter.EndTimeUTC != nil && record.OccurredAtUTC.After(*filter.EndTimeUTC):
+		return false
+	default:
+		return true
+	}
+}
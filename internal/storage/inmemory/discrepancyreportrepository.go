@@ -0,0 +1,57 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+	"slices"
+)
+
+type discrepancyReportRepositoryImpl struct {
+	store *Store
+}
+
+func NewDiscrepancyReportRepository(store *Store) domain.DiscrepancyReportRepository {
+	return discrepancyReportRepositoryImpl{store: store}
+}
+
+func (d discrepancyReportRepositoryImpl) Add(ctx context.Context, report domain.DiscrepancyReport) error {
+	defer d.store.lock(ctx)()
+
+	if _, exists := d.store.discrepancies[report.ReceptionID]; exists {
+		return errors.New("could not save discrepancy report")
+	}
+
+	d.store.discrepancies[report.ReceptionID] = cloneDiscrepancyReport(report)
+
+	return nil
+}
+
+func (d discrepancyReportRepositoryImpl) FindByReceptionID(ctx context.Context, receptionId domain.ReceptionID) (domain.DiscrepancyReport, error) {
+	defer d.store.rlock(ctx)()
+
+	report, exists := d.store.discrepancies[receptionId]
+	if !exists {
+		return domain.DiscrepancyReport{}, errors.New(domain.DiscrepancyReportDoesNotExistError)
+	}
+
+	return cloneDiscrepancyReport(report), nil
+}
+
+func (d discrepancyReportRepositoryImpl) Remove(ctx context.Context, receptionId domain.ReceptionID) error {
+	defer d.store.lock(ctx)()
+
+	delete(d.store.discrepancies, receptionId)
+
+	return nil
+}
+
+func cloneDiscrepancyReport(report domain.DiscrepancyReport) domain.DiscrepancyReport {
+	report.Missing = slices.Clone(report.Missing)
+	report.Unexpected = slices.Clone(report.Unexpected)
+	report.Duplicates = slices.Clone(report.Duplicates)
+	report.CategoryMismatches = slices.Clone(report.CategoryMismatches)
+	report.Categories = slices.Clone(report.Categories)
+
+	return report
+}
@@ -0,0 +1,46 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+	"slices"
+	"sort"
+)
+
+type duplicateScanRepositoryImpl struct {
+	store *Store
+}
+
+func NewDuplicateScanRepository(store *Store) domain.DuplicateScanRepository {
+	return duplicateScanRepositoryImpl{store: store}
+}
+
+func (r duplicateScanRepositoryImpl) Add(ctx context.Context, scan domain.DuplicateScan) error {
+	defer r.store.lock(ctx)()
+
+	if slices.ContainsFunc(r.store.duplicateScans, func(existing domain.DuplicateScan) bool { return existing.ID == scan.ID }) {
+		return errors.New("could not save duplicate scan")
+	}
+
+	r.store.duplicateScans = append(r.store.duplicateScans, scan)
+
+	return nil
+}
+
+func (r duplicateScanRepositoryImpl) FindAllByReceptionID(ctx context.Context, receptionId domain.ReceptionID) ([]domain.DuplicateScan, error) {
+	defer r.store.rlock(ctx)()
+
+	scans := make([]domain.DuplicateScan, 0)
+	for _, scan := range r.store.duplicateScans {
+		if scan.ReceptionID == receptionId {
+			scans = append(scans, scan)
+		}
+	}
+
+	sort.SliceStable(scans, func(i, j int) bool {
+		return scans[i].OccurredAtUTC.Before(scans[j].OccurredAtUTC)
+	})
+
+	return scans, nil
+}
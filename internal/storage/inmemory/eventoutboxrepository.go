@@ -0,0 +1,72 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+)
+
+type eventOutboxRepositoryImpl struct {
+	store *Store
+}
+
+func NewEventOutboxRepository(store *Store) domain.EventOutboxRepository {
+	return eventOutboxRepositoryImpl{store: store}
+}
+
+func (r eventOutboxRepositoryImpl) Add(ctx context.Context, events ...domain.Event) error {
+	defer r.store.lock(ctx)()
+
+	for _, event := range events {
+		if slices.ContainsFunc(r.store.outbox, func(record outboxRecord) bool { return record.ID == event.ID }) {
+			return errors.New("could not save event")
+		}
+	}
+
+	for _, event := range events {
+		event.Payload = slices.Clone(event.Payload)
+		r.store.outbox = append(r.store.outbox, outboxRecord{Event: event})
+	}
+
+	return nil
+}
+
+func (r eventOutboxRepositoryImpl) FindUnpublished(ctx context.Context, count int) ([]domain.Event, error) {
+	defer r.store.rlock(ctx)()
+
+	events := make([]domain.Event, 0)
+	for _, record := range r.store.outbox {
+		if record.published {
+			continue
+		}
+
+		event := record.Event
+		event.Payload = slices.Clone(event.Payload)
+		events = append(events, event)
+	}
+
+	slices.SortFunc(events, func(a, b domain.Event) int {
+		if byTime := a.OccurredAtUTC.Compare(b.OccurredAtUTC); byTime != 0 {
+			return byTime
+		}
+
+		return bytes.Compare(a.ID[:], b.ID[:])
+	})
+
+	return limit(events, count), nil
+}
+
+func (r eventOutboxRepositoryImpl) MarkPublished(ctx context.Context, id domain.EventID) error {
+	defer r.store.lock(ctx)()
+
+	for i := range r.store.outbox {
+		if r.store.outbox[i].ID == id {
+			r.store.outbox[i].published = true
+			return nil
+		}
+	}
+
+	return errors.New(domain.EventDoesNotExistError)
+}
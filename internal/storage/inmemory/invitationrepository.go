@@ -0,0 +1,57 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+)
+
+type invitationRepositoryImpl struct {
+	store *Store
+}
+
+func NewInvitationRepository(store *Store) domain.InvitationRepository {
+	return invitationRepositoryImpl{store: store}
+}
+
+func (r invitationRepositoryImpl) Add(ctx context.Context, invitation domain.Invitation) error {
+	defer r.store.lock(ctx)()
+
+	for _, stored := range r.store.invitations {
+		if stored.ID == invitation.ID || stored.TokenHash == invitation.TokenHash {
+			return errors.New("could not save invitation")
+		}
+	}
+
+	r.store.invitations[invitation.ID] = invitation
+
+	return nil
+}
+
+func (r invitationRepositoryImpl) FindByTokenHash(ctx context.Context, tokenHash string) (domain.Invitation, error) {
+	defer r.store.rlock(ctx)()
+
+	for _, invitation := range r.store.invitations {
+		if invitation.TokenHash == tokenHash {
+			return invitation, nil
+		}
+	}
+
+	return domain.Invitation{}, errors.New(domain.InvitationDoesNotExistError)
+}
+
+func (r invitationRepositoryImpl) Accept(ctx context.Context, invitation domain.Invitation) error {
+	defer r.store.lock(ctx)()
+
+	stored, exists := r.store.invitations[invitation.ID]
+	if !exists {
+		return errors.New(domain.InvitationDoesNotExistError)
+	} else if stored.AcceptedAtUTC != nil {
+		return errors.New(domain.InvitationIsAlreadyAcceptedError)
+	}
+
+	stored.AcceptedAtUTC, stored.AcceptedBy = invitation.AcceptedAtUTC, invitation.AcceptedBy
+	r.store.invitations[invitation.ID] = stored
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+	"sort"
+)
+
+type productRepositoryImpl struct {
+	store *Store
+}
+
+func NewProductRepository(store *Store) domain.ProductRepository {
+	return productRepositoryImpl{store: store}
+}
+
+func (p productRepositoryImpl) Add(ctx context.Context, product domain.Product) error {
+	defer p.store.lock(ctx)()
+
+	if _, exists := p.store.products[product.ID]; exists {
+		return errors.New("could not save product")
+	} else if reception, exists := p.store.receptions[product.ReceptionID]; exists && p.store.isAcceptedElsewhere(product, reception) {
+		return errors.New(domain.DuplicateBarcodeError)
+	}
+
+	p.store.products[product.ID] = product
+
+	return nil
+}
+
+func (p productRepositoryImpl) FindAllByReceptionID(ctx context.Context, receptionId domain.ReceptionID) ([]*domain.Product, error) {
+	defer p.store.rlock(ctx)()
+
+	return p.store.receptionProducts(receptionId), nil
+}
+
+func (p productRepositoryImpl) FindAllByFilter(ctx context.Context, filter domain.SearchProductFilter) ([]*domain.Product, error) {
+	defer p.store.rlock(ctx)()
+
+	products := make([]*domain.Product, 0)
+	for _, product := range p.store.products {
+		if product.IsRemoved() || product.Barcode == nil || product.Barcode.Value != filter.Barcode {
+			continue
+		}
+
+		reception, exists := p.store.receptions[product.ReceptionID]
+		if !exists || (filter.ReceptionStatus != 0 && reception.Status != filter.ReceptionStatus) {
+			continue
+		}
+
+		product := product
+		products = append(products, &product)
+	}
+
+	sort.Slice(products, func(i, j int) bool {
+		return products[i].CreationTimeUTC.Before(products[j].CreationTimeUTC)
+	})
+
+	return products, nil
+}
+
+func (p productRepositoryImpl) Remove(ctx context.Context, product domain.Product) error {
+	defer p.store.lock(ctx)()
+
+	stored, exists := p.store.products[product.ID]
+	if !exists {
+		return nil
+	}
+
+	stored.DeletedAtUTC, stored.DeletedBy = product.DeletedAtUTC, product.DeletedBy
+	p.store.products[product.ID] = stored
+
+	return nil
+}
+
+func (p productRepositoryImpl) FindLastRemovedByReceptionID(ctx context.Context, receptionId domain.ReceptionID) (domain.Product, error) {
+	defer p.store.rlock(ctx)()
+
+	var (
+		last  domain.Product
+		found bool
+	)
+	for _, product := range p.store.products {
+		if product.ReceptionID != receptionId || !product.IsRemoved() {
+			continue
+		}
+
+		if !found || product.DeletedAtUTC.After(*last.DeletedAtUTC) {
+			last, found = product, true
+		}
+	}
+
+	if !found {
+		return domain.Product{}, errors.New(domain.RemovedProductDoesNotExistError)
+	}
+
+	return last, nil
+}
+
+func (p productRepositoryImpl) Restore(ctx context.Context, product domain.Product) error {
+	defer p.store.lock(ctx)()
+
+	stored, exists := p.store.products[product.ID]
+	if !exists {
+		return nil
+	} else if reception, exists := p.store.receptions[stored.ReceptionID]; exists && p.store.isAcceptedElsewhere(stored, reception) {
+		return errors.New(domain.DuplicateBarcodeError)
+	}
+
+	stored.DeletedAtUTC, stored.DeletedBy = nil, nil
+	p.store.products[product.ID] = stored
+
+	return nil
+}
+
+func (s *Store) receptionProducts(receptionId domain.ReceptionID) []*domain.Product {
+	products := make([]*domain.Product, 0)
+	for _, product := range s.products {
+		if product.ReceptionID == receptionId && !product.IsRemoved() {
+			product := product
+			products = append(products, &product)
+		}
+	}
+
+	// the same order as in postgres, products accepted at the same time are ordered by id
+	sort.Slice(products, func(i, j int) bool {
+		if !products[i].CreationTimeUTC.Equal(products[j].CreationTimeUTC) {
+			return products[i].CreationTimeUTC.Before(products[j].CreationTimeUTC)
+		}
+
+		return products[i].ID.String() < products[j].ID.String()
+	})
+
+	return products
+}
+
+// isAcceptedElsewhere tells whether barcode of product in opened reception is taken by another product of opened reception,
+// it is the same check as accepted barcode index in postgres
+func (s *Store) isAcceptedElsewhere(product domain.Product, reception domain.ReceptionInfo) bool {
+	if product.Barcode == nil || reception.IsCompleted() {
+		return false
+	}
+
+	for _, other := range s.products {
+		if other.ID == product.ID || other.IsRemoved() || other.Barcode == nil || other.Barcode.Value != product.Barcode.Value {
+			continue
+		}
+
+		if otherReception, exists := s.receptions[other.ReceptionID]; exists && !otherReception.IsCompleted() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+)
+
+type pvzAssignmentRepositoryImpl struct {
+	store *Store
+}
+
+func NewPVZAssignmentRepository(store *Store) domain.PVZAssignmentRepository {
+	return pvzAssignmentRepositoryImpl{store: store}
+}
+
+func (p pvzAssignmentRepositoryImpl) Add(ctx context.Context, assignment domain.PVZAssignment) error {
+	defer p.store.lock(ctx)()
+
+	if _, exists := p.store.assignments[assignment.ID]; exists {
+		return errors.New("could not save pvz assignment")
+	}
+
+	p.store.assignments[assignment.ID] = assignment
+
+	return nil
+}
+
+func (p pvzAssignmentRepositoryImpl) FindByID(ctx context.Context, id domain.PVZAssignmentID) (domain.PVZAssignment, error) {
+	defer p.store.rlock(ctx)()
+
+	assignment, exists := p.store.assignments[id]
+	if !exists {
+		return domain.PVZAssignment{}, errors.New(domain.PVZAssignmentDoesNotExistError)
+	}
+
+	return assignment, nil
+}
+
+func (p pvzAssignmentRepositoryImpl) FindAllByPVZID(ctx context.Context, pvzId domain.PVZID) ([]domain.PVZAssignment, error) {
+	return p.findAll(ctx, func(assignment domain.PVZAssignment) bool {
+		return assignment.PVZID == pvzId
+	}), nil
+}
+
+func (p pvzAssignmentRepositoryImpl) FindAllByUserID(ctx context.Context, userId domain.UserID) ([]domain.PVZAssignment, error) {
+	return p.findAll(ctx, func(assignment domain.PVZAssignment) bool {
+		return assignment.UserID == userId
+	}), nil
+}
+
+func (p pvzAssignmentRepositoryImpl) Remove(ctx context.Context, id domain.PVZAssignmentID) error {
+	defer p.store.lock(ctx)()
+
+	if _, exists := p.store.assignments[id]; !exists {
+		return errors.New(domain.PVZAssignmentDoesNotExistError)
+	}
+
+	delete(p.store.assignments, id)
+
+	return nil
+}
+
+func (p pvzAssignmentRepositoryImpl) findAll(ctx context.Context, match func(domain.PVZAssignment) bool) []domain.PVZAssignment {
+	defer p.store.rlock(ctx)()
+
+	assignments := make([]domain.PVZAssignment, 0)
+	for _, assignment := range p.store.assignments {
+		if match(assignment) {
+			assignments = append(assignments, assignment)
+		}
+	}
+
+	slices.SortFunc(assignments, func(a, b domain.PVZAssignment) int {
+		if byTime := a.CreationTimeUTC.Compare(b.CreationTimeUTC); byTime != 0 {
+			return byTime
+		}
+
+		return bytes.Compare(a.ID[:], b.ID[:])
+	})
+
+	return assignments
+}
@@ -0,0 +1,93 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"sort"
+)
+
+type pvzReportRepositoryImpl struct {
+	store *Store
+}
+
+func NewPVZReportAggregateRepository(store *Store) domain.PVZReportAggregateRepository {
+	return pvzReportRepositoryImpl{store: store}
+}
+
+func (p pvzReportRepositoryImpl) FindAllByFilter(ctx context.Context, filter domain.SearchPVZReportAggregateFilter) ([]*domain.PVZReportAggregate, error) {
+	defer p.store.rlock(ctx)()
+
+	// sql version pages over pvz_record_number instead of offset
+	offset := int64((filter.Page - 1) * filter.Limit)
+
+	records := make([]pvzRecord, 0)
+	for _, record := range p.store.pvzs {
+		if record.recordNumber > offset {
+			records = append(records, record)
+		}
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].recordNumber < records[j].recordNumber
+	})
+
+	reports := make([]*domain.PVZReportAggregate, 0)
+	for _, record := range records {
+		pvz, joined := p.store.pvzWithCity(record)
+		if !joined {
+			continue
+		}
+
+		reports = append(reports, &domain.PVZReportAggregate{
+			PVZ:        &pvz,
+			Receptions: p.receptionAggregates(pvz.ID, filter),
+		})
+	}
+
+	return limit(reports, filter.Limit), nil
+}
+
+func (p pvzReportRepositoryImpl) receptionAggregates(pvzId domain.PVZID, filter domain.SearchPVZReportAggregateFilter) []domain.ReceptionAggregate {
+	receptions := make([]domain.ReceptionInfo, 0)
+	for _, reception := range p.store.receptions {
+		if reception.PVZID == pvzId && matchesReceptionTime(reception, filter) {
+			receptions = append(receptions, reception)
+		}
+	}
+
+	sort.Slice(receptions, func(i, j int) bool {
+		return receptions[i].CreationTimeUTC.Before(receptions[j].CreationTimeUTC)
+	})
+
+	aggregates := make([]domain.ReceptionAggregate, 0, len(receptions))
+	for _, reception := range receptions {
+		// sql version aggregates empty product list into null
+		products := p.store.receptionProducts(reception.ID)
+		if len(products) == 0 {
+			products = nil
+		}
+
+		aggregates = append(aggregates, domain.ReceptionAggregate{
+			Information: reception,
+			Products:    products,
+		})
+	}
+
+	return aggregates
+}
+
+func matchesReceptionTime(reception domain.ReceptionInfo, filter domain.SearchPVZReportAggregateFilter) bool {
+	creationTime := reception.CreationTimeUTC
+	start, end := filter.ReceptionStartTimeUTC, filter.ReceptionEndTimeUTC
+
+	switch {
+	case start != nil && end != nil:
+		return !creationTime.Before(*start) && !creationTime.After(*end)
+	case start != nil:
+		return !creationTime.Before(*start)
+	case end != nil:
+		return creationTime.Before(*end)
+	default:
+		return true
+	}
+}
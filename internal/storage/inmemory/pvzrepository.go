@@ -0,0 +1,60 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+)
+
+type pvzRepositoryImpl struct {
+	store *Store
+}
+
+func NewPVZRepository(store *Store) domain.PVZRepository {
+	return &pvzRepositoryImpl{store: store}
+}
+
+func (r *pvzRepositoryImpl) FindById(ctx context.Context, id domain.PVZID) (domain.PVZ, error) {
+	defer r.store.rlock(ctx)()
+
+	record, exists := r.store.pvzs[id]
+	if !exists {
+		return domain.PVZ{}, errors.New(domain.PVZDoesNotExistError)
+	}
+
+	pvz, joined := r.store.pvzWithCity(record)
+	if !joined {
+		return domain.PVZ{}, errors.New(domain.PVZDoesNotExistError)
+	}
+
+	return pvz, nil
+}
+
+func (r *pvzRepositoryImpl) Add(ctx context.Context, pvz domain.PVZ) error {
+	defer r.store.lock(ctx)()
+
+	if _, exists := r.store.pvzs[pvz.ID]; exists {
+		return errors.New("could not save PVZ")
+	}
+
+	r.store.lastPVZRecordNumber++
+	r.store.pvzs[pvz.ID] = pvzRecord{
+		PVZ:          pvz,
+		recordNumber: r.store.lastPVZRecordNumber,
+	}
+
+	return nil
+}
+
+// city name is taken from dictionary as pvzs are joined with cities in sql version
+func (s *Store) pvzWithCity(record pvzRecord) (domain.PVZ, bool) {
+	cityName, exists := s.cities[record.City.ID]
+	if !exists {
+		return domain.PVZ{}, false
+	}
+
+	pvz := record.PVZ
+	pvz.City.Name = cityName
+
+	return pvz, true
+}
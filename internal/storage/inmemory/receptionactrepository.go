@@ -0,0 +1,50 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+	"slices"
+)
+
+type receptionActRepositoryImpl struct {
+	store *Store
+}
+
+func NewReceptionActRepository(store *Store) domain.ReceptionActRepository {
+	return receptionActRepositoryImpl{store: store}
+}
+
+func (r receptionActRepositoryImpl) Add(ctx context.Context, act domain.ReceptionAct) error {
+	defer r.store.lock(ctx)()
+
+	if _, exists := r.store.acts[act.ReceptionID]; exists {
+		return errors.New("could not save reception act")
+	}
+
+	act.Document = slices.Clone(act.Document)
+	r.store.acts[act.ReceptionID] = act
+
+	return nil
+}
+
+func (r receptionActRepositoryImpl) FindByReceptionID(ctx context.Context, receptionId domain.ReceptionID) (domain.ReceptionAct, error) {
+	defer r.store.rlock(ctx)()
+
+	act, exists := r.store.acts[receptionId]
+	if !exists {
+		return domain.ReceptionAct{}, errors.New(domain.ReceptionActDoesNotExistError)
+	}
+
+	act.Document = slices.Clone(act.Document)
+
+	return act, nil
+}
+
+func (r receptionActRepositoryImpl) Remove(ctx context.Context, receptionId domain.ReceptionID) error {
+	defer r.store.lock(ctx)()
+
+	delete(r.store.acts, receptionId)
+
+	return nil
+}
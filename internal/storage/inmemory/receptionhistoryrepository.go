@@ -0,0 +1,46 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+	"slices"
+	"sort"
+)
+
+type receptionHistoryRepositoryImpl struct {
+	store *Store
+}
+
+func NewReceptionHistoryRepository(store *Store) domain.ReceptionHistoryRepository {
+	return receptionHistoryRepositoryImpl{store: store}
+}
+
+func (r receptionHistoryRepositoryImpl) Add(ctx context.Context, entry domain.ReceptionHistoryEntry) error {
+	defer r.store.lock(ctx)()
+
+	if slices.ContainsFunc(r.store.history, func(existing domain.ReceptionHistoryEntry) bool { return existing.ID == entry.ID }) {
+		return errors.New("could not save reception history entry")
+	}
+
+	r.store.history = append(r.store.history, entry)
+
+	return nil
+}
+
+func (r receptionHistoryRepositoryImpl) FindAllByReceptionID(ctx context.Context, receptionId domain.ReceptionID) ([]domain.ReceptionHistoryEntry, error) {
+	defer r.store.rlock(ctx)()
+
+	entries := make([]domain.ReceptionHistoryEntry, 0)
+	for _, entry := range r.store.history {
+		if entry.ReceptionID == receptionId {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].OccurredAtUTC.Before(entries[j].OccurredAtUTC)
+	})
+
+	return entries, nil
+}
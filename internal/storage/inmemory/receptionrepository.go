@@ -0,0 +1,134 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+	"sort"
+)
+
+type receptionInfoRepositoryImpl struct {
+	store *Store
+}
+
+func NewReceptionInfoRepository(store *Store) domain.ReceptionInfoRepository {
+	return receptionInfoRepositoryImpl{store: store}
+}
+
+func (r receptionInfoRepositoryImpl) Add(ctx context.Context, reception domain.ReceptionInfo) error {
+	defer r.store.lock(ctx)()
+
+	if _, exists := r.store.receptions[reception.ID]; exists {
+		return errors.New("could not save reception")
+	} else if r.store.hasAnotherOpenedReception(reception) {
+		return errors.New(domain.AnotherOpenedReceptionError)
+	}
+
+	r.store.receptions[reception.ID] = reception
+
+	return nil
+}
+
+func (r receptionInfoRepositoryImpl) FindAllByFilter(ctx context.Context, filter domain.SearchReceptionInfoFilter) ([]domain.ReceptionInfo, error) {
+	defer r.store.rlock(ctx)()
+
+	receptions := make([]domain.ReceptionInfo, 0)
+	for _, reception := range r.store.receptions {
+		if (filter.Status == 0 || reception.Status == filter.Status) && reception.PVZID == filter.PVZID {
+			receptions = append(receptions, reception)
+		}
+	}
+
+	sort.Slice(receptions, func(i, j int) bool {
+		if filter.DescendingDateOrdering {
+			return receptions[i].CreationTimeUTC.After(receptions[j].CreationTimeUTC)
+		}
+
+		return receptions[i].CreationTimeUTC.Before(receptions[j].CreationTimeUTC)
+	})
+
+	return limit(receptions, filter.Limit), nil
+}
+
+func (r receptionInfoRepositoryImpl) FindByID(ctx context.Context, id domain.ReceptionID) (domain.ReceptionInfo, error) {
+	defer r.store.rlock(ctx)()
+
+	reception, exists := r.store.receptions[id]
+	if !exists {
+		return domain.ReceptionInfo{}, errors.New(domain.ReceptionDoesNotExistsError)
+	}
+
+	return reception, nil
+}
+
+// transactions of store run one after another, so reception needs no lock of its own
+func (r receptionInfoRepositoryImpl) FindByIDForUpdate(ctx context.Context, id domain.ReceptionID) (domain.ReceptionInfo, error) {
+	return r.FindByID(ctx, id)
+}
+
+func (r receptionInfoRepositoryImpl) FindAllInProgress(ctx context.Context) ([]domain.ReceptionInfo, error) {
+	defer r.store.rlock(ctx)()
+
+	receptions := make([]domain.ReceptionInfo, 0)
+	for _, reception := range r.store.receptions {
+		if reception.Status == domain.InProggressProductAcceptanceStatus {
+			receptions = append(receptions, reception)
+		}
+	}
+
+	sort.Slice(receptions, func(i, j int) bool {
+		return receptions[i].CreationTimeUTC.Before(receptions[j].CreationTimeUTC)
+	})
+
+	return receptions, nil
+}
+
+func (r receptionInfoRepositoryImpl) Update(ctx context.Context, reception domain.ReceptionInfo) error {
+	defer r.store.lock(ctx)()
+
+	stored, exists := r.store.receptions[reception.ID]
+	if !exists {
+		return nil
+	}
+
+	if r.store.hasAnotherOpenedReception(reception) {
+		return errors.New(domain.AnotherOpenedReceptionError)
+	}
+
+	// products of reopened reception accept their barcodes again
+	if stored.IsCompleted() {
+		for _, product := range r.store.receptionProducts(reception.ID) {
+			if r.store.isAcceptedElsewhere(*product, reception) {
+				return errors.New(domain.DuplicateBarcodeError)
+			}
+		}
+	}
+
+	r.store.receptions[reception.ID] = reception
+
+	return nil
+}
+
+// the same as unique index on opened receptions of pvz
+func (s *Store) hasAnotherOpenedReception(reception domain.ReceptionInfo) bool {
+	if reception.Status != domain.InProggressProductAcceptanceStatus {
+		return false
+	}
+
+	for _, stored := range s.receptions {
+		if stored.ID != reception.ID && stored.PVZID == reception.PVZID && stored.Status == domain.InProggressProductAcceptanceStatus {
+			return true
+		}
+	}
+
+	return false
+}
+
+// the same as sql limit: negative value is not allowed and zero returns nothing
+func limit[T any](values []T, limit int) []T {
+	if limit < 0 {
+		return values[:0]
+	}
+
+	return values[:min(len(values), limit)]
+}
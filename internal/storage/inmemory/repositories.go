@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"avito/internal/storage"
+)
+
+func NewRepositories(store *Store) storage.Repositories {
+	return storage.Repositories{
+		UserRepository:                   NewUserRepository(store),
+		PVZRepository:                    NewPVZRepository(store),
+		PVZReportAggregateRepository:     NewPVZReportAggregateRepository(store),
+		ReceptionInfoRepository:          NewReceptionInfoRepository(store),
+		ProductRepository:                NewProductRepository(store),
+		ShipmentManifestRepository:       NewShipmentManifestRepository(store),
+		DiscrepancyReportRepository:      NewDiscrepancyReportRepository(store),
+		ReceptionActRepository:           NewReceptionActRepository(store),
+		ReceptionHistoryRepository:       NewReceptionHistoryRepository(store),
+		DuplicateScanRepository:          NewDuplicateScanRepository(store),
+		EventOutboxRepository:            NewEventOutboxRepository(store),
+		WebhookSubscriptionRepository:    NewWebhookSubscriptionRepository(store),
+		WebhookDeliveryAttemptRepository: NewWebhookDeliveryAttemptRepository(store),
+		AuditRepository:                  NewAuditRepository(store),
+		PVZAssignmentRepository:          NewPVZAssignmentRepository(store),
+		RoleRepository:                   NewRoleRepository(store),
+		InvitationRepository:             NewInvitationRepository(store),
+		UserTokenRepository:              NewUserTokenRepository(store),
+		APIKeyRepository:                 NewAPIKeyRepository(store),
+		SSOLoginRepository:               NewSSOLoginRepository(store),
+		UnitOfWork:                       NewUnitOfWork(store),
+	}
+}
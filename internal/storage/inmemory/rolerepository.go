@@ -0,0 +1,106 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+)
+
+type roleRepositoryImpl struct {
+	store *Store
+}
+
+func NewRoleRepository(store *Store) domain.RoleRepository {
+	return roleRepositoryImpl{store: store}
+}
+
+func (r roleRepositoryImpl) FindAll(ctx context.Context) ([]domain.UserRole, error) {
+	defer r.store.rlock(ctx)()
+
+	roles := make([]domain.UserRole, 0, len(r.store.userRoles))
+	for _, role := range r.store.userRoles {
+		roles = append(roles, cloneRole(role))
+	}
+
+	slices.SortFunc(roles, func(a, b domain.UserRole) int {
+		return int(a.ID) - int(b.ID)
+	})
+
+	return roles, nil
+}
+
+func (r roleRepositoryImpl) FindByID(ctx context.Context, id domain.UserRoleID) (domain.UserRole, error) {
+	defer r.store.rlock(ctx)()
+
+	role, exists := r.store.userRoles[id]
+	if !exists {
+		return domain.UserRole{}, errors.New(domain.RoleDoesNotExistError)
+	}
+
+	return cloneRole(role), nil
+}
+
+func (r roleRepositoryImpl) FindByName(ctx context.Context, name string) (domain.UserRole, error) {
+	defer r.store.rlock(ctx)()
+
+	if role, exists := r.findByName(name); exists {
+		return cloneRole(role), nil
+	}
+
+	return domain.UserRole{}, errors.New(domain.RoleDoesNotExistError)
+}
+
+// id is the next after the greatest one, like identity column of user_roles
+func (r roleRepositoryImpl) Add(ctx context.Context, role domain.UserRole) (domain.UserRole, error) {
+	defer r.store.lock(ctx)()
+
+	if _, taken := r.findByName(role.Name); taken {
+		return domain.UserRole{}, errors.New(domain.RoleNameIsTakenError)
+	}
+
+	var lastID domain.UserRoleID
+	for id := range r.store.userRoles {
+		lastID = max(lastID, id)
+	}
+
+	role.ID = lastID + 1
+	r.store.userRoles[role.ID] = cloneRole(role)
+
+	return cloneRole(role), nil
+}
+
+func (r roleRepositoryImpl) Update(ctx context.Context, role domain.UserRole) error {
+	defer r.store.lock(ctx)()
+
+	if _, exists := r.store.userRoles[role.ID]; !exists {
+		return errors.New(domain.RoleDoesNotExistError)
+	}
+
+	if stored, taken := r.findByName(role.Name); taken && stored.ID != role.ID {
+		return errors.New(domain.RoleNameIsTakenError)
+	}
+
+	r.store.userRoles[role.ID] = cloneRole(role)
+
+	return nil
+}
+
+// role names are unique regardless of case, the same as unique index of user_roles
+func (r roleRepositoryImpl) findByName(name string) (domain.UserRole, bool) {
+	for _, role := range r.store.userRoles {
+		if strings.EqualFold(role.Name, name) {
+			return role, true
+		}
+	}
+
+	return domain.UserRole{}, false
+}
+
+// permissions slice is copied so callers could not change stored role
+func cloneRole(role domain.UserRole) domain.UserRole {
+	role.Permissions = slices.Clone(role.Permissions)
+
+	return role
+}
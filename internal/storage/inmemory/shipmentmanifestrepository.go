@@ -0,0 +1,84 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+	"slices"
+)
+
+type shipmentManifestRepositoryImpl struct {
+	store *Store
+}
+
+func NewShipmentManifestRepository(store *Store) domain.ShipmentManifestRepository {
+	return shipmentManifestRepositoryImpl{store: store}
+}
+
+func (s shipmentManifestRepositoryImpl) Add(ctx context.Context, manifest domain.ShipmentManifest) error {
+	defer s.store.lock(ctx)()
+
+	if _, exists := s.store.manifests[manifest.ID]; exists {
+		return errors.New("could not save shipment manifest")
+	}
+
+	s.store.manifests[manifest.ID] = cloneShipmentManifest(manifest)
+
+	return nil
+}
+
+func (s shipmentManifestRepositoryImpl) FindByID(ctx context.Context, id domain.ShipmentManifestID) (domain.ShipmentManifest, error) {
+	defer s.store.rlock(ctx)()
+
+	manifest, exists := s.store.manifests[id]
+	if !exists {
+		return domain.ShipmentManifest{}, errors.New(domain.ShipmentManifestDoesNotExistError)
+	}
+
+	return cloneShipmentManifest(manifest), nil
+}
+
+func (s shipmentManifestRepositoryImpl) FindByReceptionID(ctx context.Context, receptionId domain.ReceptionID) (domain.ShipmentManifest, error) {
+	defer s.store.rlock(ctx)()
+
+	for _, manifest := range s.store.manifests {
+		if manifest.ReceptionID != nil && *manifest.ReceptionID == receptionId {
+			return cloneShipmentManifest(manifest), nil
+		}
+	}
+
+	return domain.ShipmentManifest{}, errors.New(domain.ShipmentManifestDoesNotExistError)
+}
+
+func (s shipmentManifestRepositoryImpl) Link(ctx context.Context, id domain.ShipmentManifestID, receptionId domain.ReceptionID) error {
+	defer s.store.lock(ctx)()
+
+	manifest, exists := s.store.manifests[id]
+	if !exists {
+		return errors.New(domain.ShipmentManifestDoesNotExistError)
+	} else if manifest.ReceptionID != nil && *manifest.ReceptionID != receptionId {
+		return errors.New(domain.ShipmentManifestIsAlreadyLinkedError)
+	}
+
+	for otherID, other := range s.store.manifests {
+		if otherID != id && other.ReceptionID != nil && *other.ReceptionID == receptionId {
+			other.ReceptionID = nil
+			s.store.manifests[otherID] = other
+		}
+	}
+
+	manifest.ReceptionID = &receptionId
+	s.store.manifests[id] = manifest
+
+	return nil
+}
+
+func cloneShipmentManifest(manifest domain.ShipmentManifest) domain.ShipmentManifest {
+	manifest.Items = slices.Clone(manifest.Items)
+	if manifest.ReceptionID != nil {
+		receptionID := *manifest.ReceptionID
+		manifest.ReceptionID = &receptionID
+	}
+
+	return manifest
+}
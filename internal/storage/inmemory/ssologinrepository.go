@@ -0,0 +1,53 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+	"time"
+)
+
+type ssoLoginRepositoryImpl struct {
+	store *Store
+}
+
+func NewSSOLoginRepository(store *Store) domain.SSOLoginRepository {
+	return ssoLoginRepositoryImpl{store: store}
+}
+
+func (r ssoLoginRepositoryImpl) Add(ctx context.Context, login domain.SSOLogin) error {
+	defer r.store.lock(ctx)()
+
+	if _, exists := r.store.ssoLogins[login.StateHash]; exists {
+		return errors.New("could not save sso login")
+	}
+
+	r.store.ssoLogins[login.StateHash] = login
+
+	return nil
+}
+
+func (r ssoLoginRepositoryImpl) Take(ctx context.Context, stateHash string) (domain.SSOLogin, error) {
+	defer r.store.lock(ctx)()
+
+	login, exists := r.store.ssoLogins[stateHash]
+	if !exists {
+		return domain.SSOLogin{}, errors.New(domain.SSOLoginDoesNotExistError)
+	}
+
+	delete(r.store.ssoLogins, stateHash)
+
+	return login, nil
+}
+
+func (r ssoLoginRepositoryImpl) DeleteExpired(ctx context.Context, moment time.Time) error {
+	defer r.store.lock(ctx)()
+
+	for stateHash, login := range r.store.ssoLogins {
+		if !moment.Before(login.ExpiresAtUTC) {
+			delete(r.store.ssoLogins, stateHash)
+		}
+	}
+
+	return nil
+}
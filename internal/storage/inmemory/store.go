@@ -0,0 +1,191 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"maps"
+	"slices"
+	"sync"
+)
+
+type (
+	// Store keeps state of all in-memory repositories, repositories created over the same store see each other changes
+	Store struct {
+		mu sync.RWMutex
+		// held by open transaction of unit of work, calls made outside of it wait for it to end, see lock
+		txMu sync.Mutex
+
+		cities         map[domain.CityID]string
+		userRoles      map[domain.UserRoleID]domain.UserRole
+		users          map[domain.UserID]domain.User
+		pvzs           map[domain.PVZID]pvzRecord
+		receptions     map[domain.ReceptionID]domain.ReceptionInfo
+		products       map[domain.ProductID]domain.Product
+		manifests      map[domain.ShipmentManifestID]domain.ShipmentManifest
+		discrepancies  map[domain.ReceptionID]domain.DiscrepancyReport
+		acts           map[domain.ReceptionID]domain.ReceptionAct
+		history        []domain.ReceptionHistoryEntry
+		duplicateScans []domain.DuplicateScan
+		outbox         []outboxRecord
+		webhooks       map[domain.WebhookSubscriptionID]domain.WebhookSubscription
+		deliveries     []domain.WebhookDeliveryAttempt
+		audit          []domain.AuditRecord
+		assignments    map[domain.PVZAssignmentID]domain.PVZAssignment
+		invitations    map[domain.InvitationID]domain.Invitation
+		userTokens     map[domain.UserTokenID]domain.UserToken
+		apiKeys        map[domain.APIKeyID]domain.APIKey
+		ssoLogins      map[string]domain.SSOLogin
+
+		lastPVZRecordNumber int64
+	}
+
+	pvzRecord struct {
+		domain.PVZ
+		recordNumber int64
+	}
+
+	outboxRecord struct {
+		domain.Event
+		published bool
+	}
+
+	// storeState is a copy of store data taken at the beginning of transaction
+	storeState struct {
+		userRoles           map[domain.UserRoleID]domain.UserRole
+		users               map[domain.UserID]domain.User
+		pvzs                map[domain.PVZID]pvzRecord
+		receptions          map[domain.ReceptionID]domain.ReceptionInfo
+		products            map[domain.ProductID]domain.Product
+		manifests           map[domain.ShipmentManifestID]domain.ShipmentManifest
+		discrepancies       map[domain.ReceptionID]domain.DiscrepancyReport
+		acts                map[domain.ReceptionID]domain.ReceptionAct
+		history             []domain.ReceptionHistoryEntry
+		duplicateScans      []domain.DuplicateScan
+		outbox              []outboxRecord
+		webhooks            map[domain.WebhookSubscriptionID]domain.WebhookSubscription
+		deliveries          []domain.WebhookDeliveryAttempt
+		audit               []domain.AuditRecord
+		assignments         map[domain.PVZAssignmentID]domain.PVZAssignment
+		invitations         map[domain.InvitationID]domain.Invitation
+		userTokens          map[domain.UserTokenID]domain.UserToken
+		apiKeys             map[domain.APIKeyID]domain.APIKey
+		ssoLogins           map[string]domain.SSOLogin
+		lastPVZRecordNumber int64
+	}
+)
+
+// NewStore creates store with the same dictionaries as init_db.sql
+func NewStore() *Store {
+	s := &Store{
+		cities: map[domain.CityID]string{
+			domain.KazanCityID:       "Казань",
+			domain.MoscowCityID:      "Москва",
+			domain.SaintPetersburgID: "Санкт-Петербург",
+		},
+		userRoles:     make(map[domain.UserRoleID]domain.UserRole),
+		users:         make(map[domain.UserID]domain.User),
+		pvzs:          make(map[domain.PVZID]pvzRecord),
+		receptions:    make(map[domain.ReceptionID]domain.ReceptionInfo),
+		products:      make(map[domain.ProductID]domain.Product),
+		manifests:     make(map[domain.ShipmentManifestID]domain.ShipmentManifest),
+		discrepancies: make(map[domain.ReceptionID]domain.DiscrepancyReport),
+		acts:          make(map[domain.ReceptionID]domain.ReceptionAct),
+		webhooks:      make(map[domain.WebhookSubscriptionID]domain.WebhookSubscription),
+		assignments:   make(map[domain.PVZAssignmentID]domain.PVZAssignment),
+		invitations:   make(map[domain.InvitationID]domain.Invitation),
+		userTokens:    make(map[domain.UserTokenID]domain.UserToken),
+		apiKeys:       make(map[domain.APIKeyID]domain.APIKey),
+		ssoLogins:     make(map[string]domain.SSOLogin),
+	}
+
+	for _, role := range domain.BuiltInRoles() {
+		s.userRoles[role.ID] = role
+	}
+
+	return s
+}
+
+// lock takes store for writing. Call made outside of transaction waits for open one to end, so it never sees
+// uncommitted changes and rollback of transaction never discards changes made alongside it
+func (s *Store) lock(ctx context.Context) (unlock func()) {
+	return s.acquire(ctx, s.mu.Lock, s.mu.Unlock)
+}
+
+// rlock takes store for reading the same way as lock
+func (s *Store) rlock(ctx context.Context) (unlock func()) {
+	return s.acquire(ctx, s.mu.RLock, s.mu.RUnlock)
+}
+
+func (s *Store) acquire(ctx context.Context, lock func(), unlock func()) func() {
+	if s.inTransaction(ctx) {
+		lock()
+		return unlock
+	}
+
+	s.txMu.Lock()
+	lock()
+	return func() {
+		unlock()
+		s.txMu.Unlock()
+	}
+}
+
+func (s *Store) inTransaction(ctx context.Context) bool {
+	store, _ := ctx.Value(transactionKey{}).(*Store)
+	return store == s
+}
+
+// store keeps values rather than pointers, so shallow copies are enough
+func (s *Store) snapshot() storeState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return storeState{
+		userRoles:           maps.Clone(s.userRoles),
+		users:               maps.Clone(s.users),
+		pvzs:                maps.Clone(s.pvzs),
+		receptions:          maps.Clone(s.receptions),
+		products:            maps.Clone(s.products),
+		manifests:           maps.Clone(s.manifests),
+		discrepancies:       maps.Clone(s.discrepancies),
+		acts:                maps.Clone(s.acts),
+		history:             slices.Clone(s.history),
+		duplicateScans:      slices.Clone(s.duplicateScans),
+		outbox:              slices.Clone(s.outbox),
+		webhooks:            maps.Clone(s.webhooks),
+		deliveries:          slices.Clone(s.deliveries),
+		audit:               slices.Clone(s.audit),
+		assignments:         maps.Clone(s.assignments),
+		invitations:         maps.Clone(s.invitations),
+		userTokens:          maps.Clone(s.userTokens),
+		apiKeys:             maps.Clone(s.apiKeys),
+		ssoLogins:           maps.Clone(s.ssoLogins),
+		lastPVZRecordNumber: s.lastPVZRecordNumber,
+	}
+}
+
+func (s *Store) restore(state storeState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.userRoles = state.userRoles
+	s.users = state.users
+	s.pvzs = state.pvzs
+	s.receptions = state.receptions
+	s.products = state.products
+	s.manifests = state.manifests
+	s.discrepancies = state.discrepancies
+	s.acts = state.acts
+	s.history = state.history
+	s.duplicateScans = state.duplicateScans
+	s.outbox = state.outbox
+	s.webhooks = state.webhooks
+	s.deliveries = state.deliveries
+	s.audit = state.audit
+	s.assignments = state.assignments
+	s.invitations = state.invitations
+	s.userTokens = state.userTokens
+	s.apiKeys = state.apiKeys
+	s.ssoLogins = state.ssoLogins
+	s.lastPVZRecordNumber = state.lastPVZRecordNumber
+}
@@ -0,0 +1,36 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+)
+
+type transactionKey struct{}
+
+// unitOfWorkImpl serializes transactions and puts store back to the state it had
+// at the beginning of transaction when fn fails. Calls made outside of transaction wait for it to end,
+// so nothing changes store alongside transaction and rollback loses only changes of the transaction
+type unitOfWorkImpl struct {
+	store *Store
+}
+
+func NewUnitOfWork(store *Store) domain.UnitOfWork {
+	return unitOfWorkImpl{store: store}
+}
+
+func (u unitOfWorkImpl) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if u.store.inTransaction(ctx) {
+		return fn(ctx)
+	}
+
+	u.store.txMu.Lock()
+	defer u.store.txMu.Unlock()
+
+	state := u.store.snapshot()
+	if err := fn(context.WithValue(ctx, transactionKey{}, u.store)); err != nil {
+		u.store.restore(state)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+)
+
+type userRepositoryImpl struct {
+	store *Store
+}
+
+func NewUserRepository(store *Store) domain.UserRepository {
+	return userRepositoryImpl{store: store}
+}
+
+func (r userRepositoryImpl) FindByID(ctx context.Context, id domain.UserID) (domain.User, error) {
+	defer r.store.rlock(ctx)()
+
+	user, exists := r.store.users[id]
+	if !exists {
+		return domain.User{}, errors.New(domain.UserDoesNotExistsError)
+	}
+
+	return r.withRole(user)
+}
+
+func (r userRepositoryImpl) FindByEmail(ctx context.Context, email domain.Email) (domain.User, error) {
+	defer r.store.rlock(ctx)()
+
+	for _, user := range r.store.users {
+		if user.Email == email {
+			return r.withRole(user)
+		}
+	}
+
+	return domain.User{}, errors.New(domain.UserDoesNotExistsError)
+}
+
+func (r userRepositoryImpl) FindBySSOSubject(ctx context.Context, subject string) (domain.User, error) {
+	defer r.store.rlock(ctx)()
+
+	for _, user := range r.store.users {
+		if user.SSOSubject != nil && *user.SSOSubject == subject {
+			return r.withRole(user)
+		}
+	}
+
+	return domain.User{}, errors.New(domain.UserDoesNotExistsError)
+}
+
+func (r userRepositoryImpl) Add(ctx context.Context, user domain.User) error {
+	defer r.store.lock(ctx)()
+
+	if _, exists := r.store.users[user.ID]; exists {
+		return errors.New("could not save user")
+	}
+
+	for _, stored := range r.store.users {
+		if stored.Email == user.Email || sameSSOSubject(stored, user) {
+			return errors.New("could not save user")
+		}
+	}
+
+	r.store.users[user.ID] = user
+
+	return nil
+}
+
+func (r userRepositoryImpl) Update(ctx context.Context, user domain.User) error {
+	defer r.store.lock(ctx)()
+
+	if _, exists := r.store.users[user.ID]; !exists {
+		return errors.New(domain.UserDoesNotExistsError)
+	}
+
+	for _, stored := range r.store.users {
+		if stored.ID != user.ID && (stored.Email == user.Email || sameSSOSubject(stored, user)) {
+			return errors.New("could not save user")
+		}
+	}
+
+	r.store.users[user.ID] = user
+
+	return nil
+}
+
+func (r userRepositoryImpl) UpdatePasswordHash(ctx context.Context, id domain.UserID, oldHash string, newHash string) error {
+	defer r.store.lock(ctx)()
+
+	user, exists := r.store.users[id]
+	if !exists || user.Password != oldHash {
+		return errors.New(domain.PasswordWasChangedError)
+	}
+
+	user.Password = newHash
+	r.store.users[id] = user
+
+	return nil
+}
+
+func (r userRepositoryImpl) UpdateTwoFactor(ctx context.Context, id domain.UserID, expected domain.TwoFactor, twoFactor domain.TwoFactor) error {
+	defer r.store.lock(ctx)()
+
+	user, exists := r.store.users[id]
+	if !exists || user.TwoFactor.Enabled != expected.Enabled || !sameSecret(user.TwoFactor.Secret, expected.Secret) {
+		return errors.New(domain.TwoFactorWasChangedError)
+	}
+
+	twoFactor.RecoveryCodeHashes = slices.Clone(twoFactor.RecoveryCodeHashes)
+	user.TwoFactor = twoFactor
+	r.store.users[id] = user
+
+	return nil
+}
+
+func (r userRepositoryImpl) SpendTOTPStep(ctx context.Context, id domain.UserID, step int64) error {
+	defer r.store.lock(ctx)()
+
+	user, exists := r.store.users[id]
+	if !exists || !user.TwoFactor.Enabled || step <= user.TwoFactor.LastUsedStep {
+		return errors.New(domain.BadTwoFactorCodeError)
+	}
+
+	user.TwoFactor.LastUsedStep = step
+	r.store.users[id] = user
+
+	return nil
+}
+
+func (r userRepositoryImpl) SpendRecoveryCode(ctx context.Context, id domain.UserID, codeHash string) error {
+	defer r.store.lock(ctx)()
+
+	user, exists := r.store.users[id]
+	if !exists || !user.TwoFactor.Enabled || !slices.Contains(user.TwoFactor.RecoveryCodeHashes, codeHash) {
+		return errors.New(domain.BadTwoFactorCodeError)
+	}
+
+	// new slice, so users returned before keep their codes
+	user.TwoFactor.RecoveryCodeHashes = slices.DeleteFunc(slices.Clone(user.TwoFactor.RecoveryCodeHashes), func(stored string) bool {
+		return stored == codeHash
+	})
+	r.store.users[id] = user
+
+	return nil
+}
+
+func (r userRepositoryImpl) FindAllByFilter(ctx context.Context, filter domain.SearchUserFilter) ([]domain.User, error) {
+	defer r.store.rlock(ctx)()
+
+	users := make([]domain.User, 0)
+	for _, user := range r.store.users {
+		if !matchesUserFilter(user, filter) {
+			continue
+		}
+
+		withRole, err := r.withRole(user)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, withRole)
+	}
+
+	// ids are uuid v7, so users are listed in order of registration as in sql version
+	slices.SortFunc(users, func(a, b domain.User) int {
+		return bytes.Compare(a.ID[:], b.ID[:])
+	})
+
+	offset := min(len(users), max(0, (filter.Page-1)*filter.Limit))
+
+	return limit(users[offset:], filter.Limit), nil
+}
+
+// subject is unique as in sql version, users without subject never clash
+func sameSSOSubject(a, b domain.User) bool {
+	return a.SSOSubject != nil && b.SSOSubject != nil && *a.SSOSubject == *b.SSOSubject
+}
+
+// secrets are compared as totp_secret is not distinct from $3 in sql version
+func sameSecret(a, b *string) bool {
+	return a == b || (a != nil && b != nil && *a == *b)
+}
+
+func matchesUserFilter(user domain.User, filter domain.SearchUserFilter) bool {
+	switch {
+	case filter.Email != "" && !strings.Contains(strings.ToLower(string(user.Email)), strings.ToLower(filter.Email)):
+		return false
+	case filter.RoleID != nil && *filter.RoleID != user.UserRole.ID:
+		return false
+	case filter.Deactivated != nil && *filter.Deactivated != user.Deactivated:
+		return false
+	default:
+		return true
+	}
+}
+
+// role is taken from dictionary as users are joined with user_roles in sql version
+func (r userRepositoryImpl) withRole(user domain.User) (domain.User, error) {
+	role, exists := r.store.userRoles[user.UserRole.ID]
+	if !exists {
+		return domain.User{}, errors.New(domain.UserDoesNotExistsError)
+	}
+
+	user.UserRole = cloneRole(role)
+	user.TwoFactor.RecoveryCodeHashes = slices.Clone(user.TwoFactor.RecoveryCodeHashes)
+
+	return user, nil
+}
@@ -0,0 +1,61 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+)
+
+type userTokenRepositoryImpl struct {
+	store *Store
+}
+
+func NewUserTokenRepository(store *Store) domain.UserTokenRepository {
+	return userTokenRepositoryImpl{store: store}
+}
+
+func (r userTokenRepositoryImpl) Add(ctx context.Context, token domain.UserToken) error {
+	defer r.store.lock(ctx)()
+
+	if _, exists := r.store.users[token.UserID]; !exists {
+		return errors.New("could not save token")
+	}
+
+	for _, stored := range r.store.userTokens {
+		if stored.ID == token.ID || stored.TokenHash == token.TokenHash {
+			return errors.New("could not save token")
+		}
+	}
+
+	r.store.userTokens[token.ID] = token
+
+	return nil
+}
+
+func (r userTokenRepositoryImpl) FindByTokenHash(ctx context.Context, purpose domain.UserTokenPurpose, tokenHash string) (domain.UserToken, error) {
+	defer r.store.rlock(ctx)()
+
+	for _, token := range r.store.userTokens {
+		if token.TokenHash == tokenHash && token.Purpose == purpose {
+			return token, nil
+		}
+	}
+
+	return domain.UserToken{}, errors.New(domain.UserTokenDoesNotExistError)
+}
+
+func (r userTokenRepositoryImpl) Use(ctx context.Context, token domain.UserToken) error {
+	defer r.store.lock(ctx)()
+
+	stored, exists := r.store.userTokens[token.ID]
+	if !exists {
+		return errors.New(domain.UserTokenDoesNotExistError)
+	} else if stored.UsedAtUTC != nil {
+		return errors.New(domain.UserTokenIsAlreadyUsedError)
+	}
+
+	stored.UsedAtUTC = token.UsedAtUTC
+	r.store.userTokens[token.ID] = stored
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+)
+
+type webhookDeliveryAttemptRepositoryImpl struct {
+	store *Store
+}
+
+func NewWebhookDeliveryAttemptRepository(store *Store) domain.WebhookDeliveryAttemptRepository {
+	return webhookDeliveryAttemptRepositoryImpl{store: store}
+}
+
+func (w webhookDeliveryAttemptRepositoryImpl) Add(ctx context.Context, attempt domain.WebhookDeliveryAttempt) error {
+	defer w.store.lock(ctx)()
+
+	if _, exists := w.store.webhooks[attempt.SubscriptionID]; !exists {
+		return errors.New("could not save webhook delivery attempt")
+	}
+
+	w.store.deliveries = append(w.store.deliveries, attempt)
+
+	return nil
+}
+
+func (w webhookDeliveryAttemptRepositoryImpl) FindAllBySubscriptionID(ctx context.Context, subscriptionID domain.WebhookSubscriptionID, count int) ([]domain.WebhookDeliveryAttempt, error) {
+	defer w.store.rlock(ctx)()
+
+	attempts := make([]domain.WebhookDeliveryAttempt, 0)
+	for _, attempt := range w.store.deliveries {
+		if attempt.SubscriptionID == subscriptionID {
+			attempts = append(attempts, attempt)
+		}
+	}
+
+	slices.SortFunc(attempts, func(a, b domain.WebhookDeliveryAttempt) int {
+		if byTime := b.AttemptTimeUTC.Compare(a.AttemptTimeUTC); byTime != 0 {
+			return byTime
+		}
+
+		return bytes.Compare(b.ID[:], a.ID[:])
+	})
+
+	return limit(attempts, count), nil
+}
@@ -0,0 +1,101 @@
+package inmemory
+
+import (
+	"avito/internal/domain"
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+)
+
+type webhookSubscriptionRepositoryImpl struct {
+	store *Store
+}
+
+func NewWebhookSubscriptionRepository(store *Store) domain.WebhookSubscriptionRepository {
+	return webhookSubscriptionRepositoryImpl{store: store}
+}
+
+func (w webhookSubscriptionRepositoryImpl) Add(ctx context.Context, subscription domain.WebhookSubscription) error {
+	defer w.store.lock(ctx)()
+
+	if _, exists := w.store.webhooks[subscription.ID]; exists {
+		return errors.New("could not save webhook subscription")
+	}
+
+	w.store.webhooks[subscription.ID] = cloneWebhookSubscription(subscription)
+
+	return nil
+}
+
+func (w webhookSubscriptionRepositoryImpl) FindByID(ctx context.Context, id domain.WebhookSubscriptionID) (domain.WebhookSubscription, error) {
+	defer w.store.rlock(ctx)()
+
+	subscription, exists := w.store.webhooks[id]
+	if !exists {
+		return domain.WebhookSubscription{}, errors.New(domain.WebhookSubscriptionDoesNotExistError)
+	}
+
+	return cloneWebhookSubscription(subscription), nil
+}
+
+func (w webhookSubscriptionRepositoryImpl) FindAll(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	return w.findAll(ctx, func(domain.WebhookSubscription) bool { return true }), nil
+}
+
+func (w webhookSubscriptionRepositoryImpl) FindAllByEventType(ctx context.Context, eventType domain.EventType) ([]domain.WebhookSubscription, error) {
+	return w.findAll(ctx, func(subscription domain.WebhookSubscription) bool {
+		return slices.Contains(subscription.EventTypes, eventType)
+	}), nil
+}
+
+func (w webhookSubscriptionRepositoryImpl) Remove(ctx context.Context, id domain.WebhookSubscriptionID) error {
+	defer w.store.lock(ctx)()
+
+	if _, exists := w.store.webhooks[id]; !exists {
+		return errors.New(domain.WebhookSubscriptionDoesNotExistError)
+	}
+
+	delete(w.store.webhooks, id)
+	// attempts are removed together with subscription as postgres does with cascade
+	w.store.deliveries = slices.DeleteFunc(w.store.deliveries, func(attempt domain.WebhookDeliveryAttempt) bool {
+		return attempt.SubscriptionID == id
+	})
+
+	return nil
+}
+
+func (w webhookSubscriptionRepositoryImpl) findAll(ctx context.Context, match func(domain.WebhookSubscription) bool) []domain.WebhookSubscription {
+	defer w.store.rlock(ctx)()
+
+	subscriptions := make([]domain.WebhookSubscription, 0)
+	for _, subscription := range w.store.webhooks {
+		if match(subscription) {
+			subscriptions = append(subscriptions, cloneWebhookSubscription(subscription))
+		}
+	}
+
+	slices.SortFunc(subscriptions, func(a, b domain.WebhookSubscription) int {
+		if byTime := a.CreationTimeUTC.Compare(b.CreationTimeUTC); byTime != 0 {
+			return byTime
+		}
+
+		return bytes.Compare(a.ID[:], b.ID[:])
+	})
+
+	return subscriptions
+}
+
+func cloneWebhookSubscription(subscription domain.WebhookSubscription) domain.WebhookSubscription {
+	subscription.EventTypes = slices.Clone(subscription.EventTypes)
+	if subscription.PVZID != nil {
+		pvzID := *subscription.PVZID
+		subscription.PVZID = &pvzID
+	}
+	if subscription.CityID != nil {
+		cityID := *subscription.CityID
+		subscription.CityID = &cityID
+	}
+
+	return subscription
+}
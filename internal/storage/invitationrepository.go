@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+)
+
+type invitationRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewInvitationRepository(client postgresql.Client) domain.InvitationRepository {
+	return invitationRepositoryImpl{client: client}
+}
+
+func (r invitationRepositoryImpl) Add(ctx context.Context, invitation domain.Invitation) error {
+	const query string = `
+	insert into invitations(id, token_hash, role_id, email, invited_by, creation_time_utc, expires_at_utc, accepted_at_utc, accepted_by)
+	values($1, $2, $3, $4, $5, $6, $7, $8, $9);
+	`
+
+	_, err := r.client.Exec(ctx, query,
+		invitation.ID,
+		invitation.TokenHash,
+		invitation.RoleID,
+		invitation.Email,
+		invitation.InvitedBy,
+		invitation.CreationTimeUTC,
+		invitation.ExpiresAtUTC,
+		invitation.AcceptedAtUTC,
+		invitation.AcceptedBy,
+	)
+
+	return err
+}
+
+func (r invitationRepositoryImpl) FindByTokenHash(ctx context.Context, tokenHash string) (domain.Invitation, error) {
+	const query string = `
+	select
+			  id
+			, token_hash
+			, role_id
+			, email
+			, invited_by
+			, creation_time_utc
+			, expires_at_utc
+			, accepted_at_utc
+			, accepted_by
+	  from invitations
+	 where token_hash = $1;
+	`
+
+	var invitation domain.Invitation
+	err := r.client.QueryRow(ctx, query, tokenHash).Scan(
+		&invitation.ID,
+		&invitation.TokenHash,
+		&invitation.RoleID,
+		&invitation.Email,
+		&invitation.InvitedBy,
+		&invitation.CreationTimeUTC,
+		&invitation.ExpiresAtUTC,
+		&invitation.AcceptedAtUTC,
+		&invitation.AcceptedBy,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Invitation{}, errors.New(domain.InvitationDoesNotExistError)
+	}
+
+	return invitation, err
+}
+
+// acceptance is conditional, so of two registrations racing for the same token only one succeeds
+func (r invitationRepositoryImpl) Accept(ctx context.Context, invitation domain.Invitation) error {
+	const query string = `
+	update invitations
+	   set accepted_at_utc = $2
+	     , accepted_by = $3
+	 where id = $1
+	   and accepted_at_utc is null;
+	`
+
+	tag, err := r.client.Exec(ctx, query, invitation.ID, invitation.AcceptedAtUTC, invitation.AcceptedBy)
+	if err != nil {
+		return err
+	} else if tag.RowsAffected() == 0 {
+		return errors.New(domain.InvitationIsAlreadyAcceptedError)
+	}
+
+	return nil
+}
@@ -4,8 +4,14 @@ import (
 	"avito/internal/domain"
 	postgresql "avito/pkg/database"
 	"context"
+	"errors"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 )
 
+const acceptedBarcodeIndex string = "products_accepted_barcode_index"
+
 type productRepositoryImpl struct {
 	client postgresql.Client
 }
@@ -15,11 +21,24 @@ func NewProductRepository(client postgresql.Client) domain.ProductRepository {
 }
 
 func (p productRepositoryImpl) Add(ctx context.Context, product domain.Product) error {
-	const query string = "insert into products(id, reception_id, creation_time_utc, category) values($1, $2, $3, $4);"
+	const query string = `
+		insert into products(id, reception_id, creation_time_utc, category, barcode, barcode_format, accepted_barcode)
+		values($1, $2, $3, $4, $5, $6, (select $5::varchar from receptions r where r.id = $2 and r.status = $7));
+	`
 
-	_, err := p.client.Exec(ctx, query, product.ID, product.ReceptionID, product.CreationTimeUTC, product.Category)
+	var (
+		barcode       *string
+		barcodeFormat *domain.BarcodeFormat
+	)
+	if product.Barcode != nil {
+		barcode = &product.Barcode.Value
+		barcodeFormat = &product.Barcode.Format
+	}
 
-	return err
+	_, err := p.client.Exec(ctx, query, product.ID, product.ReceptionID, product.CreationTimeUTC, product.Category, barcode, barcodeFormat,
+		domain.InProggressProductAcceptanceStatus)
+
+	return barcodeError(err)
 }
 
 func (p productRepositoryImpl) FindAllByReceptionID(ctx context.Context, receptionId domain.ReceptionID) ([]*domain.Product, error) {
@@ -29,8 +48,12 @@ func (p productRepositoryImpl) FindAllByReceptionID(ctx context.Context, recepti
 				, reception_id  
 				, creation_time_utc
 				, category
+				, barcode
+				, barcode_format
 		  from products
-		 where reception_id = $1;
+		 where reception_id = $1
+		   and deleted_at_utc is null
+		 order by creation_time_utc, id;
 	`
 
 	rows, err := p.client.Query(ctx, query, receptionId)
@@ -39,24 +62,140 @@ func (p productRepositoryImpl) FindAllByReceptionID(ctx context.Context, recepti
 		return nil, err
 	}
 
+	return scanProducts(rows)
+}
+
+func (p productRepositoryImpl) FindAllByFilter(ctx context.Context, filter domain.SearchProductFilter) ([]*domain.Product, error) {
+	const query string = `
+		select
+				  p.id
+				, p.reception_id
+				, p.creation_time_utc
+				, p.category
+				, p.barcode
+				, p.barcode_format
+		  from products p
+		  join receptions r on r.id = p.reception_id
+		 where p.barcode = $1
+		   and p.deleted_at_utc is null
+		   and ($2::smallint = 0 or r.status = $2)
+		 order by p.creation_time_utc;
+	`
+
+	rows, err := p.client.Query(ctx, query, filter.Barcode, filter.ReceptionStatus)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scanProducts(rows)
+}
+
+func (p productRepositoryImpl) Remove(ctx context.Context, product domain.Product) error {
+	const query string = "update products set deleted_at_utc = $2, deleted_by = $3, accepted_barcode = null where id = $1;"
+
+	_, err := p.client.Exec(ctx, query, product.ID, product.DeletedAtUTC, product.DeletedBy)
+
+	return err
+}
+
+func (p productRepositoryImpl) FindLastRemovedByReceptionID(ctx context.Context, receptionId domain.ReceptionID) (domain.Product, error) {
+	const query string = `
+		select
+				  id
+				, reception_id
+				, creation_time_utc
+				, category
+				, barcode
+				, barcode_format
+				, deleted_at_utc
+				, deleted_by
+		  from products
+		 where reception_id = $1
+		   and deleted_at_utc is not null
+		 order by deleted_at_utc desc
+		 limit 1;
+	`
+
+	var (
+		product       domain.Product
+		barcode       *string
+		barcodeFormat *domain.BarcodeFormat
+	)
+	err := p.client.QueryRow(ctx, query, receptionId).Scan(
+		&product.ID,
+		&product.ReceptionID,
+		&product.CreationTimeUTC,
+		&product.Category,
+		&barcode,
+		&barcodeFormat,
+		&product.DeletedAtUTC,
+		&product.DeletedBy,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Product{}, errors.New(domain.RemovedProductDoesNotExistError)
+	} else if err != nil {
+		return domain.Product{}, err
+	}
+
+	product.Barcode = scanBarcode(barcode, barcodeFormat)
+
+	return product, nil
+}
+
+func (p productRepositoryImpl) Restore(ctx context.Context, product domain.Product) error {
+	const query string = `
+		update products p
+		   set deleted_at_utc = null
+		     , deleted_by = null
+		     , accepted_barcode = (select p.barcode from receptions r where r.id = p.reception_id and r.status = $2)
+		 where p.id = $1;
+	`
+
+	_, err := p.client.Exec(ctx, query, product.ID, domain.InProggressProductAcceptanceStatus)
+
+	return barcodeError(err)
+}
+
+// barcodeError tells that the same parcel is already accepted in opened reception when accepted barcode index is violated
+func barcodeError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == acceptedBarcodeIndex {
+		return errors.New(domain.DuplicateBarcodeError)
+	}
+
+	return err
+}
+
+func scanProducts(rows pgx.Rows) ([]*domain.Product, error) {
+	defer rows.Close()
+
 	products := make([]*domain.Product, 0)
 	for rows.Next() {
-		var product domain.Product
-		err := rows.Scan(&product.ID, &product.ReceptionID, &product.CreationTimeUTC, &product.Category)
+		var (
+			product       domain.Product
+			barcode       *string
+			barcodeFormat *domain.BarcodeFormat
+		)
+		err := rows.Scan(&product.ID, &product.ReceptionID, &product.CreationTimeUTC, &product.Category, &barcode, &barcodeFormat)
 		if err != nil {
 			return nil, err
 		}
 
+		product.Barcode = scanBarcode(barcode, barcodeFormat)
 		products = append(products, &product)
 	}
 
-	return products, nil
+	return products, rows.Err()
 }
 
-func (p productRepositoryImpl) Remove(ctx context.Context, product domain.Product) error {
-	const query string = "delete from products where id = $1;"
-
-	_, err := p.client.Exec(ctx, query, product.ID)
+func scanBarcode(barcode *string, barcodeFormat *domain.BarcodeFormat) *domain.Barcode {
+	if barcode == nil || barcodeFormat == nil {
+		return nil
+	}
 
-	return err
+	return &domain.Barcode{
+		Value:  *barcode,
+		Format: *barcodeFormat,
+	}
 }
@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+)
+
+type pvzAssignmentRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewPVZAssignmentRepository(client postgresql.Client) domain.PVZAssignmentRepository {
+	return pvzAssignmentRepositoryImpl{client: client}
+}
+
+const selectPVZAssignmentsQuery string = `
+	select
+			  id
+			, user_id
+			, pvz_id
+			, valid_from_utc
+			, valid_to_utc
+			, assigned_by
+			, creation_time_utc
+	  from pvz_assignments
+`
+
+func (p pvzAssignmentRepositoryImpl) Add(ctx context.Context, assignment domain.PVZAssignment) error {
+	const query string = `
+	insert into pvz_assignments(id, user_id, pvz_id, valid_from_utc, valid_to_utc, assigned_by, creation_time_utc)
+	values($1, $2, $3, $4, $5, $6, $7);
+	`
+
+	_, err := p.client.Exec(ctx, query,
+		assignment.ID,
+		assignment.UserID,
+		assignment.PVZID,
+		assignment.ValidFromUTC,
+		assignment.ValidToUTC,
+		assignment.AssignedBy,
+		assignment.CreationTimeUTC,
+	)
+
+	return err
+}
+
+func (p pvzAssignmentRepositoryImpl) FindByID(ctx context.Context, id domain.PVZAssignmentID) (domain.PVZAssignment, error) {
+	rows, err := p.client.Query(ctx, selectPVZAssignmentsQuery+" where id = $1;", id)
+	if err != nil {
+		return domain.PVZAssignment{}, err
+	}
+
+	assignments, err := scanPVZAssignments(rows)
+	if err != nil {
+		return domain.PVZAssignment{}, err
+	} else if len(assignments) == 0 {
+		return domain.PVZAssignment{}, errors.New(domain.PVZAssignmentDoesNotExistError)
+	}
+
+	return assignments[0], nil
+}
+
+func (p pvzAssignmentRepositoryImpl) FindAllByPVZID(ctx context.Context, pvzId domain.PVZID) ([]domain.PVZAssignment, error) {
+	rows, err := p.client.Query(ctx, selectPVZAssignmentsQuery+" where pvz_id = $1 order by creation_time_utc, id;", pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanPVZAssignments(rows)
+}
+
+func (p pvzAssignmentRepositoryImpl) FindAllByUserID(ctx context.Context, userId domain.UserID) ([]domain.PVZAssignment, error) {
+	rows, err := p.client.Query(ctx, selectPVZAssignmentsQuery+" where user_id = $1 order by creation_time_utc, id;", userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanPVZAssignments(rows)
+}
+
+func (p pvzAssignmentRepositoryImpl) Remove(ctx context.Context, id domain.PVZAssignmentID) error {
+	const query string = "delete from pvz_assignments where id = $1;"
+
+	tag, err := p.client.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	} else if tag.RowsAffected() == 0 {
+		return errors.New(domain.PVZAssignmentDoesNotExistError)
+	}
+
+	return nil
+}
+
+func scanPVZAssignments(rows pgx.Rows) ([]domain.PVZAssignment, error) {
+	defer rows.Close()
+
+	assignments := make([]domain.PVZAssignment, 0)
+	for rows.Next() {
+		var assignment domain.PVZAssignment
+		err := rows.Scan(
+			&assignment.ID,
+			&assignment.UserID,
+			&assignment.PVZID,
+			&assignment.ValidFromUTC,
+			&assignment.ValidToUTC,
+			&assignment.AssignedBy,
+			&assignment.CreationTimeUTC,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments, rows.Err()
+}
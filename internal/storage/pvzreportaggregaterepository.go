@@ -33,7 +33,7 @@ func (p pvzReportRepositoryImpl) FindAllByFilter(ctx context.Context, filter dom
                     		) as info
                     		, r.products
                 	    from receptions_with_products_view r
-                   		join pvzs pr on pr.id = r.pvz_id
+                   		join receptions ri on ri.id = r.id
                   	   where r.pvz_id = p.id %s
             ) r
 		        ),
@@ -42,6 +42,7 @@ func (p pvzReportRepositoryImpl) FindAllByFilter(ctx context.Context, filter dom
 		  from pvzs p
 		  join cities c on p.city_id = c.id
 		 where p.pvz_record_number > $1
+		 order by p.pvz_record_number
 		 limit $2;
 		`
 
@@ -49,15 +50,16 @@ func (p pvzReportRepositoryImpl) FindAllByFilter(ctx context.Context, filter dom
 	offset := (filter.Page - 1) * filter.Limit
 	arguments = append(arguments, offset, filter.Limit)
 
+	// view keeps creation time as formatted text, so filtering goes over typed column of receptions
 	var receptionFilterQuery string = ""
 	if filter.ReceptionEndTimeUTC != nil && filter.ReceptionStartTimeUTC != nil {
-		receptionFilterQuery = " and (r.creation_time_utc between $3 and $4) "
+		receptionFilterQuery = " and (ri.creation_time_utc between $3 and $4) "
 		arguments = append(arguments, *filter.ReceptionStartTimeUTC, *filter.ReceptionEndTimeUTC)
 	} else if filter.ReceptionStartTimeUTC != nil {
-		receptionFilterQuery = " and (r.creation_time_utc >= $3)"
+		receptionFilterQuery = " and (ri.creation_time_utc >= $3)"
 		arguments = append(arguments, *filter.ReceptionStartTimeUTC)
 	} else if filter.ReceptionEndTimeUTC != nil {
-		receptionFilterQuery = " and (r.creation_time_utc < $3)"
+		receptionFilterQuery = " and (ri.creation_time_utc < $3)"
 		arguments = append(arguments, *filter.ReceptionEndTimeUTC)
 	}
 
@@ -67,6 +69,7 @@ func (p pvzReportRepositoryImpl) FindAllByFilter(ctx context.Context, filter dom
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	reports := make([]*domain.PVZReportAggregate, 0)
 	for rows.Next() {
@@ -91,7 +94,7 @@ func (p pvzReportRepositoryImpl) FindAllByFilter(ctx context.Context, filter dom
 		reports = append(reports, &report)
 	}
 
-	return reports, nil
+	return reports, rows.Err()
 }
 
 func NewPVZReportAggregateRepository(client postgresql.Client) domain.PVZReportAggregateRepository {
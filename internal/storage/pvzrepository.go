@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 )
 
 type pvzRepositoryImpl struct {
@@ -36,7 +37,7 @@ func (r *pvzRepositoryImpl) FindById(ctx context.Context, id domain.PVZID) (doma
 	err := row.Scan(&pvz.ID, &pvz.CreationTimeUTC, &pvz.City.ID, &pvz.City.Name)
 
 	if err != nil {
-		if err.Error() == "no rows in result set" {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.PVZ{}, errors.New(domain.PVZDoesNotExistError)
 		} else {
 			return domain.PVZ{}, err
@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+)
+
+type receptionActRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewReceptionActRepository(client postgresql.Client) domain.ReceptionActRepository {
+	return receptionActRepositoryImpl{client: client}
+}
+
+func (r receptionActRepositoryImpl) Add(ctx context.Context, act domain.ReceptionAct) error {
+	const query string = "insert into reception_acts(reception_id, creation_time_utc, document) values($1, $2, $3);"
+
+	_, err := r.client.Exec(ctx, query, act.ReceptionID, act.CreationTimeUTC, act.Document)
+
+	return err
+}
+
+func (r receptionActRepositoryImpl) FindByReceptionID(ctx context.Context, receptionId domain.ReceptionID) (domain.ReceptionAct, error) {
+	const query string = `
+	select
+			  reception_id
+			, creation_time_utc
+			, document
+	  from reception_acts
+	 where reception_id = $1;
+	`
+
+	var act domain.ReceptionAct
+	err := r.client.QueryRow(ctx, query, receptionId).Scan(&act.ReceptionID, &act.CreationTimeUTC, &act.Document)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ReceptionAct{}, errors.New(domain.ReceptionActDoesNotExistError)
+		}
+
+		return domain.ReceptionAct{}, err
+	}
+
+	return act, nil
+}
+
+func (r receptionActRepositoryImpl) Remove(ctx context.Context, receptionId domain.ReceptionID) error {
+	const query string = "delete from reception_acts where reception_id = $1;"
+
+	_, err := r.client.Exec(ctx, query, receptionId)
+
+	return err
+}
@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+)
+
+type receptionHistoryRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewReceptionHistoryRepository(client postgresql.Client) domain.ReceptionHistoryRepository {
+	return receptionHistoryRepositoryImpl{client: client}
+}
+
+func (r receptionHistoryRepositoryImpl) Add(ctx context.Context, entry domain.ReceptionHistoryEntry) error {
+	const query string = `
+	insert into reception_history(id, reception_id, action, actor_id, reason, occurred_at_utc)
+	values($1, $2, $3, $4, $5, $6);
+	`
+
+	_, err := r.client.Exec(ctx, query,
+		entry.ID,
+		entry.ReceptionID,
+		entry.Action,
+		entry.ActorID,
+		entry.Reason,
+		entry.OccurredAtUTC,
+	)
+
+	return err
+}
+
+func (r receptionHistoryRepositoryImpl) FindAllByReceptionID(ctx context.Context, receptionId domain.ReceptionID) ([]domain.ReceptionHistoryEntry, error) {
+	const query string = `
+	select
+			  id
+			, reception_id
+			, action
+			, actor_id
+			, reason
+			, occurred_at_utc
+	  from reception_history
+	 where reception_id = $1
+	 order by occurred_at_utc, id;
+	`
+
+	rows, err := r.client.Query(ctx, query, receptionId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]domain.ReceptionHistoryEntry, 0)
+	for rows.Next() {
+		var entry domain.ReceptionHistoryEntry
+		err := rows.Scan(
+			&entry.ID,
+			&entry.ReceptionID,
+			&entry.Action,
+			&entry.ActorID,
+			&entry.Reason,
+			&entry.OccurredAtUTC,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
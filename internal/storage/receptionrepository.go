@@ -4,9 +4,15 @@ import (
 	"avito/internal/domain"
 	postgresql "avito/pkg/database"
 	"context"
+	"errors"
 	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 )
 
+const openedReceptionIndex string = "receptions_opened_pvz_index"
+
 type receptionInfoRepositoryImpl struct {
 	client postgresql.Client
 }
@@ -22,7 +28,7 @@ func (r receptionInfoRepositoryImpl) Add(ctx context.Context, reception domain.R
 
 	_, err = r.client.Exec(ctx, query, reception.ID, reception.PVZID, reception.CreationTimeUTC, reception.Status)
 
-	return
+	return openedReceptionError(err)
 }
 
 func (r receptionInfoRepositoryImpl) FindAllByFilter(ctx context.Context, filter domain.SearchReceptionInfoFilter) ([]domain.ReceptionInfo, error) {
@@ -33,7 +39,7 @@ func (r receptionInfoRepositoryImpl) FindAllByFilter(ctx context.Context, filter
 		   , creation_time_utc
 		   , status
 	  from receptions
-	 where status = $1 and pvz_id = $2
+	 where ($1::smallint = 0 or status = $1) and pvz_id = $2
      order by creation_time_utc %s
 	 limit $3;
 	`
@@ -48,6 +54,7 @@ func (r receptionInfoRepositoryImpl) FindAllByFilter(ctx context.Context, filter
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	receptions := make([]domain.ReceptionInfo, 0)
 
@@ -62,18 +69,119 @@ func (r receptionInfoRepositoryImpl) FindAllByFilter(ctx context.Context, filter
 		}
 	}
 
-	return receptions, nil
+	return receptions, rows.Err()
 }
 
-func (r receptionInfoRepositoryImpl) Update(ctx context.Context, reception domain.ReceptionInfo) error {
+func (r receptionInfoRepositoryImpl) FindByID(ctx context.Context, id domain.ReceptionID) (domain.ReceptionInfo, error) {
 	const query string = `
-	update receptions
-	   set 
-	   	    pvz_id = $2
-	   	  , creation_time_utc = $3
-		  , status = $4
+	select 
+			 id
+		   , pvz_id
+		   , creation_time_utc
+		   , status
+	  from receptions
+	 where id = $1;
+	`
+
+	var reception domain.ReceptionInfo
+	err := r.client.QueryRow(ctx, query, id).Scan(&reception.ID, &reception.PVZID, &reception.CreationTimeUTC, &reception.Status)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ReceptionInfo{}, errors.New(domain.ReceptionDoesNotExistsError)
+		}
+
+		return domain.ReceptionInfo{}, err
+	}
+
+	return reception, nil
+}
+
+func (r receptionInfoRepositoryImpl) FindByIDForUpdate(ctx context.Context, id domain.ReceptionID) (domain.ReceptionInfo, error) {
+	const query string = `
+	select 
+			 id
+		   , pvz_id
+		   , creation_time_utc
+		   , status
+	  from receptions
 	 where id = $1
+	   for update;
+	`
+
+	var reception domain.ReceptionInfo
+	err := r.client.QueryRow(ctx, query, id).Scan(&reception.ID, &reception.PVZID, &reception.CreationTimeUTC, &reception.Status)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ReceptionInfo{}, errors.New(domain.ReceptionDoesNotExistsError)
+		}
+
+		return domain.ReceptionInfo{}, err
+	}
+
+	return reception, nil
+}
+
+func (r receptionInfoRepositoryImpl) FindAllInProgress(ctx context.Context) ([]domain.ReceptionInfo, error) {
+	const query string = `
+	select 
+			 id
+		   , pvz_id
+		   , creation_time_utc
+		   , status
+	  from receptions
+	 where status = $1
+	 order by creation_time_utc;
 	`
-	_, err := r.client.Exec(ctx, query, reception.ID, reception.PVZID, reception.CreationTimeUTC, reception.Status)
+
+	rows, err := r.client.Query(ctx, query, domain.InProggressProductAcceptanceStatus)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	receptions := make([]domain.ReceptionInfo, 0)
+	for rows.Next() {
+		var reception domain.ReceptionInfo
+
+		if err := rows.Scan(&reception.ID, &reception.PVZID, &reception.CreationTimeUTC, &reception.Status); err != nil {
+			return nil, err
+		}
+
+		receptions = append(receptions, reception)
+	}
+
+	return receptions, rows.Err()
+}
+
+func (r receptionInfoRepositoryImpl) Update(ctx context.Context, reception domain.ReceptionInfo) error {
+	// products keep their barcodes accepted only while reception is opened
+	const query string = `
+	with reception as (
+		update receptions
+		   set 
+		   	    pvz_id = $2
+		   	  , creation_time_utc = $3
+			  , status = $4
+		 where id = $1
+		returning id, status
+	)
+	update products p
+	   set accepted_barcode = case when r.status = $5 then p.barcode end
+	  from reception r
+	 where p.reception_id = r.id
+	   and p.deleted_at_utc is null
+	`
+	_, err := r.client.Exec(ctx, query, reception.ID, reception.PVZID, reception.CreationTimeUTC, reception.Status, domain.InProggressProductAcceptanceStatus)
+	return openedReceptionError(barcodeError(err))
+}
+
+func openedReceptionError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == openedReceptionIndex {
+		return errors.New(domain.AnotherOpenedReceptionError)
+	}
+
 	return err
 }
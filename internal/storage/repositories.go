@@ -11,14 +11,49 @@ type Repositories struct {
 	domain.PVZReportAggregateRepository
 	domain.ReceptionInfoRepository
 	domain.ProductRepository
+	domain.ShipmentManifestRepository
+	domain.DiscrepancyReportRepository
+	domain.ReceptionActRepository
+	domain.ReceptionHistoryRepository
+	domain.DuplicateScanRepository
+	domain.EventOutboxRepository
+	domain.WebhookSubscriptionRepository
+	domain.WebhookDeliveryAttemptRepository
+	domain.AuditRepository
+	domain.PVZAssignmentRepository
+	domain.RoleRepository
+	domain.InvitationRepository
+	domain.UserTokenRepository
+	domain.APIKeyRepository
+	domain.SSOLoginRepository
+	domain.UnitOfWork
 }
 
-func NewRepositories(client postgresql.Client) Repositories {
+// NewRepositories creates repositories that take part in transaction opened by UnitOfWork
+func NewRepositories(pool postgresql.Client) Repositories {
+	client := newTransactionalClient(pool)
+
 	return Repositories{
-		UserRepository:               NewUserRepository(client),
-		PVZRepository:                NewPVZRepository(client),
-		PVZReportAggregateRepository: NewPVZReportAggregateRepository(client),
-		ReceptionInfoRepository:      NewReceptionInfoRepository(client),
-		ProductRepository:            NewProductRepository(client),
+		UserRepository:                   NewUserRepository(client),
+		PVZRepository:                    NewPVZRepository(client),
+		PVZReportAggregateRepository:     NewPVZReportAggregateRepository(client),
+		ReceptionInfoRepository:          NewReceptionInfoRepository(client),
+		ProductRepository:                NewProductRepository(client),
+		ShipmentManifestRepository:       NewShipmentManifestRepository(client),
+		DiscrepancyReportRepository:      NewDiscrepancyReportRepository(client),
+		ReceptionActRepository:           NewReceptionActRepository(client),
+		ReceptionHistoryRepository:       NewReceptionHistoryRepository(client),
+		DuplicateScanRepository:          NewDuplicateScanRepository(client),
+		EventOutboxRepository:            NewEventOutboxRepository(client),
+		WebhookSubscriptionRepository:    NewWebhookSubscriptionRepository(client),
+		WebhookDeliveryAttemptRepository: NewWebhookDeliveryAttemptRepository(client),
+		AuditRepository:                  NewAuditRepository(client),
+		PVZAssignmentRepository:          NewPVZAssignmentRepository(client),
+		RoleRepository:                   NewRoleRepository(client),
+		InvitationRepository:             NewInvitationRepository(client),
+		UserTokenRepository:              NewUserTokenRepository(client),
+		APIKeyRepository:                 NewAPIKeyRepository(client),
+		SSOLoginRepository:               NewSSOLoginRepository(client),
+		UnitOfWork:                       NewUnitOfWork(client),
 	}
 }
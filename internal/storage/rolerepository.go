@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type roleRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewRoleRepository(client postgresql.Client) domain.RoleRepository {
+	return roleRepositoryImpl{client: client}
+}
+
+const selectRolesQuery string = `
+	select
+			  r.id
+			, r.name
+			, r.two_factor_required
+			, array(select p.permission from role_permissions as p where p.role_id = r.id order by p.permission collate "C") as permissions
+	  from user_roles as r
+`
+
+const uniqueViolationCode string = "23505"
+
+func (r roleRepositoryImpl) FindAll(ctx context.Context) ([]domain.UserRole, error) {
+	rows, err := r.client.Query(ctx, selectRolesQuery+" order by r.id;")
+	if err != nil {
+		return nil, err
+	}
+
+	return scanRoles(rows)
+}
+
+func (r roleRepositoryImpl) FindByID(ctx context.Context, id domain.UserRoleID) (domain.UserRole, error) {
+	rows, err := r.client.Query(ctx, selectRolesQuery+" where r.id = $1;", id)
+	if err != nil {
+		return domain.UserRole{}, err
+	}
+
+	return singleRole(rows)
+}
+
+func (r roleRepositoryImpl) FindByName(ctx context.Context, name string) (domain.UserRole, error) {
+	rows, err := r.client.Query(ctx, selectRolesQuery+" where lower(r.name) = lower($1);", name)
+	if err != nil {
+		return domain.UserRole{}, err
+	}
+
+	return singleRole(rows)
+}
+
+func (r roleRepositoryImpl) Add(ctx context.Context, role domain.UserRole) (domain.UserRole, error) {
+	const query string = "insert into user_roles(name, two_factor_required) values($1, $2) returning id;"
+
+	if err := r.client.QueryRow(ctx, query, role.Name, role.TwoFactorRequired).Scan(&role.ID); err != nil {
+		return domain.UserRole{}, roleError(err)
+	}
+
+	return role, r.insertPermissions(ctx, role)
+}
+
+// permissions are replaced as a whole, caller is expected to run it within transaction
+func (r roleRepositoryImpl) Update(ctx context.Context, role domain.UserRole) error {
+	const (
+		updateQuery string = "update user_roles set name = $2, two_factor_required = $3 where id = $1;"
+		deleteQuery string = "delete from role_permissions where role_id = $1;"
+	)
+
+	tag, err := r.client.Exec(ctx, updateQuery, role.ID, role.Name, role.TwoFactorRequired)
+	if err != nil {
+		return roleError(err)
+	} else if tag.RowsAffected() == 0 {
+		return errors.New(domain.RoleDoesNotExistError)
+	}
+
+	if _, err = r.client.Exec(ctx, deleteQuery, role.ID); err != nil {
+		return err
+	}
+
+	return r.insertPermissions(ctx, role)
+}
+
+func (r roleRepositoryImpl) insertPermissions(ctx context.Context, role domain.UserRole) error {
+	const query string = "insert into role_permissions(role_id, permission) select $1, unnest($2::text[]);"
+
+	_, err := r.client.Exec(ctx, query, role.ID, role.Permissions)
+
+	return err
+}
+
+func roleError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+		return errors.New(domain.RoleNameIsTakenError)
+	}
+
+	return err
+}
+
+func singleRole(rows pgx.Rows) (domain.UserRole, error) {
+	roles, err := scanRoles(rows)
+	if err != nil {
+		return domain.UserRole{}, err
+	} else if len(roles) == 0 {
+		return domain.UserRole{}, errors.New(domain.RoleDoesNotExistError)
+	}
+
+	return roles[0], nil
+}
+
+func scanRoles(rows pgx.Rows) ([]domain.UserRole, error) {
+	defer rows.Close()
+
+	roles := make([]domain.UserRole, 0)
+	for rows.Next() {
+		var role domain.UserRole
+		if err := rows.Scan(&role.ID, &role.Name, &role.TwoFactorRequired, &role.Permissions); err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, rows.Err()
+}
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+)
+
+type shipmentManifestRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewShipmentManifestRepository(client postgresql.Client) domain.ShipmentManifestRepository {
+	return shipmentManifestRepositoryImpl{client: client}
+}
+
+const selectShipmentManifestQuery string = `
+	select
+			  id
+			, pvz_id
+			, reception_id
+			, uploaded_by
+			, creation_time_utc
+			, barcodes
+			, categories
+	  from shipment_manifests
+`
+
+func (s shipmentManifestRepositoryImpl) Add(ctx context.Context, manifest domain.ShipmentManifest) error {
+	const query string = `
+	insert into shipment_manifests(id, pvz_id, reception_id, uploaded_by, creation_time_utc, barcodes, categories)
+	values($1, $2, $3, $4, $5, $6, $7);
+	`
+
+	// items are kept as two arrays of the same length
+	barcodes := make([]string, 0, len(manifest.Items))
+	categories := make([]domain.ProductCategory, 0, len(manifest.Items))
+	for _, item := range manifest.Items {
+		barcodes = append(barcodes, item.Barcode)
+		categories = append(categories, item.Category)
+	}
+
+	_, err := s.client.Exec(ctx, query, manifest.ID, manifest.PVZID, manifest.ReceptionID, manifest.UploadedBy, manifest.CreationTimeUTC, barcodes, categories)
+
+	return err
+}
+
+func (s shipmentManifestRepositoryImpl) FindByID(ctx context.Context, id domain.ShipmentManifestID) (domain.ShipmentManifest, error) {
+	return scanShipmentManifest(s.client.QueryRow(ctx, selectShipmentManifestQuery+" where id = $1;", id))
+}
+
+func (s shipmentManifestRepositoryImpl) FindByReceptionID(ctx context.Context, receptionId domain.ReceptionID) (domain.ShipmentManifest, error) {
+	return scanShipmentManifest(s.client.QueryRow(ctx, selectShipmentManifestQuery+" where reception_id = $1;", receptionId))
+}
+
+func (s shipmentManifestRepositoryImpl) Link(ctx context.Context, id domain.ShipmentManifestID, receptionId domain.ReceptionID) error {
+	const (
+		unlinkQuery string = "update shipment_manifests set reception_id = null where reception_id = $2 and id <> $1;"
+		linkQuery   string = `
+		update shipment_manifests
+		   set reception_id = $2
+		 where id = $1
+		   and (reception_id is null or reception_id = $2);
+		`
+	)
+
+	if _, err := s.client.Exec(ctx, unlinkQuery, id, receptionId); err != nil {
+		return err
+	}
+
+	tag, err := s.client.Exec(ctx, linkQuery, id, receptionId)
+	if err != nil {
+		return err
+	} else if tag.RowsAffected() == 0 {
+		return errors.New(domain.ShipmentManifestIsAlreadyLinkedError)
+	}
+
+	return nil
+}
+
+func scanShipmentManifest(row pgx.Row) (domain.ShipmentManifest, error) {
+	var (
+		manifest   domain.ShipmentManifest
+		barcodes   []string
+		categories []domain.ProductCategory
+	)
+	err := row.Scan(&manifest.ID, &manifest.PVZID, &manifest.ReceptionID, &manifest.UploadedBy, &manifest.CreationTimeUTC, &barcodes, &categories)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ShipmentManifest{}, errors.New(domain.ShipmentManifestDoesNotExistError)
+		}
+
+		return domain.ShipmentManifest{}, err
+	}
+
+	manifest.Items = make([]domain.ShipmentManifestItem, 0, len(barcodes))
+	for i, barcode := range barcodes {
+		manifest.Items = append(manifest.Items, domain.ShipmentManifestItem{Barcode: barcode, Category: categories[i]})
+	}
+
+	return manifest, nil
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+type ssoLoginRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewSSOLoginRepository(client postgresql.Client) domain.SSOLoginRepository {
+	return ssoLoginRepositoryImpl{client: client}
+}
+
+func (r ssoLoginRepositoryImpl) Add(ctx context.Context, login domain.SSOLogin) error {
+	const query string = `
+	insert into sso_logins(state_hash, nonce, code_verifier, creation_time_utc, expires_at_utc)
+	values($1, $2, $3, $4, $5);
+	`
+
+	_, err := r.client.Exec(ctx, query,
+		login.StateHash,
+		login.Nonce,
+		login.CodeVerifier,
+		login.CreationTimeUTC,
+		login.ExpiresAtUTC,
+	)
+
+	return err
+}
+
+// removal and read are one statement, so of two requests racing for the same state only one gets the login
+func (r ssoLoginRepositoryImpl) Take(ctx context.Context, stateHash string) (domain.SSOLogin, error) {
+	const query string = `
+	delete from sso_logins
+	 where state_hash = $1
+	returning state_hash, nonce, code_verifier, creation_time_utc, expires_at_utc;
+	`
+
+	var login domain.SSOLogin
+	err := r.client.QueryRow(ctx, query, stateHash).Scan(
+		&login.StateHash,
+		&login.Nonce,
+		&login.CodeVerifier,
+		&login.CreationTimeUTC,
+		&login.ExpiresAtUTC,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.SSOLogin{}, errors.New(domain.SSOLoginDoesNotExistError)
+	}
+
+	return login, err
+}
+
+func (r ssoLoginRepositoryImpl) DeleteExpired(ctx context.Context, moment time.Time) error {
+	const query string = `
+	delete from sso_logins
+	 where expires_at_utc <= $1;
+	`
+
+	_, err := r.client.Exec(ctx, query, moment)
+
+	return err
+}
@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type transactionKey struct{}
+
+type unitOfWorkImpl struct {
+	client postgresql.Client
+}
+
+func NewUnitOfWork(client postgresql.Client) domain.UnitOfWork {
+	return unitOfWorkImpl{client: client}
+}
+
+// WithinTransaction joins transaction already opened in ctx, so use cases could be composed
+func (u unitOfWorkImpl) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if _, opened := ctx.Value(transactionKey{}).(pgx.Tx); opened {
+		return fn(ctx)
+	}
+
+	tx, err := u.client.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	if err = fn(context.WithValue(ctx, transactionKey{}, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// transactionalClient sends queries into transaction opened by unit of work, or into pool when there is none
+type transactionalClient struct {
+	pool postgresql.Client
+}
+
+func newTransactionalClient(pool postgresql.Client) postgresql.Client {
+	return transactionalClient{pool: pool}
+}
+
+func (c transactionalClient) current(ctx context.Context) postgresql.Client {
+	if tx, opened := ctx.Value(transactionKey{}).(pgx.Tx); opened {
+		return tx
+	}
+
+	return c.pool
+}
+
+func (c transactionalClient) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	return c.current(ctx).Exec(ctx, sql, arguments...)
+}
+
+func (c transactionalClient) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return c.current(ctx).Query(ctx, sql, args...)
+}
+
+func (c transactionalClient) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return c.current(ctx).QueryRow(ctx, sql, args...)
+}
+
+func (c transactionalClient) Begin(ctx context.Context) (pgx.Tx, error) {
+	return c.current(ctx).Begin(ctx)
+}
+
+func (c transactionalClient) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	return c.current(ctx).SendBatch(ctx, b)
+}
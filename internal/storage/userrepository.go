@@ -24,22 +24,48 @@ const selectUserBaseQuery = `
 		  u.id as user_id
 		, u.email as user_email
 		, u.password as user_password
+		, u.deactivated as user_deactivated
+		, u.email_verified as user_email_verified
+		, u.totp_secret as user_totp_secret
+		, u.two_factor_enabled as user_two_factor_enabled
+		, u.recovery_code_hashes as user_recovery_code_hashes
+		, u.totp_last_used_step as user_totp_last_used_step
+		, u.service_account as user_service_account
+		, u.sso_subject as user_sso_subject
 		, u.user_role_id as user_role_id
 		, ur.name as user_role_name
+		, ur.two_factor_required as user_role_two_factor_required
+		, array(select p.permission from role_permissions as p where p.role_id = ur.id order by p.permission collate "C") as user_role_permissions
    from users as u
    join user_roles as ur on ur.id = u.user_role_id
 	`
 
 // todo: what if there is no such user?
 func scanUserFromRow(row pgx.Row) (user domain.User, err error) {
-	err = row.Scan(&user.ID, &user.Email, &user.Password, &user.UserRole.ID, &user.UserRole.Name)
+	err = row.Scan(
+		&user.ID,
+		&user.Email,
+		&user.Password,
+		&user.Deactivated,
+		&user.EmailVerified,
+		&user.TwoFactor.Secret,
+		&user.TwoFactor.Enabled,
+		&user.TwoFactor.RecoveryCodeHashes,
+		&user.TwoFactor.LastUsedStep,
+		&user.ServiceAccount,
+		&user.SSOSubject,
+		&user.UserRole.ID,
+		&user.UserRole.Name,
+		&user.UserRole.TwoFactorRequired,
+		&user.UserRole.Permissions,
+	)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
 			pgErr = err.(*pgconn.PgError)
 			log.Println(pgErr)
 			return user, errors.New("an error occured while user fetching from database")
-		} else if err.Error() == "no rows in result set" {
+		} else if errors.Is(err, pgx.ErrNoRows) {
 			err = errors.New(domain.UserDoesNotExistsError)
 			return
 		}
@@ -63,10 +89,21 @@ func (r userRepositoryImpl) FindByEmail(ctx context.Context, email domain.Email)
 	return scanUserFromRow(row)
 }
 
+func (r userRepositoryImpl) FindBySSOSubject(ctx context.Context, subject string) (domain.User, error) {
+	const query string = selectUserBaseQuery + " where u.sso_subject = $1;"
+	row := r.client.QueryRow(ctx, query, subject)
+
+	return scanUserFromRow(row)
+}
+
 func (r userRepositoryImpl) Add(ctx context.Context, user domain.User) error {
-	const query string = "insert into users (id, user_role_id, email, password) values ($1, $2, $3, $4);"
+	const query string = `
+	insert into users (id, user_role_id, email, password, deactivated, email_verified, totp_secret, two_factor_enabled, recovery_code_hashes, totp_last_used_step, service_account, sso_subject)
+	values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+	`
 
-	_, err := r.client.Exec(ctx, query, user.ID, user.UserRole.ID, user.Email, user.Password)
+	_, err := r.client.Exec(ctx, query, user.ID, user.UserRole.ID, user.Email, user.Password, user.Deactivated, user.EmailVerified,
+		user.TwoFactor.Secret, user.TwoFactor.Enabled, recoveryCodeHashes(user.TwoFactor), user.TwoFactor.LastUsedStep, user.ServiceAccount, user.SSOSubject)
 
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -81,3 +118,162 @@ func (r userRepositoryImpl) Add(ctx context.Context, user domain.User) error {
 
 	return nil
 }
+
+func (r userRepositoryImpl) Update(ctx context.Context, user domain.User) error {
+	const query string = `
+	update users
+	   set user_role_id = $2
+	     , email = $3
+	     , password = $4
+	     , deactivated = $5
+	     , email_verified = $6
+	     , totp_secret = $7
+	     , two_factor_enabled = $8
+	     , recovery_code_hashes = $9
+	     , totp_last_used_step = $10
+	     , sso_subject = $11
+	 where id = $1;
+	`
+
+	tag, err := r.client.Exec(ctx, query, user.ID, user.UserRole.ID, user.Email, user.Password, user.Deactivated, user.EmailVerified,
+		user.TwoFactor.Secret, user.TwoFactor.Enabled, recoveryCodeHashes(user.TwoFactor), user.TwoFactor.LastUsedStep, user.SSOSubject)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			log.Println(pgErr)
+			return errors.New("could not save user")
+		}
+
+		return err
+	} else if tag.RowsAffected() == 0 {
+		return errors.New(domain.UserDoesNotExistsError)
+	}
+
+	return nil
+}
+
+func (r userRepositoryImpl) UpdatePasswordHash(ctx context.Context, id domain.UserID, oldHash string, newHash string) error {
+	const query string = `
+	update users
+	   set password = $3
+	 where id = $1
+	   and password = $2;
+	`
+
+	tag, err := r.client.Exec(ctx, query, id, oldHash, newHash)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			log.Println(pgErr)
+			return errors.New("could not save user")
+		}
+
+		return err
+	} else if tag.RowsAffected() == 0 {
+		return errors.New(domain.PasswordWasChangedError)
+	}
+
+	return nil
+}
+
+func (r userRepositoryImpl) UpdateTwoFactor(ctx context.Context, id domain.UserID, expected domain.TwoFactor, twoFactor domain.TwoFactor) error {
+	const query string = `
+	update users
+	   set totp_secret = $4
+	     , two_factor_enabled = $5
+	     , recovery_code_hashes = $6
+	     , totp_last_used_step = $7
+	 where id = $1
+	   and two_factor_enabled = $2
+	   and totp_secret is not distinct from $3;
+	`
+
+	return r.execTwoFactor(ctx, domain.TwoFactorWasChangedError, query, id, expected.Enabled, expected.Secret,
+		twoFactor.Secret, twoFactor.Enabled, recoveryCodeHashes(twoFactor), twoFactor.LastUsedStep)
+}
+
+func (r userRepositoryImpl) SpendTOTPStep(ctx context.Context, id domain.UserID, step int64) error {
+	const query string = `
+	update users
+	   set totp_last_used_step = $2
+	 where id = $1
+	   and two_factor_enabled
+	   and totp_last_used_step < $2;
+	`
+
+	return r.execTwoFactor(ctx, domain.BadTwoFactorCodeError, query, id, step)
+}
+
+func (r userRepositoryImpl) SpendRecoveryCode(ctx context.Context, id domain.UserID, codeHash string) error {
+	const query string = `
+	update users
+	   set recovery_code_hashes = array_remove(recovery_code_hashes, $2)
+	 where id = $1
+	   and two_factor_enabled
+	   and $2 = any(recovery_code_hashes);
+	`
+
+	return r.execTwoFactor(ctx, domain.BadTwoFactorCodeError, query, id, codeHash)
+}
+
+// execTwoFactor runs conditional update of second factor, notAffectedError is returned when condition does not hold
+func (r userRepositoryImpl) execTwoFactor(ctx context.Context, notAffectedError string, query string, args ...any) error {
+	tag, err := r.client.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			log.Println(pgErr)
+			return errors.New("could not save user")
+		}
+
+		return err
+	} else if tag.RowsAffected() == 0 {
+		return errors.New(notAffectedError)
+	}
+
+	return nil
+}
+
+// column is not null, so user without codes is saved with empty array
+func recoveryCodeHashes(twoFactor domain.TwoFactor) []string {
+	if twoFactor.RecoveryCodeHashes == nil {
+		return []string{}
+	}
+
+	return twoFactor.RecoveryCodeHashes
+}
+
+func (r userRepositoryImpl) FindAllByFilter(ctx context.Context, filter domain.SearchUserFilter) ([]domain.User, error) {
+	const query string = selectUserBaseQuery + `
+	 where ($1 = '' or strpos(lower(u.email), lower($1)) > 0)
+	   and ($2::smallint is null or u.user_role_id = $2)
+	   and ($3::boolean is null or u.deactivated = $3)
+	 order by u.id
+	 offset $4
+	 limit $5;
+	`
+
+	rows, err := r.client.Query(ctx, query,
+		filter.Email,
+		filter.RoleID,
+		filter.Deactivated,
+		(filter.Page-1)*filter.Limit,
+		filter.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0)
+	for rows.Next() {
+		user, err := scanUserFromRow(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
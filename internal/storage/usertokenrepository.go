@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+)
+
+type userTokenRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewUserTokenRepository(client postgresql.Client) domain.UserTokenRepository {
+	return userTokenRepositoryImpl{client: client}
+}
+
+func (r userTokenRepositoryImpl) Add(ctx context.Context, token domain.UserToken) error {
+	const query string = `
+	insert into user_tokens(id, user_id, purpose, token_hash, creation_time_utc, expires_at_utc, used_at_utc)
+	values($1, $2, $3, $4, $5, $6, $7);
+	`
+
+	_, err := r.client.Exec(ctx, query,
+		token.ID,
+		token.UserID,
+		token.Purpose,
+		token.TokenHash,
+		token.CreationTimeUTC,
+		token.ExpiresAtUTC,
+		token.UsedAtUTC,
+	)
+
+	return err
+}
+
+func (r userTokenRepositoryImpl) FindByTokenHash(ctx context.Context, purpose domain.UserTokenPurpose, tokenHash string) (domain.UserToken, error) {
+	const query string = `
+	select
+			  id
+			, user_id
+			, purpose
+			, token_hash
+			, creation_time_utc
+			, expires_at_utc
+			, used_at_utc
+	  from user_tokens
+	 where token_hash = $1
+	   and purpose = $2;
+	`
+
+	var token domain.UserToken
+	err := r.client.QueryRow(ctx, query, tokenHash, purpose).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Purpose,
+		&token.TokenHash,
+		&token.CreationTimeUTC,
+		&token.ExpiresAtUTC,
+		&token.UsedAtUTC,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.UserToken{}, errors.New(domain.UserTokenDoesNotExistError)
+	}
+
+	return token, err
+}
+
+// usage is conditional, so of two requests racing for the same token only one succeeds
+func (r userTokenRepositoryImpl) Use(ctx context.Context, token domain.UserToken) error {
+	const query string = `
+	update user_tokens
+	   set used_at_utc = $2
+	 where id = $1
+	   and used_at_utc is null;
+	`
+
+	tag, err := r.client.Exec(ctx, query, token.ID, token.UsedAtUTC)
+	if err != nil {
+		return err
+	} else if tag.RowsAffected() == 0 {
+		return errors.New(domain.UserTokenIsAlreadyUsedError)
+	}
+
+	return nil
+}
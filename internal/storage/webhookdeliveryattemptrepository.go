@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+)
+
+type webhookDeliveryAttemptRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewWebhookDeliveryAttemptRepository(client postgresql.Client) domain.WebhookDeliveryAttemptRepository {
+	return webhookDeliveryAttemptRepositoryImpl{client: client}
+}
+
+func (w webhookDeliveryAttemptRepositoryImpl) Add(ctx context.Context, attempt domain.WebhookDeliveryAttempt) error {
+	const query string = `
+	insert into webhook_delivery_attempts(id, subscription_id, event_id, attempt, attempt_time_utc, status_code, error, delivered)
+	values($1, $2, $3, $4, $5, $6, $7, $8);
+	`
+
+	_, err := w.client.Exec(ctx, query,
+		attempt.ID,
+		attempt.SubscriptionID,
+		attempt.EventID,
+		attempt.Attempt,
+		attempt.AttemptTimeUTC,
+		attempt.StatusCode,
+		attempt.Error,
+		attempt.Delivered,
+	)
+
+	return err
+}
+
+func (w webhookDeliveryAttemptRepositoryImpl) FindAllBySubscriptionID(ctx context.Context, subscriptionID domain.WebhookSubscriptionID, limit int) ([]domain.WebhookDeliveryAttempt, error) {
+	const query string = `
+	select
+			  id
+			, subscription_id
+			, event_id
+			, attempt
+			, attempt_time_utc
+			, status_code
+			, error
+			, delivered
+	  from webhook_delivery_attempts
+	 where subscription_id = $1
+	 order by attempt_time_utc desc, id desc
+	 limit $2;
+	`
+
+	rows, err := w.client.Query(ctx, query, subscriptionID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attempts := make([]domain.WebhookDeliveryAttempt, 0)
+	for rows.Next() {
+		var attempt domain.WebhookDeliveryAttempt
+		err := rows.Scan(
+			&attempt.ID,
+			&attempt.SubscriptionID,
+			&attempt.EventID,
+			&attempt.Attempt,
+			&attempt.AttemptTimeUTC,
+			&attempt.StatusCode,
+			&attempt.Error,
+			&attempt.Delivered,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		attempts = append(attempts, attempt)
+	}
+
+	return attempts, rows.Err()
+}
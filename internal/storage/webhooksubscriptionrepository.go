@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"avito/internal/domain"
+	postgresql "avito/pkg/database"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+)
+
+type webhookSubscriptionRepositoryImpl struct {
+	client postgresql.Client
+}
+
+func NewWebhookSubscriptionRepository(client postgresql.Client) domain.WebhookSubscriptionRepository {
+	return webhookSubscriptionRepositoryImpl{client: client}
+}
+
+const selectWebhookSubscriptionsQuery string = `
+	select
+			  id
+			, url
+			, event_types
+			, pvz_id
+			, city_id
+			, secret
+			, created_by
+			, creation_time_utc
+	  from webhook_subscriptions
+`
+
+func (w webhookSubscriptionRepositoryImpl) Add(ctx context.Context, subscription domain.WebhookSubscription) error {
+	const query string = `
+	insert into webhook_subscriptions(id, url, event_types, pvz_id, city_id, secret, created_by, creation_time_utc)
+	values($1, $2, $3, $4, $5, $6, $7, $8);
+	`
+
+	_, err := w.client.Exec(ctx, query,
+		subscription.ID,
+		subscription.URL,
+		subscription.EventTypes,
+		subscription.PVZID,
+		subscription.CityID,
+		subscription.Secret,
+		subscription.CreatedBy,
+		subscription.CreationTimeUTC,
+	)
+
+	return err
+}
+
+func (w webhookSubscriptionRepositoryImpl) FindByID(ctx context.Context, id domain.WebhookSubscriptionID) (domain.WebhookSubscription, error) {
+	rows, err := w.client.Query(ctx, selectWebhookSubscriptionsQuery+" where id = $1;", id)
+	if err != nil {
+		return domain.WebhookSubscription{}, err
+	}
+
+	subscriptions, err := scanWebhookSubscriptions(rows)
+	if err != nil {
+		return domain.WebhookSubscription{}, err
+	} else if len(subscriptions) == 0 {
+		return domain.WebhookSubscription{}, errors.New(domain.WebhookSubscriptionDoesNotExistError)
+	}
+
+	return subscriptions[0], nil
+}
+
+func (w webhookSubscriptionRepositoryImpl) FindAll(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	rows, err := w.client.Query(ctx, selectWebhookSubscriptionsQuery+" order by creation_time_utc, id;")
+	if err != nil {
+		return nil, err
+	}
+
+	return scanWebhookSubscriptions(rows)
+}
+
+func (w webhookSubscriptionRepositoryImpl) FindAllByEventType(ctx context.Context, eventType domain.EventType) ([]domain.WebhookSubscription, error) {
+	rows, err := w.client.Query(ctx, selectWebhookSubscriptionsQuery+" where $1 = any(event_types) order by creation_time_utc, id;", eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanWebhookSubscriptions(rows)
+}
+
+func (w webhookSubscriptionRepositoryImpl) Remove(ctx context.Context, id domain.WebhookSubscriptionID) error {
+	const query string = "delete from webhook_subscriptions where id = $1;"
+
+	tag, err := w.client.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	} else if tag.RowsAffected() == 0 {
+		return errors.New(domain.WebhookSubscriptionDoesNotExistError)
+	}
+
+	return nil
+}
+
+func scanWebhookSubscriptions(rows pgx.Rows) ([]domain.WebhookSubscription, error) {
+	defer rows.Close()
+
+	subscriptions := make([]domain.WebhookSubscription, 0)
+	for rows.Next() {
+		var subscription domain.WebhookSubscription
+		err := rows.Scan(
+			&subscription.ID,
+			&subscription.URL,
+			&subscription.EventTypes,
+			&subscription.PVZID,
+			&subscription.CityID,
+			&subscription.Secret,
+			&subscription.CreatedBy,
+			&subscription.CreationTimeUTC,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, rows.Err()
+}
@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ListAuditRecordsArgs struct {
+	usecases.AuthenticationArgs
+	domain.AuditRepository
+
+	Filter ListAuditRecordsDTO
+}
+
+// ListAuditRecordsDTO narrows records down, nil and empty fields match any record
+type ListAuditRecordsDTO struct {
+	ActorID      *uuid.UUID
+	Action       string
+	EntityType   string
+	EntityID     *uuid.UUID
+	StartTimeUTC *time.Time
+	EndTimeUTC   *time.Time
+	Page         int
+	Limit        int
+}
+
+// ListAuditRecordsUseCase returns records from the newest to the oldest, only moderators see who did what
+func ListAuditRecordsUseCase(ctx context.Context, args ListAuditRecordsArgs) ([]domain.AuditRecord, error) {
+	auth := args.AuthenticationArgs
+	if _, accessErr := auth.RequirePermission(ctx, domain.AuditReadPermission); accessErr != nil {
+		return nil, accessErr
+	}
+
+	dto := args.Filter
+	dto.fixArgsIfNeeded()
+
+	return args.AuditRepository.FindAllByFilter(ctx, domain.SearchAuditRecordFilter{
+		ActorID:      dto.ActorID,
+		Action:       dto.Action,
+		EntityType:   dto.EntityType,
+		EntityID:     dto.EntityID,
+		StartTimeUTC: dto.StartTimeUTC,
+		EndTimeUTC:   dto.EndTimeUTC,
+		Page:         dto.Page,
+		Limit:        dto.Limit,
+	})
+}
+
+func (args *ListAuditRecordsDTO) fixArgsIfNeeded() {
+	if args.Limit <= 0 {
+		args.Limit = 10
+	}
+
+	if args.Page < 1 {
+		args.Page = 1
+	}
+}
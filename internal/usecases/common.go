@@ -5,6 +5,9 @@ import (
 	jwt "avito/pkg/authorization"
 	"context"
 	"errors"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 type AuthenticationArgs struct {
@@ -16,21 +19,75 @@ const (
 	IdIsRequiredArgError string = "id is required"
 )
 
-func (args *AuthenticationArgs) ValidatePrivelegies(ctx context.Context, roleIds ...domain.UserRoleID) (*domain.User, error) {
+type requestSourceKey struct{}
+
+// WithRequestSource is called by api layer for every request, audit records are marked with the source
+func WithRequestSource(ctx context.Context, source domain.RequestSource) context.Context {
+	return context.WithValue(ctx, requestSourceKey{}, source)
+}
+
+func RequestSourceFrom(ctx context.Context) domain.RequestSource {
+	source, _ := ctx.Value(requestSourceKey{}).(domain.RequestSource)
+
+	return source
+}
+
+// Audit records change of entity made by actor, it must be called within transaction of the change,
+// so the change and its record are saved or discarded together
+func Audit(ctx context.Context, audit domain.AuditRepository, actor *domain.User, action domain.AuditAction, entityType domain.AuditEntityType, entityID uuid.UUID, before any, after any) error {
+	record, err := domain.NewAuditRecord(ActorID(actor), action, entityType, entityID, before, after, RequestSourceFrom(ctx))
+	if err != nil {
+		return err
+	}
+
+	return audit.Add(ctx, record)
+}
+
+// ActorID identifies who made the change, nil actor is the service itself and gets zero id
+func ActorID(actor *domain.User) domain.UserID {
+	if actor == nil {
+		return uuid.Nil
+	}
+
+	return actor.ID
+}
+
+// EnsureAssignedToPVZ lets employee act only at pvz they are currently assigned to
+func EnsureAssignedToPVZ(ctx context.Context, assignments domain.PVZAssignmentRepository, actor *domain.User, pvzID domain.PVZID) error {
+	return domain.EnsureAssignedToPVZ(ctx, *actor, pvzID, time.Now().UTC(), assignments)
+}
+
+// RequirePermission authenticates user and lets them through only when their role has the permission,
+// user whose role requires second factor is let through only after enrollment
+func (args *AuthenticationArgs) RequirePermission(ctx context.Context, permission domain.Permission) (*domain.User, error) {
 	user, err := args.AuthorizationService.UserFromCredentials(ctx, args.JWT)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(roleIds) == 0 {
-		return user, nil
+	if user.NeedsTwoFactorEnrollment() {
+		return user, errors.New(domain.TwoFactorEnrollmentRequiredError)
 	}
 
-	for _, roleId := range roleIds {
-		if user.UserRole.ID == roleId {
-			return user, nil
-		}
+	if !user.HasPermission(permission) {
+		return user, errors.New(domain.InsufficientPrivilegesError)
+	}
+
+	return user, nil
+}
+
+func ToDomainCity(cityName string) (city domain.City, err error) {
+	switch cityName {
+	case "Казань", "казань", "КАЗАНЬ":
+		city, err = domain.NewCity(domain.KazanCityID)
+
+	case "Москва", "москва", "МОСКВА":
+		city, err = domain.NewCity(domain.MoscowCityID)
+	case "Санкт-Петербург", "санкт-петербург", "САНКТ-ПЕТЕРБУРГ":
+		city, err = domain.NewCity(domain.SaintPetersburgID)
+	default:
+		err = errors.New(domain.UnknownCityError)
 	}
 
-	return user, errors.New(domain.InsufficientPrivilegesError)
+	return
 }
@@ -0,0 +1,68 @@
+package pvz
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AssignEmployeeToPVZArgs struct {
+	usecases.AuthenticationArgs
+	domain.UserRepository
+	domain.PVZRepository
+	domain.PVZAssignmentRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	Assignment AssignEmployeeToPVZDTO
+}
+
+// AssignEmployeeToPVZDTO has optional validity period, assignment without it never expires
+type AssignEmployeeToPVZDTO struct {
+	PVZID        uuid.UUID
+	UserID       uuid.UUID
+	ValidFromUTC *time.Time
+	ValidToUTC   *time.Time
+}
+
+// AssignEmployeeToPVZUseCase lets employee open receptions and accept products at pvz, employee could work at several pvz
+func AssignEmployeeToPVZUseCase(ctx context.Context, args AssignEmployeeToPVZArgs) (domain.PVZAssignment, error) {
+	dto := args.Assignment
+	auth := args.AuthenticationArgs
+
+	moderator, accessErr := auth.RequirePermission(ctx, domain.PVZAssignPermission)
+	if accessErr != nil {
+		return domain.PVZAssignment{}, accessErr
+	} else if dto.PVZID == uuid.Nil || dto.UserID == uuid.Nil {
+		return domain.PVZAssignment{}, errors.New(usecases.IdIsRequiredArgError)
+	}
+
+	pvz, err := args.PVZRepository.FindById(ctx, dto.PVZID)
+	if err != nil {
+		return domain.PVZAssignment{}, err
+	}
+
+	employee, err := args.UserRepository.FindByID(ctx, dto.UserID)
+	if err != nil {
+		return domain.PVZAssignment{}, err
+	}
+
+	assignment, err := domain.NewPVZAssignment(employee, pvz.ID, dto.ValidFromUTC, dto.ValidToUTC, moderator.ID)
+	if err != nil {
+		return domain.PVZAssignment{}, err
+	}
+
+	err = args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := args.PVZAssignmentRepository.Add(ctx, assignment); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, moderator, domain.PVZAssignedAuditAction, domain.PVZAssignmentAuditEntityType, assignment.ID, nil, assignment)
+	})
+
+	return assignment, err
+}
@@ -15,6 +15,9 @@ const CouldNotCreatePVZError string = "an error while PVZ creation"
 type CreatePVZUseCaseArgs struct {
 	usecases.AuthenticationArgs
 	domain.PVZRepository
+	domain.EventOutboxRepository
+	domain.AuditRepository
+	domain.UnitOfWork
 
 	PVZ CreatePVZDTO
 }
@@ -29,11 +32,12 @@ func CreatePVZUseCase(ctx context.Context, args CreatePVZUseCaseArgs) (domain.PV
 	createPVZDTO := args.PVZ
 	auth := args.AuthenticationArgs
 
-	if _, accessError := auth.ValidatePrivelegies(ctx, domain.ModeratorUserRoleID); accessError != nil {
+	moderator, accessError := auth.RequirePermission(ctx, domain.PVZCreatePermission)
+	if accessError != nil {
 		return domain.PVZ{}, accessError
 	}
 
-	location, err := toDomainCity(createPVZDTO.PVZCity)
+	location, err := usecases.ToDomainCity(createPVZDTO.PVZCity)
 	if err != nil {
 		return domain.PVZ{}, err
 	}
@@ -46,31 +50,23 @@ func CreatePVZUseCase(ctx context.Context, args CreatePVZUseCaseArgs) (domain.PV
 		return domain.PVZ{}, errors.New(usecases.IdIsRequiredArgError)
 	}
 
-	// pvz, err := domain.NewPVZ(location)
-	pvz := domain.PVZ{
-		ID:              *createPVZDTO.PVZID,
-		CreationTimeUTC: *createPVZDTO.RegistrationTime,
-		City:            location,
+	pvz, err := domain.NewPVZ(*createPVZDTO.PVZID, *createPVZDTO.RegistrationTime, location)
+	if err != nil {
+		return domain.PVZ{}, err
 	}
 
-	// todo add unit of work here
-	err = args.PVZRepository.Add(ctx, pvz)
+	err = args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		events := pvz.PullEvents()
+		if err := args.PVZRepository.Add(ctx, pvz); err != nil {
+			return err
+		}
 
-	return pvz, err
-}
+		if err := usecases.Audit(ctx, args.AuditRepository, moderator, domain.PVZCreatedAuditAction, domain.PVZAuditEntityType, pvz.ID, nil, pvz); err != nil {
+			return err
+		}
 
-func toDomainCity(cityName string) (city domain.City, err error) {
-	switch cityName {
-	case "Казань", "казань", "КАЗАНЬ":
-		city, err = domain.NewCity(domain.KazanCityID)
-
-	case "Москва", "москва", "МОСКВА":
-		city, err = domain.NewCity(domain.MoscowCityID)
-	case "Санкт-Петербург", "санкт-петербург", "САНКТ-ПЕТЕРБУРГ":
-		city, err = domain.NewCity(domain.SaintPetersburgID)
-	default:
-		err = errors.New(domain.UnknownCityError)
-	}
+		return args.EventOutboxRepository.Add(ctx, events...)
+	})
 
-	return
+	return pvz, err
 }
@@ -0,0 +1,34 @@
+package pvz
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type ListPVZAssignmentsArgs struct {
+	usecases.AuthenticationArgs
+	domain.PVZRepository
+	domain.PVZAssignmentRepository
+
+	PVZID uuid.UUID
+}
+
+// ListPVZAssignmentsUseCase returns every assignment of pvz including expired and upcoming ones
+func ListPVZAssignmentsUseCase(ctx context.Context, args ListPVZAssignmentsArgs) ([]domain.PVZAssignment, error) {
+	auth := args.AuthenticationArgs
+	if _, accessErr := auth.RequirePermission(ctx, domain.PVZAssignPermission); accessErr != nil {
+		return nil, accessErr
+	} else if args.PVZID == uuid.Nil {
+		return nil, errors.New(usecases.IdIsRequiredArgError)
+	}
+
+	if _, err := args.PVZRepository.FindById(ctx, args.PVZID); err != nil {
+		return nil, err
+	}
+
+	return args.PVZAssignmentRepository.FindAllByPVZID(ctx, args.PVZID)
+}
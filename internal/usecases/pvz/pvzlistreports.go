@@ -26,7 +26,7 @@ type (
 func GetPVZListReportsUseCase(ctx context.Context, args GetPVZListUseCaseArgs) ([]*domain.PVZReportAggregate, error) {
 	auth := args.AuthenticationArgs
 	dto := args.GetPVZListReportsDTO
-	if _, accessError := auth.ValidatePrivelegies(ctx); accessError != nil {
+	if _, accessError := auth.RequirePermission(ctx, domain.PVZReadPermission); accessError != nil {
 		return nil, accessError
 	}
 
@@ -0,0 +1,46 @@
+package pvz
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type UnassignEmployeeFromPVZArgs struct {
+	usecases.AuthenticationArgs
+	domain.PVZAssignmentRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	PVZID        uuid.UUID
+	AssignmentID uuid.UUID
+}
+
+// UnassignEmployeeFromPVZUseCase removes assignment, employee keeps access to pvz through other assignments if any
+func UnassignEmployeeFromPVZUseCase(ctx context.Context, args UnassignEmployeeFromPVZArgs) error {
+	auth := args.AuthenticationArgs
+	moderator, accessErr := auth.RequirePermission(ctx, domain.PVZAssignPermission)
+	if accessErr != nil {
+		return accessErr
+	} else if args.PVZID == uuid.Nil || args.AssignmentID == uuid.Nil {
+		return errors.New(usecases.IdIsRequiredArgError)
+	}
+
+	return args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		assignment, err := args.PVZAssignmentRepository.FindByID(ctx, args.AssignmentID)
+		if err != nil {
+			return err
+		} else if assignment.PVZID != args.PVZID {
+			return errors.New(domain.PVZAssignmentDoesNotExistError)
+		}
+
+		if err := args.PVZAssignmentRepository.Remove(ctx, assignment.ID); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, moderator, domain.PVZUnassignedAuditAction, domain.PVZAssignmentAuditEntityType, assignment.ID, assignment, nil)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"avito/internal/usecases"
 	"context"
 	"errors"
+	"log"
 
 	"github.com/google/uuid"
 )
@@ -13,7 +14,13 @@ type AddProductToCurrentReceptionAtPVZArgs struct {
 	usecases.AuthenticationArgs
 	domain.ReceptionInfoRepository
 	domain.PVZRepository
+	domain.PVZAssignmentRepository
 	domain.ProductRepository
+	domain.DuplicateScanRepository
+	domain.EventOutboxRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+	domain.EventBus
 
 	PVZ AddProductToCurrentReceptionAtPVZDTO
 }
@@ -21,12 +28,16 @@ type AddProductToCurrentReceptionAtPVZArgs struct {
 type AddProductToCurrentReceptionAtPVZDTO struct {
 	PVZID           uuid.UUID
 	ProductCategory string
+	// empty barcode means product was accepted without scanning
+	Barcode       string
+	BarcodeFormat string
 }
 
 func AddProductToCurrentReceptinoAtPVZUseCase(ctx context.Context, args AddProductToCurrentReceptionAtPVZArgs) (domain.Product, error) {
 	dto := args.PVZ
 	auth := args.AuthenticationArgs
-	if _, accessError := auth.ValidatePrivelegies(ctx, domain.ClientUserRoleID); accessError != nil {
+	employee, accessError := auth.RequirePermission(ctx, domain.ProductAddPermission)
+	if accessError != nil {
 		return domain.Product{}, accessError
 	}
 
@@ -35,16 +46,63 @@ func AddProductToCurrentReceptinoAtPVZUseCase(ctx context.Context, args AddProdu
 		return domain.Product{}, argumentsErros
 	}
 
-	//todo: unit of work here
+	if err := usecases.EnsureAssignedToPVZ(ctx, args.PVZAssignmentRepository, employee, pvz.ID); err != nil {
+		return domain.Product{}, err
+	}
 
-	reception, err := pvz.CurrentReception(ctx, args.ReceptionInfoRepository)
-	if err != nil {
+	barcode, barcodeErr := dto.barcode()
+	if barcodeErr != nil {
+		return domain.Product{}, barcodeErr
+	}
+
+	var (
+		product     domain.Product
+		events      []domain.Event
+		receptionID domain.ReceptionID
+	)
+	err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		reception, err := pvz.CurrentReception(ctx, args.ReceptionInfoRepository)
+		if err != nil {
+			return err
+		}
+		receptionID = reception.ID
+
+		product, err = reception.AddNewProduct(ctx, category, barcode, args.ProductRepository)
+		if err != nil {
+			return err
+		}
+
+		if err = usecases.Audit(ctx, args.AuditRepository, employee, domain.ProductAddedAuditAction, domain.ProductAuditEntityType, product.ID, nil, product); err != nil {
+			return err
+		}
+
+		events = reception.PullEvents()
+		return args.EventOutboxRepository.Add(ctx, events...)
+	})
+	if err != nil && err.Error() == domain.DuplicateBarcodeError {
+		return domain.Product{}, recordDuplicateScan(ctx, args.DuplicateScanRepository, receptionID, *barcode, employee, err)
+	} else if err != nil {
 		return domain.Product{}, err
 	}
 
-	product, addErr := reception.AddNewProduct(ctx, category, args.ProductRepository)
+	args.EventBus.Publish(ctx, events...)
+
+	return product, nil
+}
+
+// refused product is rolled back with its transaction, the scan is kept apart, so it is reported on reconciliation.
+// Caller gets duplicateErr anyway, failure to keep the scan is only logged
+func recordDuplicateScan(ctx context.Context, scans domain.DuplicateScanRepository, receptionID domain.ReceptionID, barcode domain.Barcode, employee *domain.User, duplicateErr error) error {
+	scan, err := domain.NewDuplicateScan(receptionID, barcode, usecases.ActorID(employee))
+	if err == nil {
+		err = scans.Add(ctx, scan)
+	}
+
+	if err != nil {
+		log.Printf("could not keep duplicate scan of %s at reception %s: %v", barcode.Value, receptionID, err)
+	}
 
-	return product, addErr
+	return duplicateErr
 }
 
 func (args *AddProductToCurrentReceptionAtPVZDTO) validateArguments(ctx context.Context, r domain.PVZRepository) (domain.PVZ, domain.ProductCategory, error) {
@@ -66,6 +124,24 @@ func (args *AddProductToCurrentReceptionAtPVZDTO) validateArguments(ctx context.
 	return pvz, category, err
 }
 
+func (args *AddProductToCurrentReceptionAtPVZDTO) barcode() (*domain.Barcode, error) {
+	if args.Barcode == "" {
+		return nil, nil
+	}
+
+	format, err := toDomainBarcodeFormat(args.BarcodeFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	barcode, err := domain.NewBarcode(format, args.Barcode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &barcode, nil
+}
+
 func toDomainCategory(productCategory string) (category domain.ProductCategory, err error) {
 	switch productCategory {
 	case "электроника", "ЭЛЕКТРОНИКА":
@@ -81,3 +157,18 @@ func toDomainCategory(productCategory string) (category domain.ProductCategory,
 
 	return
 }
+
+func toDomainBarcodeFormat(barcodeFormat string) (format domain.BarcodeFormat, err error) {
+	switch barcodeFormat {
+	case "ean13":
+		format = domain.EAN13BarcodeFormat
+	case "code128":
+		format = domain.Code128BarcodeFormat
+	case "order":
+		format = domain.OrderNumberBarcodeFormat
+	default:
+		err = errors.New(domain.UnknownBarcodeFormatError)
+	}
+
+	return
+}
@@ -5,18 +5,37 @@ import (
 	"avito/internal/usecases"
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type CloseLastOpenedReceptionAtPVZArgs struct {
 	usecases.AuthenticationArgs
-	domain.ReceptionInfoRepository
+	ReceptionClosingArgs
 	domain.PVZRepository
+	domain.PVZAssignmentRepository
+	domain.UnitOfWork
+	domain.EventBus
 
 	PVZ CloseLastOpenedReceptionAtPVZDTO
 }
 
+// ReceptionClosingArgs are needed to close reception and issue its documents,
+// they are shared by closing made by employee and automatic one
+type ReceptionClosingArgs struct {
+	domain.ReceptionInfoRepository
+	domain.ProductRepository
+	domain.ShipmentManifestRepository
+	domain.DuplicateScanRepository
+	domain.DiscrepancyReportRepository
+	domain.ReceptionActRepository
+	domain.ReceptionActRenderer
+	domain.ReceptionHistoryRepository
+	domain.EventOutboxRepository
+	domain.AuditRepository
+}
+
 type CloseLastOpenedReceptionAtPVZDTO struct {
 	PVZID uuid.UUID
 }
@@ -25,7 +44,8 @@ func CloseLastOpenedReceptionUseCase(ctx context.Context, args CloseLastOpenedRe
 	createAtPVZID := args.PVZ.PVZID
 	auth := args.AuthenticationArgs
 
-	if _, accessErr := auth.ValidatePrivelegies(ctx); accessErr != nil {
+	actor, accessErr := auth.RequirePermission(ctx, domain.ReceptionClosePermission)
+	if accessErr != nil {
 		return domain.ReceptionInfo{}, accessErr
 	} else if createAtPVZID == uuid.Nil {
 		return domain.ReceptionInfo{}, errors.New(usecases.IdIsRequiredArgError)
@@ -36,17 +56,117 @@ func CloseLastOpenedReceptionUseCase(ctx context.Context, args CloseLastOpenedRe
 		return domain.ReceptionInfo{}, err
 	}
 
-	reception, err := pvz.CurrentReception(ctx, args.ReceptionInfoRepository)
+	if err = usecases.EnsureAssignedToPVZ(ctx, args.PVZAssignmentRepository, actor, pvz.ID); err != nil {
+		return domain.ReceptionInfo{}, err
+	}
+
+	var (
+		reception domain.ReceptionInfo
+		events    []domain.Event
+	)
+	err = args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		reception, events, err = closeCurrentReception(ctx, actor, pvz, args.ReceptionClosingArgs)
+		return err
+	})
 	if err != nil {
 		return reception, err
 	}
 
-	err = reception.Close()
+	args.EventBus.Publish(ctx, events...)
+
+	return reception, nil
+}
+
+func closeCurrentReception(ctx context.Context, actor *domain.User, pvz domain.PVZ, args ReceptionClosingArgs) (domain.ReceptionInfo, []domain.Event, error) {
+	reception, err := pvz.CurrentReception(ctx, args.ReceptionInfoRepository)
 	if err != nil {
-		return reception, err
+		return reception, nil, err
 	}
 
+	return closeReception(ctx, actor, pvz, reception, domain.ManualReceptionCloseReason, args)
+}
+
+// closes reception and issues its documents, act and discrepancy report,
+// returns events written to outbox. Nil actor means reception is closed automatically
+func closeReception(ctx context.Context, actor *domain.User, pvz domain.PVZ, reception domain.ReceptionInfo, reason domain.ReceptionCloseReason, args ReceptionClosingArgs) (domain.ReceptionInfo, []domain.Event, error) {
+	opened := reception
+
+	var err error
+	if reason == domain.AutomaticReceptionCloseReason {
+		err = reception.CloseAutomatically(ctx, args.ProductRepository)
+	} else {
+		err = reception.Close(ctx, args.ProductRepository)
+	}
+	if err != nil {
+		return reception, nil, err
+	}
+
+	closedAtUTC := time.Now().UTC()
+	events := reception.PullEvents()
 	err = args.ReceptionInfoRepository.Update(ctx, reception)
+	if err != nil {
+		return reception, nil, err
+	}
+
+	if err = args.EventOutboxRepository.Add(ctx, events...); err != nil {
+		return reception, nil, err
+	}
+
+	if err = recordReceptionHistory(ctx, args.ReceptionHistoryRepository, reception.ID, domain.ReceptionClosedHistoryAction, actor, historyCloseReason(reason)); err != nil {
+		return reception, nil, err
+	}
+
+	if err = usecases.Audit(ctx, args.AuditRepository, actor, domain.ReceptionClosedAuditAction, domain.ReceptionAuditEntityType, reception.ID, opened, reception); err != nil {
+		return reception, nil, err
+	}
+
+	products, err := args.ProductRepository.FindAllByReceptionID(ctx, reception.ID)
+	if err != nil {
+		return reception, nil, err
+	}
+
+	actContent, err := domain.NewReceptionActContent(pvz, reception, products, closedAtUTC)
+	if err != nil {
+		return reception, nil, err
+	}
+
+	if _, err = domain.IssueReceptionAct(ctx, actContent, args.ReceptionActRenderer, args.ReceptionActRepository); err != nil {
+		return reception, nil, err
+	}
+
+	if err = reconcileWithManifest(ctx, reception, products, args); err != nil {
+		return reception, nil, err
+	}
+
+	return reception, events, nil
+}
+
+// closed reception with linked manifest gets discrepancy report, reception without manifest is left as is
+func reconcileWithManifest(ctx context.Context, reception domain.ReceptionInfo, products []*domain.Product, args ReceptionClosingArgs) error {
+	manifest, err := args.ShipmentManifestRepository.FindByReceptionID(ctx, reception.ID)
+	if err != nil {
+		if err.Error() == domain.ShipmentManifestDoesNotExistError {
+			return nil
+		}
+
+		return err
+	}
+
+	duplicateScans, err := args.DuplicateScanRepository.FindAllByReceptionID(ctx, reception.ID)
+	if err != nil {
+		return err
+	}
+
+	report := manifest.Reconcile(reception, products, duplicateScans)
+
+	return args.DiscrepancyReportRepository.Add(ctx, report)
+}
+
+// closing by employee needs no explanation in history
+func historyCloseReason(reason domain.ReceptionCloseReason) string {
+	if reason == domain.ManualReceptionCloseReason {
+		return ""
+	}
 
-	return reception, err
+	return reason
 }
@@ -0,0 +1,130 @@
+package reception
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+	"time"
+)
+
+type CloseStaleReceptionsArgs struct {
+	ReceptionClosingArgs
+	domain.PVZRepository
+	domain.UnitOfWork
+	domain.EventBus
+
+	Policy StaleReceptionPolicy
+}
+
+// StaleReceptionPolicy tells how long reception may stay idle and what happens to it after that
+type StaleReceptionPolicy struct {
+	IdleTimeout time.Duration
+	// timeouts of particular pvz override IdleTimeout
+	PVZIdleTimeouts map[domain.PVZID]time.Duration
+	// stale reception is only flagged once instead of being closed
+	FlagOnly bool
+	NowUTC   time.Time
+}
+
+func (p StaleReceptionPolicy) idleTimeout(pvzID domain.PVZID) time.Duration {
+	if timeout, ok := p.PVZIdleTimeouts[pvzID]; ok {
+		return timeout
+	}
+
+	return p.IdleTimeout
+}
+
+// CloseStaleReceptionsUseCase closes or flags opened receptions idle for longer than timeout of their pvz,
+// every reception is handled in its own transaction, so failure of one does not hold back the others.
+// Returns receptions which were closed or flagged
+func CloseStaleReceptionsUseCase(ctx context.Context, args CloseStaleReceptionsArgs) ([]domain.ReceptionInfo, error) {
+	opened, err := args.ReceptionInfoRepository.FindAllInProgress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		handled []domain.ReceptionInfo
+		errs    []error
+	)
+	for _, candidate := range opened {
+		var (
+			reception domain.ReceptionInfo
+			events    []domain.Event
+			stale     bool
+		)
+		err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+			reception, events, stale, err = handleStaleReception(ctx, candidate.ID, args)
+			return err
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		} else if !stale {
+			continue
+		}
+
+		args.EventBus.Publish(ctx, events...)
+		handled = append(handled, reception)
+	}
+
+	return handled, errors.Join(errs...)
+}
+
+// reception is read again and locked within transaction, employee could close it or add product since it was found
+// and must not do so until it is closed or flagged
+func handleStaleReception(ctx context.Context, receptionID domain.ReceptionID, args CloseStaleReceptionsArgs) (domain.ReceptionInfo, []domain.Event, bool, error) {
+	reception, err := args.ReceptionInfoRepository.FindByIDForUpdate(ctx, receptionID)
+	if err != nil || reception.IsCompleted() {
+		return reception, nil, false, err
+	}
+
+	lastActivityUTC, err := reception.LastActivityUTC(ctx, args.ProductRepository, args.ReceptionHistoryRepository)
+	if err != nil {
+		return reception, nil, false, err
+	} else if args.Policy.NowUTC.Sub(lastActivityUTC) < args.Policy.idleTimeout(reception.PVZID) {
+		return reception, nil, false, nil
+	}
+
+	if args.Policy.FlagOnly {
+		return flagStaleReception(ctx, reception, lastActivityUTC, args)
+	}
+
+	pvz, err := args.PVZRepository.FindById(ctx, reception.PVZID)
+	if err != nil {
+		return reception, nil, false, err
+	}
+
+	reception, events, err := closeReception(ctx, nil, pvz, reception, domain.AutomaticReceptionCloseReason, args.ReceptionClosingArgs)
+
+	return reception, events, err == nil, err
+}
+
+// reception is flagged once per idle period, new activity makes it eligible for flagging again
+func flagStaleReception(ctx context.Context, reception domain.ReceptionInfo, lastActivityUTC time.Time, args CloseStaleReceptionsArgs) (domain.ReceptionInfo, []domain.Event, bool, error) {
+	entries, err := args.ReceptionHistoryRepository.FindAllByReceptionID(ctx, reception.ID)
+	if err != nil {
+		return reception, nil, false, err
+	}
+
+	for _, entry := range entries {
+		if entry.Action == domain.ReceptionFlaggedHistoryAction && !entry.OccurredAtUTC.Before(lastActivityUTC) {
+			return reception, nil, false, nil
+		}
+	}
+
+	if err = reception.FlagStale(lastActivityUTC); err != nil {
+		return reception, nil, false, err
+	}
+
+	events := reception.PullEvents()
+	if err = args.EventOutboxRepository.Add(ctx, events...); err != nil {
+		return reception, nil, false, err
+	}
+
+	if err = recordReceptionHistory(ctx, args.ReceptionHistoryRepository, reception.ID, domain.ReceptionFlaggedHistoryAction, nil, ""); err != nil {
+		return reception, nil, false, err
+	}
+
+	return reception, events, true, nil
+}
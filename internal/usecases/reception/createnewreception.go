@@ -12,7 +12,13 @@ import (
 type CreateNewReceptionArgs struct {
 	usecases.AuthenticationArgs
 	domain.ReceptionInfoRepository
+	domain.ReceptionHistoryRepository
 	domain.PVZRepository
+	domain.PVZAssignmentRepository
+	domain.EventOutboxRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+	domain.EventBus
 
 	PVZ CreateNewReceptionAtPVZDTO
 }
@@ -24,7 +30,8 @@ type CreateNewReceptionAtPVZDTO struct {
 func CreateNewReceptionUseCase(ctx context.Context, args CreateNewReceptionArgs) (domain.ReceptionInfo, error) {
 	auth := args.AuthenticationArgs
 	dto := args.PVZ
-	if _, accessErr := auth.ValidatePrivelegies(ctx, domain.ClientUserRoleID); accessErr != nil {
+	employee, accessErr := auth.RequirePermission(ctx, domain.ReceptionOpenPermission)
+	if accessErr != nil {
 		return domain.ReceptionInfo{}, accessErr
 	}
 
@@ -33,10 +40,38 @@ func CreateNewReceptionUseCase(ctx context.Context, args CreateNewReceptionArgs)
 		return domain.ReceptionInfo{}, err
 	}
 
-	//todo: unit of work here
-	reception, err := pvz.CreateNewReception(ctx, args.ReceptionInfoRepository)
+	if err = usecases.EnsureAssignedToPVZ(ctx, args.PVZAssignmentRepository, employee, pvz.ID); err != nil {
+		return domain.ReceptionInfo{}, err
+	}
+
+	var (
+		reception domain.ReceptionInfo
+		events    []domain.Event
+	)
+	err = args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		reception, err = pvz.CreateNewReception(ctx, args.ReceptionInfoRepository)
+		if err != nil {
+			return err
+		}
+
+		if err = recordReceptionHistory(ctx, args.ReceptionHistoryRepository, reception.ID, domain.ReceptionOpenedHistoryAction, employee, ""); err != nil {
+			return err
+		}
+
+		if err = usecases.Audit(ctx, args.AuditRepository, employee, domain.ReceptionOpenedAuditAction, domain.ReceptionAuditEntityType, reception.ID, nil, reception); err != nil {
+			return err
+		}
+
+		events = pvz.PullEvents()
+		return args.EventOutboxRepository.Add(ctx, events...)
+	})
+	if err != nil {
+		return reception, err
+	}
+
+	args.EventBus.Publish(ctx, events...)
 
-	return reception, err
+	return reception, nil
 }
 
 func (args *CreateNewReceptionAtPVZDTO) validateArguments(ctx context.Context, p domain.PVZRepository) (domain.PVZ, error) {
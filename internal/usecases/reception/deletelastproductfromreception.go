@@ -13,7 +13,12 @@ type DeleteLastProductFromCurrentReceptionAtPVZArgs struct {
 	usecases.AuthenticationArgs
 	domain.ReceptionInfoRepository
 	domain.PVZRepository
+	domain.PVZAssignmentRepository
 	domain.ProductRepository
+	domain.EventOutboxRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+	domain.EventBus
 
 	PVZ DeleteLastProductFromCurrentReceptionAtPVZDTO
 }
@@ -25,7 +30,8 @@ type DeleteLastProductFromCurrentReceptionAtPVZDTO struct {
 func DeleteLastProductFromCurrentReceptionAtPVZUseCase(ctx context.Context, args DeleteLastProductFromCurrentReceptionAtPVZArgs) error {
 	dto := args.PVZ
 	auth := args.AuthenticationArgs
-	if _, accessError := auth.ValidatePrivelegies(ctx, domain.ClientUserRoleID); accessError != nil {
+	employee, accessError := auth.RequirePermission(ctx, domain.ProductRemovePermission)
+	if accessError != nil {
 		return accessError
 	}
 
@@ -34,15 +40,36 @@ func DeleteLastProductFromCurrentReceptionAtPVZUseCase(ctx context.Context, args
 		return argumentsErros
 	}
 
-	//todo: unit of work here
-	reception, err := pvz.CurrentReception(ctx, args.ReceptionInfoRepository)
+	if err := usecases.EnsureAssignedToPVZ(ctx, args.PVZAssignmentRepository, employee, pvz.ID); err != nil {
+		return err
+	}
+
+	var events []domain.Event
+	err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		reception, err := pvz.CurrentReception(ctx, args.ReceptionInfoRepository)
+		if err != nil {
+			return err
+		}
+
+		removed, err := reception.RemoveLastProduct(ctx, employee.ID, args.ProductRepository)
+		if err != nil {
+			return err
+		}
+
+		if err = usecases.Audit(ctx, args.AuditRepository, employee, domain.ProductRemovedAuditAction, domain.ProductAuditEntityType, removed.ID, removed, nil); err != nil {
+			return err
+		}
+
+		events = reception.PullEvents()
+		return args.EventOutboxRepository.Add(ctx, events...)
+	})
 	if err != nil {
 		return err
 	}
 
-	_, err = reception.RemoveLastProduct(ctx, args.ProductRepository)
+	args.EventBus.Publish(ctx, events...)
 
-	return err
+	return nil
 }
 
 func (args *DeleteLastProductFromCurrentReceptionAtPVZDTO) validateArguments(ctx context.Context, r domain.PVZRepository) (domain.PVZ, error) {
@@ -0,0 +1,71 @@
+package reception
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+)
+
+type FindProductsByBarcodeArgs struct {
+	usecases.AuthenticationArgs
+	domain.ProductRepository
+	domain.ReceptionInfoRepository
+	domain.PVZAssignmentRepository
+
+	Barcode string
+}
+
+// FindProductsByBarcodeUseCase looks for every acceptance of parcel, including closed receptions.
+// Employee finds only parcels accepted at pvz they are assigned to
+func FindProductsByBarcodeUseCase(ctx context.Context, args FindProductsByBarcodeArgs) ([]*domain.Product, error) {
+	auth := args.AuthenticationArgs
+	user, accessError := auth.RequirePermission(ctx, domain.PVZReadPermission)
+	if accessError != nil {
+		return nil, accessError
+	}
+
+	if args.Barcode == "" {
+		return nil, errors.New(domain.BarcodeIsRequiredError)
+	}
+
+	filter := domain.SearchProductFilter{
+		Barcode: args.Barcode,
+	}
+
+	products, err := args.ProductRepository.FindAllByFilter(ctx, filter)
+	if err != nil || user.HasPermission(domain.AnyPVZPermission) {
+		return products, err
+	}
+
+	return productsOfAssignedPVZ(ctx, args, user, products)
+}
+
+// parcel is accepted at a few pvz at most, so receptions are looked up one by one
+func productsOfAssignedPVZ(ctx context.Context, args FindProductsByBarcodeArgs, user *domain.User, products []*domain.Product) ([]*domain.Product, error) {
+	assigned := make(map[domain.ReceptionID]bool)
+	visible := make([]*domain.Product, 0, len(products))
+	for _, product := range products {
+		isAssigned, known := assigned[product.ReceptionID]
+		if !known {
+			reception, err := args.ReceptionInfoRepository.FindByID(ctx, product.ReceptionID)
+			if err != nil {
+				return nil, err
+			}
+
+			err = usecases.EnsureAssignedToPVZ(ctx, args.PVZAssignmentRepository, user, reception.PVZID)
+			if err != nil && err.Error() != domain.NotAssignedToPVZError {
+				return nil, err
+			}
+
+			isAssigned = err == nil
+			assigned[product.ReceptionID] = isAssigned
+		}
+
+		if isAssigned {
+			visible = append(visible, product)
+		}
+	}
+
+	return visible, nil
+}
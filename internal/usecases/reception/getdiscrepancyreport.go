@@ -0,0 +1,35 @@
+package reception
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type GetDiscrepancyReportArgs struct {
+	usecases.AuthenticationArgs
+	domain.ReceptionInfoRepository
+	domain.PVZAssignmentRepository
+	domain.DiscrepancyReportRepository
+
+	ReceptionID uuid.UUID
+}
+
+func GetDiscrepancyReportUseCase(ctx context.Context, args GetDiscrepancyReportArgs) (domain.DiscrepancyReport, error) {
+	auth := args.AuthenticationArgs
+	user, accessErr := auth.RequirePermission(ctx, domain.ReportReadPermission)
+	if accessErr != nil {
+		return domain.DiscrepancyReport{}, accessErr
+	} else if args.ReceptionID == uuid.Nil {
+		return domain.DiscrepancyReport{}, errors.New(usecases.IdIsRequiredArgError)
+	}
+
+	if err := ensureAssignedToReceptionPVZ(ctx, args.ReceptionInfoRepository, args.PVZAssignmentRepository, user, args.ReceptionID); err != nil {
+		return domain.DiscrepancyReport{}, err
+	}
+
+	return args.DiscrepancyReportRepository.FindByReceptionID(ctx, args.ReceptionID)
+}
@@ -0,0 +1,35 @@
+package reception
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type GetReceptionActArgs struct {
+	usecases.AuthenticationArgs
+	domain.ReceptionInfoRepository
+	domain.PVZAssignmentRepository
+	domain.ReceptionActRepository
+
+	ReceptionID uuid.UUID
+}
+
+func GetReceptionActUseCase(ctx context.Context, args GetReceptionActArgs) (domain.ReceptionAct, error) {
+	auth := args.AuthenticationArgs
+	user, accessErr := auth.RequirePermission(ctx, domain.ReportReadPermission)
+	if accessErr != nil {
+		return domain.ReceptionAct{}, accessErr
+	} else if args.ReceptionID == uuid.Nil {
+		return domain.ReceptionAct{}, errors.New(usecases.IdIsRequiredArgError)
+	}
+
+	if err := ensureAssignedToReceptionPVZ(ctx, args.ReceptionInfoRepository, args.PVZAssignmentRepository, user, args.ReceptionID); err != nil {
+		return domain.ReceptionAct{}, err
+	}
+
+	return args.ReceptionActRepository.FindByReceptionID(ctx, args.ReceptionID)
+}
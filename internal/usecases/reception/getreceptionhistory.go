@@ -0,0 +1,55 @@
+package reception
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type GetReceptionHistoryArgs struct {
+	usecases.AuthenticationArgs
+	domain.ReceptionInfoRepository
+	domain.ReceptionHistoryRepository
+	domain.PVZAssignmentRepository
+
+	ReceptionID uuid.UUID
+}
+
+// GetReceptionHistoryUseCase tells who opened, closed and reopened reception and why, oldest step first
+func GetReceptionHistoryUseCase(ctx context.Context, args GetReceptionHistoryArgs) ([]domain.ReceptionHistoryEntry, error) {
+	auth := args.AuthenticationArgs
+	user, accessErr := auth.RequirePermission(ctx, domain.ReportReadPermission)
+	if accessErr != nil {
+		return nil, accessErr
+	} else if args.ReceptionID == uuid.Nil {
+		return nil, errors.New(usecases.IdIsRequiredArgError)
+	}
+
+	if err := ensureAssignedToReceptionPVZ(ctx, args.ReceptionInfoRepository, args.PVZAssignmentRepository, user, args.ReceptionID); err != nil {
+		return nil, err
+	}
+
+	return args.ReceptionHistoryRepository.FindAllByReceptionID(ctx, args.ReceptionID)
+}
+
+func recordReceptionHistory(ctx context.Context, history domain.ReceptionHistoryRepository, receptionID domain.ReceptionID, action domain.ReceptionHistoryAction, actor *domain.User, reason string) error {
+	entry, err := domain.NewReceptionHistoryEntry(receptionID, action, usecases.ActorID(actor), reason)
+	if err != nil {
+		return err
+	}
+
+	return history.Add(ctx, entry)
+}
+
+// documents of reception are shown to employees of its pvz only
+func ensureAssignedToReceptionPVZ(ctx context.Context, receptions domain.ReceptionInfoRepository, assignments domain.PVZAssignmentRepository, user *domain.User, receptionID domain.ReceptionID) error {
+	reception, err := receptions.FindByID(ctx, receptionID)
+	if err != nil {
+		return err
+	}
+
+	return usecases.EnsureAssignedToPVZ(ctx, assignments, user, reception.PVZID)
+}
@@ -0,0 +1,78 @@
+package reception
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type LinkShipmentManifestArgs struct {
+	usecases.AuthenticationArgs
+	domain.ReceptionInfoRepository
+	domain.PVZAssignmentRepository
+	domain.ShipmentManifestRepository
+	domain.ReceptionHistoryRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	Link LinkShipmentManifestDTO
+}
+
+type LinkShipmentManifestDTO struct {
+	ReceptionID uuid.UUID
+	ManifestID  uuid.UUID
+}
+
+// LinkShipmentManifestUseCase tells which delivery is accepted with reception in progress,
+// linking another manifest replaces previous one, which could be linked again later
+func LinkShipmentManifestUseCase(ctx context.Context, args LinkShipmentManifestArgs) (domain.ShipmentManifest, error) {
+	dto := args.Link
+	auth := args.AuthenticationArgs
+
+	moderator, accessErr := auth.RequirePermission(ctx, domain.ManifestUploadPermission)
+	if accessErr != nil {
+		return domain.ShipmentManifest{}, accessErr
+	} else if dto.ReceptionID == uuid.Nil || dto.ManifestID == uuid.Nil {
+		return domain.ShipmentManifest{}, errors.New(usecases.IdIsRequiredArgError)
+	}
+
+	if err := ensureAssignedToReceptionPVZ(ctx, args.ReceptionInfoRepository, args.PVZAssignmentRepository, moderator, dto.ReceptionID); err != nil {
+		return domain.ShipmentManifest{}, err
+	}
+
+	var manifest domain.ShipmentManifest
+	err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		reception, err := args.ReceptionInfoRepository.FindByID(ctx, dto.ReceptionID)
+		if err != nil {
+			return err
+		}
+
+		manifest, err = args.ShipmentManifestRepository.FindByID(ctx, dto.ManifestID)
+		if err != nil {
+			return err
+		}
+		unlinked := manifest
+
+		if err = manifest.LinkTo(reception); err != nil {
+			return err
+		}
+
+		if err = args.ShipmentManifestRepository.Link(ctx, manifest.ID, reception.ID); err != nil {
+			return err
+		}
+
+		if err = recordReceptionHistory(ctx, args.ReceptionHistoryRepository, reception.ID, domain.ReceptionManifestLinkedHistoryAction, moderator, ""); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, moderator, domain.ManifestLinkedAuditAction, domain.ShipmentManifestAuditEntityType, manifest.ID, unlinked, manifest)
+	})
+	if err != nil {
+		return domain.ShipmentManifest{}, err
+	}
+
+	return manifest, nil
+}
@@ -0,0 +1,93 @@
+package reception
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type ReopenReceptionArgs struct {
+	usecases.AuthenticationArgs
+	domain.ReceptionInfoRepository
+	domain.PVZAssignmentRepository
+	domain.ReceptionHistoryRepository
+	domain.ReceptionActRepository
+	domain.DiscrepancyReportRepository
+	domain.EventOutboxRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+	domain.EventBus
+
+	Reception ReopenReceptionDTO
+}
+
+type ReopenReceptionDTO struct {
+	ReceptionID uuid.UUID
+	Reason      string
+}
+
+// ReopenReceptionUseCase lets employee continue closed reception instead of splitting delivery,
+// documents issued on close are withdrawn and issued again on the next close
+func ReopenReceptionUseCase(ctx context.Context, args ReopenReceptionArgs) (domain.ReceptionInfo, error) {
+	auth := args.AuthenticationArgs
+	dto := args.Reception
+	moderator, accessErr := auth.RequirePermission(ctx, domain.ReceptionReopenPermission)
+	if accessErr != nil {
+		return domain.ReceptionInfo{}, accessErr
+	} else if dto.ReceptionID == uuid.Nil {
+		return domain.ReceptionInfo{}, errors.New(usecases.IdIsRequiredArgError)
+	}
+
+	if err := ensureAssignedToReceptionPVZ(ctx, args.ReceptionInfoRepository, args.PVZAssignmentRepository, moderator, dto.ReceptionID); err != nil {
+		return domain.ReceptionInfo{}, err
+	}
+
+	var (
+		reception domain.ReceptionInfo
+		events    []domain.Event
+	)
+	err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		reception, err = args.ReceptionInfoRepository.FindByID(ctx, dto.ReceptionID)
+		if err != nil {
+			return err
+		}
+		closed := reception
+
+		if err = reception.Reopen(ctx, dto.Reason, args.ReceptionInfoRepository); err != nil {
+			return err
+		}
+
+		events = reception.PullEvents()
+		if err = args.ReceptionInfoRepository.Update(ctx, reception); err != nil {
+			return err
+		}
+
+		if err = args.ReceptionActRepository.Remove(ctx, reception.ID); err != nil {
+			return err
+		}
+
+		if err = args.DiscrepancyReportRepository.Remove(ctx, reception.ID); err != nil {
+			return err
+		}
+
+		if err = recordReceptionHistory(ctx, args.ReceptionHistoryRepository, reception.ID, domain.ReceptionReopenedHistoryAction, moderator, dto.Reason); err != nil {
+			return err
+		}
+
+		if err = usecases.Audit(ctx, args.AuditRepository, moderator, domain.ReceptionReopenedAuditAction, domain.ReceptionAuditEntityType, reception.ID, closed, reception); err != nil {
+			return err
+		}
+
+		return args.EventOutboxRepository.Add(ctx, events...)
+	})
+	if err != nil {
+		return domain.ReceptionInfo{}, err
+	}
+
+	args.EventBus.Publish(ctx, events...)
+
+	return reception, nil
+}
@@ -0,0 +1,91 @@
+package reception
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type RestoreLastRemovedProductAtPVZArgs struct {
+	usecases.AuthenticationArgs
+	domain.ReceptionInfoRepository
+	domain.PVZRepository
+	domain.PVZAssignmentRepository
+	domain.ProductRepository
+	domain.ReceptionHistoryRepository
+	domain.EventOutboxRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+	domain.EventBus
+
+	PVZ RestoreLastRemovedProductAtPVZDTO
+}
+
+type RestoreLastRemovedProductAtPVZDTO struct {
+	PVZID uuid.UUID
+}
+
+// RestoreLastRemovedProductAtPVZUseCase undoes mistaken removal, it is possible only until reception is closed
+func RestoreLastRemovedProductAtPVZUseCase(ctx context.Context, args RestoreLastRemovedProductAtPVZArgs) (domain.Product, error) {
+	auth := args.AuthenticationArgs
+	employee, accessError := auth.RequirePermission(ctx, domain.ProductRemovePermission)
+	if accessError != nil {
+		return domain.Product{}, accessError
+	}
+
+	if args.PVZ.PVZID == uuid.Nil {
+		return domain.Product{}, errors.New(usecases.IdIsRequiredArgError)
+	}
+
+	pvz, err := args.PVZRepository.FindById(ctx, args.PVZ.PVZID)
+	if err != nil {
+		return domain.Product{}, err
+	}
+
+	if err = usecases.EnsureAssignedToPVZ(ctx, args.PVZAssignmentRepository, employee, pvz.ID); err != nil {
+		return domain.Product{}, err
+	}
+
+	var (
+		restored domain.Product
+		events   []domain.Event
+	)
+	err = args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		reception, err := pvz.CurrentReception(ctx, args.ReceptionInfoRepository)
+		if err != nil {
+			return err
+		}
+
+		// kept for audit, it tells who removed the product and when
+		removed, err := args.ProductRepository.FindLastRemovedByReceptionID(ctx, reception.ID)
+		if err != nil {
+			return err
+		}
+
+		restored, err = reception.RestoreLastRemovedProduct(ctx, args.ProductRepository)
+		if err != nil {
+			return err
+		}
+
+		if err = recordReceptionHistory(ctx, args.ReceptionHistoryRepository, reception.ID, domain.ReceptionProductRestoredHistoryAction, employee, ""); err != nil {
+			return err
+		}
+
+		if err = usecases.Audit(ctx, args.AuditRepository, employee, domain.ProductRestoredAuditAction, domain.ProductAuditEntityType, restored.ID, removed, restored); err != nil {
+			return err
+		}
+
+		events = reception.PullEvents()
+		return args.EventOutboxRepository.Add(ctx, events...)
+	})
+	if err != nil {
+		return domain.Product{}, err
+	}
+
+	args.EventBus.Publish(ctx, events...)
+
+	return restored, nil
+}
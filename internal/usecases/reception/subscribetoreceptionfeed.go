@@ -0,0 +1,142 @@
+package reception
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+const (
+	FeedFilterIsRequiredArgError string = "either pvz id or city is required"
+
+	// dashboard keeps up with scanning easily, larger backlog means it is stuck
+	receptionFeedBuffer int = 64
+)
+
+// events of reception progress, pvz creation is not interesting for dashboard
+var receptionFeedEventTypes = []domain.EventType{
+	domain.ReceptionOpenedEventType,
+	domain.ProductAddedEventType,
+	domain.ProductRemovedEventType,
+	domain.ProductRestoredEventType,
+	domain.ReceptionReopenedEventType,
+	domain.ReceptionClosedEventType,
+	domain.ReceptionStaleEventType,
+}
+
+type SubscribeToReceptionFeedArgs struct {
+	usecases.AuthenticationArgs
+	domain.PVZRepository
+	domain.PVZAssignmentRepository
+	domain.EventBus
+
+	Feed SubscribeToReceptionFeedDTO
+}
+
+// SubscribeToReceptionFeedDTO selects events of single pvz or of every pvz in the city
+type SubscribeToReceptionFeedDTO struct {
+	PVZID *uuid.UUID
+	City  *string
+}
+
+// SubscribeToReceptionFeedUseCase returns reception events of selected pvz as they are committed.
+// Employee sees only events of pvz they are assigned to, city feed skips events of other pvz of the city.
+// Channel is closed when ctx is done, subscription is cancelled or subscriber falls behind.
+func SubscribeToReceptionFeedUseCase(ctx context.Context, args SubscribeToReceptionFeedArgs) (<-chan domain.Event, func(), error) {
+	auth := args.AuthenticationArgs
+	user, accessErr := auth.RequirePermission(ctx, domain.PVZReadPermission)
+	if accessErr != nil {
+		return nil, nil, accessErr
+	}
+
+	match, err := args.Feed.matcher(ctx, args.PVZRepository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if args.Feed.PVZID != nil {
+		if err = usecases.EnsureAssignedToPVZ(ctx, args.PVZAssignmentRepository, user, domain.PVZID(*args.Feed.PVZID)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// assignment is checked for every event, so employee stops seeing pvz as soon as they are unassigned from it
+	assigned := func(ctx context.Context, event domain.Event) bool {
+		return usecases.EnsureAssignedToPVZ(ctx, args.PVZAssignmentRepository, user, event.PVZID) == nil
+	}
+
+	events, unsubscribe := args.EventBus.Subscribe(receptionFeedBuffer)
+	feed := make(chan domain.Event)
+
+	go func() {
+		defer close(feed)
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+
+				if !slices.Contains(receptionFeedEventTypes, event.Type) || !match(ctx, event) || !assigned(ctx, event) {
+					continue
+				}
+
+				select {
+				case feed <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return feed, unsubscribe, nil
+}
+
+func (dto *SubscribeToReceptionFeedDTO) matcher(ctx context.Context, pvzs domain.PVZRepository) (func(context.Context, domain.Event) bool, error) {
+	if (dto.PVZID == nil) == (dto.City == nil) {
+		return nil, errors.New(FeedFilterIsRequiredArgError)
+	}
+
+	if dto.PVZID != nil {
+		pvz, err := pvzs.FindById(ctx, domain.PVZID(*dto.PVZID))
+		if err != nil {
+			return nil, err
+		}
+
+		return func(_ context.Context, event domain.Event) bool {
+			return event.PVZID == pvz.ID
+		}, nil
+	}
+
+	city, err := usecases.ToDomainCity(*dto.City)
+	if err != nil {
+		return nil, err
+	}
+
+	// pvz never moves to other city, so city of every seen pvz is remembered
+	cities := make(map[domain.PVZID]domain.CityID)
+
+	return func(ctx context.Context, event domain.Event) bool {
+		cityID, known := cities[event.PVZID]
+		if !known {
+			pvz, err := pvzs.FindById(ctx, event.PVZID)
+			if err != nil {
+				return false
+			}
+
+			cityID = pvz.City.ID
+			cities[event.PVZID] = cityID
+		}
+
+		return cityID == city.ID
+	}, nil
+}
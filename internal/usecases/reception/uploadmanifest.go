@@ -0,0 +1,79 @@
+package reception
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type UploadShipmentManifestArgs struct {
+	usecases.AuthenticationArgs
+	domain.PVZRepository
+	domain.PVZAssignmentRepository
+	domain.ShipmentManifestRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	Manifest UploadShipmentManifestDTO
+}
+
+type UploadShipmentManifestDTO struct {
+	PVZID uuid.UUID
+	Items []ShipmentManifestItemDTO
+}
+
+type ShipmentManifestItemDTO struct {
+	Barcode         string
+	ProductCategory string
+}
+
+// UploadShipmentManifestUseCase keeps list of parcels expected with delivery at pvz,
+// manifest is compared with accepted products once it is linked to reception
+func UploadShipmentManifestUseCase(ctx context.Context, args UploadShipmentManifestArgs) (domain.ShipmentManifest, error) {
+	dto := args.Manifest
+	auth := args.AuthenticationArgs
+
+	moderator, accessErr := auth.RequirePermission(ctx, domain.ManifestUploadPermission)
+	if accessErr != nil {
+		return domain.ShipmentManifest{}, accessErr
+	} else if dto.PVZID == uuid.Nil {
+		return domain.ShipmentManifest{}, errors.New(usecases.IdIsRequiredArgError)
+	}
+
+	items := make([]domain.ShipmentManifestItem, 0, len(dto.Items))
+	for _, item := range dto.Items {
+		category, err := toDomainCategory(item.ProductCategory)
+		if err != nil {
+			return domain.ShipmentManifest{}, err
+		}
+
+		items = append(items, domain.ShipmentManifestItem{Barcode: item.Barcode, Category: category})
+	}
+
+	pvz, err := args.PVZRepository.FindById(ctx, dto.PVZID)
+	if err != nil {
+		return domain.ShipmentManifest{}, err
+	}
+
+	if err = usecases.EnsureAssignedToPVZ(ctx, args.PVZAssignmentRepository, moderator, pvz.ID); err != nil {
+		return domain.ShipmentManifest{}, err
+	}
+
+	manifest, err := domain.NewShipmentManifest(pvz, moderator.ID, items)
+	if err != nil {
+		return domain.ShipmentManifest{}, err
+	}
+
+	err = args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := args.ShipmentManifestRepository.Add(ctx, manifest); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, moderator, domain.ManifestUploadedAuditAction, domain.ShipmentManifestAuditEntityType, manifest.ID, nil, manifest)
+	})
+
+	return manifest, err
+}
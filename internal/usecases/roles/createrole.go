@@ -0,0 +1,59 @@
+package roles
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type CreateRoleArgs struct {
+	usecases.AuthenticationArgs
+	domain.RoleRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	Role RoleDTO
+}
+
+type RoleDTO struct {
+	Name        string
+	Permissions []string
+	// nil keeps requirement of second factor as is, new role does not require it
+	TwoFactorRequired *bool
+}
+
+func CreateRoleUseCase(ctx context.Context, args CreateRoleArgs) (domain.UserRole, error) {
+	auth := args.AuthenticationArgs
+
+	admin, accessErr := auth.RequirePermission(ctx, domain.RoleManagePermission)
+	if accessErr != nil {
+		return domain.UserRole{}, accessErr
+	}
+
+	role, err := domain.NewUserRole(args.Role.Name, args.Role.Permissions)
+	if err != nil {
+		return domain.UserRole{}, err
+	}
+
+	if args.Role.TwoFactorRequired != nil {
+		role.TwoFactorRequired = *args.Role.TwoFactorRequired
+	}
+
+	err = args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		if role, err = args.RoleRepository.Add(ctx, role); err != nil {
+			return err
+		}
+
+		return auditRole(ctx, args.AuditRepository, admin, domain.RoleCreatedAuditAction, nil, role)
+	})
+
+	return role, err
+}
+
+// roles are identified by small numbers rather than uuid, so record refers to zero entity
+// and the role is found by id in its states
+func auditRole(ctx context.Context, audit domain.AuditRepository, admin *domain.User, action domain.AuditAction, before any, after domain.UserRole) error {
+	return usecases.Audit(ctx, audit, admin, action, domain.RoleAuditEntityType, uuid.Nil, before, after)
+}
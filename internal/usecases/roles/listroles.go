@@ -0,0 +1,21 @@
+package roles
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+)
+
+type ListRolesArgs struct {
+	usecases.AuthenticationArgs
+	domain.RoleRepository
+}
+
+func ListRolesUseCase(ctx context.Context, args ListRolesArgs) ([]domain.UserRole, error) {
+	auth := args.AuthenticationArgs
+	if _, accessErr := auth.RequirePermission(ctx, domain.RoleManagePermission); accessErr != nil {
+		return nil, accessErr
+	}
+
+	return args.RoleRepository.FindAll(ctx)
+}
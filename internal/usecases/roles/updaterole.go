@@ -0,0 +1,52 @@
+package roles
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+)
+
+type UpdateRoleArgs struct {
+	usecases.AuthenticationArgs
+	domain.RoleRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	RoleID domain.UserRoleID
+	Role   RoleDTO
+}
+
+// UpdateRoleUseCase renames role and replaces its permissions, users of the role get new permissions with the next request.
+// Users of role which starts requiring second factor could do nothing until they enroll
+func UpdateRoleUseCase(ctx context.Context, args UpdateRoleArgs) (domain.UserRole, error) {
+	auth := args.AuthenticationArgs
+
+	admin, accessErr := auth.RequirePermission(ctx, domain.RoleManagePermission)
+	if accessErr != nil {
+		return domain.UserRole{}, accessErr
+	}
+
+	var role domain.UserRole
+	err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		if role, err = args.RoleRepository.FindByID(ctx, args.RoleID); err != nil {
+			return err
+		}
+
+		before := role
+		if err = role.Change(args.Role.Name, args.Role.Permissions); err != nil {
+			return err
+		}
+
+		if args.Role.TwoFactorRequired != nil {
+			role.TwoFactorRequired = *args.Role.TwoFactorRequired
+		}
+
+		if err = args.RoleRepository.Update(ctx, role); err != nil {
+			return err
+		}
+
+		return auditRole(ctx, args.AuditRepository, admin, domain.RoleUpdatedAuditAction, before, role)
+	})
+
+	return role, err
+}
@@ -0,0 +1,63 @@
+package serviceaccounts
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+	"net/mail"
+)
+
+type CreateServiceAccountArgs struct {
+	usecases.AuthenticationArgs
+	domain.UserRepository
+	domain.RoleRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	Account CreateServiceAccountDTO
+}
+
+type CreateServiceAccountDTO struct {
+	// identifies the integration, nothing is mailed to it
+	Email string
+	Role  string
+}
+
+// CreateServiceAccountUseCase creates account of integration, it has no password and acts only by api keys
+func CreateServiceAccountUseCase(ctx context.Context, args CreateServiceAccountArgs) (domain.User, error) {
+	dto := args.Account
+	auth := args.AuthenticationArgs
+
+	creator, accessErr := auth.RequirePermission(ctx, domain.ServiceAccountManagePermission)
+	if accessErr != nil {
+		return domain.User{}, accessErr
+	}
+
+	if _, err := mail.ParseAddress(dto.Email); err != nil {
+		return domain.User{}, errors.New(domain.InvalidEmail)
+	}
+
+	var account domain.User
+	err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		role, err := args.RoleRepository.FindByName(ctx, dto.Role)
+		if err != nil {
+			return err
+		}
+
+		if account, err = domain.NewServiceAccount(*creator, domain.Email(dto.Email), role); err != nil {
+			return err
+		}
+
+		if err = args.UserRepository.Add(ctx, account); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, creator, domain.ServiceAccountCreatedAuditAction, domain.UserAuditEntityType, account.ID, nil, account)
+	})
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	return account, nil
+}
@@ -0,0 +1,63 @@
+package serviceaccounts
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+)
+
+type IssueAPIKeyArgs struct {
+	usecases.AuthenticationArgs
+	domain.UserRepository
+	domain.APIKeyRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	ServiceAccountID domain.UserID
+	Key              IssueAPIKeyDTO
+}
+
+type IssueAPIKeyDTO struct {
+	Name  string
+	Scope []domain.Permission
+}
+
+// IssuedAPIKey is shown to its creator once, the key is not kept and could not be shown again
+type IssuedAPIKey struct {
+	domain.APIKey
+	Key string
+}
+
+// IssueAPIKeyUseCase issues key of service account within scope of the account role
+func IssueAPIKeyUseCase(ctx context.Context, args IssueAPIKeyArgs) (IssuedAPIKey, error) {
+	dto := args.Key
+	auth := args.AuthenticationArgs
+
+	creator, accessErr := auth.RequirePermission(ctx, domain.ServiceAccountManagePermission)
+	if accessErr != nil {
+		return IssuedAPIKey{}, accessErr
+	}
+
+	var issued IssuedAPIKey
+	err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		account, err := args.UserRepository.FindByID(ctx, args.ServiceAccountID)
+		if err != nil {
+			return err
+		}
+
+		if issued.APIKey, issued.Key, err = domain.NewAPIKey(*creator, account, dto.Name, dto.Scope); err != nil {
+			return err
+		}
+
+		if err = args.APIKeyRepository.Add(ctx, issued.APIKey); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, creator, domain.APIKeyIssuedAuditAction, domain.APIKeyAuditEntityType, issued.ID, nil, issued.APIKey)
+	})
+	if err != nil {
+		return IssuedAPIKey{}, err
+	}
+
+	return issued, nil
+}
@@ -0,0 +1,33 @@
+package serviceaccounts
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+)
+
+type ListAPIKeysArgs struct {
+	usecases.AuthenticationArgs
+	domain.UserRepository
+	domain.APIKeyRepository
+
+	ServiceAccountID domain.UserID
+}
+
+// ListAPIKeysUseCase lists keys of service account including revoked ones, oldest first
+func ListAPIKeysUseCase(ctx context.Context, args ListAPIKeysArgs) ([]domain.APIKey, error) {
+	auth := args.AuthenticationArgs
+	if _, accessErr := auth.RequirePermission(ctx, domain.ServiceAccountManagePermission); accessErr != nil {
+		return nil, accessErr
+	}
+
+	account, err := args.UserRepository.FindByID(ctx, args.ServiceAccountID)
+	if err != nil {
+		return nil, err
+	} else if !account.ServiceAccount {
+		return nil, errors.New(domain.NotServiceAccountError)
+	}
+
+	return args.APIKeyRepository.FindAllByServiceAccountID(ctx, account.ID)
+}
@@ -0,0 +1,56 @@
+package serviceaccounts
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+	"time"
+)
+
+type RevokeAPIKeyArgs struct {
+	usecases.AuthenticationArgs
+	domain.UserRepository
+	domain.APIKeyRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	ServiceAccountID domain.UserID
+	KeyID            domain.APIKeyID
+}
+
+// RevokeAPIKeyUseCase stops the key from working starting with the next request made with it
+func RevokeAPIKeyUseCase(ctx context.Context, args RevokeAPIKeyArgs) error {
+	auth := args.AuthenticationArgs
+	revoker, accessErr := auth.RequirePermission(ctx, domain.ServiceAccountManagePermission)
+	if accessErr != nil {
+		return accessErr
+	}
+
+	return args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		key, err := args.APIKeyRepository.FindByID(ctx, args.KeyID)
+		if err != nil {
+			return err
+		} else if key.ServiceAccountID != args.ServiceAccountID {
+			return errors.New(domain.APIKeyDoesNotExistError)
+		}
+
+		account, err := args.UserRepository.FindByID(ctx, key.ServiceAccountID)
+		if err != nil {
+			return err
+		} else if err = domain.EnsureCanGrant(*revoker, account.UserRole); err != nil {
+			return err
+		}
+
+		before := key
+		if err = key.Revoke(time.Now()); err != nil {
+			return err
+		}
+
+		if err = args.APIKeyRepository.Revoke(ctx, key); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, revoker, domain.APIKeyRevokedAuditAction, domain.APIKeyAuditEntityType, key.ID, before, key)
+	})
+}
@@ -0,0 +1,31 @@
+package users
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+)
+
+type ChangeUserRoleArgs struct {
+	usecases.AuthenticationArgs
+	domain.UserRepository
+	domain.RoleRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	UserID domain.UserID
+	Role   string
+}
+
+// ChangeUserRoleUseCase promotes or demotes user, new permissions apply from the next request of the user
+func ChangeUserRoleUseCase(ctx context.Context, args ChangeUserRoleArgs) (domain.User, error) {
+	return UpdateUserUseCase(ctx, UpdateUserArgs{
+		AuthenticationArgs: args.AuthenticationArgs,
+		UserRepository:     args.UserRepository,
+		RoleRepository:     args.RoleRepository,
+		AuditRepository:    args.AuditRepository,
+		UnitOfWork:         args.UnitOfWork,
+		UserID:             args.UserID,
+		Changes:            UpdateUserDTO{Role: &args.Role},
+	})
+}
@@ -2,8 +2,9 @@ package users
 
 import (
 	"avito/internal/domain"
-	"errors"
+	"avito/pkg/ratelimit"
 	"net/mail"
+	"strings"
 )
 
 func isValidEmail(email string) bool {
@@ -12,17 +13,46 @@ func isValidEmail(email string) bool {
 	return err == nil
 }
 
-func parseRole(role string) (domain.UserRoleID, error) {
-	switch role {
-	case "moderator":
-		return domain.ModeratorUserRoleID, nil
-	case "employee":
-		return domain.ClientUserRoleID, nil
-	default:
-		return 0, errors.New(domain.UnknownRoleNameError)
-	}
-}
-
 const (
 	PasswordIsRequiredError string = "password is required"
 )
+
+// Throttle protects credential use cases from brute force, nil members are not applied
+type Throttle struct {
+	EmailLimiter *ratelimit.Limiter
+	Lockout      *ratelimit.Lockout
+}
+
+// allow checks attempts of subject, it is email for password and id of user for second factor
+func (t Throttle) allow(subject string) error {
+	key := throttleKey(subject)
+
+	if t.Lockout != nil {
+		if err := t.Lockout.Check(key); err != nil {
+			return err
+		}
+	}
+
+	if t.EmailLimiter != nil {
+		return t.EmailLimiter.Allow(key)
+	}
+
+	return nil
+}
+
+func (t Throttle) record(subject string, signInErr error) {
+	if t.Lockout == nil {
+		return
+	}
+
+	key := throttleKey(subject)
+	if signInErr == nil {
+		t.Lockout.Reset(key)
+	} else if msg := signInErr.Error(); msg == domain.BadUserCredentialError || msg == domain.BadTwoFactorCodeError {
+		t.Lockout.Fail(key)
+	}
+}
+
+func throttleKey(subject string) string {
+	return strings.ToLower(strings.TrimSpace(subject))
+}
@@ -0,0 +1,69 @@
+package users
+
+import (
+	"avito/internal/domain"
+	jwt "avito/pkg/authorization"
+	"context"
+	"errors"
+)
+
+const (
+	DummyLoginIsDisabledError string = "dummy login is disabled"
+	UnknownDummyRoleError     string = "unknown dummy role"
+)
+
+type DummyLoginArgs struct {
+	// dummy login is turned on by config, it is never on in production
+	Enabled bool
+	jwt.JWTManager
+	domain.UserRepository
+	domain.RoleRepository
+	domain.UnitOfWork
+
+	Role string
+}
+
+// DummyLoginUseCase gives token of dummy user with built-in role without password,
+// dummy user is created on the first login as it
+func DummyLoginUseCase(ctx context.Context, args DummyLoginArgs) (jwt.JWT, error) {
+	if !args.Enabled {
+		return "", errors.New(DummyLoginIsDisabledError)
+	}
+
+	roleID, known := dummyRoles[args.Role]
+	if !known {
+		return "", errors.New(UnknownDummyRoleError)
+	}
+
+	var user domain.User
+	err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		email := domain.Email("dummy-" + args.Role + "@example.com")
+		if user, err = args.UserRepository.FindByEmail(ctx, email); err == nil || err.Error() != domain.UserDoesNotExistsError {
+			return err
+		}
+
+		role, err := args.RoleRepository.FindByID(ctx, roleID)
+		if err != nil {
+			return err
+		}
+
+		// empty password never matches, so dummy user could not sign in by password
+		if user, err = domain.NewUser(email, ""); err != nil {
+			return err
+		}
+		domain.GrantRole(&user, role)
+
+		return args.UserRepository.Add(ctx, user)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return args.JWTManager.GenerateToken(user.ID.String())
+}
+
+var dummyRoles = map[string]domain.UserRoleID{
+	domain.EmployeeUserRoleName:  domain.EmployeeUserRoleID,
+	domain.ModeratorUserRoleName: domain.ModeratorUserRoleID,
+	domain.AdminUserRoleName:     domain.AdminUserRoleID,
+}
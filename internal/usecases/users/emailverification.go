@@ -0,0 +1,71 @@
+package users
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+)
+
+type RequestEmailVerificationArgs struct {
+	usecases.AuthenticationArgs
+	domain.UserTokenRepository
+	domain.Mailer
+	domain.UnitOfWork
+}
+
+// RequestEmailVerificationUseCase mails email verification token to the user themselves
+func RequestEmailVerificationUseCase(ctx context.Context, args RequestEmailVerificationArgs) error {
+	user, err := args.AuthorizationService.UserFromCredentials(ctx, args.JWT)
+	if err != nil {
+		return err
+	} else if user.EmailVerified {
+		return errors.New(domain.EmailIsAlreadyVerifiedError)
+	}
+
+	var letter domain.MailMessage
+	err = args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		letter, err = issueUserToken(ctx, args.UserTokenRepository, *user, domain.EmailVerificationTokenPurpose)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return args.Mailer.Send(ctx, letter)
+}
+
+type VerifyEmailArgs struct {
+	domain.UserRepository
+	domain.UserTokenRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	Token string
+}
+
+// VerifyEmailUseCase marks email of user as verified by token mailed to it
+func VerifyEmailUseCase(ctx context.Context, args VerifyEmailArgs) error {
+	if args.Token == "" {
+		return errors.New(TokenIsRequiredError)
+	}
+
+	return args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		user, err := useUserToken(ctx, args.UserTokenRepository, args.UserRepository, domain.EmailVerificationTokenPurpose, args.Token)
+		if err != nil {
+			return err
+		} else if user.EmailVerified {
+			return errors.New(domain.EmailIsAlreadyVerifiedError)
+		}
+
+		before := user
+		user.EmailVerified = true
+
+		if err = args.UserRepository.Update(ctx, user); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, &user, domain.UserEmailVerifiedAuditAction, domain.UserAuditEntityType, user.ID, before, user)
+	})
+}
@@ -0,0 +1,68 @@
+package users
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+)
+
+type InviteUserArgs struct {
+	usecases.AuthenticationArgs
+	domain.RoleRepository
+	domain.InvitationRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	Invitation InviteUserDTO
+}
+
+type InviteUserDTO struct {
+	Role string
+	// optional, invitation without email could be accepted by anyone who holds the token
+	Email *string
+}
+
+// IssuedInvitation is shown to inviter once, the token is not kept and could not be shown again
+type IssuedInvitation struct {
+	domain.Invitation
+	Role  domain.UserRole
+	Token string
+}
+
+// InviteUserUseCase issues single-use invitation to role
+func InviteUserUseCase(ctx context.Context, args InviteUserArgs) (IssuedInvitation, error) {
+	dto := args.Invitation
+	auth := args.AuthenticationArgs
+
+	inviter, accessErr := auth.RequirePermission(ctx, domain.UserInvitePermission)
+	if accessErr != nil {
+		return IssuedInvitation{}, accessErr
+	}
+
+	if dto.Email != nil && !isValidEmail(*dto.Email) {
+		return IssuedInvitation{}, errors.New(domain.InvalidEmail)
+	}
+
+	var issued IssuedInvitation
+	err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		if issued.Role, err = args.RoleRepository.FindByName(ctx, dto.Role); err != nil {
+			return err
+		}
+
+		if issued.Invitation, issued.Token, err = domain.NewInvitation(*inviter, issued.Role, dto.Email); err != nil {
+			return err
+		}
+
+		if err = args.InvitationRepository.Add(ctx, issued.Invitation); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, inviter, domain.InvitationIssuedAuditAction, domain.InvitationAuditEntityType, issued.ID, nil, issued.Invitation)
+	})
+	if err != nil {
+		return IssuedInvitation{}, err
+	}
+
+	return issued, nil
+}
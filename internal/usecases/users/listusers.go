@@ -0,0 +1,68 @@
+package users
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+)
+
+type ListUsersArgs struct {
+	usecases.AuthenticationArgs
+	domain.UserRepository
+	domain.RoleRepository
+
+	Filter ListUsersDTO
+}
+
+// ListUsersDTO narrows users down, nil and empty fields match any user
+type ListUsersDTO struct {
+	// part of email, case is ignored
+	Email  string
+	Role   string
+	Active *bool
+	Page   int
+	Limit  int
+}
+
+// ListUsersUseCase returns users in order of registration
+func ListUsersUseCase(ctx context.Context, args ListUsersArgs) ([]domain.User, error) {
+	auth := args.AuthenticationArgs
+	if _, accessErr := auth.RequirePermission(ctx, domain.UserManagePermission); accessErr != nil {
+		return nil, accessErr
+	}
+
+	dto := args.Filter
+	dto.fixArgsIfNeeded()
+
+	filter := domain.SearchUserFilter{
+		Email: dto.Email,
+		Page:  dto.Page,
+		Limit: dto.Limit,
+	}
+
+	if dto.Role != "" {
+		role, err := args.RoleRepository.FindByName(ctx, dto.Role)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.RoleID = &role.ID
+	}
+
+	if dto.Active != nil {
+		deactivated := !*dto.Active
+		filter.Deactivated = &deactivated
+	}
+
+	return args.UserRepository.FindAllByFilter(ctx, filter)
+}
+
+func (args *ListUsersDTO) fixArgsIfNeeded() {
+	if args.Limit <= 0 {
+		args.Limit = 10
+	}
+
+	if args.Page < 1 {
+		args.Page = 1
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 type LoginUserUseCaseArgs struct {
 	AuthorizationService domain.AuthorizationService[jwt.JWT]
+	Throttle
 
 	User LoginUserDTO
 }
@@ -18,6 +19,8 @@ type LoginUserDTO struct {
 	Password string
 }
 
+// LoginUserUseCase gives token to user, user with second factor gets *domain.TwoFactorRequiredError
+// and the token is given by ConfirmLoginUseCase
 func LoginUserUseCase(ctx context.Context, args LoginUserUseCaseArgs) (jwt.JWT, error) {
 	loginDto := args.User
 
@@ -27,7 +30,51 @@ func LoginUserUseCase(ctx context.Context, args LoginUserUseCaseArgs) (jwt.JWT,
 		return "", errors.New(PasswordIsRequiredError)
 	}
 
+	if err := args.Throttle.allow(loginDto.Email); err != nil {
+		return "", err
+	}
+
 	token, err := args.AuthorizationService.SignIn(ctx, domain.Email(loginDto.Email), loginDto.Password)
+	args.Throttle.record(loginDto.Email, err)
+
+	return token, err
+}
+
+type ConfirmLoginArgs struct {
+	AuthorizationService domain.AuthorizationService[jwt.JWT]
+	Throttle
+
+	Login ConfirmLoginDTO
+}
+
+type ConfirmLoginDTO struct {
+	Challenge string
+	// totp code or recovery code
+	Code string
+}
+
+// ConfirmLoginUseCase is the second login step, it gives token for challenge of the first step and code of second factor.
+// Attempts are throttled by user the challenge is given to, so new challenge does not give the attacker new attempts
+func ConfirmLoginUseCase(ctx context.Context, args ConfirmLoginArgs) (jwt.JWT, error) {
+	dto := args.Login
+
+	if dto.Challenge == "" {
+		return "", errors.New(domain.BadUserCredentialError)
+	} else if dto.Code == "" {
+		return "", errors.New(domain.TwoFactorCodeIsRequiredError)
+	}
+
+	userID, err := args.AuthorizationService.ChallengedUserID(dto.Challenge)
+	if err != nil {
+		return "", err
+	}
+
+	if err = args.Throttle.allow(userID.String()); err != nil {
+		return "", err
+	}
+
+	token, err := args.AuthorizationService.SignInSecondFactor(ctx, dto.Challenge, dto.Code)
+	args.Throttle.record(userID.String(), err)
 
 	return token, err
 }
@@ -0,0 +1,128 @@
+package users
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	jwt "avito/pkg/authorization"
+	"context"
+	"errors"
+	"log"
+)
+
+type RequestPasswordResetArgs struct {
+	Throttle
+	domain.UserRepository
+	domain.UserTokenRepository
+	domain.Mailer
+	domain.UnitOfWork
+
+	Email string
+}
+
+// RequestPasswordResetUseCase mails password reset token to active user. It succeeds the same way
+// whether user exists or not, so it could not be used to find out registered emails. Letter is sent
+// in background, neither mail failure nor time taken by mail server tells that user exists
+func RequestPasswordResetUseCase(ctx context.Context, args RequestPasswordResetArgs) error {
+	if !isValidEmail(args.Email) {
+		return errors.New(domain.InvalidEmail)
+	}
+
+	if err := args.Throttle.allow(args.Email); err != nil {
+		return err
+	}
+
+	var (
+		letter *domain.MailMessage
+		userID domain.UserID
+	)
+	err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		user, err := args.UserRepository.FindByEmail(ctx, domain.Email(args.Email))
+		if err != nil {
+			if err.Error() == domain.UserDoesNotExistsError {
+				return nil
+			}
+			return err
+		} else if user.Deactivated || user.ServiceAccount || user.SSOSubject != nil {
+			// service accounts have no password, they use api keys, and users of single sign-on sign in at identity provider
+			return nil
+		}
+
+		issued, err := issueUserToken(ctx, args.UserTokenRepository, user, domain.PasswordResetTokenPurpose)
+		if err != nil {
+			return err
+		}
+
+		letter = &issued
+		userID = user.ID
+
+		return nil
+	})
+	if err != nil || letter == nil {
+		return err
+	}
+
+	// token is issued anyway, reset could be requested again
+	go func(ctx context.Context) {
+		if err := args.Mailer.Send(ctx, *letter); err != nil {
+			log.Printf("could not mail password reset token to user %s: %v", userID, err)
+		}
+	}(context.WithoutCancel(ctx))
+
+	return nil
+}
+
+type ResetPasswordArgs struct {
+	AuthorizationService domain.AuthorizationService[jwt.JWT]
+	Throttle
+	domain.UserRepository
+	domain.UserTokenRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	Reset ResetPasswordDTO
+}
+
+type ResetPasswordDTO struct {
+	Token    string
+	Password string
+}
+
+// ResetPasswordUseCase sets new password of user who holds password reset token. Token came by mail,
+// so it proves the email as well and lifts lockout of the user
+func ResetPasswordUseCase(ctx context.Context, args ResetPasswordArgs) error {
+	dto := args.Reset
+
+	if dto.Token == "" {
+		return errors.New(TokenIsRequiredError)
+	} else if dto.Password == "" {
+		return errors.New(PasswordIsRequiredError)
+	}
+
+	var user domain.User
+	err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		if user, err = useUserToken(ctx, args.UserTokenRepository, args.UserRepository, domain.PasswordResetTokenPurpose, dto.Token); err != nil {
+			return err
+		} else if user.Deactivated {
+			return errors.New(domain.UserIsDeactivatedError)
+		}
+
+		before := user
+		if err = args.AuthorizationService.SetPassword(&user, dto.Password); err != nil {
+			return err
+		}
+		user.EmailVerified = true
+
+		if err = args.UserRepository.Update(ctx, user); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, &user, domain.UserPasswordResetAuditAction, domain.UserAuditEntityType, user.ID, before, user)
+	})
+	if err != nil {
+		return err
+	}
+
+	args.Throttle.record(string(user.Email), nil)
+
+	return nil
+}
@@ -2,13 +2,23 @@ package users
 
 import (
 	"avito/internal/domain"
+	"avito/internal/usecases"
 	jwt "avito/pkg/authorization"
 	"context"
 	"errors"
+	"log"
+	"time"
 )
 
 type RegisterUserUseCaseArgs struct {
 	AuthorizationService domain.AuthorizationService[jwt.JWT]
+	Throttle
+	domain.RoleRepository
+	domain.InvitationRepository
+	domain.UserTokenRepository
+	domain.Mailer
+	domain.AuditRepository
+	domain.UnitOfWork
 
 	User RegisterUserDTO
 }
@@ -16,9 +26,12 @@ type RegisterUserUseCaseArgs struct {
 type RegisterUserDTO struct {
 	Email    string
 	Password string
-	Role     string
+	// optional, without invitation user is registered as employee
+	InviteToken string
 }
 
+// RegisterUserUseCase registers employee, other roles are granted only by invitation
+// or later by someone who has them. Email verification token is mailed to the new user
 func RegisterUserUseCase(ctx context.Context, args RegisterUserUseCaseArgs) (*domain.User, error) {
 	registerDto := args.User
 
@@ -28,13 +41,67 @@ func RegisterUserUseCase(ctx context.Context, args RegisterUserUseCaseArgs) (*do
 		return nil, errors.New(PasswordIsRequiredError)
 	}
 
-	roleId, err := parseRole(registerDto.Role)
+	if err := args.Throttle.allow(registerDto.Email); err != nil {
+		return nil, err
+	}
+
+	var user *domain.User
+	var letter domain.MailMessage
+	err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		var invitation *domain.Invitation
+		roleID := domain.EmployeeUserRoleID
+		if registerDto.InviteToken != "" {
+			found, err := args.InvitationRepository.FindByTokenHash(ctx, domain.HashToken(registerDto.InviteToken))
+			if err != nil {
+				return err
+			}
+			invitation, roleID = &found, found.RoleID
+		}
+
+		role, err := args.RoleRepository.FindByID(ctx, roleID)
+		if err != nil {
+			return err
+		}
+
+		user, err = args.AuthorizationService.SignUp(ctx, domain.Email(registerDto.Email), registerDto.Password, role)
+		if err != nil {
+			return err
+		}
+
+		if invitation != nil {
+			if err = acceptInvitation(ctx, args, invitation, *user); err != nil {
+				return err
+			}
+		}
+
+		if letter, err = issueUserToken(ctx, args.UserTokenRepository, *user, domain.EmailVerificationTokenPurpose); err != nil {
+			return err
+		}
+
+		// user registers itself, so it is the actor of its own creation
+		return usecases.Audit(ctx, args.AuditRepository, user, domain.UserRegisteredAuditAction, domain.UserAuditEntityType, user.ID, nil, user)
+	})
 	if err != nil {
-		roleId = domain.ClientUserRoleID
+		return nil, err
 	}
 
-	//todo: unit of work
-	user, err := args.AuthorizationService.SignUp(ctx, domain.Email(registerDto.Email), registerDto.Password, roleId)
+	// user is registered anyway, verification letter could be requested again
+	if err = args.Mailer.Send(ctx, letter); err != nil {
+		log.Printf("could not mail email verification token to user %s: %v", user.ID, err)
+	}
+
+	return user, nil
+}
+
+func acceptInvitation(ctx context.Context, args RegisterUserUseCaseArgs, invitation *domain.Invitation, user domain.User) error {
+	before := *invitation
+	if err := invitation.Accept(user, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	if err := args.InvitationRepository.Accept(ctx, *invitation); err != nil {
+		return err
+	}
 
-	return user, err
+	return usecases.Audit(ctx, args.AuditRepository, &user, domain.InvitationAcceptedAuditAction, domain.InvitationAuditEntityType, invitation.ID, before, *invitation)
 }
@@ -0,0 +1,182 @@
+package users
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	jwt "avito/pkg/authorization"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+const (
+	SSOIsDisabledError string = "single sign-on is disabled"
+)
+
+type StartSSOLoginArgs struct {
+	// nil when single sign-on is not configured
+	domain.IdentityProvider
+	domain.SSOLoginRepository
+	domain.UnitOfWork
+}
+
+// StartSSOLoginUseCase returns url of identity provider the user is sent to and hash of state browser keeps,
+// login is kept until user comes back
+func StartSSOLoginUseCase(ctx context.Context, args StartSSOLoginArgs) (url string, stateHash string, err error) {
+	if args.IdentityProvider == nil {
+		return "", "", errors.New(SSOIsDisabledError)
+	}
+
+	login, state, err := domain.NewSSOLogin()
+	if err != nil {
+		return "", "", err
+	}
+
+	url, err = args.IdentityProvider.AuthorizationURL(ctx, state, login.Nonce, login.CodeVerifier)
+	if err != nil {
+		return "", "", err
+	}
+
+	err = args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		// logins users abandoned are never taken, so they are cleaned up by new ones
+		if err := args.SSOLoginRepository.DeleteExpired(ctx, login.CreationTimeUTC); err != nil {
+			return err
+		}
+
+		return args.SSOLoginRepository.Add(ctx, login)
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return url, login.StateHash, nil
+}
+
+type CompleteSSOLoginArgs struct {
+	// nil when single sign-on is not configured
+	domain.IdentityProvider
+	domain.SSORoleMapping
+	jwt.JWTManager
+	domain.SSOLoginRepository
+	domain.UserRepository
+	domain.RoleRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	Login CompleteSSOLoginDTO
+}
+
+// CompleteSSOLoginDTO is what identity provider sends user back with
+type CompleteSSOLoginDTO struct {
+	Code  string
+	State string
+	// set instead of code when provider did not let user in
+	Error string
+	// hash of state browser was given on redirect to identity provider
+	BrowserStateHash string
+}
+
+// CompleteSSOLoginUseCase redeems code of identity provider and gives token of user. User is provisioned on the first
+// login, existing user with email verified by provider is linked, and role follows groups of user at provider
+func CompleteSSOLoginUseCase(ctx context.Context, args CompleteSSOLoginArgs) (jwt.JWT, error) {
+	if args.IdentityProvider == nil {
+		return "", errors.New(SSOIsDisabledError)
+	}
+
+	dto := args.Login
+	if dto.State == "" {
+		return "", errors.New(domain.SSOLoginIsInvalidError)
+	} else if err := domain.EnsureStateOfBrowser(dto.State, dto.BrowserStateHash); err != nil {
+		return "", err
+	}
+
+	// login is taken before anything else, so its state could not be replayed whatever happens next
+	login, err := args.SSOLoginRepository.Take(ctx, domain.HashToken(dto.State))
+	if err != nil {
+		if err.Error() == domain.SSOLoginDoesNotExistError {
+			return "", errors.New(domain.SSOLoginIsInvalidError)
+		}
+		return "", err
+	} else if err = login.EnsureNotExpired(time.Now()); err != nil {
+		return "", err
+	}
+
+	if dto.Error != "" {
+		return "", errors.New(domain.SSOLoginIsRefusedError)
+	} else if dto.Code == "" {
+		return "", errors.New(domain.SSOLoginIsInvalidError)
+	}
+
+	identity, err := args.IdentityProvider.Exchange(ctx, dto.Code, login.CodeVerifier, login.Nonce)
+	if err != nil {
+		log.Printf("single sign-on code exchange failed: %v", err)
+		return "", errors.New(domain.SSOLoginIsRefusedError)
+	}
+
+	roleName, err := args.SSORoleMapping.RoleFor(identity.Groups)
+	if err != nil {
+		return "", err
+	}
+
+	var user domain.User
+	err = args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		role, err := args.RoleRepository.FindByName(ctx, roleName)
+		if err != nil {
+			return err
+		}
+
+		if user, err = ssoUser(ctx, args, identity, role); err != nil {
+			return err
+		} else if user.Deactivated {
+			return errors.New(domain.UserIsDeactivatedError)
+		}
+
+		if user.UserRole.ID == role.ID {
+			return nil
+		}
+
+		// groups at provider are the source of roles of its users, change is made by the service itself
+		before := user
+		domain.GrantRole(&user, role)
+		if err = args.UserRepository.Update(ctx, user); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, nil, domain.UserRoleChangedAuditAction, domain.UserAuditEntityType, user.ID, before, user)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return args.JWTManager.GenerateToken(user.ID.String())
+}
+
+// ssoUser finds user known by subject, otherwise links user with the same email or provisions new one with role
+func ssoUser(ctx context.Context, args CompleteSSOLoginArgs, identity domain.ExternalIdentity, role domain.UserRole) (domain.User, error) {
+	user, err := args.UserRepository.FindBySSOSubject(ctx, identity.Subject)
+	if err == nil || err.Error() != domain.UserDoesNotExistsError {
+		return user, err
+	}
+
+	if user, err = args.UserRepository.FindByEmail(ctx, identity.Email); err == nil {
+		before := user
+		if err = user.LinkSSO(identity); err != nil {
+			return domain.User{}, err
+		} else if err = args.UserRepository.Update(ctx, user); err != nil {
+			return domain.User{}, err
+		}
+
+		return user, usecases.Audit(ctx, args.AuditRepository, &user, domain.UserSSOLinkedAuditAction, domain.UserAuditEntityType, user.ID, before, user)
+	} else if err.Error() != domain.UserDoesNotExistsError {
+		return domain.User{}, err
+	}
+
+	if user, err = domain.NewSSOUser(identity, role); err != nil {
+		return domain.User{}, err
+	} else if err = args.UserRepository.Add(ctx, user); err != nil {
+		return domain.User{}, err
+	}
+
+	return user, usecases.Audit(ctx, args.AuditRepository, &user, domain.UserSSOProvisionedAuditAction, domain.UserAuditEntityType, user.ID, nil, user)
+}
@@ -0,0 +1,108 @@
+package users
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+)
+
+type TwoFactorArgs struct {
+	usecases.AuthenticationArgs
+	domain.UserRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+}
+
+type TwoFactorEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// EnrollTwoFactorUseCase gives user new totp secret, second factor is enabled only when ConfirmTwoFactorUseCase
+// gets the first code. It needs authentication only, so user whose role requires second factor could enroll
+func EnrollTwoFactorUseCase(ctx context.Context, args TwoFactorArgs) (TwoFactorEnrollment, error) {
+	user, err := args.AuthorizationService.UserFromCredentials(ctx, args.JWT)
+	if err != nil {
+		return TwoFactorEnrollment{}, err
+	}
+
+	var enrollment TwoFactorEnrollment
+	err = args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		before := user.TwoFactor
+		if enrollment.Secret, enrollment.ProvisioningURI, err = args.AuthorizationService.BeginTwoFactorEnrollment(user); err != nil {
+			return err
+		}
+
+		// only second factor is written, so changes of role or deactivation made meanwhile are kept,
+		// and second factor enabled meanwhile is not replaced with the new enrollment
+		return args.UserRepository.UpdateTwoFactor(ctx, user.ID, before, user.TwoFactor)
+	})
+	if err != nil {
+		return TwoFactorEnrollment{}, err
+	}
+
+	return enrollment, nil
+}
+
+// ConfirmTwoFactorUseCase enables second factor by the first code and returns recovery codes, they are shown only once
+func ConfirmTwoFactorUseCase(ctx context.Context, args TwoFactorArgs, code string) ([]string, error) {
+	if code == "" {
+		return nil, errors.New(domain.TwoFactorCodeIsRequiredError)
+	}
+
+	user, err := args.AuthorizationService.UserFromCredentials(ctx, args.JWT)
+	if err != nil {
+		return nil, err
+	}
+
+	var recoveryCodes []string
+	err = args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		before := *user
+		if recoveryCodes, err = args.AuthorizationService.ConfirmTwoFactorEnrollment(user, code); err != nil {
+			return err
+		}
+
+		// only the secret user confirmed is enabled
+		if err = args.UserRepository.UpdateTwoFactor(ctx, user.ID, before.TwoFactor, user.TwoFactor); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, user, domain.UserTwoFactorEnabledAuditAction, domain.UserAuditEntityType, user.ID, before, *user)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
+
+// DisableTwoFactorUseCase removes second factor of user who proves they still have it by totp or recovery code
+func DisableTwoFactorUseCase(ctx context.Context, args TwoFactorArgs, code string) error {
+	if code == "" {
+		return errors.New(domain.TwoFactorCodeIsRequiredError)
+	}
+
+	user, err := args.AuthorizationService.UserFromCredentials(ctx, args.JWT)
+	if err != nil {
+		return err
+	}
+
+	return args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		before := *user
+		if err := args.AuthorizationService.VerifySecondFactor(ctx, user, code); err != nil {
+			return err
+		}
+
+		spent := user.TwoFactor
+		if err := user.DisableTwoFactor(); err != nil {
+			return err
+		}
+
+		if err := args.UserRepository.UpdateTwoFactor(ctx, user.ID, spent, user.TwoFactor); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, user, domain.UserTwoFactorDisabledAuditAction, domain.UserAuditEntityType, user.ID, before, *user)
+	})
+}
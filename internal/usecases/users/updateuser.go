@@ -0,0 +1,117 @@
+package users
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+)
+
+type UpdateUserArgs struct {
+	usecases.AuthenticationArgs
+	domain.UserRepository
+	domain.RoleRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	UserID  domain.UserID
+	Changes UpdateUserDTO
+}
+
+// UpdateUserDTO lists changes of user, nil fields are left as is
+type UpdateUserDTO struct {
+	Role   *string
+	Active *bool
+}
+
+// UpdateUserUseCase changes role and active status of user, changes apply from the next request of the user.
+// Role is changed with user:promote permission, active status with user:manage
+func UpdateUserUseCase(ctx context.Context, args UpdateUserArgs) (domain.User, error) {
+	auth := args.AuthenticationArgs
+	changes := args.Changes
+
+	required := changes.requiredPermissions()
+	granter, accessErr := auth.RequirePermission(ctx, required[0])
+	if accessErr != nil {
+		return domain.User{}, accessErr
+	}
+
+	for _, permission := range required[1:] {
+		if !granter.HasPermission(permission) {
+			return domain.User{}, errors.New(domain.InsufficientPrivilegesError)
+		}
+	}
+
+	var user domain.User
+	err := args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+		if user, err = args.UserRepository.FindByID(ctx, args.UserID); err != nil {
+			return err
+		}
+
+		type change struct {
+			action        domain.AuditAction
+			before, after domain.User
+		}
+		var changed []change
+
+		if changes.Role != nil {
+			role, err := args.RoleRepository.FindByName(ctx, *changes.Role)
+			if err != nil {
+				return err
+			}
+
+			before := user
+			if err = domain.ChangeRole(*granter, &user, role); err != nil {
+				return err
+			}
+
+			changed = append(changed, change{domain.UserRoleChangedAuditAction, before, user})
+		}
+
+		if changes.Active != nil {
+			before := user
+			if err = domain.SetActive(*granter, &user, *changes.Active); err != nil {
+				return err
+			}
+
+			action := domain.UserActivatedAuditAction
+			if user.Deactivated {
+				action = domain.UserDeactivatedAuditAction
+			}
+
+			changed = append(changed, change{action, before, user})
+		}
+
+		if len(changed) == 0 {
+			return nil
+		}
+
+		if err = args.UserRepository.Update(ctx, user); err != nil {
+			return err
+		}
+
+		for _, c := range changed {
+			if err = usecases.Audit(ctx, args.AuditRepository, granter, c.action, domain.UserAuditEntityType, user.ID, c.before, c.after); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return user, err
+}
+
+// empty update only shows user, so it needs the same permission as listing
+func (dto UpdateUserDTO) requiredPermissions() []domain.Permission {
+	var permissions []domain.Permission
+	if dto.Role != nil {
+		permissions = append(permissions, domain.UserPromotePermission)
+	}
+
+	if dto.Active != nil || len(permissions) == 0 {
+		permissions = append(permissions, domain.UserManagePermission)
+	}
+
+	return permissions
+}
@@ -0,0 +1,65 @@
+package users
+
+import (
+	"avito/internal/domain"
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	TokenIsRequiredError string = "token is required"
+)
+
+// issueUserToken stores token of purpose for user and returns letter with it,
+// the letter is sent after commit, so it never carries token that was not saved
+func issueUserToken(ctx context.Context, tokens domain.UserTokenRepository, user domain.User, purpose domain.UserTokenPurpose) (domain.MailMessage, error) {
+	lifetime, subject, text := userTokenLetter(purpose)
+
+	token, secret, err := domain.NewUserToken(user, purpose, lifetime)
+	if err != nil {
+		return domain.MailMessage{}, err
+	}
+
+	if err = tokens.Add(ctx, token); err != nil {
+		return domain.MailMessage{}, err
+	}
+
+	return domain.MailMessage{
+		To:      user.Email,
+		Subject: subject,
+		Body:    fmt.Sprintf(text, secret, int(lifetime.Hours())),
+	}, nil
+}
+
+// useUserToken spends token of purpose and returns the user it was issued to
+func useUserToken(ctx context.Context, tokens domain.UserTokenRepository, usersRepository domain.UserRepository, purpose domain.UserTokenPurpose, secret string) (domain.User, error) {
+	token, err := tokens.FindByTokenHash(ctx, purpose, domain.HashToken(secret))
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if err = token.Use(time.Now().UTC()); err != nil {
+		return domain.User{}, err
+	}
+
+	if err = tokens.Use(ctx, token); err != nil {
+		return domain.User{}, err
+	}
+
+	return usersRepository.FindByID(ctx, token.UserID)
+}
+
+func userTokenLetter(purpose domain.UserTokenPurpose) (lifetime time.Duration, subject string, text string) {
+	switch purpose {
+	case domain.PasswordResetTokenPurpose:
+		return domain.PasswordResetTokenLifetime,
+			"Сброс пароля",
+			"Для установки нового пароля передайте токен %s в POST /password_reset/confirm. Токен действует %d ч.\n" +
+				"Если вы не запрашивали сброс пароля, проигнорируйте это письмо."
+	default:
+		return domain.EmailVerificationTokenLifetime,
+			"Подтверждение email",
+			"Для подтверждения email передайте токен %s в POST /email_verification/confirm. Токен действует %d ч."
+	}
+}
@@ -0,0 +1,97 @@
+package webhooks
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"avito/pkg/netguard"
+	"context"
+	"errors"
+	"log"
+	"net/url"
+
+	"github.com/google/uuid"
+)
+
+type CreateWebhookSubscriptionArgs struct {
+	usecases.AuthenticationArgs
+	domain.PVZRepository
+	domain.WebhookSubscriptionRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+	// url of subscription could point to loopback, link-local or private address
+	PrivateNetworksAllowed bool
+
+	Subscription CreateWebhookSubscriptionDTO
+}
+
+type CreateWebhookSubscriptionDTO struct {
+	URL        string
+	EventTypes []string
+	// optional filters, subscription without them receives events of every pvz
+	PVZID  *uuid.UUID
+	City   *string
+	Secret string
+}
+
+func CreateWebhookSubscriptionUseCase(ctx context.Context, args CreateWebhookSubscriptionArgs) (domain.WebhookSubscription, error) {
+	dto := args.Subscription
+	auth := args.AuthenticationArgs
+
+	moderator, accessErr := auth.RequirePermission(ctx, domain.WebhookManagePermission)
+	if accessErr != nil {
+		return domain.WebhookSubscription{}, accessErr
+	}
+
+	if dto.PVZID != nil {
+		if _, err := args.PVZRepository.FindById(ctx, *dto.PVZID); err != nil {
+			return domain.WebhookSubscription{}, err
+		}
+	}
+
+	var cityID *domain.CityID
+	if dto.City != nil {
+		city, err := usecases.ToDomainCity(*dto.City)
+		if err != nil {
+			return domain.WebhookSubscription{}, err
+		}
+		cityID = &city.ID
+	}
+
+	subscription, err := domain.NewWebhookSubscription(dto.URL, dto.EventTypes, dto.PVZID, cityID, dto.Secret, moderator.ID)
+	if err != nil {
+		return domain.WebhookSubscription{}, err
+	}
+
+	if !args.PrivateNetworksAllowed {
+		if err = ensurePublicURL(ctx, subscription.URL); err != nil {
+			return domain.WebhookSubscription{}, err
+		}
+	}
+
+	err = args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := args.WebhookSubscriptionRepository.Add(ctx, subscription); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, moderator, domain.WebhookSubscribedAuditAction, domain.WebhookSubscriptionAuditEntityType, subscription.ID, nil, subscription)
+	})
+
+	return subscription, err
+}
+
+// address is checked again on every delivery, see netguard.Control, name could be pointed elsewhere since
+func ensurePublicURL(ctx context.Context, rawURL string) error {
+	endpoint, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.New(domain.InvalidWebhookURLError)
+	}
+
+	if err = netguard.EnsurePublicHost(ctx, endpoint.Hostname()); err != nil {
+		if err.Error() != netguard.NotPublicAddressError {
+			log.Printf("could not resolve webhook host %s: %v", endpoint.Hostname(), err)
+		}
+		return errors.New(domain.WebhookURLIsNotPublicError)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package webhooks
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultDeliveryAttemptsLimit int    = 50
+	InvalidLimitArgError         string = "limit must be between 1 and 100"
+)
+
+type ListWebhookDeliveryAttemptsArgs struct {
+	usecases.AuthenticationArgs
+	domain.WebhookSubscriptionRepository
+	domain.WebhookDeliveryAttemptRepository
+
+	SubscriptionID uuid.UUID
+	// nil means DefaultDeliveryAttemptsLimit
+	Limit *int
+}
+
+// ListWebhookDeliveryAttemptsUseCase returns the latest attempts to deliver events to subscriber
+func ListWebhookDeliveryAttemptsUseCase(ctx context.Context, args ListWebhookDeliveryAttemptsArgs) ([]domain.WebhookDeliveryAttempt, error) {
+	auth := args.AuthenticationArgs
+	if _, accessErr := auth.RequirePermission(ctx, domain.WebhookManagePermission); accessErr != nil {
+		return nil, accessErr
+	} else if args.SubscriptionID == uuid.Nil {
+		return nil, errors.New(usecases.IdIsRequiredArgError)
+	}
+
+	limit := DefaultDeliveryAttemptsLimit
+	if args.Limit != nil {
+		limit = *args.Limit
+	}
+	if limit < 1 || limit > 100 {
+		return nil, errors.New(InvalidLimitArgError)
+	}
+
+	if _, err := args.WebhookSubscriptionRepository.FindByID(ctx, args.SubscriptionID); err != nil {
+		return nil, err
+	}
+
+	return args.WebhookDeliveryAttemptRepository.FindAllBySubscriptionID(ctx, args.SubscriptionID, limit)
+}
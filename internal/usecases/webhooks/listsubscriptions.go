@@ -0,0 +1,21 @@
+package webhooks
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+)
+
+type ListWebhookSubscriptionsArgs struct {
+	usecases.AuthenticationArgs
+	domain.WebhookSubscriptionRepository
+}
+
+func ListWebhookSubscriptionsUseCase(ctx context.Context, args ListWebhookSubscriptionsArgs) ([]domain.WebhookSubscription, error) {
+	auth := args.AuthenticationArgs
+	if _, accessErr := auth.RequirePermission(ctx, domain.WebhookManagePermission); accessErr != nil {
+		return nil, accessErr
+	}
+
+	return args.WebhookSubscriptionRepository.FindAll(ctx)
+}
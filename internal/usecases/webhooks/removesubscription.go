@@ -0,0 +1,43 @@
+package webhooks
+
+import (
+	"avito/internal/domain"
+	"avito/internal/usecases"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type RemoveWebhookSubscriptionArgs struct {
+	usecases.AuthenticationArgs
+	domain.WebhookSubscriptionRepository
+	domain.AuditRepository
+	domain.UnitOfWork
+
+	SubscriptionID uuid.UUID
+}
+
+// RemoveWebhookSubscriptionUseCase stops deliveries to subscriber and forgets its delivery attempts
+func RemoveWebhookSubscriptionUseCase(ctx context.Context, args RemoveWebhookSubscriptionArgs) error {
+	auth := args.AuthenticationArgs
+	moderator, accessErr := auth.RequirePermission(ctx, domain.WebhookManagePermission)
+	if accessErr != nil {
+		return accessErr
+	} else if args.SubscriptionID == uuid.Nil {
+		return errors.New(usecases.IdIsRequiredArgError)
+	}
+
+	return args.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+		subscription, err := args.WebhookSubscriptionRepository.FindByID(ctx, args.SubscriptionID)
+		if err != nil {
+			return err
+		}
+
+		if err := args.WebhookSubscriptionRepository.Remove(ctx, subscription.ID); err != nil {
+			return err
+		}
+
+		return usecases.Audit(ctx, args.AuditRepository, moderator, domain.WebhookUnsubscribedAuditAction, domain.WebhookSubscriptionAuditEntityType, subscription.ID, subscription, nil)
+	})
+}
@@ -18,6 +18,8 @@ type (
 
 	Claims struct {
 		UserID string `json:"user_id"`
+		// empty for access tokens, token issued for other purpose must not be accepted as access token
+		Purpose string `json:"purpose,omitempty"`
 		jwt.RegisteredClaims
 	}
 )
@@ -30,11 +32,21 @@ func NewJWTManager(secretKey string, issuer string, tokenDuration time.Duration)
 	}
 }
 
+func (m *JWTManager) Issuer() string {
+	return m.issuer
+}
+
 func (m *JWTManager) GenerateToken(userId string) (JWT, error) {
+	return m.GeneratePurposeToken(userId, "", m.tokenDuration)
+}
+
+// GeneratePurposeToken issues token of purpose which lives for duration instead of configured ttl
+func (m *JWTManager) GeneratePurposeToken(userId string, purpose string, duration time.Duration) (JWT, error) {
 	claims := Claims{
-		UserID: userId,
+		UserID:  userId,
+		Purpose: purpose,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(m.tokenDuration)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    m.issuer,
 		},
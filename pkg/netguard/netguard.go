@@ -0,0 +1,68 @@
+// Package netguard keeps requests the service makes to addresses given by users away from loopback,
+// link-local and private networks, so such address could not be used to reach internal services
+package netguard
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"syscall"
+)
+
+const (
+	NotPublicAddressError string = "address is not public"
+)
+
+// IsPublic tells whether address is routable in the internet
+func IsPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	return addr.IsValid() &&
+		!addr.IsUnspecified() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast()
+}
+
+// EnsurePublicHost fails when host is not public address or is name with any address which is not public
+func EnsurePublicHost(ctx context.Context, host string) error {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return ensurePublic(addr)
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		if err := ensurePublic(addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Control is net.Dialer Control refusing to connect to address which is not public. It is called with address
+// name was resolved to, so name could not be pointed to internal address after it was checked by EnsurePublicHost
+func Control(network string, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+
+	return ensurePublic(addrPort.Addr())
+}
+
+func ensurePublic(addr netip.Addr) error {
+	if !IsPublic(addr) {
+		return errors.New(NotPublicAddressError)
+	}
+
+	return nil
+}
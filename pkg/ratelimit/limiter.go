@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	TooManyRequestsError string = "too many requests"
+)
+
+type LimitExceededError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LimitExceededError) Error() string {
+	return TooManyRequestsError
+}
+
+// RetryAfterSeconds is a value for Retry-After header, never less than one second
+func (e *LimitExceededError) RetryAfterSeconds() int {
+	seconds := int(math.Ceil(e.RetryAfter.Seconds()))
+	if seconds < 1 {
+		return 1
+	}
+
+	return seconds
+}
+
+type (
+	// Limiter allows at most limit calls per key during fixed window
+	Limiter struct {
+		mu      sync.Mutex
+		limit   int
+		window  time.Duration
+		windows map[string]*window
+		sweepAt time.Time
+	}
+
+	window struct {
+		startedAt time.Time
+		calls     int
+	}
+)
+
+func NewLimiter(limit int, windowDuration time.Duration) *Limiter {
+	return &Limiter{
+		limit:   limit,
+		window:  windowDuration,
+		windows: make(map[string]*window),
+	}
+}
+
+func (l *Limiter) Allow(key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.sweep(now)
+
+	w, exists := l.windows[key]
+	if !exists || now.Sub(w.startedAt) >= l.window {
+		w = &window{startedAt: now}
+		l.windows[key] = w
+	}
+
+	if w.calls >= l.limit {
+		return &LimitExceededError{
+			RetryAfter: w.startedAt.Add(l.window).Sub(now),
+		}
+	}
+
+	w.calls++
+
+	return nil
+}
+
+// forgets expired windows so that map does not grow with every seen key
+func (l *Limiter) sweep(now time.Time) {
+	if now.Before(l.sweepAt) {
+		return
+	}
+
+	for key, w := range l.windows {
+		if now.Sub(w.startedAt) >= l.window {
+			delete(l.windows, key)
+		}
+	}
+
+	l.sweepAt = now.Add(l.window)
+}
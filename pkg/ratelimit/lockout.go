@@ -0,0 +1,110 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	// Lockout blocks key after threshold consecutive failures.
+	// Each next lock lasts twice longer than previous one but not longer than maxDuration.
+	Lockout struct {
+		mu          sync.Mutex
+		threshold   int
+		duration    time.Duration
+		maxDuration time.Duration
+		entries     map[string]*lockoutEntry
+		sweepAt     time.Time
+	}
+
+	lockoutEntry struct {
+		failures    int
+		lockouts    int
+		lockedUntil time.Time
+		lastFailure time.Time
+	}
+)
+
+func NewLockout(threshold int, duration time.Duration, maxDuration time.Duration) *Lockout {
+	if maxDuration < duration {
+		maxDuration = duration
+	}
+
+	return &Lockout{
+		threshold:   threshold,
+		duration:    duration,
+		maxDuration: maxDuration,
+		entries:     make(map[string]*lockoutEntry),
+	}
+}
+
+func (l *Lockout) Check(key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, exists := l.entries[key]
+	if !exists {
+		return nil
+	}
+
+	if now := time.Now(); now.Before(entry.lockedUntil) {
+		return &LimitExceededError{
+			RetryAfter: entry.lockedUntil.Sub(now),
+		}
+	}
+
+	return nil
+}
+
+func (l *Lockout) Fail(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.sweep(now)
+
+	entry, exists := l.entries[key]
+	if !exists {
+		entry = &lockoutEntry{}
+		l.entries[key] = entry
+	}
+
+	entry.failures++
+	entry.lastFailure = now
+
+	if entry.failures < l.threshold {
+		return
+	}
+
+	lockDuration := l.duration
+	for i := 0; i < entry.lockouts && lockDuration < l.maxDuration; i++ {
+		lockDuration *= 2
+	}
+	lockDuration = min(lockDuration, l.maxDuration)
+
+	entry.lockouts++
+	entry.failures = 0
+	entry.lockedUntil = now.Add(lockDuration)
+}
+
+func (l *Lockout) Reset(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.entries, key)
+}
+
+// entries without failures for maxDuration are not relevant anymore
+func (l *Lockout) sweep(now time.Time) {
+	if now.Before(l.sweepAt) {
+		return
+	}
+
+	for key, entry := range l.entries {
+		if now.After(entry.lockedUntil) && now.Sub(entry.lastFailure) >= l.maxDuration {
+			delete(l.entries, key)
+		}
+	}
+
+	l.sweepAt = now.Add(l.maxDuration)
+}
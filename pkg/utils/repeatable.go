@@ -1,20 +1,39 @@
 package repeatable
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
-func DoWithTries(fn func() error, attemtps int, delayFragment time.Duration) (err error) {
+// DoWithTries retries fn until it succeeds or attempts run out, every delay is the previous one
+// multiplied by the number of the try that just failed
+func DoWithTries(fn func() error, attemtps int, delayFragment time.Duration) error {
+	return DoWithTriesContext(context.Background(), fn, attemtps, delayFragment)
+}
+
+// DoWithTriesContext retries fn with the same growing delays as DoWithTries,
+// it does not wait after the last try and gives up as soon as ctx is done
+func DoWithTriesContext(ctx context.Context, fn func() error, attemtps int, delayFragment time.Duration) (err error) {
 	delay := delayFragment
 	var delayIncreaseFactor int64 = 1
 	for attemtps > 0 {
-		if err = fn(); err != nil {
-			time.Sleep(delay)
-			attemtps--
-			delayIncreaseFactor++
-			delay = time.Duration(delay.Nanoseconds() * delayIncreaseFactor)
-			continue
+		if err = fn(); err == nil {
+			return nil
+		}
+
+		attemtps--
+		if attemtps == 0 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
 		}
 
-		return nil
+		delayIncreaseFactor++
+		delay = time.Duration(delay.Nanoseconds() * delayIncreaseFactor)
 	}
 
 	return
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -31,6 +32,18 @@ auth:
     tokenTTL: 30m
     issuer: avito.ru
     sign: supersign
+  rate-limit:
+    enabled: true
+    per-ip:
+      requests: 30
+      window: 1m
+    per-email:
+      requests: 10
+      window: 2m
+    lockout:
+      threshold: 5
+      duration: 1m
+      max-duration: 1h
 `
 
 var testConfigData []byte = []byte(testConfig)
@@ -53,6 +66,8 @@ func TestInitConfig_ShouldBindAllConfigParams(t *testing.T) {
 
 	require.Equal(t, 3000, cfg.GRPCConfig.Port, "GRPCConfig.Port should match")
 
+	require.Equal(t, config.PostgresStorageDriver, cfg.StorageConfig.Driver, "StorageConfig.Driver should default to postgres")
+
 	require.Equal(t, "localhost", cfg.PostgresConfig.Host, "PostgresConfig.Host should match")
 	require.Equal(t, 15432, cfg.PostgresConfig.Port, "PostgresConfig.Port should match")
 	require.Equal(t, 3, cfg.PostgresConfig.ConnectAttempts, "PostgresConfig.ConnectAttempts should match")
@@ -64,6 +79,15 @@ func TestInitConfig_ShouldBindAllConfigParams(t *testing.T) {
 	require.Equal(t, 30*time.Minute, cfg.AuthConfig.JWTConfig.TokenTTL, "AuthConfig.JWTConfig.TokenTTL should match")
 	require.Equal(t, "avito.ru", cfg.AuthConfig.JWTConfig.Issuer, "AuthConfig.JWTConfig.Issuer should match")
 	require.Equal(t, "supersign", cfg.AuthConfig.JWTConfig.Sign, "AuthConfig.JWTConfig.Sign should match")
+
+	require.Equal(t, true, cfg.AuthConfig.RateLimitConfig.Enabled, "RateLimitConfig.Enabled should match")
+	require.Equal(t, 30, cfg.AuthConfig.RateLimitConfig.PerIP.Requests, "RateLimitConfig.PerIP.Requests should match")
+	require.Equal(t, time.Minute, cfg.AuthConfig.RateLimitConfig.PerIP.Window, "RateLimitConfig.PerIP.Window should match")
+	require.Equal(t, 10, cfg.AuthConfig.RateLimitConfig.PerEmail.Requests, "RateLimitConfig.PerEmail.Requests should match")
+	require.Equal(t, 2*time.Minute, cfg.AuthConfig.RateLimitConfig.PerEmail.Window, "RateLimitConfig.PerEmail.Window should match")
+	require.Equal(t, 5, cfg.AuthConfig.RateLimitConfig.Lockout.Threshold, "RateLimitConfig.Lockout.Threshold should match")
+	require.Equal(t, time.Minute, cfg.AuthConfig.RateLimitConfig.Lockout.Duration, "RateLimitConfig.Lockout.Duration should match")
+	require.Equal(t, time.Hour, cfg.AuthConfig.RateLimitConfig.Lockout.MaxDuration, "RateLimitConfig.Lockout.MaxDuration should match")
 }
 
 func TestInitConfig_ShouldReturnError_WhenNoFilePath(t *testing.T) {
@@ -125,16 +149,509 @@ func TestInitConfig_ShouldOverrideSomeConfigFromEnv(t *testing.T) {
 	require.Equal(t, sign, cfg.AuthConfig.JWTConfig.Sign)
 }
 
+func TestInitConfig_ShouldSelectStorageDriverFromEnv(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigToTempFile(t)
+	t.Setenv("STORAGE_DRIVER", config.InMemoryStorageDriver)
+
+	// Act
+	cfg, err := config.InitConfig(file)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, config.InMemoryStorageDriver, cfg.StorageConfig.Driver)
+}
+
+func TestInitConfig_ShouldReturnError_WhenUnknownStorageDriver(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigToTempFile(t)
+	t.Setenv("STORAGE_DRIVER", "mssql")
+
+	// Act
+	_, err := config.InitConfig(file)
+
+	// Assert
+	require.Error(t, err)
+	require.Equal(t, config.UnknownStorageDriverError, err.Error())
+}
+
+func TestInitConfig_ShouldKeepDummyLoginDisabledByDefault(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigToTempFile(t)
+
+	// Act
+	cfg, err := config.InitConfig(file)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, config.DevelopmentEnvironment, cfg.Environment)
+	require.False(t, cfg.AuthConfig.DummyLoginConfig.Enabled)
+}
+
+func TestInitConfig_ShouldEnableDummyLoginFromEnv(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigToTempFile(t)
+	t.Setenv("DUMMY_LOGIN_ENABLED", "true")
+
+	// Act
+	cfg, err := config.InitConfig(file)
+
+	// Assert
+	require.NoError(t, err)
+	require.True(t, cfg.AuthConfig.DummyLoginConfig.Enabled)
+}
+
+func TestInitConfig_ShouldReturnError_WhenDummyLoginIsEnabledInProduction(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigToTempFile(t)
+	t.Setenv("APP_ENVIRONMENT", config.ProductionEnvironment)
+	t.Setenv("DUMMY_LOGIN_ENABLED", "true")
+
+	// Act
+	_, err := config.InitConfig(file)
+
+	// Assert
+	require.Error(t, err)
+	require.Equal(t, config.DummyLoginInProductionError, err.Error())
+}
+
+func TestInitConfig_ShouldReturnError_WhenMailIsNotSentOverSMTPInProduction(t *testing.T) {
+	for _, sender := range []string{config.LogMailSender, config.FileMailSender} {
+		t.Run(sender, func(t *testing.T) {
+			// Arrange
+			file := mustWriteConfigDataToTempFile(t, []byte(testConfig+"mail:\n  file: mail.log\n"))
+			t.Setenv("APP_ENVIRONMENT", config.ProductionEnvironment)
+			t.Setenv("MAIL_SENDER", sender)
+
+			// Act
+			_, err := config.InitConfig(file)
+
+			// Assert
+			require.Error(t, err)
+			require.Equal(t, config.PlainTextMailInProductionError, err.Error())
+		})
+	}
+}
+
+func TestInitConfig_ShouldAcceptSMTPMailInProduction(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigDataToTempFile(t, []byte(testConfig+"mail:\n  smtp:\n    host: smtp.avito.ru\n"))
+	t.Setenv("APP_ENVIRONMENT", config.ProductionEnvironment)
+	t.Setenv("MAIL_SENDER", config.SMTPMailSender)
+
+	// Act
+	cfg, err := config.InitConfig(file)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, config.SMTPMailSender, cfg.MailConfig.Sender)
+}
+
+func TestInitConfig_ShouldReturnError_WhenUnknownEnvironment(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigToTempFile(t)
+	t.Setenv("APP_ENVIRONMENT", "staging")
+
+	// Act
+	_, err := config.InitConfig(file)
+
+	// Assert
+	require.Error(t, err)
+	require.Equal(t, config.UnknownEnvironmentError, err.Error())
+}
+
+func TestInitConfig_ShouldApplyEventsDefaults(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigToTempFile(t)
+
+	// Act
+	cfg, err := config.InitConfig(file)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, config.LogEventSink, cfg.EventsConfig.Sink)
+	require.Equal(t, time.Second, cfg.EventsConfig.PollInterval)
+	require.Equal(t, 100, cfg.EventsConfig.BatchSize)
+	require.Equal(t, 5*time.Second, cfg.EventsConfig.Webhook.Timeout)
+	require.Equal(t, 3, cfg.EventsConfig.WebhookDelivery.Tries)
+	require.Equal(t, time.Second, cfg.EventsConfig.WebhookDelivery.RetryDelay)
+	require.Equal(t, 5*time.Second, cfg.EventsConfig.WebhookDelivery.Timeout)
+	require.False(t, cfg.EventsConfig.WebhookDelivery.AllowPrivateNetworks)
+}
+
+func TestInitConfig_ShouldSelectWebhookEventSinkFromEnv(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigToTempFile(t)
+	t.Setenv("EVENTS_SINK", config.WebhookEventSink)
+	t.Setenv("EVENTS_WEBHOOK_URL", "http://localhost:9000/events")
+
+	// Act
+	cfg, err := config.InitConfig(file)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, config.WebhookEventSink, cfg.EventsConfig.Sink)
+	require.Equal(t, "http://localhost:9000/events", cfg.EventsConfig.Webhook.URL)
+}
+
+func TestInitConfig_ShouldReturnError_WhenWebhookEventSinkHasNoURL(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigToTempFile(t)
+	t.Setenv("EVENTS_SINK", config.WebhookEventSink)
+
+	// Act
+	_, err := config.InitConfig(file)
+
+	// Assert
+	require.Error(t, err)
+	require.Equal(t, config.WebhookURLIsRequiredError, err.Error())
+}
+
+func TestInitConfig_ShouldReturnError_WhenUnknownEventSink(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigToTempFile(t)
+	t.Setenv("EVENTS_SINK", "kafka")
+
+	// Act
+	_, err := config.InitConfig(file)
+
+	// Assert
+	require.Error(t, err)
+	require.Equal(t, config.UnknownEventSinkError, err.Error())
+}
+
+func TestInitConfig_ShouldApplyAutoCloseDefaults(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigToTempFile(t)
+
+	// Act
+	cfg, err := config.InitConfig(file)
+
+	// Assert
+	require.NoError(t, err)
+	autoClose := cfg.ReceptionsConfig.AutoClose
+	require.False(t, autoClose.Enabled)
+	require.Equal(t, config.CloseStaleReceptionAction, autoClose.Action)
+	require.Equal(t, time.Minute, autoClose.CheckInterval)
+	require.Equal(t, 12*time.Hour, autoClose.IdleTimeout)
+	require.Empty(t, autoClose.PVZIdleTimeouts)
+}
+
+func TestInitConfig_ShouldBindPVZIdleTimeouts(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigDataToTempFile(t, []byte(testConfig+`
+receptions:
+  auto-close:
+    enabled: true
+    action: flag
+    idle-timeout: 8h
+    pvz-idle-timeouts:
+      0195d4a4-7a3e-7c4e-9b1a-3f6f2a1d7e10: 2h
+`))
+
+	// Act
+	cfg, err := config.InitConfig(file)
+
+	// Assert
+	require.NoError(t, err)
+	autoClose := cfg.ReceptionsConfig.AutoClose
+	require.True(t, autoClose.Enabled)
+	require.Equal(t, config.FlagStaleReceptionAction, autoClose.Action)
+	require.Equal(t, 8*time.Hour, autoClose.IdleTimeout)
+	require.Equal(t, map[string]time.Duration{"0195d4a4-7a3e-7c4e-9b1a-3f6f2a1d7e10": 2 * time.Hour}, autoClose.PVZIdleTimeouts)
+}
+
+func TestInitConfig_ShouldReturnError_WhenAutoCloseIsMisconfigured(t *testing.T) {
+	cases := []struct {
+		name      string
+		autoClose string
+		expected  string
+	}{
+		{
+			name:      "unknown action",
+			autoClose: "action: delete",
+			expected:  config.UnknownStaleReceptionActionError,
+		},
+		{
+			name:      "zero idle timeout",
+			autoClose: "idle-timeout: 0s",
+			expected:  config.InvalidIdleTimeoutError,
+		},
+		{
+			name:      "pvz timeout is keyed by name",
+			autoClose: "pvz-idle-timeouts:\n      moscow: 1h",
+			expected:  config.InvalidIdleTimeoutPVZIDError,
+		},
+		{
+			name:      "negative pvz timeout",
+			autoClose: "pvz-idle-timeouts:\n      0195d4a4-7a3e-7c4e-9b1a-3f6f2a1d7e10: -1h",
+			expected:  config.InvalidIdleTimeoutError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			file := mustWriteConfigDataToTempFile(t, []byte(testConfig+"receptions:\n  auto-close:\n    "+tc.autoClose+"\n"))
+
+			// Act
+			_, err := config.InitConfig(file)
+
+			// Assert
+			require.Error(t, err)
+			require.Equal(t, tc.expected, err.Error())
+		})
+	}
+}
+
+func TestInitConfig_ShouldApplyMailDefaults(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigToTempFile(t)
+
+	// Act
+	cfg, err := config.InitConfig(file)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, config.LogMailSender, cfg.MailConfig.Sender)
+	require.NotEmpty(t, cfg.MailConfig.From)
+	require.Equal(t, 587, cfg.MailConfig.SMTP.Port)
+	require.Equal(t, 10*time.Second, cfg.MailConfig.SMTP.Timeout)
+}
+
+func TestInitConfig_ShouldBindSMTPCredentialsFromEnv(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigDataToTempFile(t, []byte(testConfig+`
+mail:
+  from: noreply@avito.ru
+  smtp:
+    host: smtp.avito.ru
+    port: 2525
+`))
+	t.Setenv("MAIL_SENDER", config.SMTPMailSender)
+	t.Setenv("SMTP_USERNAME", "mailer")
+	t.Setenv("SMTP_PASSWORD", "secret")
+
+	// Act
+	cfg, err := config.InitConfig(file)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, config.SMTPMailSender, cfg.MailConfig.Sender)
+	require.Equal(t, "noreply@avito.ru", cfg.MailConfig.From)
+	require.Equal(t, "smtp.avito.ru", cfg.MailConfig.SMTP.Host)
+	require.Equal(t, 2525, cfg.MailConfig.SMTP.Port)
+	require.Equal(t, "mailer", cfg.MailConfig.SMTP.Username)
+	require.Equal(t, "secret", cfg.MailConfig.SMTP.Password)
+}
+
+func TestInitConfig_ShouldReturnError_WhenMailIsMisconfigured(t *testing.T) {
+	cases := []struct {
+		name     string
+		mail     string
+		expected string
+	}{
+		{
+			name:     "unknown sender",
+			mail:     "sender: pigeon",
+			expected: config.UnknownMailSenderError,
+		},
+		{
+			name:     "file sender without file",
+			mail:     "sender: file",
+			expected: config.MailFileIsRequiredError,
+		},
+		{
+			name:     "smtp sender without host",
+			mail:     "sender: smtp",
+			expected: config.SMTPHostIsRequiredError,
+		},
+		{
+			name:     "empty from",
+			mail:     "from: ''",
+			expected: config.MailFromIsRequiredError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			file := mustWriteConfigDataToTempFile(t, []byte(testConfig+"mail:\n  "+tc.mail+"\n"))
+
+			// Act
+			_, err := config.InitConfig(file)
+
+			// Assert
+			require.Error(t, err)
+			require.Equal(t, tc.expected, err.Error())
+		})
+	}
+}
+
+func TestInitConfig_ShouldApplyPasswordDefaults(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigToTempFile(t)
+
+	// Act
+	cfg, err := config.InitConfig(file)
+
+	// Assert
+	require.NoError(t, err)
+	password := cfg.AuthConfig.PasswordConfig
+	require.Equal(t, 8, password.MinLength)
+	require.Equal(t, 1, password.MinCharacterClasses)
+	require.Empty(t, password.BreachedListFile)
+	require.Equal(t, config.Argon2idConfig{Memory: 64 * 1024, Iterations: 1, Parallelism: 2, SaltLength: 16, KeyLength: 32}, password.Argon2id)
+}
+
+func TestInitConfig_ShouldReturnError_WhenPasswordIsMisconfigured(t *testing.T) {
+	cases := []struct {
+		name     string
+		password string
+		expected string
+	}{
+		{
+			name:     "zero min length",
+			password: "min-length: 0",
+			expected: config.InvalidPasswordMinLengthError,
+		},
+		{
+			name:     "too many character classes",
+			password: "min-character-classes: 5",
+			expected: config.InvalidPasswordCharacterClassError,
+		},
+		{
+			name:     "zero argon2id iterations",
+			password: "argon2id:\n      iterations: 0",
+			expected: config.InvalidArgon2idParamsError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			file := mustWriteConfigDataToTempFile(t, []byte(testConfig+"  password:\n    "+tc.password+"\n"))
+
+			// Act
+			_, err := config.InitConfig(file)
+
+			// Assert
+			require.Error(t, err)
+			require.Equal(t, tc.expected, err.Error())
+		})
+	}
+}
+
 func mustWriteConfigToTempFile(t *testing.T) string {
 	t.Helper()
 
+	return mustWriteConfigDataToTempFile(t, testConfigData)
+}
+
+func mustWriteConfigDataToTempFile(t *testing.T, data []byte) string {
+	t.Helper()
+
 	timestamp := time.Now().Unix()
 	fileName := fmt.Sprintf("%d.yaml", timestamp)
 	filePath := filepath.Join(t.TempDir(), fileName)
 
-	if err := os.WriteFile(filePath, testConfigData, 0o644); err != nil {
+	if err := os.WriteFile(filePath, data, 0o644); err != nil {
 		t.Fatalf("Failed to write config file: %v", err)
 	}
 
 	return filePath
 }
+
+func TestInitConfig_ShouldBindTrustedProxies(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigDataToTempFile(t, []byte(strings.Replace(testConfig, "include-swagger: true", "include-swagger: true\n  trusted-proxies: [10.0.0.0/8, 192.168.1.10/32]", 1)))
+
+	// Act
+	cfg, err := config.InitConfig(file)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.0.0.0/8", "192.168.1.10/32"}, cfg.HTTPConfig.TrustedProxies)
+}
+
+func TestInitConfig_ShouldReturnError_WhenTrustedProxyIsNotCIDR(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigDataToTempFile(t, []byte(strings.Replace(testConfig, "include-swagger: true", "include-swagger: true\n  trusted-proxies: [10.0.0.1]", 1)))
+
+	// Act
+	_, err := config.InitConfig(file)
+
+	// Assert
+	require.Error(t, err)
+	require.Equal(t, config.InvalidTrustedProxyError, err.Error())
+}
+
+func TestInitConfig_ShouldBindSSOConfig(t *testing.T) {
+	// Arrange
+	file := mustWriteConfigDataToTempFile(t, []byte(testConfig+`
+  sso:
+    enabled: true
+    issuer: https://idp.example.com
+    client-id: pvz
+    redirect-url: https://pvz.example.com/sso/callback
+    role-mapping:
+      - group: pvz-admins
+        role: admin
+      - group: pvz-staff
+        role: employee
+`))
+	t.Setenv("SSO_CLIENT_SECRET", "secret")
+
+	// Act
+	cfg, err := config.InitConfig(file)
+
+	// Assert
+	require.NoError(t, err)
+	sso := cfg.AuthConfig.SSOConfig
+	require.True(t, sso.Enabled)
+	require.Equal(t, "https://idp.example.com", sso.Issuer)
+	require.Equal(t, "pvz", sso.ClientID)
+	require.Equal(t, "secret", sso.ClientSecret)
+	require.Equal(t, "https://pvz.example.com/sso/callback", sso.RedirectURL)
+	require.Equal(t, []string{"openid", "email", "profile"}, sso.Scopes)
+	require.Equal(t, "groups", sso.GroupsClaim)
+	require.Equal(t, []config.SSORoleMappingConfig{{Group: "pvz-admins", Role: "admin"}, {Group: "pvz-staff", Role: "employee"}}, sso.RoleMapping)
+}
+
+func TestInitConfig_ShouldReturnError_WhenSSOIsMisconfigured(t *testing.T) {
+	cases := []struct {
+		name     string
+		sso      string
+		expected string
+	}{
+		{
+			name:     "no issuer",
+			sso:      "client-id: pvz\n    redirect-url: https://pvz.example.com/sso/callback\n    role-mapping: [{group: staff, role: employee}]",
+			expected: config.SSOProviderIsRequiredError,
+		},
+		{
+			name:     "no role mapping",
+			sso:      "issuer: https://idp.example.com\n    client-id: pvz\n    redirect-url: https://pvz.example.com/sso/callback",
+			expected: config.SSORoleMappingIsRequiredError,
+		},
+		{
+			name:     "mapping without role",
+			sso:      "issuer: https://idp.example.com\n    client-id: pvz\n    redirect-url: https://pvz.example.com/sso/callback\n    role-mapping: [{group: staff}]",
+			expected: config.InvalidSSORoleMappingError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			file := mustWriteConfigDataToTempFile(t, []byte(testConfig+"  sso:\n    enabled: true\n    "+tc.sso+"\n"))
+
+			// Act
+			_, err := config.InitConfig(file)
+
+			// Assert
+			require.Error(t, err)
+			require.Equal(t, tc.expected, err.Error())
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"avito/internal/storage/inmemory"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReceptionActContent_ShouldSortProductsAndCountCategories(t *testing.T) {
+	reception := getOpenedReception(t)
+	require.NoError(t, reception.Close(ctx, newProductRepository(t)))
+	pvz := getPVZ(t)
+	openedAt := reception.CreationTimeUTC
+	third := &domain.Product{ID: uuid.Must(uuid.NewV7()), Category: domain.ShoesProductCategory, CreationTimeUTC: openedAt.Add(3 * time.Minute)}
+	first := &domain.Product{ID: uuid.Must(uuid.NewV7()), Category: domain.ShoesProductCategory, CreationTimeUTC: openedAt.Add(time.Minute)}
+	second := &domain.Product{ID: uuid.Must(uuid.NewV7()), Category: domain.ElectronicsProductCategory, CreationTimeUTC: openedAt.Add(2 * time.Minute)}
+	closedAt := openedAt.Add(time.Hour)
+
+	// act
+	content, err := domain.NewReceptionActContent(pvz, reception, []*domain.Product{third, first, second}, closedAt)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, []*domain.Product{first, second, third}, content.Products)
+	require.Equal(t, closedAt, content.ClosedAtUTC)
+	require.Equal(t, []domain.CategoryCount{
+		{Category: domain.ElectronicsProductCategory, Count: 1},
+		{Category: domain.ShoesProductCategory, Count: 2},
+	}, content.CategoryCounts())
+}
+
+func TestNewReceptionActContent_ShouldReturnError_WhenReceptionIsInProgress(t *testing.T) {
+	_, err := domain.NewReceptionActContent(getPVZ(t), getOpenedReception(t), nil, time.Now().UTC())
+
+	require.Error(t, err)
+	require.Equal(t, domain.ReceptionIsNotClosedError, err.Error())
+}
+
+func TestIssueReceptionAct_ShouldStoreRenderedDocument(t *testing.T) {
+	reception := getOpenedReception(t)
+	require.NoError(t, reception.Close(ctx, newProductRepository(t)))
+	closedAt := time.Now().UTC()
+	content, err := domain.NewReceptionActContent(getPVZ(t), reception, nil, closedAt)
+	require.NoError(t, err)
+	acts := inmemory.NewReceptionActRepository(inmemory.NewStore())
+
+	// act
+	act, err := domain.IssueReceptionAct(ctx, content, fakeActRenderer{document: []byte("act")}, acts)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, domain.ReceptionAct{ReceptionID: reception.ID, CreationTimeUTC: closedAt, Document: []byte("act")}, act)
+	stored, err := acts.FindByReceptionID(ctx, reception.ID)
+	require.NoError(t, err)
+	require.Equal(t, act, stored)
+}
+
+func TestIssueReceptionAct_ShouldNotStoreAct_WhenRenderingFailed(t *testing.T) {
+	reception := getOpenedReception(t)
+	require.NoError(t, reception.Close(ctx, newProductRepository(t)))
+	content, err := domain.NewReceptionActContent(getPVZ(t), reception, nil, time.Now().UTC())
+	require.NoError(t, err)
+	acts := inmemory.NewReceptionActRepository(inmemory.NewStore())
+
+	// act
+	_, err = domain.IssueReceptionAct(ctx, content, fakeActRenderer{err: errors.New("render failed")}, acts)
+
+	// assert
+	require.Error(t, err)
+	_, err = acts.FindByReceptionID(ctx, reception.ID)
+	require.Equal(t, domain.ReceptionActDoesNotExistError, err.Error())
+}
+
+type fakeActRenderer struct {
+	document []byte
+	err      error
+}
+
+func (f fakeActRenderer) Render(content domain.ReceptionActContent) ([]byte, error) {
+	return f.document, f.err
+}
@@ -0,0 +1,136 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServiceAccount(t *testing.T) {
+	moderator := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.ModeratorRole()}
+
+	t.Run("Creates account without password", func(t *testing.T) {
+		// act
+		account, err := domain.NewServiceAccount(moderator, "reporting@example.com", domain.EmployeeRole())
+
+		// assert
+		require.NoError(t, err)
+		require.True(t, account.ServiceAccount)
+		require.Empty(t, account.Password)
+		require.Equal(t, domain.EmployeeUserRoleID, account.UserRole.ID)
+		require.False(t, account.NeedsTwoFactorEnrollment())
+	})
+
+	t.Run("Refuses role with permissions creator does not have", func(t *testing.T) {
+		// act
+		_, err := domain.NewServiceAccount(moderator, "reporting@example.com", domain.AdminRole())
+
+		// assert
+		require.EqualError(t, err, domain.RoleIsBeyondGranterError)
+	})
+}
+
+func TestNewAPIKey(t *testing.T) {
+	moderator := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.ModeratorRole()}
+	account, _ := domain.NewServiceAccount(moderator, "reporting@example.com", domain.EmployeeRole())
+
+	t.Run("Keeps only hash of key", func(t *testing.T) {
+		timeBeforeRun := time.Now().UTC()
+
+		// act
+		key, secret, err := domain.NewAPIKey(moderator, account, " reporting ", []domain.Permission{domain.ReportReadPermission, domain.PVZReadPermission})
+
+		// assert
+		require.NoError(t, err)
+		require.True(t, domain.IsAPIKey(secret))
+		require.Equal(t, domain.HashToken(secret), key.KeyHash)
+		require.NotContains(t, key.KeyHash, secret)
+		require.True(t, len(key.Hint) < len(secret))
+		require.Equal(t, secret[:len(key.Hint)], key.Hint)
+		require.Equal(t, "reporting", key.Name)
+		require.Equal(t, []domain.Permission{domain.PVZReadPermission, domain.ReportReadPermission}, key.Scope)
+		require.Equal(t, account.ID, key.ServiceAccountID)
+		require.Equal(t, moderator.ID, key.CreatedBy)
+		require.LessOrEqual(t, timeBeforeRun, key.CreationTimeUTC)
+	})
+
+	t.Run("Refuses user who is not service account", func(t *testing.T) {
+		// act
+		_, _, err := domain.NewAPIKey(moderator, moderator, "reporting", []domain.Permission{domain.PVZReadPermission})
+
+		// assert
+		require.EqualError(t, err, domain.NotServiceAccountError)
+	})
+
+	t.Run("Refuses empty name and scope", func(t *testing.T) {
+		// act
+		_, _, nameErr := domain.NewAPIKey(moderator, account, " ", []domain.Permission{domain.PVZReadPermission})
+		_, _, scopeErr := domain.NewAPIKey(moderator, account, "reporting", nil)
+
+		// assert
+		require.EqualError(t, nameErr, domain.APIKeyNameIsRequiredError)
+		require.EqualError(t, scopeErr, domain.APIKeyScopeIsRequiredError)
+	})
+
+	t.Run("Refuses scope beyond role of account", func(t *testing.T) {
+		// act
+		_, _, err := domain.NewAPIKey(moderator, account, "reporting", []domain.Permission{domain.PVZCreatePermission})
+
+		// assert
+		require.EqualError(t, err, domain.APIKeyScopeIsBeyondRoleError)
+	})
+}
+
+func TestAPIKey_Use(t *testing.T) {
+	moderator := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.ModeratorRole()}
+	account, _ := domain.NewServiceAccount(moderator, "reporting@example.com", domain.EmployeeRole())
+	now := time.Now()
+
+	t.Run("Narrows permissions to scope", func(t *testing.T) {
+		key, _, _ := domain.NewAPIKey(moderator, account, "reporting", []domain.Permission{domain.PVZReadPermission})
+		user := account
+
+		// act
+		markUsed, err := key.Use(&user, now)
+
+		// assert
+		require.NoError(t, err)
+		require.True(t, markUsed)
+		require.True(t, user.HasPermission(domain.PVZReadPermission))
+		require.False(t, user.HasPermission(domain.ReceptionOpenPermission))
+		require.True(t, account.HasPermission(domain.ReceptionOpenPermission), "role of other copies of account should be kept")
+	})
+
+	t.Run("Saves time of use once per resolution", func(t *testing.T) {
+		key, _, _ := domain.NewAPIKey(moderator, account, "reporting", []domain.Permission{domain.PVZReadPermission})
+		user := account
+
+		// act
+		first, _ := key.Use(&user, now)
+		soon, _ := key.Use(&user, now.Add(domain.APIKeyLastUseResolution/2))
+		later, _ := key.Use(&user, now.Add(domain.APIKeyLastUseResolution))
+
+		// assert
+		require.True(t, first)
+		require.False(t, soon)
+		require.True(t, later)
+		require.Equal(t, now.Add(domain.APIKeyLastUseResolution).UTC(), *key.LastUsedAtUTC)
+	})
+
+	t.Run("Refuses revoked key", func(t *testing.T) {
+		key, _, _ := domain.NewAPIKey(moderator, account, "reporting", []domain.Permission{domain.PVZReadPermission})
+		user := account
+		require.NoError(t, key.Revoke(now))
+
+		// act
+		_, err := key.Use(&user, now)
+		revokeAgainErr := key.Revoke(now)
+
+		// assert
+		require.EqualError(t, err, domain.InsufficientPrivilegesError)
+		require.EqualError(t, revokeAgainErr, domain.APIKeyIsAlreadyRevokedError)
+	})
+}
@@ -0,0 +1,129 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"avito/internal/storage/inmemory"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var assignmentMoment = time.Date(2025, 4, 10, 9, 0, 0, 0, time.UTC)
+
+func TestNewPVZAssignment(t *testing.T) {
+	employee := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.EmployeeRole()}
+	moderator := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.ModeratorRole()}
+	pvzID := uuid.Must(uuid.NewV7())
+	validFrom := assignmentMoment
+	validTo := assignmentMoment.Add(time.Hour)
+
+	t.Run("Assigns employee for given period", func(t *testing.T) {
+		// act
+		assignment, err := domain.NewPVZAssignment(employee, pvzID, &validFrom, &validTo, moderator.ID)
+
+		// assert
+		require.NoError(t, err)
+		require.NotEqual(t, uuid.Nil, assignment.ID)
+		require.Equal(t, employee.ID, assignment.UserID)
+		require.Equal(t, pvzID, assignment.PVZID)
+		require.Equal(t, validFrom, *assignment.ValidFromUTC)
+		require.Equal(t, validTo, *assignment.ValidToUTC)
+		require.Equal(t, moderator.ID, assignment.AssignedBy)
+	})
+
+	t.Run("Refuses to assign moderator", func(t *testing.T) {
+		// act
+		_, err := domain.NewPVZAssignment(moderator, pvzID, nil, nil, moderator.ID)
+
+		// assert
+		require.EqualError(t, err, domain.AssigneeIsNotEmployeeError)
+	})
+
+	t.Run("Refuses period which ends before it starts", func(t *testing.T) {
+		// act
+		_, err := domain.NewPVZAssignment(employee, pvzID, &validTo, &validFrom, moderator.ID)
+
+		// assert
+		require.EqualError(t, err, domain.InvalidAssignmentPeriodError)
+	})
+}
+
+func TestPVZAssignment_IsValidAt(t *testing.T) {
+	validFrom := assignmentMoment
+	validTo := assignmentMoment.Add(time.Hour)
+	assignment := domain.PVZAssignment{ValidFromUTC: &validFrom, ValidToUTC: &validTo}
+	tests := []struct {
+		name   string
+		moment time.Time
+		valid  bool
+	}{
+		{name: "Before period", moment: validFrom.Add(-time.Second), valid: false},
+		{name: "Start of period", moment: validFrom, valid: true},
+		{name: "Within period", moment: validFrom.Add(time.Minute), valid: true},
+		{name: "End of period", moment: validTo, valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// act
+			valid := assignment.IsValidAt(tt.moment)
+
+			// assert
+			require.Equal(t, tt.valid, valid)
+		})
+	}
+
+	t.Run("Open period never expires", func(t *testing.T) {
+		// act
+		valid := (&domain.PVZAssignment{}).IsValidAt(assignmentMoment)
+
+		// assert
+		require.True(t, valid)
+	})
+}
+
+func TestEnsureAssignedToPVZ(t *testing.T) {
+	employee := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.EmployeeRole()}
+	moderator := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.ModeratorRole()}
+	pvzID, otherPVZID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	validTo := assignmentMoment.Add(time.Hour)
+	assignments := inmemory.NewPVZAssignmentRepository(inmemory.NewStore())
+	assignment, err := domain.NewPVZAssignment(employee, pvzID, nil, &validTo, moderator.ID)
+	require.NoError(t, err)
+	require.NoError(t, assignments.Add(ctx, assignment))
+
+	t.Run("Lets employee act at assigned pvz", func(t *testing.T) {
+		// act
+		err := domain.EnsureAssignedToPVZ(ctx, employee, pvzID, assignmentMoment, assignments)
+
+		// assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Forbids employee to act at other pvz", func(t *testing.T) {
+		// act
+		err := domain.EnsureAssignedToPVZ(ctx, employee, otherPVZID, assignmentMoment, assignments)
+
+		// assert
+		require.EqualError(t, err, domain.NotAssignedToPVZError)
+		require.True(t, domain.IsAccessError(err))
+	})
+
+	t.Run("Forbids employee to act after assignment expired", func(t *testing.T) {
+		// act
+		err := domain.EnsureAssignedToPVZ(ctx, employee, pvzID, validTo, assignments)
+
+		// assert
+		require.EqualError(t, err, domain.NotAssignedToPVZError)
+	})
+
+	t.Run("Does not limit moderator", func(t *testing.T) {
+		// act
+		err := domain.EnsureAssignedToPVZ(ctx, moderator, otherPVZID, assignmentMoment, assignments)
+
+		// assert
+		require.NoError(t, err)
+	})
+}
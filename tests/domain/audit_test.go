@@ -0,0 +1,110 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditRecord_ShouldSnapshotStates(t *testing.T) {
+	actorID := uuid.Must(uuid.NewV7())
+	reception := domain.ReceptionInfo{
+		ID:              uuid.Must(uuid.NewV7()),
+		PVZID:           uuid.Must(uuid.NewV7()),
+		CreationTimeUTC: time.Date(2025, 4, 10, 9, 0, 0, 0, time.UTC),
+		Status:          domain.InProggressProductAcceptanceStatus,
+	}
+	closed := reception
+	closed.Status = domain.CloseProductAcceptanceStatus
+	source := domain.RequestSource{Protocol: domain.HTTPRequestProtocol, RequestID: "request-1"}
+	timeBeforeRun := time.Now().UTC()
+
+	// act
+	record, err := domain.NewAuditRecord(actorID, domain.ReceptionClosedAuditAction, domain.ReceptionAuditEntityType, reception.ID, reception, closed, source)
+
+	// assert
+	require.NoError(t, err)
+	require.NotEqual(t, uuid.Nil, record.ID)
+	require.Equal(t, actorID, record.ActorID)
+	require.Equal(t, domain.ReceptionClosedAuditAction, record.Action)
+	require.Equal(t, domain.ReceptionAuditEntityType, record.EntityType)
+	require.Equal(t, reception.ID, record.EntityID)
+	require.Contains(t, string(record.Before), `"status":1`)
+	require.Contains(t, string(record.After), `"status":2`)
+	require.Equal(t, domain.HTTPRequestProtocol, record.Protocol)
+	require.Equal(t, "request-1", record.RequestID)
+	require.LessOrEqual(t, timeBeforeRun, record.OccurredAtUTC)
+}
+
+func TestNewAuditRecord_ShouldLeaveMissingStateEmpty(t *testing.T) {
+	pvzID := uuid.Must(uuid.NewV7())
+
+	// act
+	record, err := domain.NewAuditRecord(uuid.Must(uuid.NewV7()), domain.PVZCreatedAuditAction, domain.PVZAuditEntityType, pvzID, nil, domain.PVZ{ID: pvzID}, domain.RequestSource{})
+
+	// assert
+	require.NoError(t, err)
+	require.Nil(t, record.Before)
+	require.NotEmpty(t, record.After)
+}
+
+func TestNewAuditRecord_ShouldNotLeakCredentials(t *testing.T) {
+	user := domain.User{
+		ID:       uuid.Must(uuid.NewV7()),
+		Email:    "employee@example.com",
+		Password: "hashed-password",
+		UserRole: domain.EmployeeRole(),
+	}
+	subscription := domain.WebhookSubscription{
+		ID:     uuid.Must(uuid.NewV7()),
+		URL:    "https://partner.example.com/hooks",
+		Secret: webhookSecret,
+	}
+
+	// act
+	userRecord, userErr := domain.NewAuditRecord(user.ID, domain.UserRegisteredAuditAction, domain.UserAuditEntityType, user.ID, nil, user, domain.RequestSource{})
+	subscriptionRecord, subscriptionErr := domain.NewAuditRecord(user.ID, domain.WebhookUnsubscribedAuditAction, domain.WebhookSubscriptionAuditEntityType, subscription.ID, subscription, nil, domain.RequestSource{})
+
+	// assert
+	require.NoError(t, userErr)
+	require.NoError(t, subscriptionErr)
+	require.Contains(t, string(userRecord.After), user.Email)
+	require.NotContains(t, string(userRecord.After), user.Password)
+	require.Contains(t, string(subscriptionRecord.Before), subscription.URL)
+	require.NotContains(t, string(subscriptionRecord.Before), webhookSecret)
+}
+
+func TestNewRequestSource_ShouldKeepOnlyWellFormedRequestID(t *testing.T) {
+	testCases := []struct {
+		name      string
+		requestID string
+		kept      bool
+	}{
+		{name: "uuid", requestID: uuid.Must(uuid.NewV7()).String(), kept: true},
+		{name: "short id", requestID: "request-1", kept: true},
+		{name: "empty", requestID: ""},
+		{name: "line break", requestID: "request-1\nforged log line"},
+		{name: "spaces", requestID: "request 1"},
+		{name: "too long", requestID: strings.Repeat("a", 65)},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// act
+			source := domain.NewRequestSource(domain.GRPCRequestProtocol, testCase.requestID)
+
+			// assert
+			require.Equal(t, domain.GRPCRequestProtocol, source.Protocol)
+			if testCase.kept {
+				require.Equal(t, testCase.requestID, source.RequestID)
+			} else {
+				require.NotEqual(t, testCase.requestID, source.RequestID)
+				require.NoError(t, uuid.Validate(source.RequestID))
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"avito/internal/storage/inmemory"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBarcode_ShouldAcceptValidValues(t *testing.T) {
+	testCases := []struct {
+		name   string
+		format domain.BarcodeFormat
+		value  string
+	}{
+		{name: "EAN-13", format: domain.EAN13BarcodeFormat, value: "4006381333931"},
+		{name: "EAN-13 with zero checksum", format: domain.EAN13BarcodeFormat, value: "4607000000090"},
+		{name: "Code 128", format: domain.Code128BarcodeFormat, value: "PVZ-Parcel 0042/A"},
+		{name: "order number", format: domain.OrderNumberBarcodeFormat, value: "1234567890"},
+		{name: "order number with parcel", format: domain.OrderNumberBarcodeFormat, value: "1234567890-2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			barcode, err := domain.NewBarcode(tc.format, tc.value)
+
+			require.NoError(t, err)
+			require.Equal(t, domain.Barcode{Value: tc.value, Format: tc.format}, barcode)
+		})
+	}
+}
+
+func TestNewBarcode_ShouldReturnError_WhenValueDoesNotMatchFormat(t *testing.T) {
+	testCases := []struct {
+		name   string
+		format domain.BarcodeFormat
+		value  string
+	}{
+		{name: "EAN-13 wrong checksum", format: domain.EAN13BarcodeFormat, value: "4006381333932"},
+		{name: "EAN-13 too short", format: domain.EAN13BarcodeFormat, value: "400638133393"},
+		{name: "EAN-13 with letters", format: domain.EAN13BarcodeFormat, value: "40063813339A1"},
+		{name: "empty Code 128", format: domain.Code128BarcodeFormat, value: ""},
+		{name: "Code 128 with non ascii", format: domain.Code128BarcodeFormat, value: "посылка"},
+		{name: "Code 128 too long", format: domain.Code128BarcodeFormat, value: "0123456789012345678901234567890123456789012345678"},
+		{name: "short order number", format: domain.OrderNumberBarcodeFormat, value: "12345"},
+		{name: "order number with letters", format: domain.OrderNumberBarcodeFormat, value: "12345678A"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := domain.NewBarcode(tc.format, tc.value)
+
+			require.Error(t, err)
+			require.Equal(t, domain.InvalidBarcodeError, err.Error())
+		})
+	}
+}
+
+func TestNewBarcode_ShouldReturnError_WhenUnknownFormat(t *testing.T) {
+	_, err := domain.NewBarcode(0, "4006381333931")
+
+	require.Error(t, err)
+	require.Equal(t, domain.UnknownBarcodeFormatError, err.Error())
+}
+
+func TestReceptionInfoAddNewProduct_ShouldKeepBarcode(t *testing.T) {
+	store := inmemory.NewStore()
+	reception := mustAddOpenedReception(t, store)
+	productRepository := inmemory.NewProductRepository(store)
+	barcode := domain.Barcode{Value: "4006381333931", Format: domain.EAN13BarcodeFormat}
+
+	// act
+	addedProduct, err := reception.AddNewProduct(ctx, domain.ShoesProductCategory, &barcode, productRepository)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, &barcode, addedProduct.Barcode)
+
+	found, err := productRepository.FindAllByFilter(ctx, domain.SearchProductFilter{Barcode: barcode.Value})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	require.Equal(t, addedProduct, *found[0])
+}
+
+func TestReceptionInfoAddNewProduct_ShouldReturnError_WhenBarcodeIsAcceptedInOpenedReception(t *testing.T) {
+	store := inmemory.NewStore()
+	reception := mustAddOpenedReception(t, store)
+	otherReception := mustAddOpenedReception(t, store)
+	productRepository := inmemory.NewProductRepository(store)
+	barcode := domain.Barcode{Value: "1234567890-1", Format: domain.OrderNumberBarcodeFormat}
+	_, err := reception.AddNewProduct(ctx, domain.ShoesProductCategory, &barcode, productRepository)
+	require.NoError(t, err)
+
+	// act
+	_, err = otherReception.AddNewProduct(ctx, domain.ShoesProductCategory, &barcode, productRepository)
+
+	// assert
+	require.Error(t, err)
+	require.Equal(t, domain.DuplicateBarcodeError, err.Error())
+
+	products, err := productRepository.FindAllByReceptionID(ctx, otherReception.ID)
+	require.NoError(t, err)
+	require.Empty(t, products)
+}
+
+func TestReceptionInfoAddNewProduct_ShouldAcceptBarcode_WhenPreviousReceptionIsClosed(t *testing.T) {
+	store := inmemory.NewStore()
+	receptionRepository := inmemory.NewReceptionInfoRepository(store)
+	productRepository := inmemory.NewProductRepository(store)
+	closedReception := mustAddOpenedReception(t, store)
+	barcode := domain.Barcode{Value: "RETURN-0001", Format: domain.Code128BarcodeFormat}
+	_, err := closedReception.AddNewProduct(ctx, domain.ClothesProductCategory, &barcode, productRepository)
+	require.NoError(t, err)
+	require.NoError(t, closedReception.Close(ctx, productRepository))
+	require.NoError(t, receptionRepository.Update(ctx, closedReception))
+	reception := mustAddOpenedReception(t, store)
+
+	// act
+	_, err = reception.AddNewProduct(ctx, domain.ClothesProductCategory, &barcode, productRepository)
+
+	// assert
+	require.NoError(t, err)
+	found, err := productRepository.FindAllByFilter(ctx, domain.SearchProductFilter{Barcode: barcode.Value})
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+}
+
+func mustAddOpenedReception(t *testing.T, store *inmemory.Store) domain.ReceptionInfo {
+	t.Helper()
+
+	reception := domain.ReceptionInfo{
+		ID:              uuid.Must(uuid.NewV7()),
+		PVZID:           uuid.Must(uuid.NewV7()),
+		CreationTimeUTC: time.Now().UTC(),
+		Status:          domain.InProggressProductAcceptanceStatus,
+	}
+	require.NoError(t, inmemory.NewReceptionInfoRepository(store).Add(ctx, reception))
+
+	return reception
+}
@@ -0,0 +1,175 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPVZ_ShouldRaisePVZCreatedEvent(t *testing.T) {
+	expected := getPVZ(t)
+
+	// act
+	pvz, err := domain.NewPVZ(expected.ID, expected.CreationTimeUTC, expected.City)
+
+	// assert
+	require.NoError(t, err)
+	events := pvz.PullEvents()
+	require.Len(t, events, 1)
+	require.Equal(t, domain.PVZCreatedEventType, events[0].Type)
+	require.Equal(t, expected.ID, events[0].PVZID)
+	require.Equal(t, expected, decodePayload[domain.PVZ](t, events[0]))
+}
+
+func TestNewPVZ_ShouldReturnError_WhenIdIsEmpty(t *testing.T) {
+	_, err := domain.NewPVZ(uuid.Nil, getPVZ(t).CreationTimeUTC, domain.City{})
+
+	require.Error(t, err)
+	require.Equal(t, domain.InvalidIdStateError, err.Error())
+}
+
+func TestPVZCreateNewReception_ShouldRaiseReceptionOpenedEvent(t *testing.T) {
+	pvz := getPVZ(t)
+
+	// act
+	reception, err := pvz.CreateNewReception(ctx, newReceptionInfoRepository(t))
+
+	// assert
+	require.NoError(t, err)
+	events := pvz.PullEvents()
+	require.Len(t, events, 1)
+	require.Equal(t, domain.ReceptionOpenedEventType, events[0].Type)
+	require.Equal(t, pvz.ID, events[0].PVZID)
+	require.Equal(t, reception.ID, decodePayload[domain.ReceptionInfo](t, events[0]).ID)
+}
+
+func TestReceptionInfoClose_ShouldRaiseReceptionClosedEventWithAcceptedProducts(t *testing.T) {
+	reception := getOpenedReception(t)
+	products := newProductRepository(t)
+	for _, category := range []domain.ProductCategory{domain.ShoesProductCategory, domain.ClothesProductCategory} {
+		_, err := reception.AddNewProduct(ctx, category, nil, products)
+		require.NoError(t, err)
+	}
+	reception.PullEvents()
+
+	// act
+	require.NoError(t, reception.Close(ctx, products))
+
+	// assert
+	events := reception.PullEvents()
+	require.Len(t, events, 1)
+	require.Equal(t, domain.ReceptionClosedEventType, events[0].Type)
+	require.Equal(t, reception.PVZID, events[0].PVZID)
+	payload := decodePayload[domain.ReceptionClosedEventPayload](t, events[0])
+	require.Equal(t, reception.ID, payload.ID)
+	require.Equal(t, domain.CloseProductAcceptanceStatus, payload.Status)
+	require.Equal(t, 2, payload.AcceptedProducts)
+	require.Equal(t, domain.ManualReceptionCloseReason, payload.CloseReason)
+}
+
+func TestReceptionInfoCloseAutomatically_ShouldRaiseReceptionClosedEventWithAutomaticReason(t *testing.T) {
+	reception := getOpenedReception(t)
+	products := newProductRepository(t)
+	_, err := reception.AddNewProduct(ctx, domain.ShoesProductCategory, nil, products)
+	require.NoError(t, err)
+	reception.PullEvents()
+
+	// act
+	err = reception.CloseAutomatically(ctx, products)
+
+	// assert
+	require.NoError(t, err)
+	require.True(t, reception.IsCompleted())
+	events := reception.PullEvents()
+	require.Len(t, events, 1)
+	require.Equal(t, domain.ReceptionClosedEventType, events[0].Type)
+	payload := decodePayload[domain.ReceptionClosedEventPayload](t, events[0])
+	require.Equal(t, 1, payload.AcceptedProducts)
+	require.Equal(t, domain.AutomaticReceptionCloseReason, payload.CloseReason)
+}
+
+func TestReceptionInfoFlagStale_ShouldRaiseStaleEventWithoutClosing(t *testing.T) {
+	reception := getOpenedReception(t)
+	idleSince := reception.CreationTimeUTC
+
+	// act
+	err := reception.FlagStale(idleSince)
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, reception.IsCompleted())
+	events := reception.PullEvents()
+	require.Len(t, events, 1)
+	require.Equal(t, domain.ReceptionStaleEventType, events[0].Type)
+	require.Equal(t, reception.PVZID, events[0].PVZID)
+	payload := decodePayload[domain.ReceptionStaleEventPayload](t, events[0])
+	require.Equal(t, reception.ID, payload.ID)
+	require.True(t, idleSince.Equal(payload.IdleSinceUTC))
+}
+
+func TestReceptionInfoFlagStale_ShouldReturnError_WhenClosed(t *testing.T) {
+	reception := getOpenedReception(t)
+	reception.Status = domain.CloseProductAcceptanceStatus
+
+	// act
+	err := reception.FlagStale(reception.CreationTimeUTC)
+
+	// assert
+	require.Error(t, err)
+	require.Equal(t, domain.ReceptionIsAlreadyClosedError, err.Error())
+	require.Empty(t, reception.PullEvents())
+}
+
+func TestReceptionInfo_ShouldRaiseProductEventsInOrder(t *testing.T) {
+	reception := getOpenedReception(t)
+	products := newProductRepository(t)
+
+	// act
+	added, err := reception.AddNewProduct(ctx, domain.ShoesProductCategory, nil, products)
+	require.NoError(t, err)
+	removed, err := reception.RemoveLastProduct(ctx, uuid.Must(uuid.NewV7()), products)
+	require.NoError(t, err)
+
+	// assert
+	events := reception.PullEvents()
+	require.Len(t, events, 2)
+	require.Equal(t, domain.ProductAddedEventType, events[0].Type)
+	require.Equal(t, added.ID, decodePayload[domain.Product](t, events[0]).ID)
+	require.Equal(t, domain.ProductRemovedEventType, events[1].Type)
+	require.Equal(t, removed.ID, decodePayload[domain.Product](t, events[1]).ID)
+}
+
+func TestReceptionInfo_ShouldNotRaiseEvent_WhenOperationFails(t *testing.T) {
+	reception := getOpenedReception(t)
+
+	// act
+	_, err := reception.RemoveLastProduct(ctx, uuid.Must(uuid.NewV7()), newProductRepository(t))
+
+	// assert
+	require.Error(t, err)
+	require.Empty(t, reception.PullEvents())
+}
+
+func TestPullEvents_ShouldForgetPulledEvents(t *testing.T) {
+	reception := getOpenedReception(t)
+	require.NoError(t, reception.Close(ctx, newProductRepository(t)))
+	require.Len(t, reception.PullEvents(), 1)
+
+	// act
+	events := reception.PullEvents()
+
+	// assert
+	require.Empty(t, events)
+}
+
+func decodePayload[T any](t *testing.T, event domain.Event) T {
+	t.Helper()
+
+	var payload T
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+
+	return payload
+}
@@ -0,0 +1,135 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInvitation(t *testing.T) {
+	moderator := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.ModeratorRole()}
+
+	t.Run("Keeps only hash of token", func(t *testing.T) {
+		email := domain.Email(" Invited@Example.com ")
+		timeBeforeRun := time.Now().UTC()
+
+		// act
+		invitation, token, err := domain.NewInvitation(moderator, domain.ModeratorRole(), &email)
+
+		// assert
+		require.NoError(t, err)
+		require.NotEmpty(t, token)
+		require.Equal(t, domain.HashToken(token), invitation.TokenHash)
+		require.NotContains(t, invitation.TokenHash, token)
+		require.Equal(t, domain.ModeratorUserRoleID, invitation.RoleID)
+		require.Equal(t, domain.Email("invited@example.com"), *invitation.Email)
+		require.Equal(t, moderator.ID, invitation.InvitedBy)
+		require.Equal(t, invitation.CreationTimeUTC.Add(domain.InvitationLifetime), invitation.ExpiresAtUTC)
+		require.LessOrEqual(t, timeBeforeRun, invitation.CreationTimeUTC)
+	})
+
+	t.Run("Lets moderator invite employee", func(t *testing.T) {
+		// act
+		invitation, _, err := domain.NewInvitation(moderator, domain.EmployeeRole(), nil)
+
+		// assert
+		require.NoError(t, err)
+		require.Equal(t, domain.EmployeeUserRoleID, invitation.RoleID)
+		require.Nil(t, invitation.Email)
+	})
+
+	t.Run("Refuses role with permissions inviter does not have", func(t *testing.T) {
+		// act
+		_, _, err := domain.NewInvitation(moderator, domain.AdminRole(), nil)
+
+		// assert
+		require.EqualError(t, err, domain.RoleIsBeyondGranterError)
+		require.True(t, domain.IsAccessError(err))
+	})
+}
+
+func TestInvitation_Accept(t *testing.T) {
+	moderator := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.ModeratorRole()}
+	user := domain.User{ID: uuid.Must(uuid.NewV7()), Email: "invited@example.com"}
+	email := domain.Email("invited@example.com")
+	otherEmail := domain.Email("other@example.com")
+
+	t.Run("Accepts invitation once", func(t *testing.T) {
+		invitation, _, err := domain.NewInvitation(moderator, domain.EmployeeRole(), &email)
+		require.NoError(t, err)
+		moment := invitation.CreationTimeUTC.Add(time.Hour)
+
+		// act
+		first := invitation.Accept(user, moment)
+		second := invitation.Accept(user, moment)
+
+		// assert
+		require.NoError(t, first)
+		require.Equal(t, moment, *invitation.AcceptedAtUTC)
+		require.Equal(t, user.ID, *invitation.AcceptedBy)
+		require.EqualError(t, second, domain.InvitationIsAlreadyAcceptedError)
+	})
+
+	t.Run("Refuses expired invitation", func(t *testing.T) {
+		invitation, _, err := domain.NewInvitation(moderator, domain.EmployeeRole(), nil)
+		require.NoError(t, err)
+
+		// act
+		err = invitation.Accept(user, invitation.ExpiresAtUTC)
+
+		// assert
+		require.EqualError(t, err, domain.InvitationIsExpiredError)
+		require.Nil(t, invitation.AcceptedAtUTC)
+	})
+
+	t.Run("Refuses user with another email", func(t *testing.T) {
+		invitation, _, err := domain.NewInvitation(moderator, domain.EmployeeRole(), &otherEmail)
+		require.NoError(t, err)
+
+		// act
+		err = invitation.Accept(user, invitation.CreationTimeUTC)
+
+		// assert
+		require.EqualError(t, err, domain.InvitationEmailMismatchError)
+	})
+}
+
+func TestChangeRole(t *testing.T) {
+	moderator := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.ModeratorRole()}
+	admin := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.AdminRole()}
+
+	t.Run("Promotes employee", func(t *testing.T) {
+		employee := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.EmployeeRole()}
+
+		// act
+		err := domain.ChangeRole(moderator, &employee, domain.ModeratorRole())
+
+		// assert
+		require.NoError(t, err)
+		require.Equal(t, domain.ModeratorRole(), employee.UserRole)
+	})
+
+	t.Run("Refuses to demote more powerful user", func(t *testing.T) {
+		target := admin
+
+		// act
+		err := domain.ChangeRole(moderator, &target, domain.EmployeeRole())
+
+		// assert
+		require.EqualError(t, err, domain.RoleIsBeyondGranterError)
+		require.Equal(t, domain.AdminRole(), target.UserRole)
+	})
+
+	t.Run("Refuses to change own role", func(t *testing.T) {
+		self := admin
+
+		// act
+		err := domain.ChangeRole(admin, &self, domain.EmployeeRole())
+
+		// assert
+		require.EqualError(t, err, domain.OwnRoleChangeError)
+	})
+}
@@ -0,0 +1,290 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewShipmentManifest_ShouldCreateManifest(t *testing.T) {
+	pvz := domain.PVZ{ID: uuid.Must(uuid.NewV7())}
+	moderatorID := uuid.Must(uuid.NewV7())
+	items := []domain.ShipmentManifestItem{
+		{Barcode: "4006381333931", Category: domain.ElectronicsProductCategory},
+		{Barcode: "1234567890-1", Category: domain.ShoesProductCategory},
+	}
+	timeBeforeRun := time.Now().UTC()
+
+	// act
+	manifest, err := domain.NewShipmentManifest(pvz, moderatorID, items)
+
+	// assert
+	require.NoError(t, err)
+	require.NotEqual(t, uuid.Nil, manifest.ID)
+	require.Equal(t, pvz.ID, manifest.PVZID)
+	require.Nil(t, manifest.ReceptionID)
+	require.Equal(t, moderatorID, manifest.UploadedBy)
+	require.LessOrEqual(t, timeBeforeRun, manifest.CreationTimeUTC)
+	require.Equal(t, items, manifest.Items)
+}
+
+func TestNewShipmentManifest_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name          string
+		items         []domain.ShipmentManifestItem
+		expectedError string
+	}{
+		{
+			name:          "no parcels",
+			items:         nil,
+			expectedError: domain.ShipmentManifestIsEmptyError,
+		},
+		{
+			name: "blank barcode",
+			items: []domain.ShipmentManifestItem{
+				{Barcode: "4006381333931", Category: domain.ElectronicsProductCategory},
+				{Barcode: "", Category: domain.ElectronicsProductCategory},
+			},
+			expectedError: domain.BarcodeIsRequiredError,
+		},
+		{
+			name:          "unknown category",
+			items:         []domain.ShipmentManifestItem{{Barcode: "4006381333931", Category: 42}},
+			expectedError: domain.UnknownProductCategoryError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := domain.NewShipmentManifest(domain.PVZ{ID: uuid.Must(uuid.NewV7())}, uuid.Must(uuid.NewV7()), tc.items)
+
+			require.Error(t, err)
+			require.Equal(t, tc.expectedError, err.Error())
+		})
+	}
+}
+
+func TestShipmentManifestLinkTo_ShouldLinkReceptionOfTheSamePVZ(t *testing.T) {
+	reception := getOpenedReception(t)
+	manifest := getManifest(t, reception.PVZID, []string{"A"})
+
+	// act
+	err := manifest.LinkTo(reception)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, &reception.ID, manifest.ReceptionID)
+	require.NoError(t, manifest.LinkTo(reception), "linking to the same reception again changes nothing")
+}
+
+func TestShipmentManifestLinkTo_ShouldReturnError(t *testing.T) {
+	closedReception := getOpenedReception(t)
+	closedReception.Status = domain.CloseProductAcceptanceStatus
+	reception := getOpenedReception(t)
+	otherReception := getOpenedReception(t)
+	otherReception.PVZID = reception.PVZID
+	linked := getManifest(t, reception.PVZID, []string{"A"})
+	require.NoError(t, linked.LinkTo(otherReception))
+
+	testCases := []struct {
+		name          string
+		manifest      domain.ShipmentManifest
+		reception     domain.ReceptionInfo
+		expectedError string
+	}{
+		{
+			name:          "closed reception",
+			manifest:      getManifest(t, closedReception.PVZID, []string{"A"}),
+			reception:     closedReception,
+			expectedError: domain.ReceptionIsAlreadyClosedError,
+		},
+		{
+			name:          "reception of another pvz",
+			manifest:      getManifest(t, uuid.Must(uuid.NewV7()), []string{"A"}),
+			reception:     reception,
+			expectedError: domain.ShipmentManifestIsForAnotherPVZError,
+		},
+		{
+			name:          "manifest linked to another reception",
+			manifest:      linked,
+			reception:     reception,
+			expectedError: domain.ShipmentManifestIsAlreadyLinkedError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.manifest.LinkTo(tc.reception)
+
+			require.Error(t, err)
+			require.Equal(t, tc.expectedError, err.Error())
+		})
+	}
+}
+
+func TestShipmentManifestReconcile(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		manifest             []string
+		accepted             []string
+		scannedAgain         []string
+		missing              []string
+		unexpected           []string
+		duplicates           []string
+		unidentifiedProducts int
+	}{
+		{
+			name:       "everything accepted",
+			manifest:   []string{"A", "B", "B"},
+			accepted:   []string{"B", "A", "B"},
+			missing:    []string{},
+			unexpected: []string{},
+			duplicates: []string{},
+		},
+		{
+			name:       "missing parcels",
+			manifest:   []string{"C", "A", "B", "B"},
+			accepted:   []string{"B"},
+			missing:    []string{"A", "B", "C"},
+			unexpected: []string{},
+			duplicates: []string{},
+		},
+		{
+			name:       "unexpected parcels",
+			manifest:   []string{"A"},
+			accepted:   []string{"A", "Z", "Y"},
+			missing:    []string{},
+			unexpected: []string{"Y", "Z"},
+			duplicates: []string{},
+		},
+		{
+			name:         "duplicate scans of expected and unexpected parcels",
+			manifest:     []string{"A", "B"},
+			accepted:     []string{"A", "B", "Z"},
+			scannedAgain: []string{"Z", "A", "Z"},
+			missing:      []string{},
+			unexpected:   []string{"Z"},
+			duplicates:   []string{"A", "Z"},
+		},
+		{
+			name:                 "products without barcode",
+			manifest:             []string{"A"},
+			accepted:             []string{"", "A", ""},
+			missing:              []string{},
+			unexpected:           []string{},
+			duplicates:           []string{},
+			unidentifiedProducts: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reception := getOpenedReception(t)
+			manifest := getManifest(t, reception.PVZID, tc.manifest)
+			require.NoError(t, manifest.LinkTo(reception))
+
+			// every parcel is expected and accepted as the same category, so only barcodes could differ
+			products := make([]*domain.Product, len(tc.accepted))
+			for i, barcode := range tc.accepted {
+				products[i] = &domain.Product{ID: uuid.Must(uuid.NewV7()), ReceptionID: reception.ID, Category: domain.ClothesProductCategory}
+				if barcode != "" {
+					products[i].Barcode = &domain.Barcode{Value: barcode, Format: domain.Code128BarcodeFormat}
+				}
+			}
+
+			duplicateScans := make([]domain.DuplicateScan, len(tc.scannedAgain))
+			for i, barcode := range tc.scannedAgain {
+				duplicateScans[i], _ = domain.NewDuplicateScan(reception.ID, domain.Barcode{Value: barcode, Format: domain.Code128BarcodeFormat}, uuid.Must(uuid.NewV7()))
+			}
+
+			// act
+			report := manifest.Reconcile(reception, products, duplicateScans)
+
+			// assert
+			require.Equal(t, reception.ID, report.ReceptionID)
+			require.Equal(t, manifest.ID, report.ManifestID)
+			require.Equal(t, tc.missing, report.Missing)
+			require.Equal(t, tc.unexpected, report.Unexpected)
+			require.Equal(t, tc.duplicates, report.Duplicates)
+			require.Equal(t, tc.unidentifiedProducts, report.UnidentifiedProducts)
+			require.Empty(t, report.CategoryMismatches)
+
+			hasDiscrepancies := len(tc.missing)+len(tc.unexpected)+len(tc.duplicates)+tc.unidentifiedProducts > 0
+			require.Equal(t, hasDiscrepancies, report.HasDiscrepancies())
+		})
+	}
+}
+
+func TestShipmentManifestReconcile_ShouldReconcileCategories(t *testing.T) {
+	reception := getOpenedReception(t)
+	manifest, err := domain.NewShipmentManifest(domain.PVZ{ID: reception.PVZID}, uuid.Must(uuid.NewV7()), []domain.ShipmentManifestItem{
+		{Barcode: "A", Category: domain.ElectronicsProductCategory},
+		{Barcode: "B", Category: domain.ShoesProductCategory},
+		{Barcode: "B", Category: domain.ClothesProductCategory},
+		{Barcode: "C", Category: domain.ShoesProductCategory},
+	})
+	require.NoError(t, err)
+	require.NoError(t, manifest.LinkTo(reception))
+	accepted := []struct {
+		barcode  string
+		category domain.ProductCategory
+	}{
+		{"A", domain.ClothesProductCategory},
+		{"B", domain.ClothesProductCategory},
+		{"B", domain.ShoesProductCategory},
+		{"C", domain.ShoesProductCategory},
+		{"", domain.ShoesProductCategory},
+	}
+	products := make([]*domain.Product, 0, len(accepted))
+	for _, product := range accepted {
+		products = append(products, &domain.Product{ID: uuid.Must(uuid.NewV7()), ReceptionID: reception.ID, Category: product.category})
+		if product.barcode != "" {
+			products[len(products)-1].Barcode = &domain.Barcode{Value: product.barcode, Format: domain.Code128BarcodeFormat}
+		}
+	}
+
+	// act
+	report := manifest.Reconcile(reception, products, nil)
+
+	// assert
+	require.Equal(t, []domain.CategoryMismatch{
+		{Barcode: "A", Expected: domain.ElectronicsProductCategory, Accepted: domain.ClothesProductCategory},
+	}, report.CategoryMismatches)
+	require.Equal(t, []domain.CategoryDiscrepancy{
+		{Category: domain.ElectronicsProductCategory, Expected: 1, Accepted: 0},
+		{Category: domain.ClothesProductCategory, Expected: 1, Accepted: 2},
+		{Category: domain.ShoesProductCategory, Expected: 2, Accepted: 3},
+	}, report.Categories)
+	require.Empty(t, report.Missing)
+	require.Empty(t, report.Unexpected)
+	require.True(t, report.HasDiscrepancies())
+}
+
+// getManifest expects every parcel as clothes
+func getManifest(t *testing.T, pvzID domain.PVZID, barcodes []string) domain.ShipmentManifest {
+	t.Helper()
+
+	items := make([]domain.ShipmentManifestItem, 0, len(barcodes))
+	for _, barcode := range barcodes {
+		items = append(items, domain.ShipmentManifestItem{Barcode: barcode, Category: domain.ClothesProductCategory})
+	}
+
+	manifest, err := domain.NewShipmentManifest(domain.PVZ{ID: pvzID}, uuid.Must(uuid.NewV7()), items)
+	require.NoError(t, err)
+
+	return manifest
+}
+
+func getOpenedReception(t *testing.T) domain.ReceptionInfo {
+	t.Helper()
+
+	return domain.ReceptionInfo{
+		ID:              uuid.Must(uuid.NewV7()),
+		PVZID:           uuid.Must(uuid.NewV7()),
+		CreationTimeUTC: time.Now().UTC(),
+		Status:          domain.InProggressProductAcceptanceStatus,
+	}
+}
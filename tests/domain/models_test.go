@@ -2,6 +2,7 @@ package domain_test
 
 import (
 	"avito/internal/domain"
+	"avito/internal/storage/inmemory"
 	"context"
 	"math"
 	"math/rand"
@@ -21,8 +22,8 @@ func TestGrantModeratorRole_ShouldGrantModeratorRole(t *testing.T) {
 		Email:    "test@test.test",
 		Password: "sdf",
 		UserRole: domain.UserRole{
-			ID:   domain.ClientUserRoleID,
-			Name: domain.ClientUserRoleName,
+			ID:   domain.EmployeeUserRoleID,
+			Name: domain.EmployeeUserRoleName,
 		},
 	}
 
@@ -31,15 +32,13 @@ func TestGrantModeratorRole_ShouldGrantModeratorRole(t *testing.T) {
 
 	require.Equal(t, domain.ModeratorUserRoleID, user.UserRole.ID)
 	require.Equal(t, domain.ModeratorUserRoleName, user.UserRole.Name)
+	require.True(t, user.HasPermission(domain.PVZCreatePermission))
 }
 
 func TestNewUser(t *testing.T) {
 	email := "example.com@example.com"
 	password := email
-	initialRole := domain.UserRole{
-		ID:   domain.ClientUserRoleID,
-		Name: domain.ClientUserRoleName,
-	}
+	initialRole := domain.EmployeeRole()
 
 	user, err := domain.NewUser(email, password)
 
@@ -51,11 +50,11 @@ func TestNewUser(t *testing.T) {
 
 func TestPVZCreateNewReception_ShouldCreateReception(t *testing.T) {
 	pvz := getPVZ(t)
-	receptionRepositoryFake := NewFakeReceptionInfoRepository(t)
+	receptionRepository := newReceptionInfoRepository(t)
 	timeBeforeRun := time.Now().UTC()
 
 	// act
-	reception, err := pvz.CreateNewReception(ctx, receptionRepositoryFake)
+	reception, err := pvz.CreateNewReception(ctx, receptionRepository)
 
 	// assert
 	require.NoError(t, err)
@@ -63,21 +62,26 @@ func TestPVZCreateNewReception_ShouldCreateReception(t *testing.T) {
 	require.NotEqual(t, uuid.Nil, reception.PVZID)
 	require.LessOrEqual(t, timeBeforeRun, reception.CreationTimeUTC)
 	require.Equal(t, domain.InProggressProductAcceptanceStatus, reception.Status)
-	addedReception, exists := receptionRepositoryFake.Receptions[reception.ID]
+	addedReceptions, err := receptionRepository.FindAllByFilter(ctx, domain.SearchReceptionInfoFilter{
+		PVZID:  pvz.ID,
+		Status: domain.InProggressProductAcceptanceStatus,
+		Limit:  1,
+	})
 
-	require.True(t, exists)
-	require.Equal(t, reception, addedReception)
+	require.NoError(t, err)
+	require.Len(t, addedReceptions, 1)
+	require.Equal(t, reception, addedReceptions[0])
 }
 
 func TestPVZCreateNewReception_ShouldReturnError_WhenOtherReceptionIsOpened(t *testing.T) {
 	pvz := getPVZ(t)
-	receptionRepositoryFake := NewFakeReceptionInfoRepository(t)
+	receptionRepository := newReceptionInfoRepository(t)
 	recption := getValidReception(t, pvz, domain.InProggressProductAcceptanceStatus)
-	receptionRepositoryFake.Receptions[recption.ID] = recption
+	require.NoError(t, receptionRepository.Add(ctx, recption))
 	expectedErrorMsg := domain.AnotherOpenedReceptionError
 
 	// act
-	_, err := pvz.CreateNewReception(ctx, receptionRepositoryFake)
+	_, err := pvz.CreateNewReception(ctx, receptionRepository)
 
 	// assert
 	require.Error(t, err)
@@ -86,12 +90,12 @@ func TestPVZCreateNewReception_ShouldReturnError_WhenOtherReceptionIsOpened(t *t
 
 func TestPVZCurrentReception_ShouldReturnLastOpenedReception(t *testing.T) {
 	pvz := getPVZ(t)
-	receptionRepositoryFake := NewFakeReceptionInfoRepository(t)
+	receptionRepository := newReceptionInfoRepository(t)
 	reception := getValidReception(t, pvz, domain.InProggressProductAcceptanceStatus)
-	receptionRepositoryFake.Receptions[reception.ID] = reception
+	require.NoError(t, receptionRepository.Add(ctx, reception))
 
 	//act
-	currentReception, err := pvz.CurrentReception(ctx, receptionRepositoryFake)
+	currentReception, err := pvz.CurrentReception(ctx, receptionRepository)
 
 	require.NoError(t, err)
 	require.Equal(t, reception, currentReception)
@@ -99,11 +103,11 @@ func TestPVZCurrentReception_ShouldReturnLastOpenedReception(t *testing.T) {
 
 func TestPVZCurrentReception_ShouldReturnError_WhenNoReceptions(t *testing.T) {
 	pvz := getPVZ(t)
-	receptionRepositoryFake := NewFakeReceptionInfoRepository(t)
+	receptionRepository := newReceptionInfoRepository(t)
 	expectedErrorMsg := domain.AllReceptionsAreClosed
 
 	//act
-	_, err := pvz.CurrentReception(ctx, receptionRepositoryFake)
+	_, err := pvz.CurrentReception(ctx, receptionRepository)
 
 	require.Error(t, err)
 	require.Equal(t, expectedErrorMsg, err.Error())
@@ -157,7 +161,7 @@ func TestReceptionInfoClose_ShouldChangeReceptionStatus(t *testing.T) {
 		Status:          domain.InProggressProductAcceptanceStatus,
 	}
 
-	err := reception.Close()
+	err := reception.Close(ctx, newProductRepository(t))
 
 	require.NoError(t, err)
 	require.Equal(t, domain.CloseProductAcceptanceStatus, reception.Status)
@@ -172,7 +176,7 @@ func TestReceptionInfoClose_ShouldReturnError_WhenAlreadyClosed(t *testing.T) {
 		Status:          domain.CloseProductAcceptanceStatus,
 	}
 
-	err := reception.Close()
+	err := reception.Close(ctx, newProductRepository(t))
 
 	require.Error(t, err)
 	require.Equal(t, domain.ReceptionIsAlreadyClosedError, err.Error())
@@ -187,11 +191,11 @@ func TestReceptionInfoAddNewProduct_ShouldCreateAndAddProduct(t *testing.T) {
 		Status:          domain.InProggressProductAcceptanceStatus,
 	}
 	expectedProductCategory := domain.ElectronicsProductCategory
-	productRepositoryFake := NewFakeProductRepository(t)
+	productRepository := newProductRepository(t)
 	timeBeforeRun := time.Now().UTC()
 
 	// act
-	addedProduct, err := reception.AddNewProduct(ctx, expectedProductCategory, productRepositoryFake)
+	addedProduct, err := reception.AddNewProduct(ctx, expectedProductCategory, nil, productRepository)
 
 	// assert
 	require.NoError(t, err)
@@ -200,7 +204,7 @@ func TestReceptionInfoAddNewProduct_ShouldCreateAndAddProduct(t *testing.T) {
 	require.LessOrEqual(t, timeBeforeRun, addedProduct.CreationTimeUTC)
 	require.Equal(t, expectedProductCategory, addedProduct.Category)
 
-	storageProduct, exists := productRepositoryFake.Products[addedProduct.ID]
+	storageProduct, exists := findProduct(t, productRepository, reception.ID, addedProduct.ID)
 	require.True(t, exists)
 	require.Equal(t, addedProduct, storageProduct)
 }
@@ -214,17 +218,19 @@ func TestReceptionInfoAddNewProduct_ShouldReturnError_WhenClosed(t *testing.T) {
 		Status:          domain.CloseProductAcceptanceStatus,
 	}
 	expectedProductCategory := domain.ElectronicsProductCategory
-	productRepositoryFake := NewFakeProductRepository(t)
+	productRepository := newProductRepository(t)
 	expectedErrorMsg := domain.ReceptionIsAlreadyClosedError
 
 	// act
-	_, err := reception.AddNewProduct(ctx, expectedProductCategory, productRepositoryFake)
+	_, err := reception.AddNewProduct(ctx, expectedProductCategory, nil, productRepository)
 
 	// assert
 	require.Error(t, err)
 	require.Equal(t, expectedErrorMsg, err.Error())
 
-	require.Equal(t, 0, len(productRepositoryFake.Products))
+	storageProducts, err := productRepository.FindAllByReceptionID(ctx, reception.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(storageProducts))
 }
 
 func TestReceptionInfoRemoveLastProduct_ShouldRemoveLastAddedProduct(t *testing.T) {
@@ -237,22 +243,24 @@ func TestReceptionInfoRemoveLastProduct_ShouldRemoveLastAddedProduct(t *testing.
 	}
 	product1Category := domain.ElectronicsProductCategory
 	product2Category := domain.ShoesProductCategory
-	productRepositoryFake := NewFakeProductRepository(t)
+	productRepository := newProductRepository(t)
 	var product1, product2 domain.Product
 
 	// act
-	product1, _ = reception.AddNewProduct(ctx, product1Category, productRepositoryFake)
+	product1, _ = reception.AddNewProduct(ctx, product1Category, nil, productRepository)
 	time.Sleep(time.Duration(1 * time.Second))
-	product2, _ = reception.AddNewProduct(ctx, product2Category, productRepositoryFake)
-	removedProduct, err := reception.RemoveLastProduct(ctx, productRepositoryFake)
+	product2, _ = reception.AddNewProduct(ctx, product2Category, nil, productRepository)
+	removedProduct, err := reception.RemoveLastProduct(ctx, id, productRepository)
 
 	// assert
 	require.NoError(t, err)
 	require.Equal(t, product2.Category, removedProduct.Category)
 
-	storageProduct, exists := productRepositoryFake.Products[product1.ID]
+	storageProduct, exists := findProduct(t, productRepository, reception.ID, product1.ID)
 	require.True(t, exists)
 	require.Equal(t, product1.Category, storageProduct.Category)
+	_, exists = findProduct(t, productRepository, reception.ID, product2.ID)
+	require.False(t, exists)
 }
 
 func TestReceptionInfoRemoveLastProduct_ShouldReturnError_WhenReceptionIsClosed(t *testing.T) {
@@ -264,19 +272,283 @@ func TestReceptionInfoRemoveLastProduct_ShouldReturnError_WhenReceptionIsClosed(
 		Status:          domain.InProggressProductAcceptanceStatus,
 	}
 	productCategory := domain.ElectronicsProductCategory
-	productRepositoryFake := NewFakeProductRepository(t)
+	productRepository := newProductRepository(t)
 	expectedErrorMsg := domain.ReceptionIsAlreadyClosedError
 
 	// act
-	_, _ = reception.AddNewProduct(ctx, productCategory, productRepositoryFake)
-	reception.Close()
-	_, err := reception.RemoveLastProduct(ctx, productRepositoryFake)
+	_, _ = reception.AddNewProduct(ctx, productCategory, nil, productRepository)
+	reception.Close(ctx, productRepository)
+	_, err := reception.RemoveLastProduct(ctx, id, productRepository)
 
 	// assert
 	require.Error(t, err)
 	require.Equal(t, expectedErrorMsg, err.Error())
 }
 
+func TestReceptionInfoRemoveLastProduct_ShouldMarkProductRemovedByUser(t *testing.T) {
+	reception := getOpenedReception(t)
+	productRepository := newProductRepository(t)
+	employeeID := uuid.Must(uuid.NewV7())
+	added, _ := reception.AddNewProduct(ctx, domain.ShoesProductCategory, nil, productRepository)
+	timeBeforeRun := time.Now().UTC()
+
+	// act
+	removedProduct, err := reception.RemoveLastProduct(ctx, employeeID, productRepository)
+
+	// assert
+	require.NoError(t, err)
+	require.True(t, removedProduct.IsRemoved())
+	require.Equal(t, &employeeID, removedProduct.DeletedBy)
+	require.LessOrEqual(t, timeBeforeRun, *removedProduct.DeletedAtUTC)
+
+	storageProduct, err := productRepository.FindLastRemovedByReceptionID(ctx, reception.ID)
+	require.NoError(t, err)
+	require.Equal(t, added.ID, storageProduct.ID)
+}
+
+func TestReceptionInfoRestoreLastRemovedProduct_ShouldRestoreLatestRemoval(t *testing.T) {
+	reception := getOpenedReception(t)
+	productRepository := newProductRepository(t)
+	employeeID := uuid.Must(uuid.NewV7())
+	first, _ := reception.AddNewProduct(ctx, domain.ShoesProductCategory, nil, productRepository)
+	time.Sleep(time.Millisecond)
+	second, _ := reception.AddNewProduct(ctx, domain.ClothesProductCategory, nil, productRepository)
+	reception.RemoveLastProduct(ctx, employeeID, productRepository)
+	time.Sleep(time.Millisecond)
+	reception.RemoveLastProduct(ctx, employeeID, productRepository)
+	reception.PullEvents()
+
+	// act
+	restoredProduct, err := reception.RestoreLastRemovedProduct(ctx, productRepository)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, first.ID, restoredProduct.ID)
+	require.False(t, restoredProduct.IsRemoved())
+	require.True(t, first.CreationTimeUTC.Equal(restoredProduct.CreationTimeUTC))
+
+	_, exists := findProduct(t, productRepository, reception.ID, first.ID)
+	require.True(t, exists)
+	_, exists = findProduct(t, productRepository, reception.ID, second.ID)
+	require.False(t, exists)
+
+	events := reception.PullEvents()
+	require.Len(t, events, 1)
+	require.Equal(t, domain.ProductRestoredEventType, events[0].Type)
+	require.Equal(t, first.ID, decodePayload[domain.Product](t, events[0]).ID)
+}
+
+func TestReceptionInfoRestoreLastRemovedProduct_ShouldReturnError(t *testing.T) {
+	barcode, err := domain.NewBarcode(domain.EAN13BarcodeFormat, "4006381333931")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		arrange          func(t *testing.T, reception *domain.ReceptionInfo, products domain.ProductRepository)
+		expectedErrorMsg string
+	}{
+		{
+			name: "nothing was removed",
+			arrange: func(t *testing.T, reception *domain.ReceptionInfo, products domain.ProductRepository) {
+				reception.AddNewProduct(ctx, domain.ShoesProductCategory, nil, products)
+			},
+			expectedErrorMsg: domain.RemovedProductDoesNotExistError,
+		},
+		{
+			name: "reception is closed",
+			arrange: func(t *testing.T, reception *domain.ReceptionInfo, products domain.ProductRepository) {
+				reception.AddNewProduct(ctx, domain.ShoesProductCategory, nil, products)
+				reception.RemoveLastProduct(ctx, uuid.Must(uuid.NewV7()), products)
+				reception.Close(ctx, products)
+			},
+			expectedErrorMsg: domain.ReceptionIsAlreadyClosedError,
+		},
+		{
+			name: "parcel was scanned again after removal",
+			arrange: func(t *testing.T, reception *domain.ReceptionInfo, products domain.ProductRepository) {
+				reception.AddNewProduct(ctx, domain.ElectronicsProductCategory, &barcode, products)
+				reception.RemoveLastProduct(ctx, uuid.Must(uuid.NewV7()), products)
+				_, err := reception.AddNewProduct(ctx, domain.ElectronicsProductCategory, &barcode, products)
+				require.NoError(t, err)
+			},
+			expectedErrorMsg: domain.DuplicateBarcodeError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := inmemory.NewStore()
+			reception := getOpenedReception(t)
+			require.NoError(t, inmemory.NewReceptionInfoRepository(store).Add(ctx, reception))
+			products := inmemory.NewProductRepository(store)
+			tc.arrange(t, &reception, products)
+			reception.PullEvents()
+
+			// act
+			_, err := reception.RestoreLastRemovedProduct(ctx, products)
+
+			// assert
+			require.Error(t, err)
+			require.Equal(t, tc.expectedErrorMsg, err.Error())
+			require.Empty(t, reception.PullEvents())
+		})
+	}
+}
+
+func TestReceptionInfoReopen_ShouldReopenLatestClosedReception(t *testing.T) {
+	receptions := inmemory.NewReceptionInfoRepository(inmemory.NewStore())
+	reception := getOpenedReception(t)
+	reception.Status = domain.CloseProductAcceptanceStatus
+	require.NoError(t, receptions.Add(ctx, reception))
+
+	// act
+	err := reception.Reopen(ctx, "closed before the second truck", receptions)
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, reception.IsCompleted())
+
+	events := reception.PullEvents()
+	require.Len(t, events, 1)
+	require.Equal(t, domain.ReceptionReopenedEventType, events[0].Type)
+	payload := decodePayload[domain.ReceptionReopenedEventPayload](t, events[0])
+	require.Equal(t, reception.ID, payload.ID)
+	require.Equal(t, domain.InProggressProductAcceptanceStatus, payload.Status)
+	require.Equal(t, "closed before the second truck", payload.Reason)
+}
+
+func TestReceptionInfoReopen_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name             string
+		status           domain.ReceptionStatus
+		reason           string
+		newerReception   bool
+		expectedErrorMsg string
+	}{
+		{
+			name:             "reception is opened",
+			status:           domain.InProggressProductAcceptanceStatus,
+			reason:           "reason",
+			expectedErrorMsg: domain.ReceptionIsNotClosedError,
+		},
+		{
+			name:             "reason is blank",
+			status:           domain.CloseProductAcceptanceStatus,
+			reason:           "  ",
+			expectedErrorMsg: domain.ReopenReasonIsRequiredError,
+		},
+		{
+			name:             "pvz has newer reception",
+			status:           domain.CloseProductAcceptanceStatus,
+			reason:           "reason",
+			newerReception:   true,
+			expectedErrorMsg: domain.NewerReceptionExistsError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			receptions := inmemory.NewReceptionInfoRepository(inmemory.NewStore())
+			reception := getOpenedReception(t)
+			reception.Status = tc.status
+			require.NoError(t, receptions.Add(ctx, reception))
+			if tc.newerReception {
+				newer := getOpenedReception(t)
+				newer.PVZID = reception.PVZID
+				newer.CreationTimeUTC = reception.CreationTimeUTC.Add(time.Hour)
+				require.NoError(t, receptions.Add(ctx, newer))
+			}
+
+			// act
+			err := reception.Reopen(ctx, tc.reason, receptions)
+
+			// assert
+			require.Error(t, err)
+			require.Equal(t, tc.expectedErrorMsg, err.Error())
+			require.Equal(t, tc.status, reception.Status)
+			require.Empty(t, reception.PullEvents())
+		})
+	}
+}
+
+func TestReceptionInfoLastActivityUTC_ShouldReturnCreationTime_WhenNothingHappened(t *testing.T) {
+	reception := getOpenedReception(t)
+	reception.CreationTimeUTC = reception.CreationTimeUTC.Add(-time.Hour)
+
+	// act
+	lastActivity, err := reception.LastActivityUTC(ctx, newProductRepository(t), newReceptionHistoryRepository(t))
+
+	// assert
+	require.NoError(t, err)
+	require.True(t, reception.CreationTimeUTC.Equal(lastActivity))
+}
+
+func TestReceptionInfoLastActivityUTC_ShouldTrackProductChanges(t *testing.T) {
+	reception := getOpenedReception(t)
+	productRepository := newProductRepository(t)
+	history := newReceptionHistoryRepository(t)
+	time.Sleep(time.Millisecond)
+	first, _ := reception.AddNewProduct(ctx, domain.ShoesProductCategory, nil, productRepository)
+	time.Sleep(time.Millisecond)
+	second, _ := reception.AddNewProduct(ctx, domain.ClothesProductCategory, nil, productRepository)
+
+	// act
+	afterAdding, addingErr := reception.LastActivityUTC(ctx, productRepository, history)
+	time.Sleep(time.Millisecond)
+	removed, _ := reception.RemoveLastProduct(ctx, uuid.Must(uuid.NewV7()), productRepository)
+	afterRemoval, removalErr := reception.LastActivityUTC(ctx, productRepository, history)
+
+	// assert
+	require.NoError(t, addingErr)
+	require.True(t, second.CreationTimeUTC.Equal(afterAdding))
+	require.True(t, afterAdding.After(first.CreationTimeUTC))
+
+	require.NoError(t, removalErr)
+	require.True(t, removed.DeletedAtUTC.Equal(afterRemoval))
+}
+
+func TestReceptionInfoLastActivityUTC_ShouldTrackReopeningOnly(t *testing.T) {
+	reception := getOpenedReception(t)
+	history := newReceptionHistoryRepository(t)
+	time.Sleep(time.Millisecond)
+	reopened, _ := domain.NewReceptionHistoryEntry(reception.ID, domain.ReceptionReopenedHistoryAction, uuid.Must(uuid.NewV7()), "забыли коробку")
+	require.NoError(t, history.Add(ctx, reopened))
+	time.Sleep(time.Millisecond)
+	flagged, _ := domain.NewReceptionHistoryEntry(reception.ID, domain.ReceptionFlaggedHistoryAction, uuid.Nil, "")
+	require.NoError(t, history.Add(ctx, flagged))
+
+	// act
+	lastActivity, err := reception.LastActivityUTC(ctx, newProductRepository(t), history)
+
+	// assert
+	require.NoError(t, err)
+	require.True(t, reopened.OccurredAtUTC.Equal(lastActivity))
+}
+
+func TestReceptionInfoLastActivityUTC_ShouldTrackRestoringAndManifestLinking(t *testing.T) {
+	testCases := []domain.ReceptionHistoryAction{
+		domain.ReceptionProductRestoredHistoryAction,
+		domain.ReceptionManifestLinkedHistoryAction,
+	}
+
+	for _, action := range testCases {
+		t.Run(string(action), func(t *testing.T) {
+			reception := getOpenedReception(t)
+			history := newReceptionHistoryRepository(t)
+			time.Sleep(time.Millisecond)
+			entry, _ := domain.NewReceptionHistoryEntry(reception.ID, action, uuid.Must(uuid.NewV7()), "")
+			require.NoError(t, history.Add(ctx, entry))
+
+			// act
+			lastActivity, err := reception.LastActivityUTC(ctx, newProductRepository(t), history)
+
+			// assert
+			require.NoError(t, err)
+			require.True(t, entry.OccurredAtUTC.Equal(lastActivity))
+		})
+	}
+}
+
 func TestNewCity_ShouldCreateCity(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -365,90 +637,76 @@ func getPVZ(t *testing.T) domain.PVZ {
 	}
 }
 
-type FakeReceptionInfoRepository struct {
-	Receptions map[domain.ReceptionID]domain.ReceptionInfo
+func newReceptionInfoRepository(t *testing.T) domain.ReceptionInfoRepository {
+	t.Helper()
+
+	return inmemory.NewReceptionInfoRepository(inmemory.NewStore())
 }
 
-func NewFakeReceptionInfoRepository(t *testing.T) *FakeReceptionInfoRepository {
+func newReceptionHistoryRepository(t *testing.T) domain.ReceptionHistoryRepository {
 	t.Helper()
-	return &FakeReceptionInfoRepository{
-		Receptions: make(map[domain.ReceptionID]domain.ReceptionInfo),
-	}
+
+	return inmemory.NewReceptionHistoryRepository(inmemory.NewStore())
 }
 
-func (r *FakeReceptionInfoRepository) FindAllByFilter(ctx context.Context, filter domain.SearchReceptionInfoFilter) ([]domain.ReceptionInfo, error) {
-	var results []domain.ReceptionInfo
+func newProductRepository(t *testing.T) domain.ProductRepository {
+	t.Helper()
 
-	for _, reception := range r.Receptions {
-		if reception.PVZID == filter.PVZID && (filter.Status == 0 || reception.Status == filter.Status) {
-			results = append(results, reception)
-		}
-	}
+	return inmemory.NewProductRepository(inmemory.NewStore())
+}
 
-	if filter.DescendingDateOrdering {
-		for i := 0; i < len(results)-1; i++ {
-			for j := i + 1; j < len(results); j++ {
-				if results[i].CreationTimeUTC.Before(results[j].CreationTimeUTC) {
-					results[i], results[j] = results[j], results[i]
-				}
-			}
-		}
-	} else {
-		for i := 0; i < len(results)-1; i++ {
-			for j := i + 1; j < len(results); j++ {
-				if results[i].CreationTimeUTC.After(results[j].CreationTimeUTC) {
-					results[i], results[j] = results[j], results[i]
-				}
-			}
-		}
-	}
+func findProduct(t *testing.T, products domain.ProductRepository, receptionId domain.ReceptionID, productId domain.ProductID) (domain.Product, bool) {
+	t.Helper()
+
+	receptionProducts, err := products.FindAllByReceptionID(ctx, receptionId)
+	require.NoError(t, err)
 
-	if filter.Limit > 0 && len(results) > filter.Limit {
-		results = results[:filter.Limit]
+	for _, product := range receptionProducts {
+		if product.ID == productId {
+			return *product, true
+		}
 	}
 
-	return results, nil
+	return domain.Product{}, false
 }
 
-func (r *FakeReceptionInfoRepository) Update(ctx context.Context, reception domain.ReceptionInfo) error {
-	r.Receptions[reception.ID] = reception
-	return nil
-}
+func TestSetActive(t *testing.T) {
+	moderator := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.ModeratorRole()}
+	admin := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.AdminRole()}
 
-func (r *FakeReceptionInfoRepository) Add(ctx context.Context, reception domain.ReceptionInfo) error {
-	r.Receptions[reception.ID] = reception
-	return nil
-}
+	t.Run("Deactivates and activates employee", func(t *testing.T) {
+		employee := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.EmployeeRole()}
 
-type FakeProductRepository struct {
-	Products map[domain.ReceptionID]domain.Product
-}
+		// act
+		deactivateErr := domain.SetActive(moderator, &employee, false)
+		deactivated := employee.Deactivated
+		activateErr := domain.SetActive(moderator, &employee, true)
 
-func NewFakeProductRepository(t *testing.T) *FakeProductRepository {
-	t.Helper()
-	return &FakeProductRepository{
-		Products: make(map[domain.ReceptionID]domain.Product),
-	}
-}
+		// assert
+		require.NoError(t, deactivateErr)
+		require.True(t, deactivated)
+		require.NoError(t, activateErr)
+		require.False(t, employee.Deactivated)
+	})
 
-func (r *FakeProductRepository) Add(ctx context.Context, product domain.Product) error {
-	r.Products[product.ID] = product
-	return nil
-}
+	t.Run("Refuses to deactivate more powerful user", func(t *testing.T) {
+		target := admin
 
-func (r *FakeProductRepository) FindAllByReceptionID(ctx context.Context, receptionId domain.ReceptionID) ([]*domain.Product, error) {
-	result := make([]*domain.Product, 0)
-	for _, p := range r.Products {
-		if p.ReceptionID == receptionId {
-			result = append(result, &p)
-		}
-	}
+		// act
+		err := domain.SetActive(moderator, &target, false)
 
-	return result, nil
-}
+		// assert
+		require.EqualError(t, err, domain.RoleIsBeyondGranterError)
+		require.False(t, target.Deactivated)
+	})
+
+	t.Run("Refuses to deactivate themselves", func(t *testing.T) {
+		self := moderator
 
-func (r *FakeProductRepository) Remove(ctx context.Context, product domain.Product) error {
-	delete(r.Products, product.ID)
+		// act
+		err := domain.SetActive(moderator, &self, false)
 
-	return nil
+		// assert
+		require.EqualError(t, err, domain.OwnActiveStatusChangeError)
+	})
 }
@@ -0,0 +1,38 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicy_Check(t *testing.T) {
+	policy := domain.NewPasswordPolicy(8, 2, []string{"Password1", "qwerty123"})
+
+	cases := []struct {
+		name     string
+		password string
+		expected string
+	}{
+		{name: "Accepts long password of two classes", password: "correct horse", expected: ""},
+		{name: "Counts letters instead of bytes", password: "пароль12", expected: ""},
+		{name: "Refuses short password", password: "Ab1!", expected: domain.PasswordIsTooShortError},
+		{name: "Refuses password of one class", password: "abcdefghij", expected: domain.PasswordIsTooSimpleError},
+		{name: "Refuses breached password ignoring case", password: "PASSWORD1", expected: domain.PasswordIsBreachedError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// act
+			err := policy.Check(tc.password)
+
+			// assert
+			if tc.expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expected)
+			}
+		})
+	}
+}
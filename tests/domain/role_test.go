@@ -0,0 +1,79 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserRole(t *testing.T) {
+	t.Run("Keeps permissions sorted and without repeats", func(t *testing.T) {
+		// act
+		role, err := domain.NewUserRole(" auditor ", []domain.Permission{domain.ReportReadPermission, domain.AuditReadPermission, domain.ReportReadPermission})
+
+		// assert
+		require.NoError(t, err)
+		require.Equal(t, "auditor", role.Name)
+		require.Equal(t, []domain.Permission{domain.AuditReadPermission, domain.ReportReadPermission}, role.Permissions)
+		require.True(t, role.HasPermission(domain.AuditReadPermission))
+		require.False(t, role.HasPermission(domain.RoleManagePermission))
+	})
+
+	t.Run("Refuses unknown permission", func(t *testing.T) {
+		// act
+		_, err := domain.NewUserRole("auditor", []domain.Permission{"audit:delete"})
+
+		// assert
+		require.EqualError(t, err, domain.UnknownPermissionError)
+	})
+
+	t.Run("Refuses blank name", func(t *testing.T) {
+		// act
+		_, err := domain.NewUserRole("  ", nil)
+
+		// assert
+		require.EqualError(t, err, domain.RoleNameIsRequiredError)
+	})
+}
+
+func TestUserRole_Change(t *testing.T) {
+	t.Run("Replaces name and permissions", func(t *testing.T) {
+		role := domain.ModeratorRole()
+
+		// act
+		err := role.Change("senior moderator", []domain.Permission{domain.PVZCreatePermission})
+
+		// assert
+		require.NoError(t, err)
+		require.Equal(t, domain.ModeratorUserRoleID, role.ID)
+		require.Equal(t, "senior moderator", role.Name)
+		require.Equal(t, []domain.Permission{domain.PVZCreatePermission}, role.Permissions)
+	})
+
+	t.Run("Leaves admin role intact", func(t *testing.T) {
+		role := domain.AdminRole()
+
+		// act
+		err := role.Change(domain.AdminUserRoleName, nil)
+
+		// assert
+		require.EqualError(t, err, domain.AdminRoleIsImmutableError)
+		require.Equal(t, domain.AdminRole(), role)
+	})
+}
+
+func TestBuiltInRoles(t *testing.T) {
+	employee, moderator, admin := domain.EmployeeRole(), domain.ModeratorRole(), domain.AdminRole()
+
+	// assert
+	require.True(t, employee.HasPermission(domain.ReceptionOpenPermission))
+	require.False(t, employee.HasPermission(domain.AnyPVZPermission))
+	require.False(t, employee.HasPermission(domain.PVZCreatePermission))
+	require.True(t, moderator.HasPermission(domain.AnyPVZPermission))
+	require.False(t, moderator.HasPermission(domain.ReceptionOpenPermission))
+	require.False(t, moderator.HasPermission(domain.RoleManagePermission))
+	for _, permission := range domain.AllPermissions() {
+		require.True(t, admin.HasPermission(permission), permission)
+	}
+}
@@ -0,0 +1,128 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSSOLogin(t *testing.T) {
+	t.Run("Keeps only hash of state", func(t *testing.T) {
+		timeBeforeRun := time.Now().UTC()
+
+		// act
+		login, state, err := domain.NewSSOLogin()
+
+		// assert
+		require.NoError(t, err)
+		require.Equal(t, domain.HashToken(state), login.StateHash)
+		require.NotEmpty(t, login.Nonce)
+		require.NotEqual(t, state, login.Nonce)
+		require.GreaterOrEqual(t, len(login.CodeVerifier), 43, "PKCE verifier must be at least 43 characters long")
+		require.LessOrEqual(t, timeBeforeRun, login.CreationTimeUTC)
+		require.Equal(t, login.CreationTimeUTC.Add(domain.SSOLoginLifetime), login.ExpiresAtUTC)
+	})
+
+	t.Run("Refuses expired login", func(t *testing.T) {
+		login, _, _ := domain.NewSSOLogin()
+
+		// act
+		errInTime := login.EnsureNotExpired(login.ExpiresAtUTC.Add(-time.Second))
+		errAfter := login.EnsureNotExpired(login.ExpiresAtUTC)
+
+		// assert
+		require.NoError(t, errInTime)
+		require.EqualError(t, errAfter, domain.SSOLoginIsInvalidError)
+	})
+}
+
+func TestSSORoleMapping_RoleFor(t *testing.T) {
+	mapping := domain.SSORoleMapping{
+		{Group: "pvz-admins", Role: domain.AdminUserRoleName},
+		{Group: "pvz-moderators", Role: domain.ModeratorUserRoleName},
+		{Group: "pvz-staff", Role: domain.EmployeeUserRoleName},
+	}
+
+	t.Run("Gives role of the first mapped group", func(t *testing.T) {
+		// act
+		role, err := mapping.RoleFor([]string{"everyone", "pvz-staff", "pvz-moderators"})
+
+		// assert
+		require.NoError(t, err)
+		require.Equal(t, domain.ModeratorUserRoleName, role)
+	})
+
+	t.Run("Refuses user of no mapped group", func(t *testing.T) {
+		// act
+		_, err := mapping.RoleFor([]string{"everyone"})
+
+		// assert
+		require.EqualError(t, err, domain.SSOGroupsHaveNoRoleError)
+		require.True(t, domain.IsAccessError(err))
+	})
+}
+
+func TestNewSSOUser(t *testing.T) {
+	t.Run("Creates user without password", func(t *testing.T) {
+		identity := domain.ExternalIdentity{Subject: "staff-1", Email: "staff@example.com", EmailVerified: true}
+
+		// act
+		user, err := domain.NewSSOUser(identity, domain.AdminRole())
+
+		// assert
+		require.NoError(t, err)
+		require.Empty(t, user.Password)
+		require.Equal(t, "staff-1", *user.SSOSubject)
+		require.True(t, user.EmailVerified)
+		require.Equal(t, domain.AdminUserRoleID, user.UserRole.ID)
+		require.False(t, user.NeedsTwoFactorEnrollment(), "second factor is proved to identity provider")
+	})
+
+	t.Run("Refuses identity without email", func(t *testing.T) {
+		// act
+		_, err := domain.NewSSOUser(domain.ExternalIdentity{Subject: "staff-1"}, domain.EmployeeRole())
+
+		// assert
+		require.EqualError(t, err, domain.SSOEmailIsRequiredError)
+	})
+}
+
+func TestUser_LinkSSO(t *testing.T) {
+	t.Run("Replaces password with subject", func(t *testing.T) {
+		user, _ := domain.NewUser("staff@example.com", "hash")
+
+		// act
+		err := user.LinkSSO(domain.ExternalIdentity{Subject: "staff-1", Email: "staff@example.com", EmailVerified: true})
+
+		// assert
+		require.NoError(t, err)
+		require.Empty(t, user.Password)
+		require.Equal(t, "staff-1", *user.SSOSubject)
+		require.True(t, user.EmailVerified)
+	})
+
+	t.Run("Refuses email provider did not verify", func(t *testing.T) {
+		user, _ := domain.NewUser("staff@example.com", "hash")
+
+		// act
+		err := user.LinkSSO(domain.ExternalIdentity{Subject: "staff-1", Email: "staff@example.com"})
+
+		// assert
+		require.EqualError(t, err, domain.SSOEmailIsTakenError)
+		require.Nil(t, user.SSOSubject)
+		require.Equal(t, "hash", user.Password)
+	})
+
+	t.Run("Refuses service account", func(t *testing.T) {
+		moderator := domain.User{UserRole: domain.ModeratorRole()}
+		account, _ := domain.NewServiceAccount(moderator, "reporting@example.com", domain.EmployeeRole())
+
+		// act
+		err := account.LinkSSO(domain.ExternalIdentity{Subject: "reporting", Email: "reporting@example.com", EmailVerified: true})
+
+		// assert
+		require.EqualError(t, err, domain.SSOEmailIsTakenError)
+	})
+}
@@ -0,0 +1,126 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUser_EnableTwoFactor(t *testing.T) {
+	t.Run("Enables enrolled second factor and keeps only hashes of recovery codes", func(t *testing.T) {
+		user := domain.User{ID: uuid.Must(uuid.NewV7())}
+		require.NoError(t, user.BeginTwoFactorEnrollment("SECRET"))
+
+		// act
+		codes, err := user.EnableTwoFactor(42)
+
+		// assert
+		require.NoError(t, err)
+		require.True(t, user.TwoFactor.Enabled)
+		require.Equal(t, int64(42), user.TwoFactor.LastUsedStep)
+		require.Len(t, codes, domain.RecoveryCodesCount)
+		require.Len(t, user.TwoFactor.RecoveryCodeHashes, domain.RecoveryCodesCount)
+		require.Equal(t, domain.HashToken(codes[0]), user.TwoFactor.RecoveryCodeHashes[0])
+	})
+
+	t.Run("Refuses user without enrollment", func(t *testing.T) {
+		user := domain.User{ID: uuid.Must(uuid.NewV7())}
+
+		// act
+		_, err := user.EnableTwoFactor(42)
+
+		// assert
+		require.EqualError(t, err, domain.TwoFactorIsNotEnrolledError)
+		require.False(t, user.TwoFactor.Enabled)
+	})
+
+	t.Run("Refuses to restart enrollment of enabled second factor", func(t *testing.T) {
+		user := domain.User{ID: uuid.Must(uuid.NewV7())}
+		require.NoError(t, user.BeginTwoFactorEnrollment("SECRET"))
+		_, err := user.EnableTwoFactor(42)
+		require.NoError(t, err)
+
+		// act
+		err = user.BeginTwoFactorEnrollment("OTHER")
+
+		// assert
+		require.EqualError(t, err, domain.TwoFactorIsAlreadyEnabledError)
+		require.Equal(t, "SECRET", *user.TwoFactor.Secret)
+	})
+}
+
+func TestUser_UseSecondFactor(t *testing.T) {
+	newUser := func(t *testing.T) (domain.User, []string) {
+		user := domain.User{ID: uuid.Must(uuid.NewV7())}
+		require.NoError(t, user.BeginTwoFactorEnrollment("SECRET"))
+		codes, err := user.EnableTwoFactor(42)
+		require.NoError(t, err)
+
+		return user, codes
+	}
+
+	t.Run("Refuses replay of totp step", func(t *testing.T) {
+		user, _ := newUser(t)
+
+		// act
+		next := user.UseTOTPStep(43)
+		replayed := user.UseTOTPStep(43)
+		earlier := user.UseTOTPStep(41)
+
+		// assert
+		require.NoError(t, next)
+		require.EqualError(t, replayed, domain.BadTwoFactorCodeError)
+		require.EqualError(t, earlier, domain.BadTwoFactorCodeError)
+	})
+
+	t.Run("Accepts recovery code once", func(t *testing.T) {
+		user, codes := newUser(t)
+		before := user
+
+		// act
+		first := user.UseRecoveryCode(codes[0])
+		second := user.UseRecoveryCode(codes[0])
+
+		// assert
+		require.NoError(t, first)
+		require.EqualError(t, second, domain.BadTwoFactorCodeError)
+		require.Len(t, user.TwoFactor.RecoveryCodeHashes, domain.RecoveryCodesCount-1)
+		require.Len(t, before.TwoFactor.RecoveryCodeHashes, domain.RecoveryCodesCount)
+	})
+}
+
+func TestUser_DisableTwoFactor(t *testing.T) {
+	t.Run("Removes second factor", func(t *testing.T) {
+		user := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: domain.EmployeeRole()}
+		require.NoError(t, user.BeginTwoFactorEnrollment("SECRET"))
+		_, err := user.EnableTwoFactor(42)
+		require.NoError(t, err)
+
+		// act
+		err = user.DisableTwoFactor()
+
+		// assert
+		require.NoError(t, err)
+		require.Equal(t, domain.TwoFactor{}, user.TwoFactor)
+	})
+
+	t.Run("Keeps second factor required by role", func(t *testing.T) {
+		role := domain.ModeratorRole()
+		role.TwoFactorRequired = true
+		user := domain.User{ID: uuid.Must(uuid.NewV7()), UserRole: role}
+		require.True(t, user.NeedsTwoFactorEnrollment())
+		require.NoError(t, user.BeginTwoFactorEnrollment("SECRET"))
+		_, err := user.EnableTwoFactor(42)
+		require.NoError(t, err)
+
+		// act
+		err = user.DisableTwoFactor()
+
+		// assert
+		require.EqualError(t, err, domain.TwoFactorIsRequiredByRoleError)
+		require.True(t, user.TwoFactor.Enabled)
+		require.False(t, user.NeedsTwoFactorEnrollment())
+	})
+}
@@ -0,0 +1,74 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserToken(t *testing.T) {
+	user := domain.User{ID: uuid.Must(uuid.NewV7()), Email: "owner@example.com"}
+
+	t.Run("Keeps only hash of token", func(t *testing.T) {
+		timeBeforeRun := time.Now().UTC()
+
+		// act
+		userToken, token, err := domain.NewUserToken(user, domain.PasswordResetTokenPurpose, domain.PasswordResetTokenLifetime)
+
+		// assert
+		require.NoError(t, err)
+		require.NotEmpty(t, token)
+		require.Equal(t, domain.HashToken(token), userToken.TokenHash)
+		require.NotContains(t, userToken.TokenHash, token)
+		require.Equal(t, user.ID, userToken.UserID)
+		require.Equal(t, domain.PasswordResetTokenPurpose, userToken.Purpose)
+		require.Equal(t, userToken.CreationTimeUTC.Add(domain.PasswordResetTokenLifetime), userToken.ExpiresAtUTC)
+		require.LessOrEqual(t, timeBeforeRun, userToken.CreationTimeUTC)
+		require.Nil(t, userToken.UsedAtUTC)
+	})
+
+	t.Run("Issues different tokens", func(t *testing.T) {
+		// act
+		_, first, err := domain.NewUserToken(user, domain.EmailVerificationTokenPurpose, domain.EmailVerificationTokenLifetime)
+		require.NoError(t, err)
+		_, second, err := domain.NewUserToken(user, domain.EmailVerificationTokenPurpose, domain.EmailVerificationTokenLifetime)
+
+		// assert
+		require.NoError(t, err)
+		require.NotEqual(t, first, second)
+	})
+}
+
+func TestUserToken_Use(t *testing.T) {
+	user := domain.User{ID: uuid.Must(uuid.NewV7()), Email: "owner@example.com"}
+
+	t.Run("Uses token once", func(t *testing.T) {
+		userToken, _, err := domain.NewUserToken(user, domain.PasswordResetTokenPurpose, time.Hour)
+		require.NoError(t, err)
+		moment := userToken.CreationTimeUTC.Add(time.Minute)
+
+		// act
+		first := userToken.Use(moment)
+		second := userToken.Use(moment)
+
+		// assert
+		require.NoError(t, first)
+		require.Equal(t, moment, *userToken.UsedAtUTC)
+		require.EqualError(t, second, domain.UserTokenIsAlreadyUsedError)
+	})
+
+	t.Run("Refuses expired token", func(t *testing.T) {
+		userToken, _, err := domain.NewUserToken(user, domain.PasswordResetTokenPurpose, time.Hour)
+		require.NoError(t, err)
+
+		// act
+		err = userToken.Use(userToken.ExpiresAtUTC)
+
+		// assert
+		require.EqualError(t, err, domain.UserTokenIsExpiredError)
+		require.Nil(t, userToken.UsedAtUTC)
+	})
+}
@@ -0,0 +1,158 @@
+package domain_test
+
+import (
+	"avito/internal/domain"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+const webhookSecret string = "0123456789abcdef"
+
+func TestNewWebhookSubscription_ShouldCreateSubscription(t *testing.T) {
+	moderatorID := uuid.Must(uuid.NewV7())
+	cityID := domain.KazanCityID
+	timeBeforeRun := time.Now().UTC()
+
+	// act
+	subscription, err := domain.NewWebhookSubscription(
+		"https://partner.example.com/hooks",
+		[]domain.EventType{domain.ReceptionClosedEventType, domain.ReceptionOpenedEventType, domain.ReceptionClosedEventType},
+		nil,
+		&cityID,
+		webhookSecret,
+		moderatorID,
+	)
+
+	// assert
+	require.NoError(t, err)
+	require.NotEqual(t, uuid.Nil, subscription.ID)
+	require.Equal(t, "https://partner.example.com/hooks", subscription.URL)
+	require.Equal(t, []domain.EventType{domain.ReceptionClosedEventType, domain.ReceptionOpenedEventType}, subscription.EventTypes)
+	require.Nil(t, subscription.PVZID)
+	require.Equal(t, &cityID, subscription.CityID)
+	require.Equal(t, webhookSecret, subscription.Secret)
+	require.Equal(t, moderatorID, subscription.CreatedBy)
+	require.LessOrEqual(t, timeBeforeRun, subscription.CreationTimeUTC)
+}
+
+func TestNewWebhookSubscription_ShouldReturnError(t *testing.T) {
+	unknownCityID := domain.CityID(-1)
+
+	testCases := []struct {
+		name          string
+		url           string
+		eventTypes    []domain.EventType
+		cityID        *domain.CityID
+		secret        string
+		expectedError string
+	}{
+		{
+			name:          "relative url",
+			url:           "/hooks",
+			eventTypes:    []domain.EventType{domain.ProductAddedEventType},
+			secret:        webhookSecret,
+			expectedError: domain.InvalidWebhookURLError,
+		},
+		{
+			name:          "not http url",
+			url:           "ftp://partner.example.com/hooks",
+			eventTypes:    []domain.EventType{domain.ProductAddedEventType},
+			secret:        webhookSecret,
+			expectedError: domain.InvalidWebhookURLError,
+		},
+		{
+			name:          "no event types",
+			url:           "https://partner.example.com/hooks",
+			secret:        webhookSecret,
+			expectedError: domain.WebhookEventTypesAreRequiredError,
+		},
+		{
+			name:          "unknown event type",
+			url:           "https://partner.example.com/hooks",
+			eventTypes:    []domain.EventType{domain.ProductAddedEventType, "product.sold"},
+			secret:        webhookSecret,
+			expectedError: domain.UnknownEventTypeError,
+		},
+		{
+			name:          "short secret",
+			url:           "https://partner.example.com/hooks",
+			eventTypes:    []domain.EventType{domain.ProductAddedEventType},
+			secret:        "secret",
+			expectedError: domain.WebhookSecretIsTooShortError,
+		},
+		{
+			name:          "unknown city",
+			url:           "https://partner.example.com/hooks",
+			eventTypes:    []domain.EventType{domain.ProductAddedEventType},
+			cityID:        &unknownCityID,
+			secret:        webhookSecret,
+			expectedError: domain.UnknownCityError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// act
+			_, err := domain.NewWebhookSubscription(tc.url, tc.eventTypes, nil, tc.cityID, tc.secret, uuid.Must(uuid.NewV7()))
+
+			// assert
+			require.Error(t, err)
+			require.Equal(t, tc.expectedError, err.Error())
+		})
+	}
+}
+
+func TestWebhookSubscriptionMatches(t *testing.T) {
+	pvz := getPVZ(t)
+	pvz.City = domain.City{ID: domain.KazanCityID}
+	otherPVZID := uuid.Must(uuid.NewV7())
+	kazan, moscow := domain.KazanCityID, domain.MoscowCityID
+	event := domain.Event{ID: uuid.Must(uuid.NewV7()), Type: domain.ReceptionClosedEventType, PVZID: pvz.ID}
+
+	testCases := []struct {
+		name       string
+		eventTypes []domain.EventType
+		pvzID      *domain.PVZID
+		cityID     *domain.CityID
+		expected   bool
+	}{
+		{name: "any pvz", eventTypes: []domain.EventType{domain.ReceptionClosedEventType}, expected: true},
+		{name: "other event type", eventTypes: []domain.EventType{domain.ReceptionOpenedEventType}, expected: false},
+		{name: "same pvz", eventTypes: []domain.EventType{domain.ReceptionClosedEventType}, pvzID: &pvz.ID, expected: true},
+		{name: "other pvz", eventTypes: []domain.EventType{domain.ReceptionClosedEventType}, pvzID: &otherPVZID, expected: false},
+		{name: "same city", eventTypes: []domain.EventType{domain.ReceptionClosedEventType}, cityID: &kazan, expected: true},
+		{name: "other city", eventTypes: []domain.EventType{domain.ReceptionClosedEventType}, cityID: &moscow, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			subscription := domain.WebhookSubscription{EventTypes: tc.eventTypes, PVZID: tc.pvzID, CityID: tc.cityID}
+
+			// act
+			matches := subscription.Matches(event, pvz)
+
+			// assert
+			require.Equal(t, tc.expected, matches)
+		})
+	}
+}
+
+func TestNewWebhookDeliveryAttempt_ShouldRecordFailure(t *testing.T) {
+	subscriptionID, eventID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+
+	// act
+	attempt, err := domain.NewWebhookDeliveryAttempt(subscriptionID, eventID, 2, 503, errors.New("webhook responded with status 503"))
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, subscriptionID, attempt.SubscriptionID)
+	require.Equal(t, eventID, attempt.EventID)
+	require.Equal(t, 2, attempt.Attempt)
+	require.Equal(t, 503, attempt.StatusCode)
+	require.Equal(t, "webhook responded with status 503", attempt.Error)
+	require.False(t, attempt.Delivered)
+}
@@ -0,0 +1,45 @@
+package e2e_test
+
+import (
+	"avito/tests/e2e/client"
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceptionAct(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	pvz := h.createPVZ(t, moderator, client.Казань)
+	h.assignEmployee(t, moderator, *pvz.Id)
+	reception := h.openReception(t, employee, *pvz.Id)
+
+	added, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+		PvzId: *pvz.Id,
+		Type:  client.PostProductsJSONBodyTypeОбувь,
+	}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, added.StatusCode(), string(added.Body))
+
+	notIssued, err := h.http.GetReceptionsReceptionIdActWithResponse(ctx, *reception.Id, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, notIssued.StatusCode())
+	require.Equal(t, "reception act was not found", notIssued.JSON400.Message)
+
+	closed, err := h.http.PostPvzPvzIdCloseLastReceptionWithResponse(ctx, *pvz.Id, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, closed.StatusCode(), string(closed.Body))
+
+	act, err := h.http.GetReceptionsReceptionIdActWithResponse(ctx, *reception.Id, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, act.StatusCode(), string(act.Body))
+	require.Equal(t, "application/pdf", act.HTTPResponse.Header.Get("Content-Type"))
+	require.True(t, bytes.HasPrefix(act.Body, []byte("%PDF-")))
+
+	again, err := h.http.GetReceptionsReceptionIdActWithResponse(ctx, *reception.Id, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, act.Body, again.Body, "stored act is returned as is")
+}
@@ -0,0 +1,134 @@
+package e2e_test
+
+import (
+	"avito/tests/e2e/client"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPVZAssignments(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	pvzID := *h.createPVZ(t, moderator, client.Москва).Id
+
+	forbidden, err := h.http.PostPvzPvzIdAssignmentsWithResponse(ctx, pvzID, client.PostPvzPvzIdAssignmentsJSONRequestBody{UserId: h.dummyEmployeeID}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, forbidden.StatusCode(), string(forbidden.Body))
+
+	moderatorAssigned, err := h.http.PostPvzPvzIdAssignmentsWithResponse(ctx, pvzID, client.PostPvzPvzIdAssignmentsJSONRequestBody{UserId: h.dummyModeratorID}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, moderatorAssigned.StatusCode(), string(moderatorAssigned.Body))
+
+	assignment := h.assignEmployee(t, moderator, pvzID)
+	require.Equal(t, h.dummyEmployeeID, assignment.UserId)
+	require.Equal(t, pvzID, assignment.PvzId)
+	require.Equal(t, h.dummyModeratorID, assignment.AssignedBy)
+
+	list, err := h.http.GetPvzPvzIdAssignmentsWithResponse(ctx, pvzID, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, list.StatusCode(), string(list.Body))
+	require.Len(t, *list.JSON200, 1)
+	require.Equal(t, assignment.Id, (*list.JSON200)[0].Id)
+
+	reception := h.openReception(t, employee, pvzID)
+
+	removed, err := h.http.DeletePvzPvzIdAssignmentsAssignmentIdWithResponse(ctx, pvzID, assignment.Id, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, removed.StatusCode(), string(removed.Body))
+
+	history, err := h.http.GetReceptionsReceptionIdHistoryWithResponse(ctx, *reception.Id, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, history.StatusCode(), string(history.Body))
+
+	closed, err := h.http.PostPvzPvzIdCloseLastReceptionWithResponse(ctx, pvzID, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, closed.StatusCode(), string(closed.Body))
+
+	h.closeReception(t, moderator, pvzID)
+}
+
+func TestPVZAssignments_ShouldForbidReceptions_OutsideOfValidityPeriod(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	expiredPVZID := *h.createPVZ(t, moderator, client.Москва).Id
+	upcomingPVZID := *h.createPVZ(t, moderator, client.Казань).Id
+	now := time.Now().UTC()
+	expiredFrom, expiredTo := now.Add(-2*time.Hour), now.Add(-time.Hour)
+	upcomingFrom := now.Add(time.Hour)
+
+	for pvzID, body := range map[uuid.UUID]client.PostPvzPvzIdAssignmentsJSONRequestBody{
+		expiredPVZID:  {UserId: h.dummyEmployeeID, ValidFrom: &expiredFrom, ValidTo: &expiredTo},
+		upcomingPVZID: {UserId: h.dummyEmployeeID, ValidFrom: &upcomingFrom},
+	} {
+		response, err := h.http.PostPvzPvzIdAssignmentsWithResponse(ctx, pvzID, body, bearer(moderator))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, response.StatusCode(), string(response.Body))
+
+		opened, err := h.http.PostReceptionsWithResponse(ctx, client.PostReceptionsJSONRequestBody{PvzId: pvzID}, bearer(employee))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, opened.StatusCode(), string(opened.Body))
+	}
+
+	invalid, err := h.http.PostPvzPvzIdAssignmentsWithResponse(ctx, expiredPVZID, client.PostPvzPvzIdAssignmentsJSONRequestBody{
+		UserId:    h.dummyEmployeeID,
+		ValidFrom: &expiredTo,
+		ValidTo:   &expiredFrom,
+	}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, invalid.StatusCode(), string(invalid.Body))
+}
+
+func TestPVZAssignments_ShouldForbidReopeningAndManifests_AtPVZOfOthers(t *testing.T) {
+	h := startApp(t)
+	admin := h.dummyLogin(t, client.Admin)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	pvzID := *h.createPVZ(t, moderator, client.Москва).Id
+	assignment := h.assignEmployee(t, moderator, pvzID)
+	reception := h.openReception(t, employee, pvzID)
+	h.closeReception(t, employee, pvzID)
+	removed, err := h.http.DeletePvzPvzIdAssignmentsAssignmentIdWithResponse(ctx, pvzID, assignment.Id, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, removed.StatusCode(), string(removed.Body))
+
+	// custom permissions of employee do not lift assignment to pvz
+	roles, err := h.http.GetRolesWithResponse(ctx, bearer(admin))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, roles.StatusCode(), string(roles.Body))
+	employeeRole := (*roles.JSON200)[0]
+	extended := client.RoleRequest{
+		Name:        employeeRole.Name,
+		Permissions: append(employeeRole.Permissions, client.PermissionReceptionReopen, client.PermissionManifestUpload),
+	}
+	updated, err := h.http.PutRolesRoleIdWithResponse(ctx, employeeRole.Id, extended, bearer(admin))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, updated.StatusCode(), string(updated.Body))
+	reason := client.PostReceptionsReceptionIdReopenJSONRequestBody{Reason: "забыли коробку"}
+	items := client.PostPvzPvzIdManifestsJSONRequestBody{Items: []client.ShipmentManifestItem{{Barcode: "4006381333931", Type: client.ClothesCategory}}}
+
+	reopenedByEmployee, err := h.http.PostReceptionsReceptionIdReopenWithResponse(ctx, *reception.Id, reason, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, reopenedByEmployee.StatusCode(), string(reopenedByEmployee.Body))
+
+	uploadedByEmployee, err := h.http.PostPvzPvzIdManifestsWithResponse(ctx, pvzID, items, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, uploadedByEmployee.StatusCode(), string(uploadedByEmployee.Body))
+
+	reopened, err := h.http.PostReceptionsReceptionIdReopenWithResponse(ctx, *reception.Id, reason, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, reopened.StatusCode(), string(reopened.Body))
+	manifest, err := h.http.PostPvzPvzIdManifestsWithResponse(ctx, pvzID, items, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, manifest.StatusCode(), string(manifest.Body))
+
+	linkedByEmployee, err := h.http.PostReceptionsReceptionIdManifestWithResponse(ctx, *reception.Id,
+		client.PostReceptionsReceptionIdManifestJSONRequestBody{ManifestId: manifest.JSON201.Id}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, linkedByEmployee.StatusCode(), string(linkedByEmployee.Body))
+}
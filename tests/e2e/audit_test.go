@@ -0,0 +1,99 @@
+package e2e_test
+
+import (
+	"avito/tests/e2e/client"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditTrail(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	pvz := h.createPVZ(t, moderator, client.Казань)
+	h.assignEmployee(t, moderator, *pvz.Id)
+	reception := h.openReception(t, employee, *pvz.Id)
+
+	requestID := uuid.Must(uuid.NewV7()).String()
+	closed, err := h.http.PostPvzPvzIdCloseLastReceptionWithResponse(ctx, *pvz.Id, bearer(employee), withRequestID(requestID))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, closed.StatusCode(), string(closed.Body))
+	require.Equal(t, requestID, closed.HTTPResponse.Header.Get("X-Request-Id"))
+
+	forbidden, err := h.http.GetAuditWithResponse(ctx, &client.GetAuditParams{}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, forbidden.StatusCode())
+
+	all, err := h.http.GetAuditWithResponse(ctx, &client.GetAuditParams{}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, all.StatusCode(), string(all.Body))
+	records := *all.JSON200
+	require.Len(t, records, 4)
+	require.Equal(t, []client.AuditAction{client.AuditActionReceptionClose, client.AuditActionReceptionOpen, client.AuditActionPvzAssign, client.AuditActionPvzCreate},
+		[]client.AuditAction{records[0].Action, records[1].Action, records[2].Action, records[3].Action})
+	require.Equal(t, records[0].ActorId, records[1].ActorId)
+	require.NotEqual(t, records[0].ActorId, records[3].ActorId)
+
+	close := records[0]
+	require.Equal(t, client.AuditEntityTypeReception, close.EntityType)
+	require.Equal(t, *reception.Id, close.EntityId)
+	require.Equal(t, client.Http, close.Protocol)
+	require.Equal(t, requestID, close.RequestId)
+	require.NotNil(t, close.Before)
+	require.NotNil(t, close.After)
+	require.NotEqual(t, (*close.Before)["status"], (*close.After)["status"])
+	require.Nil(t, records[3].Before)
+	require.Equal(t, pvz.Id.String(), (*records[3].After)["id"])
+
+	action := client.AuditActionReceptionOpen
+	byAction, err := h.http.GetAuditWithResponse(ctx, &client.GetAuditParams{Action: &action, EntityId: reception.Id}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, byAction.StatusCode(), string(byAction.Body))
+	require.Len(t, *byAction.JSON200, 1)
+	require.Equal(t, records[1].Id, (*byAction.JSON200)[0].Id)
+
+	page, limit := 2, 2
+	paged, err := h.http.GetAuditWithResponse(ctx, &client.GetAuditParams{Page: &page, Limit: &limit}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, paged.StatusCode(), string(paged.Body))
+	require.Len(t, *paged.JSON200, 2)
+	require.Equal(t, records[2].Id, (*paged.JSON200)[0].Id)
+	require.Equal(t, records[3].Id, (*paged.JSON200)[1].Id)
+}
+
+func TestAuditTrail_Registration(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+
+	registered, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{
+		Email:    "audit@example.com",
+		Password: "password",
+	}, withRequestID("forged id, not written into log as is"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, registered.StatusCode(), string(registered.Body))
+	requestID := registered.HTTPResponse.Header.Get("X-Request-Id")
+	require.NoError(t, uuid.Validate(requestID))
+
+	entityType := client.AuditEntityTypeUser
+	response, err := h.http.GetAuditWithResponse(ctx, &client.GetAuditParams{EntityType: &entityType}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode(), string(response.Body))
+	require.Len(t, *response.JSON200, 1)
+	record := (*response.JSON200)[0]
+	require.Equal(t, client.AuditActionUserRegister, record.Action)
+	require.Equal(t, *registered.JSON201.Id, record.ActorId)
+	require.Equal(t, *registered.JSON201.Id, record.EntityId)
+	require.Equal(t, requestID, record.RequestId)
+	require.NotContains(t, string(response.Body), "password")
+}
+
+func withRequestID(requestID string) client.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-Request-Id", requestID)
+		return nil
+	}
+}
@@ -0,0 +1,112 @@
+package e2e_test
+
+import (
+	"avito/internal/config"
+	"avito/tests/e2e/client"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoCloseStaleReceptions(t *testing.T) {
+	stalePVZID := uuid.Must(uuid.NewV7())
+	cfg := testConfig()
+	cfg.ReceptionsConfig.AutoClose = config.AutoCloseConfig{
+		Enabled:         true,
+		Action:          config.CloseStaleReceptionAction,
+		CheckInterval:   10 * time.Millisecond,
+		IdleTimeout:     time.Hour,
+		PVZIdleTimeouts: map[string]time.Duration{stalePVZID.String(): time.Millisecond},
+	}
+	h := startAppWithConfig(t, cfg)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	h.createPVZWithID(t, moderator, stalePVZID, client.Москва)
+	h.assignEmployee(t, moderator, stalePVZID)
+	busyPVZID := *h.createPVZ(t, moderator, client.Казань).Id
+	h.assignEmployee(t, moderator, busyPVZID)
+	stale := h.openReception(t, employee, stalePVZID)
+	busy := h.openReception(t, employee, busyPVZID)
+
+	var entries []client.ReceptionHistoryEntry
+	require.Eventually(t, func() bool {
+		entries = h.receptionHistory(t, employee, *stale.Id)
+		return len(entries) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, client.Closed, entries[1].Action)
+	require.Equal(t, uuid.Nil, entries[1].ActorId)
+	require.Equal(t, "automatic", *entries[1].Reason)
+
+	act, err := h.http.GetReceptionsReceptionIdActWithResponse(ctx, *stale.Id, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, act.StatusCode(), string(act.Body))
+
+	next := h.openReception(t, employee, stalePVZID)
+	require.NotEqual(t, *stale.Id, *next.Id)
+
+	require.Len(t, h.receptionHistory(t, employee, *busy.Id), 1)
+	h.closeReception(t, employee, busyPVZID)
+
+	action := client.AuditActionReceptionClose
+	records, err := h.http.GetAuditWithResponse(ctx, &client.GetAuditParams{Action: &action, EntityId: stale.Id}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, records.StatusCode(), string(records.Body))
+	require.Len(t, *records.JSON200, 1)
+	require.Equal(t, uuid.Nil, (*records.JSON200)[0].ActorId)
+}
+
+func TestAutoCloseStaleReceptions_ShouldOnlyFlagOnce_WhenFlagActionIsConfigured(t *testing.T) {
+	cfg := testConfig()
+	cfg.ReceptionsConfig.AutoClose = config.AutoCloseConfig{
+		Enabled:       true,
+		Action:        config.FlagStaleReceptionAction,
+		CheckInterval: 10 * time.Millisecond,
+		IdleTimeout:   time.Millisecond,
+	}
+	h := startAppWithConfig(t, cfg)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	pvzID := *h.createPVZ(t, moderator, client.СанктПетербург).Id
+	h.assignEmployee(t, moderator, pvzID)
+	reception := h.openReception(t, employee, pvzID)
+
+	require.Eventually(t, func() bool {
+		return len(h.receptionHistory(t, employee, *reception.Id)) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	// several more checks pass without new activity
+	time.Sleep(100 * time.Millisecond)
+
+	entries := h.receptionHistory(t, employee, *reception.Id)
+	require.Len(t, entries, 2)
+	require.Equal(t, client.Flagged, entries[1].Action)
+	require.Equal(t, uuid.Nil, entries[1].ActorId)
+
+	closed := h.closeReception(t, employee, pvzID)
+	require.Equal(t, *reception.Id, *closed.Id)
+}
+
+func (h harness) createPVZWithID(t *testing.T, moderator client.Token, id uuid.UUID, city client.PVZCity) {
+	t.Helper()
+
+	registrationDate := time.Now().UTC()
+	response, err := h.http.PostPvzWithResponse(ctx, client.PostPvzJSONRequestBody{
+		Id:               &id,
+		City:             city,
+		RegistrationDate: &registrationDate,
+	}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, response.StatusCode(), string(response.Body))
+}
+
+func (h harness) receptionHistory(t *testing.T, token client.Token, receptionID uuid.UUID) []client.ReceptionHistoryEntry {
+	t.Helper()
+
+	response, err := h.http.GetReceptionsReceptionIdHistoryWithResponse(ctx, receptionID, bearer(token))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode(), string(response.Body))
+
+	return *response.JSON200
+}
@@ -0,0 +1,122 @@
+package e2e_test
+
+import (
+	"avito/tests/e2e/client"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductBarcodes(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	moscow := h.createPVZ(t, moderator, client.Москва)
+	h.assignEmployee(t, moderator, *moscow.Id)
+	kazan := h.createPVZ(t, moderator, client.Казань)
+	h.assignEmployee(t, moderator, *kazan.Id)
+	reception := h.openReception(t, employee, *moscow.Id)
+	h.openReception(t, employee, *kazan.Id)
+	barcode := client.Barcode{Value: "4006381333931", Format: client.Ean13}
+
+	added, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+		PvzId:   *moscow.Id,
+		Type:    client.PostProductsJSONBodyTypeЭлектроника,
+		Barcode: &barcode,
+	}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, added.StatusCode(), string(added.Body))
+	require.Equal(t, &barcode, added.JSON201.Barcode)
+
+	t.Run("double scan in another opened reception", func(t *testing.T) {
+		response, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+			PvzId:   *kazan.Id,
+			Type:    client.PostProductsJSONBodyTypeЭлектроника,
+			Barcode: &barcode,
+		}, bearer(employee))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, response.StatusCode())
+		require.Equal(t, "product with this barcode is already accepted in opened reception", response.JSON400.Message)
+	})
+
+	t.Run("wrong checksum", func(t *testing.T) {
+		response, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+			PvzId:   *moscow.Id,
+			Type:    client.PostProductsJSONBodyTypeОбувь,
+			Barcode: &client.Barcode{Value: "4006381333932", Format: client.Ean13},
+		}, bearer(employee))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, response.StatusCode())
+		require.Equal(t, "barcode does not match its format", response.JSON400.Message)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		response, err := h.http.PostProductsWithBodyWithResponse(ctx, "application/json", strings.NewReader(
+			`{"pvzId": "`+moscow.Id.String()+`", "type": "обувь", "barcode": {"value": "123", "format": "qr"}}`,
+		), bearer(employee))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, response.StatusCode())
+		require.Equal(t, []string{"barcode.format"}, failedFields(t, response.JSON400))
+	})
+
+	t.Run("lookup by barcode", func(t *testing.T) {
+		response, err := h.http.GetProductsWithResponse(ctx, &client.GetProductsParams{Barcode: barcode.Value}, bearer(moderator))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, response.StatusCode(), string(response.Body))
+		require.Len(t, *response.JSON200, 1)
+
+		found := (*response.JSON200)[0]
+		require.Equal(t, *added.JSON201.Id, *found.Id)
+		require.Equal(t, *reception.Id, found.ReceptionId)
+		require.Equal(t, &barcode, found.Barcode)
+	})
+
+	t.Run("lookup at pvz employee is not assigned to", func(t *testing.T) {
+		admin := h.dummyLogin(t, client.Admin)
+		unassigned := h.createPVZ(t, moderator, client.Москва)
+		h.openReception(t, admin, *unassigned.Id)
+		other := client.Barcode{Value: "4607000000069", Format: client.Ean13}
+		response, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+			PvzId:   *unassigned.Id,
+			Type:    client.PostProductsJSONBodyTypeОбувь,
+			Barcode: &other,
+		}, bearer(admin))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, response.StatusCode(), string(response.Body))
+
+		byEmployee, err := h.http.GetProductsWithResponse(ctx, &client.GetProductsParams{Barcode: other.Value}, bearer(employee))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, byEmployee.StatusCode(), string(byEmployee.Body))
+		require.Empty(t, *byEmployee.JSON200)
+
+		byModerator, err := h.http.GetProductsWithResponse(ctx, &client.GetProductsParams{Barcode: other.Value}, bearer(moderator))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, byModerator.StatusCode(), string(byModerator.Body))
+		require.Len(t, *byModerator.JSON200, 1)
+	})
+
+	t.Run("lookup of unknown barcode", func(t *testing.T) {
+		response, err := h.http.GetProductsWithResponse(ctx, &client.GetProductsParams{Barcode: "4607000000090"}, bearer(employee))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, response.StatusCode(), string(response.Body))
+		require.Empty(t, *response.JSON200)
+	})
+
+	t.Run("report keeps barcode", func(t *testing.T) {
+		reports, err := h.http.GetPvzWithResponse(ctx, &client.GetPvzParams{}, bearer(moderator))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, reports.StatusCode(), string(reports.Body))
+
+		for _, report := range *reports.JSON200 {
+			if *report.Pvz.Id != *moscow.Id {
+				continue
+			}
+
+			products := *(*report.Receptions)[0].Products
+			require.Len(t, products, 1)
+			require.Equal(t, &barcode, products[0].Barcode)
+		}
+	})
+}
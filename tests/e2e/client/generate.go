@@ -0,0 +1,4 @@
+// Package client is http client of the service generated from public openapi schema
+package client
+
+//go:generate go tool oapi-codegen -package client -generate types,client -o client.gen.go ../../../schema/openapi.yaml
@@ -0,0 +1,136 @@
+package e2e_test
+
+import (
+	"avito/internal/config"
+	"avito/internal/domain"
+	"avito/tests/e2e/client"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsAreRelayedToWebhook(t *testing.T) {
+	receiver := newEventReceiver(t)
+	cfg := testConfig()
+	cfg.EventsConfig.Sink = config.WebhookEventSink
+	cfg.EventsConfig.Webhook = config.EventWebhookConfig{URL: receiver.url, Timeout: time.Second}
+	h := startAppWithConfig(t, cfg)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+
+	pvz := h.createPVZ(t, moderator, client.Казань)
+	h.assignEmployee(t, moderator, *pvz.Id)
+	h.openReception(t, employee, *pvz.Id)
+
+	// rejected change raises nothing
+	secondOpened, err := h.http.PostReceptionsWithResponse(ctx, client.PostReceptionsJSONRequestBody{PvzId: *pvz.Id}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, secondOpened.StatusCode())
+
+	added, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+		PvzId: *pvz.Id,
+		Type:  client.PostProductsJSONBodyTypeОбувь,
+	}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, added.StatusCode(), string(added.Body))
+
+	deleted, err := h.http.PostPvzPvzIdDeleteLastProductWithResponse(ctx, *pvz.Id, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, deleted.StatusCode(), string(deleted.Body))
+
+	closed, err := h.http.PostPvzPvzIdCloseLastReceptionWithResponse(ctx, *pvz.Id, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, closed.StatusCode(), string(closed.Body))
+
+	events := receiver.waitFor(t, 5)
+	types := make([]domain.EventType, 0, len(events))
+	for _, event := range events {
+		require.Equal(t, *pvz.Id, event.PVZID)
+		types = append(types, event.Type)
+	}
+	require.Equal(t, []domain.EventType{
+		domain.PVZCreatedEventType,
+		domain.ReceptionOpenedEventType,
+		domain.ProductAddedEventType,
+		domain.ProductRemovedEventType,
+		domain.ReceptionClosedEventType,
+	}, types)
+	require.Greater(t, receiver.deliveries(), len(events), "rejected delivery must be retried")
+}
+
+// eventReceiver rejects the first delivery, so relay has to deliver it again
+type eventReceiver struct {
+	url string
+
+	mu        sync.Mutex
+	attempts  int
+	seen      map[domain.EventID]bool
+	events    []domain.Event
+	delivered chan struct{}
+}
+
+func newEventReceiver(t *testing.T) *eventReceiver {
+	t.Helper()
+
+	receiver := &eventReceiver{
+		seen:      make(map[domain.EventID]bool),
+		delivered: make(chan struct{}, 100),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receiver.mu.Lock()
+		defer receiver.mu.Unlock()
+
+		receiver.attempts++
+		if receiver.attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var event domain.Event
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if !receiver.seen[event.ID] {
+			receiver.seen[event.ID] = true
+			receiver.events = append(receiver.events, event)
+			receiver.delivered <- struct{}{}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+	receiver.url = server.URL
+
+	return receiver
+}
+
+func (r *eventReceiver) waitFor(t *testing.T, count int) []domain.Event {
+	t.Helper()
+
+	for range count {
+		select {
+		case <-r.delivered:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected %d events", count)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]domain.Event(nil), r.events...)
+}
+
+func (r *eventReceiver) deliveries() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.attempts
+}
@@ -0,0 +1,154 @@
+package e2e_test
+
+import (
+	grpc_profile "avito/internal/api/grpc-profile"
+	"avito/tests/e2e/client"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceptionFlow(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+
+	pvzID := uuid.Must(uuid.NewV7())
+	registrationDate := time.Date(2025, 4, 10, 9, 0, 0, 0, time.UTC)
+
+	// employee is not allowed to create pvz
+	forbidden, err := h.http.PostPvzWithResponse(ctx, client.PostPvzJSONRequestBody{
+		Id:               &pvzID,
+		City:             client.Москва,
+		RegistrationDate: &registrationDate,
+	}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, forbidden.StatusCode())
+
+	createdPVZ, err := h.http.PostPvzWithResponse(ctx, client.PostPvzJSONRequestBody{
+		Id:               &pvzID,
+		City:             client.Москва,
+		RegistrationDate: &registrationDate,
+	}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, createdPVZ.StatusCode(), string(createdPVZ.Body))
+	require.Equal(t, pvzID, *createdPVZ.JSON201.Id)
+	require.Equal(t, client.Москва, createdPVZ.JSON201.City)
+
+	// employee works only at pvz they are assigned to
+	notAssigned, err := h.http.PostReceptionsWithResponse(ctx, client.PostReceptionsJSONRequestBody{PvzId: pvzID}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, notAssigned.StatusCode())
+	h.assignEmployee(t, moderator, pvzID)
+
+	opened, err := h.http.PostReceptionsWithResponse(ctx, client.PostReceptionsJSONRequestBody{PvzId: pvzID}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, opened.StatusCode(), string(opened.Body))
+	require.Equal(t, client.InProgress, opened.JSON201.Status)
+	receptionID := *opened.JSON201.Id
+
+	secondOpened, err := h.http.PostReceptionsWithResponse(ctx, client.PostReceptionsJSONRequestBody{PvzId: pvzID}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, secondOpened.StatusCode())
+
+	for _, productType := range []client.PostProductsJSONBodyType{
+		client.PostProductsJSONBodyTypeЭлектроника,
+		client.PostProductsJSONBodyTypeОбувь,
+		client.PostProductsJSONBodyTypeОдежда,
+	} {
+		added, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+			PvzId: pvzID,
+			Type:  productType,
+		}, bearer(employee))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, added.StatusCode(), string(added.Body))
+		require.Equal(t, receptionID, added.JSON201.ReceptionId)
+	}
+
+	deleted, err := h.http.PostPvzPvzIdDeleteLastProductWithResponse(ctx, pvzID, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, deleted.StatusCode(), string(deleted.Body))
+
+	closed, err := h.http.PostPvzPvzIdCloseLastReceptionWithResponse(ctx, pvzID, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, closed.StatusCode(), string(closed.Body))
+	require.Equal(t, client.Close, closed.JSON200.Status)
+	require.Equal(t, receptionID, *closed.JSON200.Id)
+
+	closedAgain, err := h.http.PostPvzPvzIdCloseLastReceptionWithResponse(ctx, pvzID, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, closedAgain.StatusCode())
+
+	t.Run("http report", func(t *testing.T) {
+		page, limit := 1, 10
+		reports, err := h.http.GetPvzWithResponse(ctx, &client.GetPvzParams{Page: &page, Limit: &limit}, bearer(moderator))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, reports.StatusCode(), string(reports.Body))
+		require.Len(t, *reports.JSON200, 1)
+
+		report := (*reports.JSON200)[0]
+		require.Equal(t, pvzID, *report.Pvz.Id)
+		require.Len(t, *report.Receptions, 1)
+
+		reception := (*report.Receptions)[0]
+		require.Equal(t, receptionID, *reception.Reception.Id)
+		require.Equal(t, client.Close, reception.Reception.Status)
+		require.Len(t, *reception.Products, 2)
+		types := []client.ProductType{(*reception.Products)[0].Type, (*reception.Products)[1].Type}
+		require.ElementsMatch(t, []client.ProductType{client.ProductTypeЭлектроника, client.ProductTypeОбувь}, types)
+	})
+
+	t.Run("grpc report", func(t *testing.T) {
+		response, err := h.grpc.GetPVZReport(ctx, &grpc_profile.PVZReportRequest{Page: 1, Limit: 10})
+		require.NoError(t, err)
+		require.Empty(t, response.Error)
+		require.Len(t, response.Reports.Values, 1)
+
+		report := response.Reports.Values[0]
+		require.Equal(t, pvzID.String(), report.Pvz.Id)
+		require.Equal(t, "Москва", report.Pvz.City.Name)
+		require.Len(t, report.Receptions.Values, 1)
+
+		reception := report.Receptions.Values[0]
+		require.Equal(t, receptionID.String(), reception.Reception.Id)
+		require.Equal(t, "close", reception.Reception.Status)
+		require.Len(t, reception.Products.Values, 2)
+		categories := []string{reception.Products.Values[0].Category, reception.Products.Values[1].Category}
+		require.ElementsMatch(t, []string{"электроника", "обувь"}, categories)
+	})
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	h := startApp(t)
+
+	registered, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{
+		Email:    "e2e@example.com",
+		Password: "password",
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, registered.StatusCode(), string(registered.Body))
+	require.Equal(t, "employee", registered.JSON201.Role)
+
+	wrongPassword, err := h.http.PostLoginWithResponse(ctx, client.PostLoginJSONRequestBody{
+		Email:    "e2e@example.com",
+		Password: "wrong",
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, wrongPassword.StatusCode())
+
+	loggedIn, err := h.http.PostLoginWithResponse(ctx, client.PostLoginJSONRequestBody{
+		Email:    "e2e@example.com",
+		Password: "password",
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, loggedIn.StatusCode(), string(loggedIn.Body))
+	require.NotEmpty(t, *loggedIn.JSON200)
+
+	page, limit := 1, 10
+	reports, err := h.http.GetPvzWithResponse(ctx, &client.GetPvzParams{Page: &page, Limit: &limit}, bearer(*loggedIn.JSON200))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, reports.StatusCode(), string(reports.Body))
+}
@@ -0,0 +1,196 @@
+package e2e_test
+
+import (
+	grpc_profile "avito/internal/api/grpc-profile"
+	"avito/internal/app"
+	"avito/internal/config"
+	jwt "avito/pkg/authorization"
+	"avito/tests/e2e/client"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var ctx = context.Background()
+
+type harness struct {
+	// base url of http api
+	url  string
+	http client.ClientWithResponsesInterface
+	// raw responses, for streams which are never read to the end
+	stream client.ClientInterface
+	grpc   grpc_profile.PVZReportServiceClient
+	// ids of users behind dummy logins, they are created at start when dummy login is enabled
+	dummyEmployeeID  uuid.UUID
+	dummyModeratorID uuid.UUID
+	dummyAdminID     uuid.UUID
+}
+
+// startApp boots whole application in-process on random ports over in-memory storage
+func startApp(t *testing.T) harness {
+	t.Helper()
+
+	return startAppWithConfig(t, testConfig())
+}
+
+func startAppWithConfig(t *testing.T, cfg config.Config) harness {
+	t.Helper()
+
+	application, err := app.New(cfg)
+	require.NoError(t, err)
+	require.NoError(t, application.Start())
+	t.Cleanup(func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		application.Stop(stopCtx)
+	})
+
+	httpClient, err := client.NewClientWithResponses("http://" + application.HTTPAddr())
+	require.NoError(t, err)
+	streamClient, err := client.NewClient("http://" + application.HTTPAddr())
+	require.NoError(t, err)
+
+	conn, err := grpc.NewClient(application.GRPCAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	h := harness{
+		url:    "http://" + application.HTTPAddr(),
+		http:   httpClient,
+		stream: streamClient,
+		grpc:   grpc_profile.NewPVZReportServiceClient(conn),
+	}
+
+	if cfg.AuthConfig.DummyLoginConfig.Enabled {
+		// the same order as ids of dummy users seeded before, tests rely on it when users are listed
+		jwtManager := jwt.NewJWTManager(cfg.AuthConfig.JWTConfig.Sign, cfg.AuthConfig.JWTConfig.Issuer, cfg.AuthConfig.JWTConfig.TokenTTL)
+		h.dummyEmployeeID = h.dummyUserID(t, jwtManager, client.Employee)
+		h.dummyModeratorID = h.dummyUserID(t, jwtManager, client.Moderator)
+		h.dummyAdminID = h.dummyUserID(t, jwtManager, client.Admin)
+	}
+
+	return h
+}
+
+func testConfig() config.Config {
+	return config.Config{
+		StorageConfig: config.StorageConfig{
+			Driver: config.InMemoryStorageDriver,
+		},
+		HTTPConfig: config.HTTPConfig{
+			Host: "127.0.0.1",
+		},
+		AuthConfig: config.AuthConfig{
+			JWTConfig: config.JWTConfig{
+				TokenTTL: time.Hour,
+				Sign:     "e2e",
+				Issuer:   "e2e",
+			},
+			DummyLoginConfig: config.DummyLoginConfig{
+				Enabled: true,
+			},
+			PasswordConfig: config.PasswordConfig{
+				MinLength:           8,
+				MinCharacterClasses: 1,
+				// cheap hashing keeps tests fast
+				Argon2id: config.Argon2idConfig{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32},
+			},
+		},
+		EventsConfig: config.EventsConfig{
+			Sink:         config.BrokerEventSink,
+			PollInterval: 10 * time.Millisecond,
+			BatchSize:    100,
+			WebhookDelivery: config.WebhookDeliveryConfig{
+				Tries:      3,
+				RetryDelay: 10 * time.Millisecond,
+				Timeout:    time.Second,
+				// subscribers of tests listen on loopback
+				AllowPrivateNetworks: true,
+			},
+		},
+		MailConfig: config.MailConfig{
+			Sender: config.LogMailSender,
+			From:   "noreply@e2e",
+		},
+	}
+}
+
+func bearer(token client.Token) client.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+}
+
+func (h harness) dummyLogin(t *testing.T, role client.PostDummyLoginJSONBodyRole) client.Token {
+	t.Helper()
+
+	response, err := h.http.PostDummyLoginWithResponse(ctx, client.PostDummyLoginJSONRequestBody{Role: role})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode(), string(response.Body))
+	require.NotNil(t, response.JSON200)
+
+	return *response.JSON200
+}
+
+func (h harness) dummyUserID(t *testing.T, jwtManager *jwt.JWTManager, role client.PostDummyLoginJSONBodyRole) uuid.UUID {
+	t.Helper()
+
+	claims, err := jwtManager.ExtractClaimsFrom(h.dummyLogin(t, role))
+	require.NoError(t, err)
+
+	return uuid.MustParse(claims.UserID)
+}
+
+func (h harness) createPVZ(t *testing.T, moderator client.Token, city client.PVZCity) client.PVZ {
+	t.Helper()
+
+	id := uuid.Must(uuid.NewV7())
+	registrationDate := time.Now().UTC()
+	response, err := h.http.PostPvzWithResponse(ctx, client.PostPvzJSONRequestBody{
+		Id:               &id,
+		City:             city,
+		RegistrationDate: &registrationDate,
+	}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, response.StatusCode(), string(response.Body))
+
+	return *response.JSON201
+}
+
+// assignEmployee lets dummy employee work at pvz
+func (h harness) assignEmployee(t *testing.T, moderator client.Token, pvzID uuid.UUID) client.PVZAssignment {
+	t.Helper()
+
+	response, err := h.http.PostPvzPvzIdAssignmentsWithResponse(ctx, pvzID, client.PostPvzPvzIdAssignmentsJSONRequestBody{UserId: h.dummyEmployeeID}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, response.StatusCode(), string(response.Body))
+
+	return *response.JSON201
+}
+
+func (h harness) openReception(t *testing.T, employee client.Token, pvzID uuid.UUID) client.Reception {
+	t.Helper()
+
+	response, err := h.http.PostReceptionsWithResponse(ctx, client.PostReceptionsJSONRequestBody{PvzId: pvzID}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, response.StatusCode(), string(response.Body))
+
+	return *response.JSON201
+}
+
+func (h harness) closeReception(t *testing.T, employee client.Token, pvzID uuid.UUID) client.Reception {
+	t.Helper()
+
+	response, err := h.http.PostPvzPvzIdCloseLastReceptionWithResponse(ctx, pvzID, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode(), string(response.Body))
+
+	return *response.JSON200
+}
@@ -0,0 +1,105 @@
+package e2e_test
+
+import (
+	"avito/tests/e2e/client"
+	"net/http"
+	"strings"
+	"testing"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistrationIgnoresRequestedRole(t *testing.T) {
+	h := startApp(t)
+
+	registered, err := h.http.PostRegisterWithBodyWithResponse(ctx, "application/json",
+		strings.NewReader(`{"email":"self-promoted@example.com","password":"password","role":"moderator"}`))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, registered.StatusCode(), string(registered.Body))
+	require.Equal(t, "employee", registered.JSON201.Role)
+}
+
+func TestInvitations(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+
+	byEmployee, err := h.http.PostInvitationsWithResponse(ctx, client.PostInvitationsJSONRequestBody{Role: "employee"}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, byEmployee.StatusCode(), string(byEmployee.Body))
+
+	toAdmin, err := h.http.PostInvitationsWithResponse(ctx, client.PostInvitationsJSONRequestBody{Role: "admin"}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, toAdmin.StatusCode(), string(toAdmin.Body))
+
+	email := openapi_types.Email("invited@example.com")
+	issued, err := h.http.PostInvitationsWithResponse(ctx, client.PostInvitationsJSONRequestBody{Role: "moderator", Email: &email}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, issued.StatusCode(), string(issued.Body))
+	require.Equal(t, "moderator", issued.JSON201.Role)
+	token := issued.JSON201.Token
+
+	wrongEmail, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{
+		Email:       "stranger@example.com",
+		Password:    "password",
+		InviteToken: &token,
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, wrongEmail.StatusCode(), string(wrongEmail.Body))
+
+	registered, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{
+		Email:       email,
+		Password:    "password",
+		InviteToken: &token,
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, registered.StatusCode(), string(registered.Body))
+	require.Equal(t, "moderator", registered.JSON201.Role)
+
+	reused, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{
+		Email:       "invited-again@example.com",
+		Password:    "password",
+		InviteToken: &token,
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, reused.StatusCode(), string(reused.Body))
+}
+
+func TestChangeUserRole(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	h.dummyLogin(t, client.Admin)
+
+	byEmployee, err := h.http.PutUsersUserIdRoleWithResponse(ctx, h.dummyModeratorID, client.PutUsersUserIdRoleJSONRequestBody{Role: "employee"}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, byEmployee.StatusCode(), string(byEmployee.Body))
+
+	promoted, err := h.http.PutUsersUserIdRoleWithResponse(ctx, h.dummyEmployeeID, client.PutUsersUserIdRoleJSONRequestBody{Role: "moderator"}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, promoted.StatusCode(), string(promoted.Body))
+	require.Equal(t, "moderator", promoted.JSON200.Role)
+	// role is read on every request, so the same token is promoted right away
+	h.createPVZ(t, employee, client.Москва)
+
+	demoted, err := h.http.PutUsersUserIdRoleWithResponse(ctx, h.dummyEmployeeID, client.PutUsersUserIdRoleJSONRequestBody{Role: "employee"}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, demoted.StatusCode(), string(demoted.Body))
+	require.Equal(t, "employee", demoted.JSON200.Role)
+
+	own, err := h.http.PutUsersUserIdRoleWithResponse(ctx, h.dummyModeratorID, client.PutUsersUserIdRoleJSONRequestBody{Role: "employee"}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, own.StatusCode(), string(own.Body))
+
+	adminDemoted, err := h.http.PutUsersUserIdRoleWithResponse(ctx, h.dummyAdminID, client.PutUsersUserIdRoleJSONRequestBody{Role: "employee"}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, adminDemoted.StatusCode(), string(adminDemoted.Body))
+
+	entityType := client.AuditEntityTypeUser
+	records, err := h.http.GetAuditWithResponse(ctx, &client.GetAuditParams{EntityType: &entityType}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, records.StatusCode(), string(records.Body))
+	require.Len(t, *records.JSON200, 2)
+	require.Equal(t, client.AuditActionUserRoleChange, (*records.JSON200)[0].Action)
+}
@@ -0,0 +1,129 @@
+package e2e_test
+
+import (
+	"avito/internal/config"
+	"avito/tests/e2e/client"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var mailedToken = regexp.MustCompile(`токен (\S+) в POST (/[\w/]+)`)
+
+// startAppWithMailbox boots application which appends letters to file instead of sending them
+func startAppWithMailbox(t *testing.T) (harness, mailbox) {
+	t.Helper()
+
+	cfg := testConfig()
+	cfg.MailConfig.Sender = config.FileMailSender
+	cfg.MailConfig.File = filepath.Join(t.TempDir(), "mail.txt")
+
+	return startAppWithConfig(t, cfg), mailbox(cfg.MailConfig.File)
+}
+
+type mailbox string
+
+// lastToken returns token of the last letter which tells to send it to path,
+// some letters are sent in background, so it waits for the first one to arrive
+func (m mailbox) lastToken(t *testing.T, path string) string {
+	t.Helper()
+
+	token := ""
+	require.Eventually(t, func() bool {
+		content, err := os.ReadFile(string(m))
+		if err != nil {
+			return false
+		}
+
+		for _, match := range mailedToken.FindAllStringSubmatch(string(content), -1) {
+			if match[2] == path {
+				token = match[1]
+			}
+		}
+
+		return token != ""
+	}, time.Second, 10*time.Millisecond, "no letter for %s", path)
+
+	return token
+}
+
+func TestPasswordReset(t *testing.T) {
+	h, mail := startAppWithMailbox(t)
+	const email = "forgetful@example.com"
+
+	registered, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{Email: email, Password: "old-password"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, registered.StatusCode(), string(registered.Body))
+	require.False(t, registered.JSON201.EmailVerified)
+
+	unknown, err := h.http.PostPasswordResetWithResponse(ctx, client.PostPasswordResetJSONRequestBody{Email: "nobody@example.com"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, unknown.StatusCode(), string(unknown.Body))
+
+	requested, err := h.http.PostPasswordResetWithResponse(ctx, client.PostPasswordResetJSONRequestBody{Email: email})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, requested.StatusCode(), string(requested.Body))
+	token := mail.lastToken(t, "/password_reset/confirm")
+
+	reset, err := h.http.PostPasswordResetConfirmWithResponse(ctx, client.PostPasswordResetConfirmJSONRequestBody{Token: token, Password: "new-password"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, reset.StatusCode(), string(reset.Body))
+
+	reused, err := h.http.PostPasswordResetConfirmWithResponse(ctx, client.PostPasswordResetConfirmJSONRequestBody{Token: token, Password: "other-password"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, reused.StatusCode(), string(reused.Body))
+
+	oldLogin, err := h.http.PostLoginWithResponse(ctx, client.PostLoginJSONRequestBody{Email: email, Password: "old-password"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, oldLogin.StatusCode(), string(oldLogin.Body))
+
+	newLogin, err := h.http.PostLoginWithResponse(ctx, client.PostLoginJSONRequestBody{Email: email, Password: "new-password"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, newLogin.StatusCode(), string(newLogin.Body))
+}
+
+func TestEmailVerification(t *testing.T) {
+	h, mail := startAppWithMailbox(t)
+	const email = "verified@example.com"
+
+	registered, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{Email: email, Password: "password"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, registered.StatusCode(), string(registered.Body))
+	tokenOfRegistration := mail.lastToken(t, "/email_verification/confirm")
+
+	login, err := h.http.PostLoginWithResponse(ctx, client.PostLoginJSONRequestBody{Email: email, Password: "password"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, login.StatusCode(), string(login.Body))
+	user := *login.JSON200
+
+	resent, err := h.http.PostEmailVerificationWithResponse(ctx, bearer(user))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, resent.StatusCode(), string(resent.Body))
+	token := mail.lastToken(t, "/email_verification/confirm")
+	require.NotEqual(t, tokenOfRegistration, token)
+
+	wrongPurpose, err := h.http.PostPasswordResetConfirmWithResponse(ctx, client.PostPasswordResetConfirmJSONRequestBody{Token: token, Password: "password"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, wrongPurpose.StatusCode(), string(wrongPurpose.Body))
+
+	verified, err := h.http.PostEmailVerificationConfirmWithResponse(ctx, client.PostEmailVerificationConfirmJSONRequestBody{Token: token})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, verified.StatusCode(), string(verified.Body))
+
+	again, err := h.http.PostEmailVerificationWithResponse(ctx, bearer(user))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, again.StatusCode(), string(again.Body))
+
+	moderator := h.dummyLogin(t, client.Moderator)
+	emailPart := email
+	found, err := h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{Email: &emailPart}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, found.StatusCode(), string(found.Body))
+	require.Len(t, *found.JSON200, 1)
+	require.True(t, (*found.JSON200)[0].EmailVerified)
+}
@@ -0,0 +1,128 @@
+package e2e_test
+
+import (
+	"avito/tests/e2e/client"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShipmentManifestDiscrepancies(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	pvz := h.createPVZ(t, moderator, client.СанктПетербург)
+	otherPVZ := h.createPVZ(t, moderator, client.Москва)
+	h.assignEmployee(t, moderator, *pvz.Id)
+	reception := h.openReception(t, employee, *pvz.Id)
+	items := []client.ShipmentManifestItem{
+		{Barcode: "4006381333931", Type: client.ClothesCategory},
+		{Barcode: "1234567890-1", Type: client.ClothesCategory},
+		{Barcode: "1234567890-2", Type: client.ShoesCategory},
+	}
+
+	forbidden, err := h.http.PostPvzPvzIdManifestsWithResponse(ctx, *pvz.Id,
+		client.PostPvzPvzIdManifestsJSONRequestBody{Items: items}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, forbidden.StatusCode())
+
+	manifest, err := h.http.PostPvzPvzIdManifestsWithResponse(ctx, *pvz.Id,
+		client.PostPvzPvzIdManifestsJSONRequestBody{Items: items}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, manifest.StatusCode(), string(manifest.Body))
+	require.Equal(t, *pvz.Id, manifest.JSON201.PvzId)
+	require.Nil(t, manifest.JSON201.ReceptionId)
+	require.Equal(t, items, manifest.JSON201.Items)
+
+	otherManifest, err := h.http.PostPvzPvzIdManifestsWithResponse(ctx, *otherPVZ.Id,
+		client.PostPvzPvzIdManifestsJSONRequestBody{Items: items}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, otherManifest.StatusCode(), string(otherManifest.Body))
+
+	ofAnotherPVZ, err := h.http.PostReceptionsReceptionIdManifestWithResponse(ctx, *reception.Id,
+		client.PostReceptionsReceptionIdManifestJSONRequestBody{ManifestId: otherManifest.JSON201.Id}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, ofAnotherPVZ.StatusCode())
+	require.Equal(t, "shipment manifest is expected at another pvz", ofAnotherPVZ.JSON400.Message)
+
+	linked, err := h.http.PostReceptionsReceptionIdManifestWithResponse(ctx, *reception.Id,
+		client.PostReceptionsReceptionIdManifestJSONRequestBody{ManifestId: manifest.JSON201.Id}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, linked.StatusCode(), string(linked.Body))
+	require.Equal(t, reception.Id, linked.JSON200.ReceptionId)
+
+	notReady, err := h.http.GetReceptionsReceptionIdDiscrepanciesWithResponse(ctx, *reception.Id, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, notReady.StatusCode())
+
+	for _, product := range []struct {
+		barcode     client.Barcode
+		productType client.PostProductsJSONBodyType
+	}{
+		{client.Barcode{Value: "4006381333931", Format: client.Ean13}, client.PostProductsJSONBodyTypeОдежда},
+		{client.Barcode{Value: "1234567890-2", Format: client.Order}, client.PostProductsJSONBodyTypeЭлектроника},
+		{client.Barcode{Value: "9876543210", Format: client.Order}, client.PostProductsJSONBodyTypeОдежда},
+	} {
+		added, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+			PvzId:   *pvz.Id,
+			Type:    product.productType,
+			Barcode: &product.barcode,
+		}, bearer(employee))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, added.StatusCode(), string(added.Body))
+	}
+	added, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+		PvzId: *pvz.Id,
+		Type:  client.PostProductsJSONBodyTypeОдежда,
+	}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, added.StatusCode(), string(added.Body))
+	scannedAgain, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+		PvzId:   *pvz.Id,
+		Type:    client.PostProductsJSONBodyTypeОдежда,
+		Barcode: &client.Barcode{Value: "4006381333931", Format: client.Ean13},
+	}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, scannedAgain.StatusCode(), string(scannedAgain.Body))
+
+	closed, err := h.http.PostPvzPvzIdCloseLastReceptionWithResponse(ctx, *pvz.Id, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, closed.StatusCode(), string(closed.Body))
+
+	report, err := h.http.GetReceptionsReceptionIdDiscrepanciesWithResponse(ctx, *reception.Id, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, report.StatusCode(), string(report.Body))
+	require.Equal(t, manifest.JSON201.Id, report.JSON200.ManifestId)
+	require.Equal(t, []string{"1234567890-1"}, report.JSON200.Missing)
+	require.Equal(t, []string{"9876543210"}, report.JSON200.Unexpected)
+	require.Equal(t, []string{"4006381333931"}, report.JSON200.Duplicates)
+	require.Equal(t, 1, report.JSON200.UnidentifiedProducts)
+	require.Equal(t, []client.CategoryMismatch{
+		{Barcode: "1234567890-2", Expected: client.ShoesCategory, Accepted: client.ElectronicsCategory},
+	}, report.JSON200.CategoryMismatches)
+	require.Equal(t, []client.CategoryDiscrepancy{
+		{Type: client.ElectronicsCategory, Expected: 0, Accepted: 1},
+		{Type: client.ClothesCategory, Expected: 2, Accepted: 3},
+		{Type: client.ShoesCategory, Expected: 1, Accepted: 0},
+	}, report.JSON200.Categories)
+
+	toClosed, err := h.http.PostReceptionsReceptionIdManifestWithResponse(ctx, *reception.Id,
+		client.PostReceptionsReceptionIdManifestJSONRequestBody{ManifestId: manifest.JSON201.Id}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, toClosed.StatusCode())
+	require.Equal(t, "reception is already closed", toClosed.JSON400.Message)
+
+	next := h.openReception(t, employee, *pvz.Id)
+	relinked, err := h.http.PostReceptionsReceptionIdManifestWithResponse(ctx, *next.Id,
+		client.PostReceptionsReceptionIdManifestJSONRequestBody{ManifestId: manifest.JSON201.Id}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, relinked.StatusCode())
+	require.Equal(t, "shipment manifest is already linked to another reception", relinked.JSON400.Message)
+
+	unknown, err := h.http.PostReceptionsReceptionIdManifestWithResponse(ctx, *next.Id,
+		client.PostReceptionsReceptionIdManifestJSONRequestBody{ManifestId: uuid.Must(uuid.NewV7())}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, unknown.StatusCode())
+}
@@ -0,0 +1,80 @@
+package e2e_test
+
+import (
+	grpc_profile "avito/internal/api/grpc-profile"
+	"avito/internal/config"
+	"avito/tests/e2e/client"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit_ShouldCountLoginsByConnectionIP(t *testing.T) {
+	cfg := testConfig()
+	cfg.AuthConfig.RateLimitConfig = config.RateLimitConfig{
+		Enabled:  true,
+		PerIP:    config.RateConfig{Requests: 2, Window: time.Minute},
+		PerEmail: config.RateConfig{Requests: 100, Window: time.Minute},
+		Lockout:  config.LockoutConfig{Threshold: 100, Duration: time.Second, MaxDuration: time.Second},
+	}
+	h := startAppWithConfig(t, cfg)
+
+	// reports have their own budget
+	for range 2 {
+		_, err := h.grpc.GetPVZReport(ctx, &grpc_profile.PVZReportRequest{Page: 1, Limit: 10})
+		require.NoError(t, err)
+	}
+
+	statuses := make([]int, 0, 3)
+	for i := range 3 {
+		// headers set by client do not make requests come from another ip
+		spoofed := func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("X-Forwarded-For", fmt.Sprintf("203.0.113.%d", i))
+			req.Header.Set("X-Real-IP", fmt.Sprintf("198.51.100.%d", i))
+			return nil
+		}
+
+		response, err := h.http.PostLoginWithResponse(ctx, client.PostLoginJSONRequestBody{Email: "nobody@example.com", Password: "password"}, spoofed)
+		require.NoError(t, err)
+		statuses = append(statuses, response.StatusCode())
+	}
+
+	require.Equal(t, []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusTooManyRequests}, statuses)
+}
+
+func TestRateLimit_ShouldCountLoginsByForwardedIP_BehindTrustedProxy(t *testing.T) {
+	cfg := testConfig()
+	cfg.HTTPConfig.TrustedProxies = []string{"127.0.0.0/8"}
+	cfg.AuthConfig.RateLimitConfig = config.RateLimitConfig{
+		Enabled:  true,
+		PerIP:    config.RateConfig{Requests: 2, Window: time.Minute},
+		PerEmail: config.RateConfig{Requests: 100, Window: time.Minute},
+		Lockout:  config.LockoutConfig{Threshold: 100, Duration: time.Second, MaxDuration: time.Second},
+	}
+	h := startAppWithConfig(t, cfg)
+
+	login := func(forwardedFor string) int {
+		forwarded := func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+			return nil
+		}
+
+		response, err := h.http.PostLoginWithResponse(ctx, client.PostLoginJSONRequestBody{Email: "nobody@example.com", Password: "password"}, forwarded)
+		require.NoError(t, err)
+		return response.StatusCode()
+	}
+
+	statuses := make([]int, 0, 5)
+	for range 3 {
+		statuses = append(statuses, login("203.0.113.1"))
+	}
+	// address added by client in front of the one proxy saw does not change the client
+	statuses = append(statuses, login("198.51.100.1, 203.0.113.1"))
+	statuses = append(statuses, login("203.0.113.2"))
+
+	require.Equal(t, []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusUnauthorized}, statuses)
+}
@@ -0,0 +1,76 @@
+package e2e_test
+
+import (
+	"avito/tests/e2e/client"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReopenReception(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	pvzID := *h.createPVZ(t, moderator, client.СанктПетербург).Id
+	h.assignEmployee(t, moderator, pvzID)
+	reception := h.openReception(t, employee, pvzID)
+	h.closeReception(t, employee, pvzID)
+	reason := client.PostReceptionsReceptionIdReopenJSONRequestBody{Reason: "закрыли до разгрузки второй машины"}
+
+	forbidden, err := h.http.PostReceptionsReceptionIdReopenWithResponse(ctx, *reception.Id, reason, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, forbidden.StatusCode())
+
+	withoutReason, err := h.http.PostReceptionsReceptionIdReopenWithResponse(ctx, *reception.Id,
+		client.PostReceptionsReceptionIdReopenJSONRequestBody{}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, withoutReason.StatusCode())
+
+	reopened, err := h.http.PostReceptionsReceptionIdReopenWithResponse(ctx, *reception.Id, reason, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, reopened.StatusCode(), string(reopened.Body))
+	require.Equal(t, client.InProgress, reopened.JSON200.Status)
+
+	withdrawn, err := h.http.GetReceptionsReceptionIdActWithResponse(ctx, *reception.Id, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, withdrawn.StatusCode())
+
+	added, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+		PvzId: pvzID,
+		Type:  client.PostProductsJSONBodyTypeОдежда,
+	}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, added.StatusCode(), string(added.Body))
+	require.Equal(t, *reception.Id, added.JSON201.ReceptionId)
+
+	h.closeReception(t, employee, pvzID)
+	reissued, err := h.http.GetReceptionsReceptionIdActWithResponse(ctx, *reception.Id, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, reissued.StatusCode(), string(reissued.Body))
+
+	history, err := h.http.GetReceptionsReceptionIdHistoryWithResponse(ctx, *reception.Id, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, history.StatusCode(), string(history.Body))
+	entries := *history.JSON200
+	require.Len(t, entries, 4)
+	require.Equal(t, []client.ReceptionHistoryEntryAction{client.Opened, client.Closed, client.Reopened, client.Closed},
+		[]client.ReceptionHistoryEntryAction{entries[0].Action, entries[1].Action, entries[2].Action, entries[3].Action})
+	require.Equal(t, reason.Reason, *entries[2].Reason)
+	require.NotEqual(t, entries[1].ActorId, entries[2].ActorId)
+	require.Nil(t, entries[3].Reason)
+
+	newer := h.openReception(t, employee, pvzID)
+	h.closeReception(t, employee, pvzID)
+	outdated, err := h.http.PostReceptionsReceptionIdReopenWithResponse(ctx, *reception.Id, reason, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, outdated.StatusCode())
+	require.Equal(t, "pvz already has newer reception", outdated.JSON400.Message)
+
+	opened, err := h.http.PostReceptionsReceptionIdReopenWithResponse(ctx, *newer.Id, reason, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, opened.StatusCode(), string(opened.Body))
+	again, err := h.http.PostReceptionsReceptionIdReopenWithResponse(ctx, *newer.Id, reason, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, again.StatusCode())
+}
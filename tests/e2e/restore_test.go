@@ -0,0 +1,91 @@
+package e2e_test
+
+import (
+	"avito/tests/e2e/client"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreLastRemovedProduct(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	pvzID := *h.createPVZ(t, moderator, client.Москва).Id
+	h.assignEmployee(t, moderator, pvzID)
+	reception := h.openReception(t, employee, pvzID)
+
+	nothingRemoved, err := h.http.PostPvzPvzIdRestoreLastProductWithResponse(ctx, pvzID, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, nothingRemoved.StatusCode(), string(nothingRemoved.Body))
+
+	added, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+		PvzId: pvzID,
+		Type:  client.PostProductsJSONBodyTypeОбувь,
+	}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, added.StatusCode(), string(added.Body))
+	deleted, err := h.http.PostPvzPvzIdDeleteLastProductWithResponse(ctx, pvzID, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, deleted.StatusCode(), string(deleted.Body))
+	require.Empty(t, h.reportProducts(t, moderator))
+
+	forbidden, err := h.http.PostPvzPvzIdRestoreLastProductWithResponse(ctx, pvzID, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, forbidden.StatusCode())
+
+	restored, err := h.http.PostPvzPvzIdRestoreLastProductWithResponse(ctx, pvzID, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, restored.StatusCode(), string(restored.Body))
+	require.Equal(t, *added.JSON201.Id, *restored.JSON200.Id)
+	require.True(t, added.JSON201.DateTime.Equal(*restored.JSON200.DateTime))
+
+	products := h.reportProducts(t, moderator)
+	require.Len(t, products, 1)
+	require.Equal(t, *added.JSON201.Id, *products[0].Id)
+
+	action := client.AuditActionProductRestore
+	audit, err := h.http.GetAuditWithResponse(ctx, &client.GetAuditParams{Action: &action}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, audit.StatusCode(), string(audit.Body))
+	require.Len(t, *audit.JSON200, 1)
+	record := (*audit.JSON200)[0]
+	require.Equal(t, *added.JSON201.Id, record.EntityId)
+	require.Contains(t, *record.Before, "deleted_at_utc")
+	require.NotContains(t, *record.After, "deleted_at_utc")
+
+	history, err := h.http.GetReceptionsReceptionIdHistoryWithResponse(ctx, *reception.Id, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, history.StatusCode(), string(history.Body))
+	entries := *history.JSON200
+	require.Len(t, entries, 2)
+	require.Equal(t, client.ProductRestoredAction, entries[1].Action)
+
+	deleted, err = h.http.PostPvzPvzIdDeleteLastProductWithResponse(ctx, pvzID, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, deleted.StatusCode(), string(deleted.Body))
+	closed, err := h.http.PostPvzPvzIdCloseLastReceptionWithResponse(ctx, pvzID, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, closed.StatusCode(), string(closed.Body))
+
+	afterClose, err := h.http.PostPvzPvzIdRestoreLastProductWithResponse(ctx, pvzID, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, afterClose.StatusCode(), string(afterClose.Body))
+	require.Empty(t, h.reportProducts(t, moderator))
+}
+
+// products of the only reception of the only pvz in report
+func (h harness) reportProducts(t *testing.T, moderator client.Token) []client.Product {
+	t.Helper()
+
+	page, limit := 1, 10
+	reports, err := h.http.GetPvzWithResponse(ctx, &client.GetPvzParams{Page: &page, Limit: &limit}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, reports.StatusCode(), string(reports.Body))
+	require.Len(t, *reports.JSON200, 1)
+	receptions := *(*reports.JSON200)[0].Receptions
+	require.Len(t, receptions, 1)
+
+	return *receptions[0].Products
+}
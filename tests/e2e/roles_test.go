@@ -0,0 +1,60 @@
+package e2e_test
+
+import (
+	"avito/tests/e2e/client"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoles(t *testing.T) {
+	h := startApp(t)
+	admin := h.dummyLogin(t, client.Admin)
+	moderator := h.dummyLogin(t, client.Moderator)
+
+	forbidden, err := h.http.GetRolesWithResponse(ctx, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, forbidden.StatusCode(), string(forbidden.Body))
+
+	builtIn, err := h.http.GetRolesWithResponse(ctx, bearer(admin))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, builtIn.StatusCode(), string(builtIn.Body))
+	require.Len(t, *builtIn.JSON200, 3)
+	require.Equal(t, []string{"employee", "moderator", "admin"},
+		[]string{(*builtIn.JSON200)[0].Name, (*builtIn.JSON200)[1].Name, (*builtIn.JSON200)[2].Name})
+
+	auditor := client.RoleRequest{Name: "auditor", Permissions: []client.Permission{client.PermissionAuditRead, client.PermissionReportRead}}
+	created, err := h.http.PostRolesWithResponse(ctx, auditor, bearer(admin))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode(), string(created.Body))
+	require.Equal(t, "auditor", created.JSON201.Name)
+	require.Equal(t, auditor.Permissions, created.JSON201.Permissions)
+
+	duplicate, err := h.http.PostRolesWithResponse(ctx, auditor, bearer(admin))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, duplicate.StatusCode(), string(duplicate.Body))
+
+	// moderator loses webhooks right away, permissions are read on every request
+	withoutWebhooks := client.RoleRequest{Name: "moderator", Permissions: []client.Permission{client.PermissionPvzCreate, client.PermissionAuditRead}}
+	updated, err := h.http.PutRolesRoleIdWithResponse(ctx, (*builtIn.JSON200)[1].Id, withoutWebhooks, bearer(admin))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, updated.StatusCode(), string(updated.Body))
+
+	subscriptions, err := h.http.GetWebhooksWithResponse(ctx, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, subscriptions.StatusCode(), string(subscriptions.Body))
+	h.createPVZ(t, moderator, client.Москва)
+
+	adminChanged, err := h.http.PutRolesRoleIdWithResponse(ctx, (*builtIn.JSON200)[2].Id, withoutWebhooks, bearer(admin))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, adminChanged.StatusCode(), string(adminChanged.Body))
+
+	entityType := client.AuditEntityTypeRole
+	records, err := h.http.GetAuditWithResponse(ctx, &client.GetAuditParams{EntityType: &entityType}, bearer(admin))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, records.StatusCode(), string(records.Body))
+	require.Len(t, *records.JSON200, 2)
+	require.Equal(t, client.AuditActionRoleUpdate, (*records.JSON200)[0].Action)
+	require.Equal(t, client.AuditActionRoleCreate, (*records.JSON200)[1].Action)
+}
@@ -0,0 +1,110 @@
+package e2e_test
+
+import (
+	grpc_profile "avito/internal/api/grpc-profile"
+	"avito/internal/domain"
+	"avito/tests/e2e/client"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServiceAccountAPIKeys(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+
+	byEmployee, err := h.http.PostServiceAccountsWithResponse(ctx, client.PostServiceAccountsJSONRequestBody{Email: "reporting@example.com", Role: "moderator"}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, byEmployee.StatusCode(), string(byEmployee.Body))
+
+	created, err := h.http.PostServiceAccountsWithResponse(ctx, client.PostServiceAccountsJSONRequestBody{Email: "reporting@example.com", Role: "moderator"}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode(), string(created.Body))
+	require.True(t, created.JSON201.ServiceAccount)
+	accountID := *created.JSON201.Id
+
+	beyondRole, err := h.http.PostServiceAccountsUserIdApiKeysWithResponse(ctx, accountID, client.PostServiceAccountsUserIdApiKeysJSONRequestBody{
+		Name:  "reporting",
+		Scope: []client.Permission{client.PermissionRoleManage},
+	}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, beyondRole.StatusCode(), string(beyondRole.Body))
+
+	issued, err := h.http.PostServiceAccountsUserIdApiKeysWithResponse(ctx, accountID, client.PostServiceAccountsUserIdApiKeysJSONRequestBody{
+		Name:  "reporting",
+		Scope: []client.Permission{client.PermissionPvzRead, client.PermissionAuditRead},
+	}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, issued.StatusCode(), string(issued.Body))
+	require.NotNil(t, issued.JSON201.Key)
+	key := client.Token(*issued.JSON201.Key)
+
+	t.Run("key is accepted within its scope", func(t *testing.T) {
+		reports, err := h.http.GetPvzWithResponse(ctx, &client.GetPvzParams{}, bearer(key))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, reports.StatusCode(), string(reports.Body))
+
+		audit, err := h.http.GetAuditWithResponse(ctx, &client.GetAuditParams{}, bearer(key))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, audit.StatusCode(), string(audit.Body))
+
+		outOfScope, err := h.http.PostPvzWithResponse(ctx, client.PostPvzJSONRequestBody{City: client.Москва}, bearer(key))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, outOfScope.StatusCode(), string(outOfScope.Body))
+	})
+
+	t.Run("key is accepted by grpc", func(t *testing.T) {
+		withKey := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+key)
+		response, err := h.grpc.GetPVZReport(withKey, &grpc_profile.PVZReportRequest{Page: 1, Limit: 10})
+		require.NoError(t, err)
+		require.Empty(t, response.Error)
+	})
+
+	t.Run("service account could not log in with password", func(t *testing.T) {
+		loggedIn, err := h.http.PostLoginWithResponse(ctx, client.PostLoginJSONRequestBody{Email: "reporting@example.com", Password: ""})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, loggedIn.StatusCode(), string(loggedIn.Body))
+	})
+
+	t.Run("list shows last use without keys", func(t *testing.T) {
+		keys, err := h.http.GetServiceAccountsUserIdApiKeysWithResponse(ctx, accountID, bearer(moderator))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, keys.StatusCode(), string(keys.Body))
+		require.Len(t, *keys.JSON200, 1)
+		listed := (*keys.JSON200)[0]
+		require.Equal(t, issued.JSON201.Id, listed.Id)
+		require.Nil(t, listed.Key)
+		require.Equal(t, string(key)[:len(listed.Hint)], listed.Hint)
+		require.NotNil(t, listed.LastUsedAt)
+		require.WithinDuration(t, time.Now(), *listed.LastUsedAt, time.Minute)
+	})
+
+	t.Run("revoked key stops working", func(t *testing.T) {
+		otherAccount := uuid.Must(uuid.NewV7())
+		wrongAccount, err := h.http.DeleteServiceAccountsUserIdApiKeysKeyIdWithResponse(ctx, otherAccount, issued.JSON201.Id, bearer(moderator))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, wrongAccount.StatusCode(), string(wrongAccount.Body))
+
+		revoked, err := h.http.DeleteServiceAccountsUserIdApiKeysKeyIdWithResponse(ctx, accountID, issued.JSON201.Id, bearer(moderator))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, revoked.StatusCode(), string(revoked.Body))
+
+		audit, err := h.http.GetAuditWithResponse(ctx, &client.GetAuditParams{}, bearer(key))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, audit.StatusCode(), string(audit.Body))
+
+		withKey := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+key)
+		response, err := h.grpc.GetPVZReport(withKey, &grpc_profile.PVZReportRequest{Page: 1, Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, domain.InsufficientPrivilegesError, response.Error)
+
+		again, err := h.http.DeleteServiceAccountsUserIdApiKeysKeyIdWithResponse(ctx, accountID, issued.JSON201.Id, bearer(moderator))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, again.StatusCode(), string(again.Body))
+	})
+}
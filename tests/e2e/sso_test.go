@@ -0,0 +1,220 @@
+package e2e_test
+
+import (
+	"avito/internal/config"
+	"avito/internal/domain"
+	"avito/tests/e2e/client"
+	"avito/tests/mockidp"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// identity provider sends user back here, the address is never opened, callback is called by the test itself
+const ssoRedirectURL string = "http://pvz.test/sso/callback"
+
+func TestSSOLogin(t *testing.T) {
+	idp, err := mockidp.New("pvz", "pvz-secret")
+	require.NoError(t, err)
+	t.Cleanup(idp.Close)
+
+	cfg := testConfig()
+	cfg.AuthConfig.SSOConfig = config.SSOConfig{
+		Enabled:      true,
+		Issuer:       idp.Issuer(),
+		ClientID:     "pvz",
+		ClientSecret: "pvz-secret",
+		RedirectURL:  ssoRedirectURL,
+		Scopes:       []string{"openid", "email", "groups"},
+		GroupsClaim:  "groups",
+		RoleMapping: []config.SSORoleMappingConfig{
+			{Group: "pvz-moderators", Role: domain.ModeratorUserRoleName},
+			{Group: "pvz-staff", Role: domain.EmployeeUserRoleName},
+		},
+	}
+	h := startAppWithConfig(t, cfg)
+	admin := h.dummyLogin(t, client.Admin)
+
+	idp.SetUser(mockidp.User{Subject: "staff-1", Email: "staff@example.com", EmailVerified: true, Groups: []string{"everyone", "pvz-moderators"}})
+	firstState := h.ssoLogin(t)
+
+	var userID string
+	t.Run("new user is provisioned with role of group", func(t *testing.T) {
+		token := h.ssoCallback(t, firstState, http.StatusOK)
+
+		h.createPVZ(t, token, client.Москва)
+
+		user := h.userByEmail(t, admin, "staff@example.com")
+		require.Equal(t, domain.ModeratorUserRoleName, user.Role)
+		require.True(t, user.Sso)
+		require.True(t, user.EmailVerified)
+		userID = user.Id.String()
+	})
+
+	t.Run("state could not be used twice", func(t *testing.T) {
+		h.ssoCallback(t, firstState, http.StatusBadRequest)
+	})
+
+	t.Run("role follows groups on the next login", func(t *testing.T) {
+		idp.SetUser(mockidp.User{Subject: "staff-1", Email: "staff@example.com", EmailVerified: true, Groups: []string{"pvz-staff"}})
+
+		token := h.ssoCallback(t, h.ssoLogin(t), http.StatusOK)
+
+		registrationDate := time.Now().UTC()
+		pvz, err := h.http.PostPvzWithResponse(ctx, client.PostPvzJSONRequestBody{City: client.Москва, RegistrationDate: &registrationDate}, bearer(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, pvz.StatusCode(), string(pvz.Body))
+
+		user := h.userByEmail(t, admin, "staff@example.com")
+		require.Equal(t, userID, user.Id.String())
+		require.Equal(t, domain.EmployeeUserRoleName, user.Role)
+	})
+
+	t.Run("user of no mapped group is refused", func(t *testing.T) {
+		idp.SetUser(mockidp.User{Subject: "guest", Email: "guest@example.com", EmailVerified: true, Groups: []string{"everyone"}})
+
+		h.ssoCallback(t, h.ssoLogin(t), http.StatusForbidden)
+
+		email := "guest@example.com"
+		users, err := h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{Email: &email}, bearer(admin))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, users.StatusCode(), string(users.Body))
+		require.Empty(t, *users.JSON200)
+	})
+
+	t.Run("existing user is linked only by verified email", func(t *testing.T) {
+		registered, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{Email: "courier@example.com", Password: "password"})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, registered.StatusCode(), string(registered.Body))
+
+		idp.SetUser(mockidp.User{Subject: "courier", Email: "courier@example.com", Groups: []string{"pvz-staff"}})
+		h.ssoCallback(t, h.ssoLogin(t), http.StatusBadRequest)
+
+		idp.SetUser(mockidp.User{Subject: "courier", Email: "courier@example.com", EmailVerified: true, Groups: []string{"pvz-staff"}})
+		h.ssoCallback(t, h.ssoLogin(t), http.StatusOK)
+
+		user := h.userByEmail(t, admin, "courier@example.com")
+		require.Equal(t, registered.JSON201.Id, user.Id)
+		require.True(t, user.Sso)
+
+		byPassword, err := h.http.PostLoginWithResponse(ctx, client.PostLoginJSONRequestBody{Email: "courier@example.com", Password: "password"})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, byPassword.StatusCode(), string(byPassword.Body))
+	})
+
+	t.Run("login refused by provider is refused", func(t *testing.T) {
+		state := h.ssoLogin(t)
+		state.query.Del("code")
+		state.query.Set("error", "access_denied")
+
+		h.ssoCallback(t, state, http.StatusForbidden)
+	})
+
+	t.Run("login is completed only in browser which started it", func(t *testing.T) {
+		idp.SetUser(mockidp.User{Subject: "staff-1", Email: "staff@example.com", EmailVerified: true, Groups: []string{"pvz-staff"}})
+		started := h.ssoLogin(t)
+
+		// someone sends user link to callback of login they started themselves
+		followed := started
+		followed.stateCookie = h.ssoLogin(t).stateCookie
+		h.ssoCallback(t, followed, http.StatusBadRequest)
+
+		withoutCookie := started
+		withoutCookie.stateCookie = ""
+		h.ssoCallback(t, withoutCookie, http.StatusBadRequest)
+
+		h.ssoCallback(t, started, http.StatusOK)
+	})
+}
+
+func TestSSOLogin_ShouldBeNotFound_WhenDisabled(t *testing.T) {
+	h := startApp(t)
+
+	login, err := h.http.GetSsoLoginWithResponse(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, login.StatusCode(), string(login.Body))
+
+	code, state := "code", "state"
+	callback, err := h.http.GetSsoCallbackWithResponse(ctx, &client.GetSsoCallbackParams{Code: &code, State: &state})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, callback.StatusCode(), string(callback.Body))
+}
+
+// ssoReturn is what browser brings back to callback: query identity provider sent user back with and cookie
+// browser was given on redirect to provider
+type ssoReturn struct {
+	query       url.Values
+	stateCookie string
+}
+
+// ssoLogin walks redirects as browser would
+func (h harness) ssoLogin(t *testing.T) ssoReturn {
+	t.Helper()
+
+	browser := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	var stateCookie string
+	location := h.url + "/sso/login"
+	for range 2 {
+		response, err := browser.Get(location)
+		require.NoError(t, err)
+		response.Body.Close()
+		require.Equal(t, http.StatusFound, response.StatusCode)
+		for _, cookie := range response.Cookies() {
+			if cookie.Name == "sso_state" {
+				require.True(t, cookie.HttpOnly)
+				require.Equal(t, http.SameSiteLaxMode, cookie.SameSite)
+				stateCookie = cookie.Value
+			}
+		}
+		location = response.Header.Get("Location")
+	}
+
+	callback, err := url.Parse(location)
+	require.NoError(t, err)
+	require.Equal(t, ssoRedirectURL, callback.Scheme+"://"+callback.Host+callback.Path)
+	require.NotEmpty(t, stateCookie)
+
+	return ssoReturn{query: callback.Query(), stateCookie: stateCookie}
+}
+
+func (h harness) ssoCallback(t *testing.T, login ssoReturn, expectedStatus int) client.Token {
+	t.Helper()
+
+	query := login.query
+	state, code, providerError := query.Get("state"), query.Get("code"), query.Get("error")
+	params := client.GetSsoCallbackParams{State: &state}
+	if query.Has("code") {
+		params.Code = &code
+	}
+	if query.Has("error") {
+		params.Error = &providerError
+	}
+	if login.stateCookie != "" {
+		params.SsoState = &login.stateCookie
+	}
+
+	response, err := h.http.GetSsoCallbackWithResponse(ctx, &params)
+	require.NoError(t, err)
+	require.Equal(t, expectedStatus, response.StatusCode(), string(response.Body))
+	if expectedStatus != http.StatusOK {
+		return ""
+	}
+
+	return *response.JSON200
+}
+
+func (h harness) userByEmail(t *testing.T, admin client.Token, email string) client.User {
+	t.Helper()
+
+	users, err := h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{Email: &email}, bearer(admin))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, users.StatusCode(), string(users.Body))
+	require.Len(t, *users.JSON200, 1)
+
+	return (*users.JSON200)[0]
+}
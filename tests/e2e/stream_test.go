@@ -0,0 +1,135 @@
+package e2e_test
+
+import (
+	"avito/internal/domain"
+	"avito/tests/e2e/client"
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceptionStream(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	kazanPVZ := h.createPVZ(t, moderator, client.Казань)
+	h.assignEmployee(t, moderator, *kazanPVZ.Id)
+	moscowPVZ := h.createPVZ(t, moderator, client.Москва)
+	h.assignEmployee(t, moderator, *moscowPVZ.Id)
+	unassignedPVZ := h.createPVZ(t, moderator, client.Казань)
+
+	anonymous, err := h.http.GetReceptionsStreamWithResponse(ctx, &client.GetReceptionsStreamParams{PvzId: kazanPVZ.Id})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, anonymous.StatusCode())
+
+	unfiltered, err := h.http.GetReceptionsStreamWithResponse(ctx, &client.GetReceptionsStreamParams{}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, unfiltered.StatusCode())
+
+	notAssigned, err := h.http.GetReceptionsStreamWithResponse(ctx, &client.GetReceptionsStreamParams{PvzId: unassignedPVZ.Id}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, notAssigned.StatusCode())
+
+	city := client.Казань
+	byCity := h.openStream(t, employee, &client.GetReceptionsStreamParams{City: &city})
+	byPVZ := h.openStream(t, moderator, &client.GetReceptionsStreamParams{PvzId: kazanPVZ.Id})
+
+	// other city is not streamed, nor pvz of the city employee is not assigned to
+	h.openReception(t, h.dummyLogin(t, client.Admin), *unassignedPVZ.Id)
+	h.openReception(t, employee, *moscowPVZ.Id)
+	h.openReception(t, employee, *kazanPVZ.Id)
+	added, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+		PvzId: *kazanPVZ.Id,
+		Type:  client.PostProductsJSONBodyTypeОдежда,
+	}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, added.StatusCode(), string(added.Body))
+	deleted, err := h.http.PostPvzPvzIdDeleteLastProductWithResponse(ctx, *kazanPVZ.Id, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, deleted.StatusCode(), string(deleted.Body))
+	closed, err := h.http.PostPvzPvzIdCloseLastReceptionWithResponse(ctx, *kazanPVZ.Id, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, closed.StatusCode(), string(closed.Body))
+
+	expected := []domain.EventType{
+		domain.ReceptionOpenedEventType,
+		domain.ProductAddedEventType,
+		domain.ProductRemovedEventType,
+		domain.ReceptionClosedEventType,
+	}
+	for _, stream := range []*sseReader{byCity, byPVZ} {
+		for _, eventType := range expected {
+			message := stream.next(t)
+			require.Equal(t, eventType, message.event)
+
+			var event domain.Event
+			require.NoError(t, json.Unmarshal([]byte(message.data), &event))
+			require.Equal(t, *kazanPVZ.Id, event.PVZID)
+			require.Equal(t, event.ID.String(), message.id)
+		}
+	}
+}
+
+type sseMessage struct {
+	id    string
+	event string
+	data  string
+}
+
+type sseReader struct {
+	messages chan sseMessage
+}
+
+func (h harness) openStream(t *testing.T, token client.Token, params *client.GetReceptionsStreamParams) *sseReader {
+	t.Helper()
+
+	response, err := h.stream.GetReceptionsStream(ctx, params, bearer(token))
+	require.NoError(t, err)
+	t.Cleanup(func() { response.Body.Close() })
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+
+	reader := &sseReader{messages: make(chan sseMessage, 100)}
+	go func() {
+		defer close(reader.messages)
+
+		var message sseMessage
+		scanner := bufio.NewScanner(response.Body)
+		for scanner.Scan() {
+			field, value, _ := strings.Cut(scanner.Text(), ": ")
+			switch field {
+			case "id":
+				message.id = value
+			case "event":
+				message.event = value
+			case "data":
+				message.data = value
+			case "":
+				if message.event != "" {
+					reader.messages <- message
+				}
+				message = sseMessage{}
+			}
+		}
+	}()
+
+	return reader
+}
+
+func (r *sseReader) next(t *testing.T) sseMessage {
+	t.Helper()
+
+	select {
+	case message, ok := <-r.messages:
+		require.True(t, ok, "stream is closed")
+		return message
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected stream message")
+		return sseMessage{}
+	}
+}
@@ -0,0 +1,177 @@
+package e2e_test
+
+import (
+	"avito/internal/config"
+	"avito/pkg/totp"
+	"avito/tests/e2e/client"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// enrollTwoFactor enables second factor by code of previous step, so code of current step is left for login
+func (h harness) enrollTwoFactor(t *testing.T, token client.Token) (secret string, recoveryCodes []string) {
+	t.Helper()
+
+	enrolled, err := h.http.PostTwoFactorEnrollWithResponse(ctx, bearer(token))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, enrolled.StatusCode(), string(enrolled.Body))
+	secret = enrolled.JSON200.Secret
+
+	code, err := totp.Code(secret, totp.Step(time.Now())-1)
+	require.NoError(t, err)
+	confirmed, err := h.http.PostTwoFactorConfirmWithResponse(ctx, client.PostTwoFactorConfirmJSONRequestBody{Code: code}, bearer(token))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, confirmed.StatusCode(), string(confirmed.Body))
+
+	return secret, confirmed.JSON200.RecoveryCodes
+}
+
+func TestTwoFactorLogin(t *testing.T) {
+	h := startApp(t)
+	credentials := client.PostLoginJSONRequestBody{Email: "two-factor@example.com", Password: "password"}
+	registered, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{Email: credentials.Email, Password: credentials.Password})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, registered.StatusCode(), string(registered.Body))
+	require.False(t, registered.JSON201.TwoFactorEnabled)
+
+	loggedIn, err := h.http.PostLoginWithResponse(ctx, credentials)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, loggedIn.StatusCode(), string(loggedIn.Body))
+	secret, recoveryCodes := h.enrollTwoFactor(t, *loggedIn.JSON200)
+	require.Len(t, recoveryCodes, 10)
+
+	// password alone gives challenge of the second step only
+	challenged, err := h.http.PostLoginWithResponse(ctx, credentials)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, challenged.StatusCode(), string(challenged.Body))
+	challenge := challenged.JSON202.Challenge
+
+	withChallenge, err := h.http.PostTwoFactorEnrollWithResponse(ctx, bearer(client.Token(challenge)))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, withChallenge.StatusCode(), string(withChallenge.Body))
+
+	badCode, err := h.http.PostLoginTwoFactorWithResponse(ctx, client.PostLoginTwoFactorJSONRequestBody{Challenge: challenge, Code: "000000000"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, badCode.StatusCode(), string(badCode.Body))
+
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	require.NoError(t, err)
+	confirmed, err := h.http.PostLoginTwoFactorWithResponse(ctx, client.PostLoginTwoFactorJSONRequestBody{Challenge: challenge, Code: code})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, confirmed.StatusCode(), string(confirmed.Body))
+	token := *confirmed.JSON200
+
+	replayed, err := h.http.PostLoginTwoFactorWithResponse(ctx, client.PostLoginTwoFactorJSONRequestBody{Challenge: challenge, Code: code})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, replayed.StatusCode(), string(replayed.Body))
+
+	recovered, err := h.http.PostLoginTwoFactorWithResponse(ctx, client.PostLoginTwoFactorJSONRequestBody{Challenge: challenge, Code: recoveryCodes[0]})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, recovered.StatusCode(), string(recovered.Body))
+
+	disabled, err := h.http.PostTwoFactorDisableWithResponse(ctx, client.PostTwoFactorDisableJSONRequestBody{Code: recoveryCodes[1]}, bearer(token))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, disabled.StatusCode(), string(disabled.Body))
+
+	withPassword, err := h.http.PostLoginWithResponse(ctx, credentials)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, withPassword.StatusCode(), string(withPassword.Body))
+
+	entityType := client.AuditEntityTypeUser
+	admin := h.dummyLogin(t, client.Admin)
+	records, err := h.http.GetAuditWithResponse(ctx, &client.GetAuditParams{EntityType: &entityType, EntityId: registered.JSON201.Id}, bearer(admin))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, records.StatusCode(), string(records.Body))
+	require.Len(t, *records.JSON200, 3)
+	require.Equal(t, client.AuditActionUserTwoFactorDisable, (*records.JSON200)[0].Action)
+	require.Equal(t, client.AuditActionUserTwoFactorEnable, (*records.JSON200)[1].Action)
+	require.NotContains(t, string(records.Body), secret)
+}
+
+func TestTwoFactorRequiredByRole(t *testing.T) {
+	h := startApp(t)
+	admin := h.dummyLogin(t, client.Admin)
+	moderator := h.dummyLogin(t, client.Moderator)
+
+	builtIn, err := h.http.GetRolesWithResponse(ctx, bearer(admin))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, builtIn.StatusCode(), string(builtIn.Body))
+	moderatorRole := (*builtIn.JSON200)[1]
+	require.False(t, moderatorRole.TwoFactorRequired)
+
+	required := true
+	updated, err := h.http.PutRolesRoleIdWithResponse(ctx, moderatorRole.Id, client.RoleRequest{
+		Name:              moderatorRole.Name,
+		Permissions:       moderatorRole.Permissions,
+		TwoFactorRequired: &required,
+	}, bearer(admin))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, updated.StatusCode(), string(updated.Body))
+	require.True(t, updated.JSON200.TwoFactorRequired)
+
+	// moderator keeps nothing but enrollment until second factor is enabled
+	withoutSecondFactor, err := h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, withoutSecondFactor.StatusCode(), string(withoutSecondFactor.Body))
+
+	_, recoveryCodes := h.enrollTwoFactor(t, moderator)
+
+	withSecondFactor, err := h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, withSecondFactor.StatusCode(), string(withSecondFactor.Body))
+
+	disabled, err := h.http.PostTwoFactorDisableWithResponse(ctx, client.PostTwoFactorDisableJSONRequestBody{Code: recoveryCodes[0]}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, disabled.StatusCode(), string(disabled.Body))
+}
+
+func TestTwoFactorLogin_ShouldLockUserOut_AcrossChallenges(t *testing.T) {
+	cfg := testConfig()
+	cfg.AuthConfig.RateLimitConfig = config.RateLimitConfig{
+		Enabled:  true,
+		PerIP:    config.RateConfig{Requests: 100, Window: time.Minute},
+		PerEmail: config.RateConfig{Requests: 100, Window: time.Minute},
+		Lockout:  config.LockoutConfig{Threshold: 2, Duration: time.Minute, MaxDuration: time.Minute},
+	}
+	h := startAppWithConfig(t, cfg)
+	credentials := client.PostLoginJSONRequestBody{Email: "guessed@example.com", Password: "password"}
+	registered, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{Email: credentials.Email, Password: credentials.Password})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, registered.StatusCode(), string(registered.Body))
+	loggedIn, err := h.http.PostLoginWithResponse(ctx, credentials)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, loggedIn.StatusCode(), string(loggedIn.Body))
+	secret, _ := h.enrollTwoFactor(t, *loggedIn.JSON200)
+
+	// attacker knowing password signs in again for every guess, fresh challenge gives no fresh attempts
+	statuses := make([]int, 0, 3)
+	challenges := make(map[string]struct{}, 3)
+	for i := range 3 {
+		if i > 0 {
+			// challenges issued within the same second are the same token
+			time.Sleep(time.Second)
+		}
+
+		challenged, err := h.http.PostLoginWithResponse(ctx, credentials)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusAccepted, challenged.StatusCode(), string(challenged.Body))
+		challenges[challenged.JSON202.Challenge] = struct{}{}
+
+		guessed, err := h.http.PostLoginTwoFactorWithResponse(ctx, client.PostLoginTwoFactorJSONRequestBody{Challenge: challenged.JSON202.Challenge, Code: "000000000"})
+		require.NoError(t, err)
+		statuses = append(statuses, guessed.StatusCode())
+	}
+	require.Len(t, challenges, 3)
+	require.Equal(t, []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusTooManyRequests}, statuses)
+
+	challenged, err := h.http.PostLoginWithResponse(ctx, credentials)
+	require.NoError(t, err)
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	require.NoError(t, err)
+	locked, err := h.http.PostLoginTwoFactorWithResponse(ctx, client.PostLoginTwoFactorJSONRequestBody{Challenge: challenged.JSON202.Challenge, Code: code})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusTooManyRequests, locked.StatusCode(), string(locked.Body))
+}
@@ -0,0 +1,180 @@
+package e2e_test
+
+import (
+	grpc_profile "avito/internal/api/grpc-profile"
+	"avito/internal/domain"
+	"avito/tests/e2e/client"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListUsers(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	for _, email := range []openapi_types.Email{"listed-first@example.com", "listed-second@example.com", "other@example.com"} {
+		registered, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{Email: email, Password: "password"})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, registered.StatusCode(), string(registered.Body))
+	}
+
+	forbidden, err := h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, forbidden.StatusCode(), string(forbidden.Body))
+
+	email := "LISTED"
+	byEmail, err := h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{Email: &email}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, byEmail.StatusCode(), string(byEmail.Body))
+	require.Len(t, *byEmail.JSON200, 2)
+	require.Equal(t, openapi_types.Email("listed-first@example.com"), (*byEmail.JSON200)[0].Email)
+	require.True(t, (*byEmail.JSON200)[0].Active)
+
+	page, limit := 2, 1
+	secondPage, err := h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{Email: &email, Page: &page, Limit: &limit}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, secondPage.StatusCode(), string(secondPage.Body))
+	require.Len(t, *secondPage.JSON200, 1)
+	require.Equal(t, openapi_types.Email("listed-second@example.com"), (*secondPage.JSON200)[0].Email)
+
+	role := "moderator"
+	moderators, err := h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{Role: &role}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, moderators.StatusCode(), string(moderators.Body))
+	require.Len(t, *moderators.JSON200, 1)
+	require.Equal(t, h.dummyModeratorID, *(*moderators.JSON200)[0].Id)
+
+	unknownRole := "superuser"
+	badRole, err := h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{Role: &unknownRole}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, badRole.StatusCode(), string(badRole.Body))
+}
+
+func TestDeactivateUser(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	h.dummyLogin(t, client.Admin)
+	credentials := client.PostLoginJSONRequestBody{Email: "deactivated@example.com", Password: "password"}
+
+	registered, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{Email: credentials.Email, Password: credentials.Password})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, registered.StatusCode(), string(registered.Body))
+	userID := *registered.JSON201.Id
+
+	loggedIn, err := h.http.PostLoginWithResponse(ctx, credentials)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, loggedIn.StatusCode(), string(loggedIn.Body))
+	token := *loggedIn.JSON200
+
+	inactive, active := false, true
+	deactivated, err := h.http.PatchUsersUserIdWithResponse(ctx, userID, client.PatchUsersUserIdJSONRequestBody{Active: &inactive}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, deactivated.StatusCode(), string(deactivated.Body))
+	require.False(t, deactivated.JSON200.Active)
+
+	// token issued before deactivation stops working right away
+	withOldToken, err := h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{}, bearer(token))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, withOldToken.StatusCode(), string(withOldToken.Body))
+	require.Equal(t, domain.UserIsDeactivatedError, withOldToken.JSON403.Message)
+
+	refused, err := h.http.PostLoginWithResponse(ctx, credentials)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, refused.StatusCode(), string(refused.Body))
+
+	listed, err := h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{Active: &inactive}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listed.StatusCode(), string(listed.Body))
+	require.Len(t, *listed.JSON200, 1)
+	require.Equal(t, userID, *(*listed.JSON200)[0].Id)
+
+	activated, err := h.http.PatchUsersUserIdWithResponse(ctx, userID, client.PatchUsersUserIdJSONRequestBody{Active: &active}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, activated.StatusCode(), string(activated.Body))
+	require.True(t, activated.JSON200.Active)
+
+	withOldToken, err = h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{}, bearer(token))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, withOldToken.StatusCode(), string(withOldToken.Body))
+	require.Equal(t, domain.InsufficientPrivilegesError, withOldToken.JSON403.Message)
+
+	self, err := h.http.PatchUsersUserIdWithResponse(ctx, h.dummyModeratorID, client.PatchUsersUserIdJSONRequestBody{Active: &inactive}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, self.StatusCode(), string(self.Body))
+
+	adminDeactivated, err := h.http.PatchUsersUserIdWithResponse(ctx, h.dummyAdminID, client.PatchUsersUserIdJSONRequestBody{Active: &inactive}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, adminDeactivated.StatusCode(), string(adminDeactivated.Body))
+
+	entityID := userID
+	records, err := h.http.GetAuditWithResponse(ctx, &client.GetAuditParams{EntityId: &entityID}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, records.StatusCode(), string(records.Body))
+	require.Len(t, *records.JSON200, 3)
+	require.Equal(t, client.AuditActionUserActivate, (*records.JSON200)[0].Action)
+	require.Equal(t, client.AuditActionUserDeactivate, (*records.JSON200)[1].Action)
+	require.Equal(t, client.AuditActionUserRegister, (*records.JSON200)[2].Action)
+}
+
+func TestPatchUserRoleAndStatus(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+
+	role, inactive := "moderator", false
+	updated, err := h.http.PatchUsersUserIdWithResponse(ctx, h.dummyEmployeeID, client.PatchUsersUserIdJSONRequestBody{Role: &role, Active: &inactive}, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, updated.StatusCode(), string(updated.Body))
+	require.Equal(t, "moderator", updated.JSON200.Role)
+	require.False(t, updated.JSON200.Active)
+
+	forbidden, err := h.http.GetUsersWithResponse(ctx, &client.GetUsersParams{}, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, forbidden.StatusCode(), string(forbidden.Body))
+}
+
+func TestPasswordPolicy(t *testing.T) {
+	cfg := testConfig()
+	cfg.AuthConfig.PasswordConfig.MinCharacterClasses = 2
+	cfg.AuthConfig.PasswordConfig.BreachedListFile = filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(cfg.AuthConfig.PasswordConfig.BreachedListFile, []byte("password1\n"), 0o644))
+	h := startAppWithConfig(t, cfg)
+
+	cases := []struct {
+		password string
+		expected string
+	}{
+		{password: "Ab1", expected: domain.PasswordIsTooShortError},
+		{password: "password", expected: domain.PasswordIsTooSimpleError},
+		{password: "Password1", expected: domain.PasswordIsBreachedError},
+	}
+	for _, tc := range cases {
+		refused, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{Email: "policy@example.com", Password: tc.password})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, refused.StatusCode(), string(refused.Body))
+		require.Equal(t, tc.expected, refused.JSON400.Message)
+	}
+
+	registered, err := h.http.PostRegisterWithResponse(ctx, client.PostRegisterJSONRequestBody{Email: "policy@example.com", Password: "correct horse 7"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, registered.StatusCode(), string(registered.Body))
+}
+
+func TestDummyLogin_ShouldBeRefused_WhenDisabled(t *testing.T) {
+	cfg := testConfig()
+	cfg.AuthConfig.DummyLoginConfig.Enabled = false
+	h := startAppWithConfig(t, cfg)
+
+	response, err := h.http.PostDummyLoginWithResponse(ctx, client.PostDummyLoginJSONRequestBody{Role: client.Moderator})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, response.StatusCode(), string(response.Body))
+
+	report, err := h.grpc.GetPVZReport(ctx, &grpc_profile.PVZReportRequest{Page: 1, Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, domain.InsufficientPrivilegesError, report.Error)
+}
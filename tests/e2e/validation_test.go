@@ -0,0 +1,71 @@
+package e2e_test
+
+import (
+	"avito/tests/e2e/client"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestValidation(t *testing.T) {
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+
+	t.Run("unknown city and malformed id", func(t *testing.T) {
+		response, err := h.http.PostPvzWithBodyWithResponse(ctx, "application/json",
+			strings.NewReader(`{"id": "not-uuid", "city": "Лондон"}`), bearer(moderator))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, response.StatusCode())
+		require.Equal(t, []string{"city", "id"}, failedFields(t, response.JSON400))
+	})
+
+	t.Run("unknown product type and missing pvz", func(t *testing.T) {
+		response, err := h.http.PostProductsWithBodyWithResponse(ctx, "application/json",
+			strings.NewReader(`{"type": "мебель"}`), bearer(employee))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, response.StatusCode())
+		require.Equal(t, []string{"pvzId", "type"}, failedFields(t, response.JSON400))
+	})
+
+	t.Run("page and limit out of bounds", func(t *testing.T) {
+		page, limit := 0, 31
+		response, err := h.http.GetPvzWithResponse(ctx, &client.GetPvzParams{Page: &page, Limit: &limit}, bearer(moderator))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, response.StatusCode())
+		require.Equal(t, []string{"limit", "page"}, failedFields(t, response.JSON400))
+	})
+
+	t.Run("domain errors are reported without fields", func(t *testing.T) {
+		response, err := h.http.PostPvzPvzIdCloseLastReceptionWithResponse(ctx, uuid.Must(uuid.NewV7()), bearer(employee))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, response.StatusCode())
+		require.NotEmpty(t, response.JSON400.Message)
+		require.Nil(t, response.JSON400.Errors)
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		response, err := h.http.PostDummyLoginWithResponse(ctx, client.PostDummyLoginJSONRequestBody{Role: "superuser"})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, response.StatusCode())
+		require.Equal(t, []string{"role"}, failedFields(t, response.JSON400))
+	})
+}
+
+func failedFields(t *testing.T, body *client.Error) []string {
+	t.Helper()
+
+	require.NotNil(t, body)
+	require.NotNil(t, body.Errors)
+
+	fields := make([]string, len(*body.Errors))
+	for i, fieldErr := range *body.Errors {
+		require.NotEmpty(t, fieldErr.Message)
+		fields[i] = fieldErr.Field
+	}
+
+	return fields
+}
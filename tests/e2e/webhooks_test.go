@@ -0,0 +1,182 @@
+package e2e_test
+
+import (
+	"avito/internal/domain"
+	"avito/internal/services"
+	"avito/tests/e2e/client"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const partnerSecret string = "partner-secret-0123456789"
+
+func TestWebhookSubscriptions(t *testing.T) {
+	partner := newPartnerReceiver(t)
+	h := startApp(t)
+	moderator := h.dummyLogin(t, client.Moderator)
+	employee := h.dummyLogin(t, client.Employee)
+	city := client.Казань
+	request := client.PostWebhooksJSONRequestBody{
+		Url:        partner.url,
+		EventTypes: []client.WebhookEventType{client.ReceptionClosed},
+		City:       &city,
+		Secret:     partnerSecret,
+	}
+
+	forbidden, err := h.http.PostWebhooksWithResponse(ctx, request, bearer(employee))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, forbidden.StatusCode())
+
+	created, err := h.http.PostWebhooksWithResponse(ctx, request, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode(), string(created.Body))
+	subscription := *created.JSON201
+	require.Equal(t, &city, subscription.City)
+	require.NotContains(t, string(created.Body), partnerSecret)
+
+	listed, err := h.http.GetWebhooksWithResponse(ctx, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listed.StatusCode(), string(listed.Body))
+	require.Len(t, *listed.JSON200, 1)
+
+	// reception of other city is not delivered
+	for _, pvzCity := range []client.PVZCity{client.Москва, client.Казань} {
+		pvz := h.createPVZ(t, moderator, pvzCity)
+		h.assignEmployee(t, moderator, *pvz.Id)
+		h.openReception(t, employee, *pvz.Id)
+		added, err := h.http.PostProductsWithResponse(ctx, client.PostProductsJSONRequestBody{
+			PvzId: *pvz.Id,
+			Type:  client.PostProductsJSONBodyTypeЭлектроника,
+		}, bearer(employee))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, added.StatusCode(), string(added.Body))
+		closed, err := h.http.PostPvzPvzIdCloseLastReceptionWithResponse(ctx, *pvz.Id, bearer(employee))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, closed.StatusCode(), string(closed.Body))
+
+		if pvzCity == client.Казань {
+			event := partner.waitForEvent(t)
+			require.Equal(t, domain.ReceptionClosedEventType, event.Type)
+			require.Equal(t, *pvz.Id, event.PVZID)
+			var payload domain.ReceptionClosedEventPayload
+			require.NoError(t, json.Unmarshal(event.Payload, &payload))
+			require.Equal(t, 1, payload.AcceptedProducts)
+		}
+	}
+	require.Equal(t, 1, partner.eventsCount())
+
+	var deliveries []client.WebhookDeliveryAttempt
+	require.Eventually(t, func() bool {
+		response, err := h.http.GetWebhooksSubscriptionIdDeliveriesWithResponse(ctx, subscription.Id, nil, bearer(moderator))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, response.StatusCode(), string(response.Body))
+		deliveries = *response.JSON200
+
+		return len(deliveries) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.True(t, deliveries[0].Delivered)
+	require.Equal(t, 2, deliveries[0].Attempt)
+	require.False(t, deliveries[1].Delivered)
+	require.Equal(t, http.StatusServiceUnavailable, deliveries[1].StatusCode)
+
+	removed, err := h.http.DeleteWebhooksSubscriptionIdWithResponse(ctx, subscription.Id, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, removed.StatusCode(), string(removed.Body))
+
+	missing, err := h.http.GetWebhooksSubscriptionIdDeliveriesWithResponse(ctx, subscription.Id, nil, bearer(moderator))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, missing.StatusCode())
+}
+
+// partnerReceiver rejects the first delivery and accepts only correctly signed events
+func TestWebhookSubscriptions_ShouldRefusePrivateAddress(t *testing.T) {
+	cfg := testConfig()
+	cfg.EventsConfig.WebhookDelivery.AllowPrivateNetworks = false
+	h := startAppWithConfig(t, cfg)
+	moderator := h.dummyLogin(t, client.Moderator)
+
+	for _, url := range []string{"http://127.0.0.1:8080/hook", "http://[::ffff:10.0.0.1]/hook", "http://169.254.169.254/latest", "http://localhost/hook"} {
+		t.Run(url, func(t *testing.T) {
+			response, err := h.http.PostWebhooksWithResponse(ctx, client.PostWebhooksJSONRequestBody{
+				Url:        url,
+				EventTypes: []client.WebhookEventType{client.ReceptionClosed},
+				Secret:     partnerSecret,
+			}, bearer(moderator))
+			require.NoError(t, err)
+			require.Equal(t, http.StatusBadRequest, response.StatusCode(), string(response.Body))
+			require.Equal(t, domain.WebhookURLIsNotPublicError, response.JSON400.Message)
+		})
+	}
+}
+
+type partnerReceiver struct {
+	url string
+
+	mu       sync.Mutex
+	attempts int
+	events   []domain.Event
+	received chan domain.Event
+}
+
+func newPartnerReceiver(t *testing.T) *partnerReceiver {
+	t.Helper()
+
+	receiver := &partnerReceiver{received: make(chan domain.Event, 10)}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receiver.mu.Lock()
+		defer receiver.mu.Unlock()
+
+		receiver.attempts++
+		if receiver.attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil || r.Header.Get(services.WebhookSignatureHeader) != services.SignWebhookPayload(partnerSecret, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var event domain.Event
+		if err := json.Unmarshal(body, &event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		receiver.events = append(receiver.events, event)
+		receiver.received <- event
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+	receiver.url = server.URL
+
+	return receiver
+}
+
+func (r *partnerReceiver) waitForEvent(t *testing.T) domain.Event {
+	t.Helper()
+
+	select {
+	case event := <-r.received:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected event")
+		return domain.Event{}
+	}
+}
+
+func (r *partnerReceiver) eventsCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.events)
+}
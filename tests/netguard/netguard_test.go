@@ -0,0 +1,60 @@
+package netguard_test
+
+import (
+	"avito/pkg/netguard"
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		address string
+		public  bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"224.0.0.1", false},
+		// ipv4 address written as ipv6 one is checked as ipv4
+		{"::ffff:127.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			// Act
+			public := netguard.IsPublic(netip.MustParseAddr(tt.address))
+
+			// Assert
+			require.Equal(t, tt.public, public)
+		})
+	}
+}
+
+func TestEnsurePublicHost_ShouldRefuseNameOfLoopback(t *testing.T) {
+	// Act
+	err := netguard.EnsurePublicHost(context.Background(), "localhost")
+
+	// Assert
+	require.EqualError(t, err, netguard.NotPublicAddressError)
+}
+
+func TestControl_ShouldRefuseToConnectToPrivateAddress(t *testing.T) {
+	// Act
+	private := netguard.Control("tcp", "10.0.0.1:443", nil)
+	public := netguard.Control("tcp", "93.184.216.34:443", nil)
+
+	// Assert
+	require.EqualError(t, private, netguard.NotPublicAddressError)
+	require.NoError(t, public)
+}
@@ -0,0 +1,170 @@
+package oidc_test
+
+import (
+	"avito/pkg/oidc"
+	"avito/tests/mockidp"
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	clientID     string = "pvz"
+	clientSecret string = "pvz-secret"
+	redirectURL  string = "http://pvz.test/sso/callback"
+	codeVerifier string = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+)
+
+func TestCodeChallenge_ShouldMatchRFCVector(t *testing.T) {
+	// Act
+	challenge := oidc.CodeChallenge(codeVerifier)
+
+	// Assert, example of RFC 7636 appendix B
+	require.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", challenge)
+}
+
+func TestAuthCodeURL_ShouldAskForCodeWithPKCE(t *testing.T) {
+	// Arrange
+	idp := startIdP(t, clientSecret)
+	provider := newProvider(idp, clientSecret)
+
+	// Act
+	authURL, err := provider.AuthCodeURL(context.Background(), "state", "nonce", codeVerifier)
+
+	// Assert
+	require.NoError(t, err)
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	require.Equal(t, idp.Issuer()+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	query := parsed.Query()
+	require.Equal(t, "code", query.Get("response_type"))
+	require.Equal(t, clientID, query.Get("client_id"))
+	require.Equal(t, redirectURL, query.Get("redirect_uri"))
+	require.Equal(t, "openid email groups", query.Get("scope"))
+	require.Equal(t, "state", query.Get("state"))
+	require.Equal(t, "nonce", query.Get("nonce"))
+	require.Equal(t, oidc.CodeChallenge(codeVerifier), query.Get("code_challenge"))
+	require.Equal(t, "S256", query.Get("code_challenge_method"))
+}
+
+func TestExchange_ShouldReturnVerifiedIDToken(t *testing.T) {
+	for _, secret := range []string{clientSecret, ""} {
+		t.Run("secret "+secret, func(t *testing.T) {
+			// Arrange
+			idp := startIdP(t, secret)
+			idp.SetUser(mockidp.User{Subject: "staff-1", Email: "staff@example.com", EmailVerified: true, Groups: []string{"pvz-staff", "everyone"}})
+			provider := newProvider(idp, secret)
+			code := authorize(t, provider, "nonce", codeVerifier)
+
+			// Act
+			token, err := provider.Exchange(context.Background(), code, codeVerifier, "nonce")
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, "staff-1", token.Subject)
+			require.Equal(t, "staff@example.com", token.Email)
+			require.True(t, token.EmailVerified)
+			require.Equal(t, []string{"pvz-staff", "everyone"}, token.Strings("groups"))
+			require.Empty(t, token.Strings("roles"))
+		})
+	}
+}
+
+func TestExchange_ShouldReturnError_WhenNonceDoesNotMatch(t *testing.T) {
+	// Arrange
+	idp := startIdP(t, clientSecret)
+	provider := newProvider(idp, clientSecret)
+	code := authorize(t, provider, "nonce", codeVerifier)
+
+	// Act
+	_, err := provider.Exchange(context.Background(), code, codeVerifier, "other-nonce")
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestExchange_ShouldReturnError_WhenCodeVerifierDoesNotMatch(t *testing.T) {
+	// Arrange
+	idp := startIdP(t, clientSecret)
+	provider := newProvider(idp, clientSecret)
+	code := authorize(t, provider, "nonce", codeVerifier)
+
+	// Act
+	_, err := provider.Exchange(context.Background(), code, "another-verifier-of-enough-length-0123456789", "nonce")
+
+	// Assert
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid_grant")
+}
+
+func TestExchange_ShouldReturnError_WhenCodeIsUsedTwice(t *testing.T) {
+	// Arrange
+	idp := startIdP(t, clientSecret)
+	provider := newProvider(idp, clientSecret)
+	code := authorize(t, provider, "nonce", codeVerifier)
+	_, err := provider.Exchange(context.Background(), code, codeVerifier, "nonce")
+	require.NoError(t, err)
+
+	// Act
+	_, err = provider.Exchange(context.Background(), code, codeVerifier, "nonce")
+
+	// Assert
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid_grant")
+}
+
+func startIdP(t *testing.T, secret string) *mockidp.Provider {
+	t.Helper()
+
+	idp, err := mockidp.New(clientID, secret)
+	require.NoError(t, err)
+	t.Cleanup(idp.Close)
+	idp.SetUser(mockidp.User{Subject: "staff", Email: "staff@example.com", Groups: []string{"pvz-staff"}})
+
+	return idp
+}
+
+func newProvider(idp *mockidp.Provider, secret string) *oidc.Provider {
+	return oidc.NewProvider(oidc.Config{
+		Issuer:       idp.Issuer(),
+		ClientID:     clientID,
+		ClientSecret: secret,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"openid", "email", "groups"},
+	}, http.DefaultClient)
+}
+
+// authorize signs in at provider and returns code provider sent back
+func authorize(t *testing.T, provider *oidc.Provider, nonce string, codeVerifier string) string {
+	t.Helper()
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "state", nonce, codeVerifier)
+	require.NoError(t, err)
+
+	callback := follow(t, authURL)
+	require.Equal(t, "state", callback.Get("state"))
+	require.NotEmpty(t, callback.Get("code"))
+
+	return callback.Get("code")
+}
+
+// follow opens url without following redirect and returns query of redirect
+func follow(t *testing.T, target string) url.Values {
+	t.Helper()
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	response, err := client.Get(target)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusFound, response.StatusCode)
+
+	location, err := url.Parse(response.Header.Get("Location"))
+	require.NoError(t, err)
+
+	return location.Query()
+}
@@ -0,0 +1,124 @@
+package ratelimit_test
+
+import (
+	"avito/pkg/ratelimit"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiterAllow_ShouldRejectCallsOverLimit(t *testing.T) {
+	// Arrange
+	limiter := ratelimit.NewLimiter(2, time.Minute)
+
+	// Act
+	first := limiter.Allow("127.0.0.1")
+	second := limiter.Allow("127.0.0.1")
+	third := limiter.Allow("127.0.0.1")
+
+	// Assert
+	require.NoError(t, first)
+	require.NoError(t, second)
+	require.Error(t, third)
+	require.Equal(t, ratelimit.TooManyRequestsError, third.Error())
+
+	var limitErr *ratelimit.LimitExceededError
+	require.True(t, errors.As(third, &limitErr))
+	require.LessOrEqual(t, limitErr.RetryAfter, time.Minute)
+	require.Equal(t, 60, limitErr.RetryAfterSeconds())
+}
+
+func TestLimiterAllow_ShouldCountKeysSeparately(t *testing.T) {
+	// Arrange
+	limiter := ratelimit.NewLimiter(1, time.Minute)
+
+	// Act
+	first := limiter.Allow("127.0.0.1")
+	second := limiter.Allow("127.0.0.2")
+
+	// Assert
+	require.NoError(t, first)
+	require.NoError(t, second)
+}
+
+func TestLimiterAllow_ShouldAllowAgain_WhenWindowPassed(t *testing.T) {
+	// Arrange
+	limiter := ratelimit.NewLimiter(1, 50*time.Millisecond)
+	require.NoError(t, limiter.Allow("key"))
+	require.Error(t, limiter.Allow("key"))
+
+	// Act
+	time.Sleep(60 * time.Millisecond)
+	err := limiter.Allow("key")
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestLockout_ShouldLock_WhenThresholdReached(t *testing.T) {
+	// Arrange
+	lockout := ratelimit.NewLockout(3, time.Minute, time.Hour)
+
+	// Act
+	lockout.Fail("user@example.com")
+	lockout.Fail("user@example.com")
+	beforeThreshold := lockout.Check("user@example.com")
+	lockout.Fail("user@example.com")
+	afterThreshold := lockout.Check("user@example.com")
+
+	// Assert
+	require.NoError(t, beforeThreshold)
+	require.Error(t, afterThreshold)
+	require.NoError(t, lockout.Check("other@example.com"))
+}
+
+func TestLockout_ShouldDoubleLockDuration_OnEachLockout(t *testing.T) {
+	// Arrange
+	lockout := ratelimit.NewLockout(1, 20*time.Millisecond, time.Hour)
+	var limitErr *ratelimit.LimitExceededError
+
+	// Act
+	lockout.Fail("key")
+	firstErr := lockout.Check("key")
+	time.Sleep(25 * time.Millisecond)
+	lockout.Fail("key")
+	secondErr := lockout.Check("key")
+
+	// Assert
+	require.True(t, errors.As(firstErr, &limitErr))
+	require.LessOrEqual(t, limitErr.RetryAfter, 20*time.Millisecond)
+	require.True(t, errors.As(secondErr, &limitErr))
+	require.Greater(t, limitErr.RetryAfter, 20*time.Millisecond)
+	require.LessOrEqual(t, limitErr.RetryAfter, 40*time.Millisecond)
+}
+
+func TestLockout_ShouldNotExceedMaxDuration(t *testing.T) {
+	// Arrange
+	lockout := ratelimit.NewLockout(1, time.Minute, 90*time.Second)
+	var limitErr *ratelimit.LimitExceededError
+
+	// Act
+	for i := 0; i < 5; i++ {
+		lockout.Fail("key")
+	}
+	err := lockout.Check("key")
+
+	// Assert
+	require.True(t, errors.As(err, &limitErr))
+	require.LessOrEqual(t, limitErr.RetryAfter, 90*time.Second)
+}
+
+func TestLockout_ShouldUnlock_WhenReset(t *testing.T) {
+	// Arrange
+	lockout := ratelimit.NewLockout(1, time.Minute, time.Hour)
+	lockout.Fail("key")
+	require.Error(t, lockout.Check("key"))
+
+	// Act
+	lockout.Reset("key")
+
+	// Assert
+	require.NoError(t, lockout.Check("key"))
+}
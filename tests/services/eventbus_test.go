@@ -0,0 +1,84 @@
+package services_test
+
+import (
+	"avito/internal/domain"
+	"avito/internal/services"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventBus_Publish(t *testing.T) {
+	t.Run("Delivers events to every subscriber in order", func(t *testing.T) {
+		// Arrange
+		bus := services.NewEventBus()
+		first, unsubscribeFirst := bus.Subscribe(2)
+		defer unsubscribeFirst()
+		second, unsubscribeSecond := bus.Subscribe(2)
+		defer unsubscribeSecond()
+		opened, added := outboxEvent(0), outboxEvent(1)
+
+		// Act
+		bus.Publish(ctx, opened, added)
+
+		// Assert
+		for _, events := range []<-chan domain.Event{first, second} {
+			assert.Equal(t, opened.ID, (<-events).ID)
+			assert.Equal(t, added.ID, (<-events).ID)
+		}
+	})
+
+	t.Run("Drops subscriber that falls behind without blocking others", func(t *testing.T) {
+		// Arrange
+		bus := services.NewEventBus()
+		slow, unsubscribeSlow := bus.Subscribe(1)
+		defer unsubscribeSlow()
+		fast, unsubscribeFast := bus.Subscribe(2)
+		defer unsubscribeFast()
+		first, second := outboxEvent(0), outboxEvent(1)
+
+		// Act
+		bus.Publish(ctx, first, second)
+
+		// Assert
+		assert.Equal(t, first.ID, (<-slow).ID)
+		_, open := <-slow
+		assert.False(t, open)
+		assert.Equal(t, first.ID, (<-fast).ID)
+		assert.Equal(t, second.ID, (<-fast).ID)
+	})
+
+	t.Run("Stops delivering after unsubscribe", func(t *testing.T) {
+		// Arrange
+		bus := services.NewEventBus()
+		events, unsubscribe := bus.Subscribe(1)
+
+		// Act
+		unsubscribe()
+		bus.Publish(ctx, outboxEvent(0))
+
+		// Assert
+		_, open := <-events
+		assert.False(t, open)
+		require.NotPanics(t, unsubscribe)
+	})
+}
+
+func TestEventBus_Close_ShouldEndEverySubscription(t *testing.T) {
+	// Arrange
+	bus := services.NewEventBus()
+	existing, unsubscribe := bus.Subscribe(1)
+	defer unsubscribe()
+
+	// Act
+	bus.Close()
+	late, unsubscribeLate := bus.Subscribe(1)
+	defer unsubscribeLate()
+
+	// Assert
+	_, open := <-existing
+	assert.False(t, open)
+	_, open = <-late
+	assert.False(t, open)
+}
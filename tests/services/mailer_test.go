@@ -0,0 +1,167 @@
+package services_test
+
+import (
+	"avito/internal/domain"
+	"avito/internal/services"
+	"bytes"
+	"log"
+	"net"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var letter = domain.MailMessage{
+	To:      "user@example.com",
+	Subject: "Сброс пароля",
+	Body:    "token: secret-token",
+}
+
+func TestLogMailer_Send_ShouldWriteLetter(t *testing.T) {
+	// Arrange
+	var output bytes.Buffer
+	mailer := services.NewLogMailer(log.New(&output, "", 0))
+
+	// Act
+	err := mailer.Send(ctx, letter)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), string(letter.To))
+	assert.Contains(t, output.String(), letter.Subject)
+	assert.Contains(t, output.String(), letter.Body)
+}
+
+func TestFileMailer_Send_ShouldAppendLetters(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "mail.txt")
+	mailer := services.NewFileMailer(path, "noreply@example.com")
+	second := letter
+	second.Body = "token: other-token"
+
+	// Act
+	firstErr := mailer.Send(ctx, letter)
+	secondErr := mailer.Send(ctx, second)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "From: noreply@example.com")
+	assert.Contains(t, string(content), "To: user@example.com")
+	assert.Contains(t, string(content), letter.Body)
+	assert.Contains(t, string(content), second.Body)
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	t.Run("Delivers letter to smtp server", func(t *testing.T) {
+		// Arrange
+		server := newFakeSMTPServer(t, "250 OK")
+		mailer := services.NewSMTPMailer("127.0.0.1", server.port, "", "", "noreply@example.com", time.Second)
+
+		// Act
+		err := mailer.Send(ctx, letter)
+
+		// Assert
+		require.NoError(t, err)
+		received := <-server.received
+		assert.Equal(t, "<noreply@example.com>", received.from)
+		assert.Equal(t, "<user@example.com>", received.to)
+		assert.Contains(t, received.data, "Subject: =?utf-8?q?")
+		assert.Contains(t, received.data, letter.Body)
+	})
+
+	t.Run("Returns error when server rejects letter", func(t *testing.T) {
+		// Arrange
+		server := newFakeSMTPServer(t, "554 rejected")
+		mailer := services.NewSMTPMailer("127.0.0.1", server.port, "", "", "noreply@example.com", time.Second)
+
+		// Act
+		err := mailer.Send(ctx, letter)
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "rejected")
+	})
+}
+
+type (
+	fakeSMTPServer struct {
+		port     int
+		received chan receivedMail
+	}
+
+	receivedMail struct {
+		from, to, data string
+	}
+)
+
+// newFakeSMTPServer accepts one session without extensions and answers end of data with dataReply
+func newFakeSMTPServer(t *testing.T, dataReply string) fakeSMTPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	server := fakeSMTPServer{
+		port:     listener.Addr().(*net.TCPAddr).Port,
+		received: make(chan receivedMail, 1),
+	}
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		text := textproto.NewConn(conn)
+		var mail receivedMail
+		text.PrintfLine("220 fake smtp")
+		for {
+			line, err := text.ReadLine()
+			if err != nil {
+				return
+			}
+
+			command := strings.ToUpper(line)
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				text.PrintfLine("250 fake")
+			case strings.HasPrefix(command, "MAIL FROM:"):
+				mail.from = line[len("MAIL FROM:"):]
+				text.PrintfLine("250 OK")
+			case strings.HasPrefix(command, "RCPT TO:"):
+				mail.to = line[len("RCPT TO:"):]
+				text.PrintfLine("250 OK")
+			case command == "DATA":
+				text.PrintfLine("354 go ahead")
+				data, err := text.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				mail.data = string(data)
+				text.PrintfLine("%s", dataReply)
+				if strings.HasPrefix(dataReply, "250") {
+					server.received <- mail
+				}
+			case command == "QUIT":
+				text.PrintfLine("221 bye")
+				return
+			default:
+				text.PrintfLine("502 %s", strconv.Quote(line))
+			}
+		}
+	}()
+
+	return server
+}
@@ -0,0 +1,259 @@
+package services_test
+
+import (
+	"avito/internal/domain"
+	"avito/internal/services"
+	"avito/internal/storage/inmemory"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxRelay_RelayPending(t *testing.T) {
+	t.Run("Publishes events in order and marks them published", func(t *testing.T) {
+		// Arrange
+		outbox := inmemory.NewEventOutboxRepository(inmemory.NewStore())
+		first, second := outboxEvent(0), outboxEvent(1)
+		require.NoError(t, outbox.Add(ctx, second, first))
+		sink := &fakeEventSink{}
+		relay := services.NewOutboxRelay(outbox, sink, 10, time.Second)
+
+		// Act
+		relayed, err := relay.RelayPending(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, relayed)
+		assert.Equal(t, []domain.EventID{first.ID, second.ID}, sink.publishedIDs())
+		pending, err := outbox.FindUnpublished(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("Keeps failed event and the rest for the next relay", func(t *testing.T) {
+		// Arrange
+		outbox := inmemory.NewEventOutboxRepository(inmemory.NewStore())
+		first, second, third := outboxEvent(0), outboxEvent(1), outboxEvent(2)
+		require.NoError(t, outbox.Add(ctx, first, second, third))
+		sink := &fakeEventSink{failOn: map[domain.EventID]bool{second.ID: true}}
+		relay := services.NewOutboxRelay(outbox, sink, 10, time.Second)
+
+		// Act
+		relayed, err := relay.RelayPending(ctx)
+		delete(sink.failOn, second.ID)
+		retried, retryErr := relay.RelayPending(ctx)
+
+		// Assert
+		require.Error(t, err)
+		assert.Equal(t, 1, relayed)
+		require.NoError(t, retryErr)
+		assert.Equal(t, 2, retried)
+		assert.Equal(t, []domain.EventID{first.ID, second.ID, third.ID}, sink.publishedIDs())
+	})
+
+	t.Run("Publishes at most batch size events", func(t *testing.T) {
+		// Arrange
+		outbox := inmemory.NewEventOutboxRepository(inmemory.NewStore())
+		require.NoError(t, outbox.Add(ctx, outboxEvent(0), outboxEvent(1), outboxEvent(2)))
+		relay := services.NewOutboxRelay(outbox, &fakeEventSink{}, 2, time.Second)
+
+		// Act
+		relayed, err := relay.RelayPending(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, relayed)
+		pending, err := outbox.FindUnpublished(ctx, 10)
+		require.NoError(t, err)
+		assert.Len(t, pending, 1)
+	})
+}
+
+func TestOutboxRelay_Run_ShouldRelayUntilCancelled(t *testing.T) {
+	// Arrange
+	outbox := inmemory.NewEventOutboxRepository(inmemory.NewStore())
+	event := outboxEvent(0)
+	require.NoError(t, outbox.Add(ctx, event))
+	broker := services.NewLocalBroker()
+	received, unsubscribe := broker.Subscribe(1)
+	defer unsubscribe()
+	relay := services.NewOutboxRelay(outbox, broker, 10, 10*time.Millisecond)
+	runCtx, cancel := context.WithCancel(ctx)
+	stopped := make(chan struct{})
+
+	// Act
+	go func() {
+		relay.Run(runCtx)
+		close(stopped)
+	}()
+
+	// Assert
+	select {
+	case published := <-received:
+		assert.Equal(t, event.ID, published.ID)
+	case <-time.After(time.Second):
+		t.Fatal("event was not relayed")
+	}
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("relay did not stop")
+	}
+}
+
+func TestWebhookEventSink_Publish(t *testing.T) {
+	t.Run("Posts event as json", func(t *testing.T) {
+		// Arrange
+		var (
+			body    []byte
+			headers http.Header
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ = io.ReadAll(r.Body)
+			headers = r.Header
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+		event := outboxEvent(0)
+		sink := services.NewWebhookEventSink(server.URL, server.Client())
+
+		// Act
+		err := sink.Publish(ctx, event)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", headers.Get("Content-Type"))
+		assert.Equal(t, event.ID.String(), headers.Get("X-Event-Id"))
+		assert.Equal(t, event.Type, headers.Get("X-Event-Type"))
+		var posted domain.Event
+		require.NoError(t, json.Unmarshal(body, &posted))
+		assert.Equal(t, event.ID, posted.ID)
+		assert.JSONEq(t, string(event.Payload), string(posted.Payload))
+	})
+
+	t.Run("Fails when receiver does not accept event", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+		sink := services.NewWebhookEventSink(server.URL, server.Client())
+
+		// Act
+		err := sink.Publish(ctx, outboxEvent(0))
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestLogEventSink_Publish_ShouldWriteEvent(t *testing.T) {
+	// Arrange
+	var output bytes.Buffer
+	event := outboxEvent(0)
+	sink := services.NewLogEventSink(log.New(&output, "", 0))
+
+	// Act
+	err := sink.Publish(ctx, event)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), event.ID.String())
+	assert.Contains(t, output.String(), event.Type)
+}
+
+func TestLocalBroker_Publish(t *testing.T) {
+	t.Run("Delivers event to every subscriber", func(t *testing.T) {
+		// Arrange
+		broker := services.NewLocalBroker()
+		first, unsubscribeFirst := broker.Subscribe(1)
+		defer unsubscribeFirst()
+		second, unsubscribeSecond := broker.Subscribe(1)
+		defer unsubscribeSecond()
+		event := outboxEvent(0)
+
+		// Act
+		err := broker.Publish(ctx, event)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, event.ID, (<-first).ID)
+		assert.Equal(t, event.ID, (<-second).ID)
+	})
+
+	t.Run("Fails when subscriber is full", func(t *testing.T) {
+		// Arrange
+		broker := services.NewLocalBroker()
+		_, unsubscribe := broker.Subscribe(1)
+		defer unsubscribe()
+		require.NoError(t, broker.Publish(ctx, outboxEvent(0)))
+
+		// Act
+		err := broker.Publish(ctx, outboxEvent(1))
+
+		// Assert
+		require.Error(t, err)
+		assert.Equal(t, services.BrokerSubscriberIsFullError, err.Error())
+	})
+
+	t.Run("Stops delivering after unsubscribe", func(t *testing.T) {
+		// Arrange
+		broker := services.NewLocalBroker()
+		events, unsubscribe := broker.Subscribe(1)
+
+		// Act
+		unsubscribe()
+		err := broker.Publish(ctx, outboxEvent(0))
+
+		// Assert
+		require.NoError(t, err)
+		_, open := <-events
+		assert.False(t, open)
+	})
+}
+
+func outboxEvent(minutes int) domain.Event {
+	return domain.Event{
+		ID:            uuid.Must(uuid.NewV7()),
+		Type:          domain.ProductAddedEventType,
+		PVZID:         uuid.MustParse("0196587b-1ca2-7ee7-83ef-9c37c806c968"),
+		OccurredAtUTC: time.Date(2025, 4, 10, 9, minutes, 0, 0, time.UTC),
+		Payload:       json.RawMessage(`{"category":1}`),
+	}
+}
+
+type fakeEventSink struct {
+	failOn    map[domain.EventID]bool
+	published []domain.Event
+}
+
+func (s *fakeEventSink) Publish(ctx context.Context, event domain.Event) error {
+	if s.failOn[event.ID] {
+		return errors.New("sink is unavailable")
+	}
+
+	s.published = append(s.published, event)
+
+	return nil
+}
+
+func (s *fakeEventSink) publishedIDs() []domain.EventID {
+	ids := make([]domain.EventID, 0, len(s.published))
+	for _, event := range s.published {
+		ids = append(ids, event.ID)
+	}
+
+	return ids
+}
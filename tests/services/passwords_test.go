@@ -0,0 +1,31 @@
+package services_test
+
+import (
+	"avito/internal/services"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBreachedPasswords_ShouldSkipCommentsAndEmptyLines(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# top passwords\n123456\r\n\npassword\nqwerty\n"), 0o644))
+
+	// Act
+	passwords, err := services.ReadBreachedPasswords(path)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{"123456", "password", "qwerty"}, passwords)
+}
+
+func TestReadBreachedPasswords_ShouldReturnError_WhenFileIsMissing(t *testing.T) {
+	// Act
+	_, err := services.ReadBreachedPasswords(filepath.Join(t.TempDir(), "missing.txt"))
+
+	// Assert
+	require.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package services_test
+
+import (
+	"avito/internal/domain"
+	"avito/internal/services"
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPDFReceptionActRenderer_ShouldRenderPDF(t *testing.T) {
+	// Arrange
+	renderer := services.NewPDFReceptionActRenderer()
+	content := actContent(t, 3)
+
+	// Act
+	document, err := renderer.Render(content)
+
+	// Assert
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(document, []byte("%PDF-")), "document should be pdf")
+	require.True(t, bytes.Contains(document, []byte("D:20250410120000")), "creation date should be taken from content")
+}
+
+func TestPDFReceptionActRenderer_ShouldBeDeterministic(t *testing.T) {
+	// Arrange
+	renderer := services.NewPDFReceptionActRenderer()
+	content := actContent(t, 50)
+
+	// Act
+	first, firstErr := renderer.Render(content)
+	second, secondErr := renderer.Render(content)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	require.Equal(t, first, second)
+}
+
+func TestPDFReceptionActRenderer_ShouldRenderDifferentDocuments_WhenContentDiffers(t *testing.T) {
+	// Arrange
+	renderer := services.NewPDFReceptionActRenderer()
+	content := actContent(t, 3)
+	withoutProduct := content
+	withoutProduct.Products = content.Products[:2]
+
+	// Act
+	first, firstErr := renderer.Render(content)
+	second, secondErr := renderer.Render(withoutProduct)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	require.NotEqual(t, first, second)
+}
+
+func TestPDFReceptionActRenderer_ShouldRenderEmptyReception(t *testing.T) {
+	// Arrange
+	renderer := services.NewPDFReceptionActRenderer()
+	content := actContent(t, 0)
+
+	// Act
+	document, err := renderer.Render(content)
+
+	// Assert
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(document, []byte("%PDF-")))
+}
+
+// fixed ids and timestamps, so rendering does not depend on the moment test is run
+func actContent(t *testing.T, productsCount int) domain.ReceptionActContent {
+	t.Helper()
+
+	openedAt := time.Date(2025, 4, 10, 9, 0, 0, 0, time.UTC)
+	reception := domain.ReceptionInfo{
+		ID:              uuid.MustParse("0196587b-1ca2-7ee7-83ef-9c37c806c968"),
+		PVZID:           uuid.MustParse("0196587b-1ca2-7ee7-83ef-9c37c806c969"),
+		CreationTimeUTC: openedAt,
+		Status:          domain.CloseProductAcceptanceStatus,
+	}
+	pvz := domain.PVZ{
+		ID:              reception.PVZID,
+		CreationTimeUTC: openedAt.Add(-24 * time.Hour),
+		City:            domain.City{ID: domain.MoscowCityID, Name: "Москва"},
+	}
+
+	categories := []domain.ProductCategory{domain.ElectronicsProductCategory, domain.ClothesProductCategory, domain.ShoesProductCategory}
+	products := make([]*domain.Product, productsCount)
+	for i := range products {
+		products[i] = &domain.Product{
+			ID:              uuid.MustParse(fmt.Sprintf("0196587b-1ca2-7ee7-83ef-%012d", i)),
+			ReceptionID:     reception.ID,
+			CreationTimeUTC: openedAt.Add(time.Duration(i) * time.Minute),
+			Category:        categories[i%len(categories)],
+		}
+		if i%2 == 0 {
+			products[i].Barcode = &domain.Barcode{Value: fmt.Sprintf("12345678-%d", i), Format: domain.OrderNumberBarcodeFormat}
+		}
+	}
+
+	content, err := domain.NewReceptionActContent(pvz, reception, products, time.Date(2025, 4, 10, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	return content
+}
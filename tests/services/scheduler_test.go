@@ -0,0 +1,57 @@
+package services_test
+
+import (
+	"avito/internal/services"
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_Run(t *testing.T) {
+	t.Run("Runs job right away and keeps running it after failure", func(t *testing.T) {
+		// Arrange
+		var runs atomic.Int32
+		scheduler := services.NewScheduler("test job", time.Millisecond, func(ctx context.Context) error {
+			runs.Add(1)
+			return errors.New("job failed")
+		})
+		runCtx, cancel := context.WithCancel(ctx)
+		stopped := make(chan struct{})
+
+		// Act
+		go func() {
+			scheduler.Run(runCtx)
+			close(stopped)
+		}()
+
+		// Assert
+		require.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, time.Millisecond)
+		cancel()
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			t.Fatal("scheduler did not stop after cancel")
+		}
+	})
+
+	t.Run("Does not run job again after cancel", func(t *testing.T) {
+		// Arrange
+		var runs atomic.Int32
+		scheduler := services.NewScheduler("test job", time.Hour, func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		})
+		runCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		// Act
+		scheduler.Run(runCtx)
+
+		// Assert
+		require.Equal(t, int32(1), runs.Load())
+	})
+}
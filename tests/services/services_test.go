@@ -3,7 +3,9 @@ package services_test
 import (
 	"avito/internal/domain"
 	"avito/internal/services"
+	"avito/internal/storage/inmemory"
 	jwt "avito/pkg/authorization"
+	"avito/pkg/totp"
 	"context"
 	"errors"
 	"testing"
@@ -22,7 +24,7 @@ func TestAuthorizationService_SignIn(t *testing.T) {
 		name        string
 		email       domain.Email
 		password    string
-		userSetup   func(repo FakeUserRepository) domain.User
+		userSetup   func(repo domain.UserRepository) domain.User
 		expectToken bool
 		expectErr   string
 	}{
@@ -30,7 +32,7 @@ func TestAuthorizationService_SignIn(t *testing.T) {
 			name:     "Success",
 			email:    "test@example.com",
 			password: "password123",
-			userSetup: func(repo FakeUserRepository) domain.User {
+			userSetup: func(repo domain.UserRepository) domain.User {
 				hash := hash("password123")
 				user, _ := domain.NewUser("test@example.com", hash)
 				repo.Add(ctx, user)
@@ -43,7 +45,7 @@ func TestAuthorizationService_SignIn(t *testing.T) {
 			name:     "User not found",
 			email:    "unknown@example.com",
 			password: "password123",
-			userSetup: func(repo FakeUserRepository) domain.User {
+			userSetup: func(repo domain.UserRepository) domain.User {
 				return domain.User{}
 			},
 			expectToken: false,
@@ -53,7 +55,7 @@ func TestAuthorizationService_SignIn(t *testing.T) {
 			name:     "Wrong password",
 			email:    "test@example.com",
 			password: "wrongpassword",
-			userSetup: func(repo FakeUserRepository) domain.User {
+			userSetup: func(repo domain.UserRepository) domain.User {
 				hash := hash("password123")
 				user, _ := domain.NewUser("test@example.com", hash)
 				repo.Add(ctx, user)
@@ -62,14 +64,29 @@ func TestAuthorizationService_SignIn(t *testing.T) {
 			expectToken: false,
 			expectErr:   domain.BadUserCredentialError,
 		},
+		{
+			name:     "Deactivated user",
+			email:    "test@example.com",
+			password: "password123",
+			userSetup: func(repo domain.UserRepository) domain.User {
+				hash := hash("password123")
+				user, _ := domain.NewUser("test@example.com", hash)
+				user.Deactivated = true
+				repo.Add(ctx, user)
+				return user
+			},
+			expectToken: false,
+			expectErr:   domain.UserIsDeactivatedError,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange
-			repo := NewFakeUserRepository()
+			store := inmemory.NewStore()
+			repo := inmemory.NewUserRepository(store)
 			jwtManager := jwtManager
-			svc := services.NewAuthorizationService(jwtManager, repo)
+			svc := services.NewAuthorizationService(jwtManager, repo, inmemory.NewAPIKeyRepository(store), domain.PasswordPolicy{}, argon2id.DefaultParams)
 			ctx := context.Background()
 			user := tt.userSetup(repo)
 
@@ -98,16 +115,16 @@ func TestAuthorizationService_SignUp(t *testing.T) {
 		name      string
 		email     domain.Email
 		password  string
-		role      domain.UserRoleID
-		userSetup func(repo FakeUserRepository)
+		role      domain.UserRole
+		userSetup func(repo domain.UserRepository)
 		expectErr bool
 	}{
 		{
 			name:     "Success - Regular user",
 			email:    "new@example.com",
 			password: "password123",
-			role:     domain.ClientUserRoleID,
-			userSetup: func(repo FakeUserRepository) {
+			role:     domain.EmployeeRole(),
+			userSetup: func(repo domain.UserRepository) {
 			},
 			expectErr: false,
 		},
@@ -115,8 +132,8 @@ func TestAuthorizationService_SignUp(t *testing.T) {
 			name:     "Success - Moderator",
 			email:    "mod@example.com",
 			password: "password123",
-			role:     domain.ModeratorUserRoleID,
-			userSetup: func(repo FakeUserRepository) {
+			role:     domain.ModeratorRole(),
+			userSetup: func(repo domain.UserRepository) {
 			},
 			expectErr: false,
 		},
@@ -124,21 +141,22 @@ func TestAuthorizationService_SignUp(t *testing.T) {
 			name:     "Email already exists",
 			email:    "existing@example.com",
 			password: "password123",
-			role:     domain.ClientUserRoleID,
-			userSetup: func(repo FakeUserRepository) {
+			role:     domain.EmployeeRole(),
+			userSetup: func(repo domain.UserRepository) {
 				hash := hash("password123")
 				user, _ := domain.NewUser("existing@example.com", hash)
 				repo.Add(ctx, user)
 			},
-			expectErr: false,
+			expectErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange
-			repo := NewFakeUserRepository()
-			svc := services.NewAuthorizationService(jwtManager, repo)
+			store := inmemory.NewStore()
+			repo := inmemory.NewUserRepository(store)
+			svc := services.NewAuthorizationService(jwtManager, repo, inmemory.NewAPIKeyRepository(store), domain.PasswordPolicy{}, argon2id.DefaultParams)
 			ctx := context.Background()
 
 			// Setup user
@@ -155,11 +173,7 @@ func TestAuthorizationService_SignUp(t *testing.T) {
 				assert.NoError(t, err)
 				assert.NotNil(t, user)
 				assert.Equal(t, tt.email, user.Email)
-				if tt.role == domain.ModeratorUserRoleID {
-					assert.Equal(t, domain.ModeratorUserRoleID, user.UserRole.ID)
-				} else {
-					assert.NotEqual(t, domain.ModeratorUserRoleID, user.UserRole.ID)
-				}
+				assert.Equal(t, tt.role, user.UserRole)
 				match := compare(tt.password, user.Password)
 				assert.True(t, match)
 				stored, _ := repo.FindByEmail(ctx, tt.email)
@@ -169,17 +183,82 @@ func TestAuthorizationService_SignUp(t *testing.T) {
 	}
 }
 
+func TestAuthorizationService_SignUp_ShouldRefusePasswordAgainstPolicy(t *testing.T) {
+	// Arrange
+	store := inmemory.NewStore()
+	repo := inmemory.NewUserRepository(store)
+	policy := domain.NewPasswordPolicy(8, 2, []string{"password123"})
+	svc := services.NewAuthorizationService(jwtManager, repo, inmemory.NewAPIKeyRepository(store), policy, argon2id.DefaultParams)
+
+	// Act
+	_, shortErr := svc.SignUp(ctx, "short@example.com", "pass1", domain.EmployeeRole())
+	_, breachedErr := svc.SignUp(ctx, "breached@example.com", "Password123", domain.EmployeeRole())
+
+	// Assert
+	assert.EqualError(t, shortErr, domain.PasswordIsTooShortError)
+	assert.EqualError(t, breachedErr, domain.PasswordIsBreachedError)
+	_, findErr := repo.FindByEmail(ctx, "breached@example.com")
+	assert.EqualError(t, findErr, domain.UserDoesNotExistsError)
+}
+
+func TestAuthorizationService_SignIn_ShouldRehashPasswordOfWeakerHash(t *testing.T) {
+	weak := &argon2id.Params{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+	strong := &argon2id.Params{Memory: 2048, Iterations: 2, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+	// password chosen before policy is kept, only its hash changes
+	policy := domain.NewPasswordPolicy(20, 3, nil)
+
+	t.Run("Replaces weaker hash", func(t *testing.T) {
+		// Arrange
+		store := inmemory.NewStore()
+		repo := inmemory.NewUserRepository(store)
+		svc := services.NewAuthorizationService(jwtManager, repo, inmemory.NewAPIKeyRepository(store), policy, strong)
+		weakHash, _ := argon2id.CreateHash("password123", weak)
+		user, _ := domain.NewUser("test@example.com", weakHash)
+		repo.Add(ctx, user)
+
+		// Act
+		token, err := svc.SignIn(ctx, user.Email, "password123")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotEmpty(t, token)
+		stored, _ := repo.FindByID(ctx, user.ID)
+		match, params, err := argon2id.CheckHash("password123", stored.Password)
+		assert.NoError(t, err)
+		assert.True(t, match)
+		assert.Equal(t, strong, params)
+	})
+
+	t.Run("Keeps hash made with configured parameters", func(t *testing.T) {
+		// Arrange
+		store := inmemory.NewStore()
+		repo := inmemory.NewUserRepository(store)
+		svc := services.NewAuthorizationService(jwtManager, repo, inmemory.NewAPIKeyRepository(store), policy, weak)
+		weakHash, _ := argon2id.CreateHash("password123", weak)
+		user, _ := domain.NewUser("test@example.com", weakHash)
+		repo.Add(ctx, user)
+
+		// Act
+		_, err := svc.SignIn(ctx, user.Email, "password123")
+
+		// Assert
+		assert.NoError(t, err)
+		stored, _ := repo.FindByID(ctx, user.ID)
+		assert.Equal(t, weakHash, stored.Password)
+	})
+}
+
 func TestAuthorizationService_UserFromCredentials(t *testing.T) {
 	tests := []struct {
 		name        string
 		credentials jwt.JWT
-		userSetup   func(repo FakeUserRepository) (domain.User, jwt.JWT)
+		userSetup   func(repo domain.UserRepository) (domain.User, jwt.JWT)
 		expectErr   string
 	}{
 		{
 			name:        "Success",
 			credentials: "",
-			userSetup: func(repo FakeUserRepository) (domain.User, jwt.JWT) {
+			userSetup: func(repo domain.UserRepository) (domain.User, jwt.JWT) {
 				user, _ := domain.NewUser("test@example.com", "hash")
 				repo.Add(context.Background(), user)
 				token, _ := jwtManager.GenerateToken(user.ID.String())
@@ -190,7 +269,7 @@ func TestAuthorizationService_UserFromCredentials(t *testing.T) {
 		{
 			name:        "Empty credentials",
 			credentials: "",
-			userSetup: func(repo FakeUserRepository) (domain.User, jwt.JWT) {
+			userSetup: func(repo domain.UserRepository) (domain.User, jwt.JWT) {
 				return domain.User{}, ""
 			},
 			expectErr: domain.InsufficientPrivilegesError,
@@ -198,7 +277,7 @@ func TestAuthorizationService_UserFromCredentials(t *testing.T) {
 		{
 			name:        "Invalid token",
 			credentials: "invalid-token",
-			userSetup: func(repo FakeUserRepository) (domain.User, jwt.JWT) {
+			userSetup: func(repo domain.UserRepository) (domain.User, jwt.JWT) {
 				return domain.User{}, ""
 			},
 			expectErr: domain.InsufficientPrivilegesError,
@@ -206,7 +285,7 @@ func TestAuthorizationService_UserFromCredentials(t *testing.T) {
 		{
 			name:        "User not found",
 			credentials: "",
-			userSetup: func(repo FakeUserRepository) (domain.User, jwt.JWT) {
+			userSetup: func(repo domain.UserRepository) (domain.User, jwt.JWT) {
 				user, _ := domain.NewUser("test@example.com", "hash")
 				token, _ := jwtManager.GenerateToken(user.ID.String())
 
@@ -214,13 +293,27 @@ func TestAuthorizationService_UserFromCredentials(t *testing.T) {
 			},
 			expectErr: domain.InsufficientPrivilegesError,
 		},
+		{
+			name:        "Deactivated user",
+			credentials: "",
+			userSetup: func(repo domain.UserRepository) (domain.User, jwt.JWT) {
+				user, _ := domain.NewUser("test@example.com", "hash")
+				user.Deactivated = true
+				repo.Add(context.Background(), user)
+				token, _ := jwtManager.GenerateToken(user.ID.String())
+
+				return user, token
+			},
+			expectErr: domain.UserIsDeactivatedError,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange
-			repo := NewFakeUserRepository()
-			svc := services.NewAuthorizationService(jwtManager, repo)
+			store := inmemory.NewStore()
+			repo := inmemory.NewUserRepository(store)
+			svc := services.NewAuthorizationService(jwtManager, repo, inmemory.NewAPIKeyRepository(store), domain.PasswordPolicy{}, argon2id.DefaultParams)
 			ctx := context.Background()
 
 			// Setup user and token
@@ -247,51 +340,134 @@ func TestAuthorizationService_UserFromCredentials(t *testing.T) {
 	}
 }
 
-func hash(value string) string {
-	hash, _ := argon2id.CreateHash("password123", argon2id.DefaultParams)
+func TestAuthorizationService_UserFromCredentials_ShouldReturnError_WhenUserCouldNotBeLoaded(t *testing.T) {
+	// Arrange
+	store := inmemory.NewStore()
+	repo := failingUserRepository{UserRepository: inmemory.NewUserRepository(store), err: errors.New("connection refused")}
+	svc := services.NewAuthorizationService(jwtManager, repo, inmemory.NewAPIKeyRepository(store), domain.PasswordPolicy{}, argon2id.DefaultParams)
+	user, _ := domain.NewUser("test@example.com", "hash")
+	token, _ := jwtManager.GenerateToken(user.ID.String())
 
-	return hash
+	// Act
+	result, err := svc.UserFromCredentials(ctx, token)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, "connection refused", err.Error())
+	assert.Nil(t, result)
 }
 
-func compare(value, hash string) bool {
-	match, _ := argon2id.ComparePasswordAndHash(value, hash)
-	return match
+// failingUserRepository fails to find users by id the way unavailable database does
+type failingUserRepository struct {
+	domain.UserRepository
+	err error
 }
 
-type FakeUserRepository struct {
-	emailMap map[domain.Email]*domain.User
-	idMap    map[domain.UserID]*domain.User
+func (r failingUserRepository) FindByID(ctx context.Context, id domain.UserID) (domain.User, error) {
+	return domain.User{}, r.err
 }
 
-func (f FakeUserRepository) Add(ctx context.Context, user domain.User) error {
-	ref := &user
-	f.idMap[user.ID] = ref
-	f.emailMap[user.Email] = ref
+func TestAuthorizationService_SetPassword_ShouldReplacePasswordHash(t *testing.T) {
+	// Arrange
+	store := inmemory.NewStore()
+	repo := inmemory.NewUserRepository(store)
+	svc := services.NewAuthorizationService(jwtManager, repo, inmemory.NewAPIKeyRepository(store), domain.PasswordPolicy{}, argon2id.DefaultParams)
+	user, _ := domain.NewUser("test@example.com", hash("password123"))
+	oldHash := user.Password
+
+	// Act
+	err := svc.SetPassword(&user, "new-password")
 
-	return nil
+	// Assert
+	assert.NoError(t, err)
+	assert.NotEqual(t, oldHash, user.Password)
+	assert.NotContains(t, user.Password, "new-password")
+	assert.True(t, compare("new-password", user.Password))
+	assert.False(t, compare("password123", user.Password))
 }
 
-func (f FakeUserRepository) FindByEmail(ctx context.Context, email domain.Email) (domain.User, error) {
-	user, exists := f.emailMap[email]
-	if !exists {
-		return domain.User{}, errors.New(domain.UserDoesNotExistsError)
-	}
+func TestAuthorizationService_SignInSecondFactor(t *testing.T) {
+	// Arrange
+	store := inmemory.NewStore()
+	repo := inmemory.NewUserRepository(store)
+	svc := services.NewAuthorizationService(jwtManager, repo, inmemory.NewAPIKeyRepository(store), domain.PasswordPolicy{}, argon2id.DefaultParams)
+	user, _ := domain.NewUser("test@example.com", hash("password123"))
+	secret, _, err := svc.BeginTwoFactorEnrollment(&user)
+	assert.NoError(t, err)
+	// enrollment is confirmed by code of previous step, so code of current step is not spent yet
+	step := totp.Step(time.Now())
+	confirmCode, _ := totp.Code(secret, step-1)
+	recoveryCodes, err := svc.ConfirmTwoFactorEnrollment(&user, confirmCode)
+	assert.NoError(t, err)
+	repo.Add(ctx, user)
+	code, _ := totp.Code(secret, step)
 
-	return *user, nil
+	// Act
+	token, signInErr := svc.SignIn(ctx, user.Email, "password123")
+	var required *domain.TwoFactorRequiredError
+	assert.ErrorAs(t, signInErr, &required)
+	_, challengeAsCredentialsErr := svc.UserFromCredentials(ctx, jwt.JWT(required.Challenge))
+	_, badCodeErr := svc.SignInSecondFactor(ctx, required.Challenge, "000000000")
+	confirmed, confirmErr := svc.SignInSecondFactor(ctx, required.Challenge, code)
+	_, replayErr := svc.SignInSecondFactor(ctx, required.Challenge, code)
+	recovered, recoveryErr := svc.SignInSecondFactor(ctx, required.Challenge, recoveryCodes[0])
+	_, tokenAsChallengeErr := svc.SignInSecondFactor(ctx, string(confirmed), recoveryCodes[1])
+
+	// Assert
+	assert.Empty(t, token)
+	assert.EqualError(t, challengeAsCredentialsErr, domain.InsufficientPrivilegesError)
+	assert.EqualError(t, badCodeErr, domain.BadTwoFactorCodeError)
+	assert.NoError(t, confirmErr)
+	claims, _ := jwtManager.ExtractClaimsFrom(confirmed)
+	assert.Equal(t, user.ID.String(), claims.UserID)
+	assert.EqualError(t, replayErr, domain.BadTwoFactorCodeError)
+	assert.NoError(t, recoveryErr)
+	assert.NotEmpty(t, recovered)
+	assert.EqualError(t, tokenAsChallengeErr, domain.BadUserCredentialError)
+	stored, _ := repo.FindByID(ctx, user.ID)
+	assert.Len(t, stored.TwoFactor.RecoveryCodeHashes, domain.RecoveryCodesCount-1)
 }
 
-func (f FakeUserRepository) FindByID(ctx context.Context, id domain.UserID) (domain.User, error) {
-	user, exists := f.idMap[id]
-	if !exists {
-		return domain.User{}, errors.New(domain.UserDoesNotExistsError)
-	}
+func hash(value string) string {
+	hash, _ := argon2id.CreateHash("password123", argon2id.DefaultParams)
 
-	return *user, nil
+	return hash
 }
 
-func NewFakeUserRepository() FakeUserRepository {
-	return FakeUserRepository{
-		emailMap: make(map[domain.Email]*domain.User),
-		idMap:    make(map[domain.UserID]*domain.User),
-	}
+func compare(value, hash string) bool {
+	match, _ := argon2id.ComparePasswordAndHash(value, hash)
+	return match
+}
+
+func TestAuthorizationService_UserFromCredentials_ShouldAcceptAPIKey(t *testing.T) {
+	// Arrange
+	// api keys refer to stored service accounts
+	store := inmemory.NewStore()
+	repo := inmemory.NewUserRepository(store)
+	apiKeys := inmemory.NewAPIKeyRepository(store)
+	svc := services.NewAuthorizationService(jwtManager, repo, apiKeys, domain.PasswordPolicy{}, argon2id.DefaultParams)
+	moderator, _ := domain.NewUser("moderator@example.com", hash("password123"))
+	moderator.UserRole = domain.ModeratorRole()
+	account, _ := domain.NewServiceAccount(moderator, "reporting@example.com", domain.ModeratorRole())
+	repo.Add(ctx, account)
+	key, secret, _ := domain.NewAPIKey(moderator, account, "reporting", []domain.Permission{domain.PVZReadPermission})
+	apiKeys.Add(ctx, key)
+	revoked, revokedSecret, _ := domain.NewAPIKey(moderator, account, "old reporting", []domain.Permission{domain.PVZReadPermission})
+	revoked.Revoke(time.Now())
+	apiKeys.Add(ctx, revoked)
+
+	// Act
+	user, err := svc.UserFromCredentials(ctx, jwt.JWT(secret))
+	used, _ := apiKeys.FindByID(ctx, key.ID)
+	_, revokedErr := svc.UserFromCredentials(ctx, jwt.JWT(revokedSecret))
+	_, unknownErr := svc.UserFromCredentials(ctx, jwt.JWT(domain.APIKeyPrefix+"unknown"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, account.ID, user.ID)
+	assert.True(t, user.HasPermission(domain.PVZReadPermission))
+	assert.False(t, user.HasPermission(domain.PVZCreatePermission), "permissions outside of scope should be dropped")
+	assert.NotNil(t, used.LastUsedAtUTC)
+	assert.EqualError(t, revokedErr, domain.InsufficientPrivilegesError)
+	assert.EqualError(t, unknownErr, domain.InsufficientPrivilegesError)
 }
@@ -0,0 +1,245 @@
+package services_test
+
+import (
+	"avito/internal/domain"
+	"avito/internal/services"
+	"avito/internal/storage/inmemory"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDispatcher_Publish(t *testing.T) {
+	t.Run("Posts event signed with subscription secret", func(t *testing.T) {
+		// Arrange
+		var (
+			body    []byte
+			headers http.Header
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ = io.ReadAll(r.Body)
+			headers = r.Header
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+		env := newDispatcherEnv(t, server, 3)
+		subscription := env.subscribe(t, server.URL, nil)
+		event := env.event()
+
+		// Act
+		err := env.dispatcher.Deliver(ctx, event)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, services.SignWebhookPayload(subscription.Secret, body), headers.Get(services.WebhookSignatureHeader))
+		assert.Equal(t, subscription.ID.String(), headers.Get(services.WebhookSubscriptionIDHeader))
+		assert.Equal(t, event.ID.String(), headers.Get("X-Event-Id"))
+		attempts := env.attemptsOf(t, subscription)
+		require.Len(t, attempts, 1)
+		assert.True(t, attempts[0].Delivered)
+		assert.Equal(t, http.StatusNoContent, attempts[0].StatusCode)
+	})
+
+	t.Run("Retries failed delivery and records every try", func(t *testing.T) {
+		// Arrange
+		var (
+			mu    sync.Mutex
+			tries int
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			tries++
+			if tries < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		env := newDispatcherEnv(t, server, 3)
+		subscription := env.subscribe(t, server.URL, nil)
+
+		// Act
+		err := env.dispatcher.Deliver(ctx, env.event())
+
+		// Assert
+		require.NoError(t, err)
+		attempts := env.attemptsOf(t, subscription)
+		require.Len(t, attempts, 3)
+		assert.True(t, attempts[0].Delivered)
+		assert.Equal(t, 3, attempts[0].Attempt)
+		assert.False(t, attempts[2].Delivered)
+		assert.Equal(t, http.StatusServiceUnavailable, attempts[2].StatusCode)
+		assert.NotEmpty(t, attempts[2].Error)
+	})
+
+	t.Run("Gives up after all tries without failing relay", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		env := newDispatcherEnv(t, server, 2)
+		subscription := env.subscribe(t, server.URL, nil)
+
+		// Act
+		err := env.dispatcher.Deliver(ctx, env.event())
+
+		// Assert
+		require.NoError(t, err)
+		attempts := env.attemptsOf(t, subscription)
+		require.Len(t, attempts, 2)
+		for _, attempt := range attempts {
+			assert.False(t, attempt.Delivered)
+		}
+	})
+
+	t.Run("Skips subscriptions of other pvz", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		env := newDispatcherEnv(t, server, 1)
+		otherPVZID := uuid.Must(uuid.NewV7())
+		other := env.subscribe(t, server.URL, &otherPVZID)
+		own := env.subscribe(t, server.URL, &env.pvz.ID)
+
+		// Act
+		err := env.dispatcher.Deliver(ctx, env.event())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, env.attemptsOf(t, other))
+		assert.Len(t, env.attemptsOf(t, own), 1)
+	})
+}
+
+func TestWebhookDispatcher_Publish_ShouldNotWaitForSubscriber(t *testing.T) {
+	// Arrange
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	env := newDispatcherEnv(t, server, 1)
+	subscription := env.subscribe(t, server.URL, nil)
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	go env.dispatcher.Run(runCtx)
+
+	// Act
+	err := env.dispatcher.Publish(ctx, env.event())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, env.attemptsOf(t, subscription))
+	close(release)
+	require.Eventually(t, func() bool {
+		return len(env.attemptsOf(t, subscription)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWebhookDispatcher_Run_ShouldNotHoldSubscribers_BehindSlowOne(t *testing.T) {
+	// Arrange
+	release := make(chan struct{})
+	slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer slowServer.Close()
+	defer close(release)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	env := newDispatcherEnv(t, server, 1)
+	slow := env.subscribe(t, slowServer.URL, nil)
+	fast := env.subscribe(t, server.URL, nil)
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	go env.dispatcher.Run(runCtx)
+
+	// Act
+	for range 3 {
+		require.NoError(t, env.dispatcher.Publish(ctx, env.event()))
+	}
+
+	// Assert
+	require.Eventually(t, func() bool {
+		return len(env.attemptsOf(t, fast)) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, env.attemptsOf(t, slow))
+}
+
+func TestSignWebhookPayload_ShouldReturnHexHMAC(t *testing.T) {
+	// Act
+	signature := services.SignWebhookPayload("key", []byte("The quick brown fox jumps over the lazy dog"))
+
+	// Assert
+	assert.Equal(t, "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", signature)
+}
+
+type dispatcherEnv struct {
+	dispatcher    *services.WebhookDispatcher
+	subscriptions domain.WebhookSubscriptionRepository
+	attempts      domain.WebhookDeliveryAttemptRepository
+	pvz           domain.PVZ
+}
+
+func newDispatcherEnv(t *testing.T, server *httptest.Server, tries int) dispatcherEnv {
+	t.Helper()
+
+	store := inmemory.NewStore()
+	pvzs := inmemory.NewPVZRepository(store)
+	city, err := domain.NewCity(domain.KazanCityID)
+	require.NoError(t, err)
+	pvz := domain.PVZ{ID: uuid.Must(uuid.NewV7()), CreationTimeUTC: time.Now().UTC(), City: city}
+	require.NoError(t, pvzs.Add(ctx, pvz))
+
+	env := dispatcherEnv{
+		subscriptions: inmemory.NewWebhookSubscriptionRepository(store),
+		attempts:      inmemory.NewWebhookDeliveryAttemptRepository(store),
+		pvz:           pvz,
+	}
+	env.dispatcher = services.NewWebhookDispatcher(env.subscriptions, env.attempts, pvzs, server.Client(), tries, time.Millisecond)
+
+	return env
+}
+
+func (e dispatcherEnv) subscribe(t *testing.T, url string, pvzID *domain.PVZID) domain.WebhookSubscription {
+	t.Helper()
+
+	subscription, err := domain.NewWebhookSubscription(url, []domain.EventType{domain.ProductAddedEventType}, pvzID, nil, "0123456789abcdef", uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+	require.NoError(t, e.subscriptions.Add(ctx, subscription))
+
+	return subscription
+}
+
+func (e dispatcherEnv) event() domain.Event {
+	event := outboxEvent(0)
+	event.PVZID = e.pvz.ID
+
+	return event
+}
+
+func (e dispatcherEnv) attemptsOf(t *testing.T, subscription domain.WebhookSubscription) []domain.WebhookDeliveryAttempt {
+	t.Helper()
+
+	attempts, err := e.attempts.FindAllBySubscriptionID(ctx, subscription.ID, 10)
+	require.NoError(t, err)
+
+	return attempts
+}
@@ -0,0 +1,96 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunReceptionActRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindByReceptionID should return added act", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		act := domain.ReceptionAct{
+			ReceptionID:     newID(t),
+			CreationTimeUTC: at(t, 30),
+			Document:        []byte("%PDF-1.3\x00\xff binary"),
+		}
+		require.NoError(t, repositories.ReceptionActRepository.Add(ctx, act))
+
+		// Act
+		found, err := repositories.ReceptionActRepository.FindByReceptionID(ctx, act.ReceptionID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, act.ReceptionID, found.ReceptionID)
+		require.Equal(t, act.Document, found.Document)
+		require.True(t, act.CreationTimeUTC.Equal(found.CreationTimeUTC), "expected %s, got %s", act.CreationTimeUTC, found.CreationTimeUTC)
+	})
+
+	t.Run("Add should fail when reception already has act", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		act := domain.ReceptionAct{
+			ReceptionID:     newID(t),
+			CreationTimeUTC: at(t, 30),
+			Document:        []byte("%PDF-1.3"),
+		}
+		require.NoError(t, repositories.ReceptionActRepository.Add(ctx, act))
+
+		// Act
+		err := repositories.ReceptionActRepository.Add(ctx, act)
+
+		// Assert
+		require.Error(t, err)
+	})
+
+	t.Run("FindByReceptionID should return error when act does not exist", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		_, err := repositories.ReceptionActRepository.FindByReceptionID(ctx, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.ReceptionActDoesNotExistError, err.Error())
+	})
+
+	t.Run("Remove should allow issuing act again", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		act := domain.ReceptionAct{
+			ReceptionID:     newID(t),
+			CreationTimeUTC: at(t, 30),
+			Document:        []byte("%PDF-1.3 first"),
+		}
+		require.NoError(t, repositories.ReceptionActRepository.Add(ctx, act))
+		reissued := act
+		reissued.CreationTimeUTC = at(t, 40)
+		reissued.Document = []byte("%PDF-1.3 second")
+
+		// Act
+		err := repositories.ReceptionActRepository.Remove(ctx, act.ReceptionID)
+
+		// Assert
+		require.NoError(t, err)
+		_, err = repositories.ReceptionActRepository.FindByReceptionID(ctx, act.ReceptionID)
+		require.Error(t, err)
+		require.NoError(t, repositories.ReceptionActRepository.Add(ctx, reissued))
+		found, err := repositories.ReceptionActRepository.FindByReceptionID(ctx, act.ReceptionID)
+		require.NoError(t, err)
+		require.Equal(t, reissued.Document, found.Document)
+	})
+
+	t.Run("Remove should succeed when act does not exist", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		err := repositories.ReceptionActRepository.Remove(ctx, newID(t))
+
+		// Assert
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,145 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"avito/internal/storage"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunAPIKeyRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindByKeyHash should return added key", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		keys := repositories.APIKeyRepository
+		key := newAPIKey(t, mustAddServiceAccount(t, repositories, "contract-key-owner@example.com"), "contract-key-hash", 0)
+		require.NoError(t, keys.Add(ctx, key))
+
+		// Act
+		found, err := keys.FindByKeyHash(ctx, key.KeyHash)
+
+		// Assert
+		require.NoError(t, err)
+		requireSameAPIKey(t, key, found)
+	})
+
+	t.Run("FindByKeyHash should return error when key does not exist", func(t *testing.T) {
+		// Arrange
+		keys := newRepositories(t).APIKeyRepository
+
+		// Act
+		_, err := keys.FindByKeyHash(ctx, "contract-unknown-key-hash")
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.APIKeyDoesNotExistError, err.Error())
+	})
+
+	t.Run("FindAllByServiceAccountID should return keys of account from the oldest", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		keys := repositories.APIKeyRepository
+		account := mustAddServiceAccount(t, repositories, "contract-keys-owner@example.com")
+		other := mustAddServiceAccount(t, repositories, "contract-keys-other@example.com")
+		newer := newAPIKey(t, account, "contract-newer-key-hash", 10)
+		older := newAPIKey(t, account, "contract-older-key-hash", 0)
+		require.NoError(t, keys.Add(ctx, newer))
+		require.NoError(t, keys.Add(ctx, older))
+		require.NoError(t, keys.Add(ctx, newAPIKey(t, other, "contract-other-key-hash", 5)))
+
+		// Act
+		found, err := keys.FindAllByServiceAccountID(ctx, account.ID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+		requireSameAPIKey(t, older, found[0])
+		requireSameAPIKey(t, newer, found[1])
+	})
+
+	t.Run("Revoke should save revocation only once", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		keys := repositories.APIKeyRepository
+		key := newAPIKey(t, mustAddServiceAccount(t, repositories, "contract-key-revoked@example.com"), "contract-revoked-key-hash", 0)
+		require.NoError(t, keys.Add(ctx, key))
+		require.NoError(t, key.Revoke(at(t, 30)))
+
+		// Act
+		first := keys.Revoke(ctx, key)
+		second := keys.Revoke(ctx, key)
+
+		// Assert
+		require.NoError(t, first)
+		require.Error(t, second)
+		require.Equal(t, domain.APIKeyIsAlreadyRevokedError, second.Error())
+		found, err := keys.FindByID(ctx, key.ID)
+		require.NoError(t, err)
+		requireSameAPIKey(t, key, found)
+	})
+
+	t.Run("MarkUsed should save time of use and keep revocation", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		keys := repositories.APIKeyRepository
+		key := newAPIKey(t, mustAddServiceAccount(t, repositories, "contract-key-used@example.com"), "contract-used-key-hash", 0)
+		require.NoError(t, keys.Add(ctx, key))
+		revoked := key
+		require.NoError(t, revoked.Revoke(at(t, 20)))
+		require.NoError(t, keys.Revoke(ctx, revoked))
+		usedAt := at(t, 10)
+		key.LastUsedAtUTC = &usedAt
+
+		// Act
+		err := keys.MarkUsed(ctx, key)
+
+		// Assert
+		require.NoError(t, err)
+		found, err := keys.FindByID(ctx, key.ID)
+		require.NoError(t, err)
+		requireSameOptionalTime(t, &usedAt, found.LastUsedAtUTC)
+		requireSameOptionalTime(t, revoked.RevokedAtUTC, found.RevokedAtUTC)
+	})
+}
+
+func mustAddServiceAccount(t *testing.T, repositories storage.Repositories, email domain.Email) domain.User {
+	t.Helper()
+
+	user, err := domain.NewUser(email, "")
+	require.NoError(t, err)
+	user.ServiceAccount = true
+	require.NoError(t, repositories.UserRepository.Add(ctx, user))
+
+	return user
+}
+
+func newAPIKey(t *testing.T, account domain.User, keyHash string, minutes int) domain.APIKey {
+	t.Helper()
+
+	return domain.APIKey{
+		ID:               newID(t),
+		ServiceAccountID: account.ID,
+		Name:             "contract key",
+		Hint:             "pvzk_contr",
+		KeyHash:          keyHash,
+		Scope:            []domain.Permission{domain.PVZReadPermission, domain.ReportReadPermission},
+		CreatedBy:        account.ID,
+		CreationTimeUTC:  at(t, minutes),
+	}
+}
+
+func requireSameAPIKey(t *testing.T, expected domain.APIKey, actual domain.APIKey) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.ServiceAccountID, actual.ServiceAccountID)
+	require.Equal(t, expected.Name, actual.Name)
+	require.Equal(t, expected.Hint, actual.Hint)
+	require.Equal(t, expected.KeyHash, actual.KeyHash)
+	require.Equal(t, expected.Scope, actual.Scope)
+	require.Equal(t, expected.CreatedBy, actual.CreatedBy)
+	require.True(t, expected.CreationTimeUTC.Equal(actual.CreationTimeUTC), "expected %s, got %s", expected.CreationTimeUTC, actual.CreationTimeUTC)
+	requireSameOptionalTime(t, expected.RevokedAtUTC, actual.RevokedAtUTC)
+	requireSameOptionalTime(t, expected.LastUsedAtUTC, actual.LastUsedAtUTC)
+}
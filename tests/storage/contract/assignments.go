@@ -0,0 +1,142 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunPVZAssignmentRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindByID should return added assignment", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		validFrom, validTo := at(t, 0), at(t, 60)
+		assignment := newPVZAssignment(t, newID(t), newID(t), 0)
+		assignment.ValidFromUTC, assignment.ValidToUTC = &validFrom, &validTo
+		require.NoError(t, repositories.PVZAssignmentRepository.Add(ctx, assignment))
+
+		// Act
+		found, err := repositories.PVZAssignmentRepository.FindByID(ctx, assignment.ID)
+
+		// Assert
+		require.NoError(t, err)
+		requireSamePVZAssignment(t, assignment, found)
+	})
+
+	t.Run("FindByID should return error when assignment does not exist", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		_, err := repositories.PVZAssignmentRepository.FindByID(ctx, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.PVZAssignmentDoesNotExistError, err.Error())
+	})
+
+	t.Run("FindAllByPVZID and FindAllByUserID should return matching assignments oldest first", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		userID, pvzID := newID(t), newID(t)
+		later := newPVZAssignment(t, userID, pvzID, 10)
+		earlier := newPVZAssignment(t, newID(t), pvzID, 0)
+		elsewhere := newPVZAssignment(t, userID, newID(t), 5)
+		for _, assignment := range []domain.PVZAssignment{later, earlier, elsewhere} {
+			require.NoError(t, repositories.PVZAssignmentRepository.Add(ctx, assignment))
+		}
+
+		// Act
+		byPVZ, pvzErr := repositories.PVZAssignmentRepository.FindAllByPVZID(ctx, pvzID)
+		byUser, userErr := repositories.PVZAssignmentRepository.FindAllByUserID(ctx, userID)
+
+		// Assert
+		require.NoError(t, pvzErr)
+		require.Len(t, byPVZ, 2)
+		requireSamePVZAssignment(t, earlier, byPVZ[0])
+		requireSamePVZAssignment(t, later, byPVZ[1])
+		require.NoError(t, userErr)
+		require.Len(t, byUser, 2)
+		requireSamePVZAssignment(t, elsewhere, byUser[0])
+		requireSamePVZAssignment(t, later, byUser[1])
+	})
+
+	t.Run("FindAllByUserID should return empty list when user has no assignments", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		assignments, err := repositories.PVZAssignmentRepository.FindAllByUserID(ctx, newID(t))
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, assignments)
+		require.Empty(t, assignments)
+	})
+
+	t.Run("Remove should delete assignment", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		assignment := newPVZAssignment(t, newID(t), newID(t), 0)
+		require.NoError(t, repositories.PVZAssignmentRepository.Add(ctx, assignment))
+
+		// Act
+		err := repositories.PVZAssignmentRepository.Remove(ctx, assignment.ID)
+
+		// Assert
+		require.NoError(t, err)
+		_, err = repositories.PVZAssignmentRepository.FindByID(ctx, assignment.ID)
+		require.Error(t, err)
+		require.Equal(t, domain.PVZAssignmentDoesNotExistError, err.Error())
+	})
+
+	t.Run("Remove should return error when assignment does not exist", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		err := repositories.PVZAssignmentRepository.Remove(ctx, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.PVZAssignmentDoesNotExistError, err.Error())
+	})
+}
+
+func newPVZAssignment(t *testing.T, userID domain.UserID, pvzID domain.PVZID, minutes int) domain.PVZAssignment {
+	t.Helper()
+
+	return domain.PVZAssignment{
+		ID:              newID(t),
+		UserID:          userID,
+		PVZID:           pvzID,
+		AssignedBy:      newID(t),
+		CreationTimeUTC: at(t, minutes),
+	}
+}
+
+func requireSamePVZAssignment(t *testing.T, expected domain.PVZAssignment, actual domain.PVZAssignment) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.UserID, actual.UserID)
+	require.Equal(t, expected.PVZID, actual.PVZID)
+	require.Equal(t, expected.AssignedBy, actual.AssignedBy)
+	requireSameOptionalTime(t, expected.ValidFromUTC, actual.ValidFromUTC)
+	requireSameOptionalTime(t, expected.ValidToUTC, actual.ValidToUTC)
+	require.True(t, expected.CreationTimeUTC.Equal(actual.CreationTimeUTC), "expected %s, got %s", expected.CreationTimeUTC, actual.CreationTimeUTC)
+}
+
+func requireSameOptionalTime(t *testing.T, expected *time.Time, actual *time.Time) {
+	t.Helper()
+
+	if expected == nil {
+		require.Nil(t, actual)
+		return
+	}
+
+	require.NotNil(t, actual)
+	require.True(t, expected.Equal(*actual), "expected %s, got %s", *expected, *actual)
+}
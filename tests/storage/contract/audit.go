@@ -0,0 +1,158 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func RunAuditRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindAllByFilter should return added records newest first", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		entityID := newID(t)
+		created := newAuditRecord(t, newID(t), domain.ReceptionOpenedAuditAction, entityID, 1)
+		created.Before = nil
+		created.After = []byte(`{"status": 1}`)
+		closed := newAuditRecord(t, newID(t), domain.ReceptionClosedAuditAction, entityID, 2)
+		require.NoError(t, repositories.AuditRepository.Add(ctx, created))
+		require.NoError(t, repositories.AuditRepository.Add(ctx, closed))
+
+		// Act
+		records, err := repositories.AuditRepository.FindAllByFilter(ctx, auditFilter())
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		requireSameAuditRecord(t, closed, records[0])
+		requireSameAuditRecord(t, created, records[1])
+	})
+
+	t.Run("FindAllByFilter should match every given field", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		actorID, entityID := newID(t), newID(t)
+		expected := newAuditRecord(t, actorID, domain.ReceptionClosedAuditAction, entityID, 10)
+		records := []domain.AuditRecord{
+			expected,
+			newAuditRecord(t, newID(t), domain.ReceptionClosedAuditAction, entityID, 10),
+			newAuditRecord(t, actorID, domain.ReceptionOpenedAuditAction, entityID, 10),
+			newAuditRecord(t, actorID, domain.ReceptionClosedAuditAction, newID(t), 10),
+			newAuditRecord(t, actorID, domain.ReceptionClosedAuditAction, entityID, 1),
+			newAuditRecord(t, actorID, domain.ReceptionClosedAuditAction, entityID, 30),
+		}
+		for _, record := range records {
+			require.NoError(t, repositories.AuditRepository.Add(ctx, record))
+		}
+		filter := auditFilter()
+		filter.ActorID = &actorID
+		filter.Action = domain.ReceptionClosedAuditAction
+		filter.EntityType = domain.ReceptionAuditEntityType
+		filter.EntityID = &entityID
+		startTime, endTime := at(t, 5), at(t, 20)
+		filter.StartTimeUTC, filter.EndTimeUTC = &startTime, &endTime
+
+		// Act
+		found, err := repositories.AuditRepository.FindAllByFilter(ctx, filter)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		requireSameAuditRecord(t, expected, found[0])
+	})
+
+	t.Run("FindAllByFilter should return requested page", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		records := make([]domain.AuditRecord, 0, 5)
+		for minutes := range 5 {
+			record := newAuditRecord(t, newID(t), domain.ProductAddedAuditAction, newID(t), minutes)
+			require.NoError(t, repositories.AuditRepository.Add(ctx, record))
+			records = append(records, record)
+		}
+		filter := auditFilter()
+		filter.Page, filter.Limit = 2, 2
+
+		// Act
+		found, err := repositories.AuditRepository.FindAllByFilter(ctx, filter)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+		requireSameAuditRecord(t, records[2], found[0])
+		requireSameAuditRecord(t, records[1], found[1])
+	})
+
+	t.Run("Add should be discarded with transaction it was made in", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		failure := errors.New("failure after audit")
+
+		// Act
+		err := repositories.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := repositories.AuditRepository.Add(ctx, newAuditRecord(t, newID(t), domain.PVZCreatedAuditAction, newID(t), 1)); err != nil {
+				return err
+			}
+
+			return failure
+		})
+
+		// Assert
+		require.ErrorIs(t, err, failure)
+		records, err := repositories.AuditRepository.FindAllByFilter(ctx, auditFilter())
+		require.NoError(t, err)
+		require.Empty(t, records)
+	})
+}
+
+func newAuditRecord(t *testing.T, actorID domain.UserID, action domain.AuditAction, entityID uuid.UUID, minutes int) domain.AuditRecord {
+	t.Helper()
+
+	return domain.AuditRecord{
+		ID:            newID(t),
+		ActorID:       actorID,
+		Action:        action,
+		EntityType:    domain.ReceptionAuditEntityType,
+		EntityID:      entityID,
+		Before:        []byte(`{"status": 1}`),
+		After:         []byte(`{"status": 2}`),
+		Protocol:      domain.HTTPRequestProtocol,
+		RequestID:     newID(t).String(),
+		OccurredAtUTC: at(t, minutes),
+	}
+}
+
+func auditFilter() domain.SearchAuditRecordFilter {
+	return domain.SearchAuditRecordFilter{Page: 1, Limit: 10}
+}
+
+func requireSameAuditRecord(t *testing.T, expected domain.AuditRecord, actual domain.AuditRecord) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.ActorID, actual.ActorID)
+	require.Equal(t, expected.Action, actual.Action)
+	require.Equal(t, expected.EntityType, actual.EntityType)
+	require.Equal(t, expected.EntityID, actual.EntityID)
+	requireSameAuditState(t, expected.Before, actual.Before)
+	requireSameAuditState(t, expected.After, actual.After)
+	require.Equal(t, expected.Protocol, actual.Protocol)
+	require.Equal(t, expected.RequestID, actual.RequestID)
+	require.True(t, expected.OccurredAtUTC.Equal(actual.OccurredAtUTC), "expected %s, got %s", expected.OccurredAtUTC, actual.OccurredAtUTC)
+}
+
+// postgres normalizes jsonb, so states are compared by content
+func requireSameAuditState(t *testing.T, expected []byte, actual []byte) {
+	t.Helper()
+
+	if len(expected) == 0 {
+		require.Empty(t, actual)
+		return
+	}
+
+	require.JSONEq(t, string(expected), string(actual))
+}
@@ -0,0 +1,176 @@
+// Package contract holds behaviour every implementation of domain repositories must follow.
+// Suites are plugged with a factory that returns fresh isolated repositories for each test.
+package contract
+
+import (
+	"avito/internal/domain"
+	"avito/internal/storage"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type RepositoriesFactory func(t *testing.T) storage.Repositories
+
+var ctx = context.Background()
+
+// RunAll runs contract of every repository from storage.Repositories
+func RunAll(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("UserRepository", func(t *testing.T) {
+		RunUserRepositoryContract(t, newRepositories)
+	})
+	t.Run("PVZRepository", func(t *testing.T) {
+		RunPVZRepositoryContract(t, newRepositories)
+	})
+	t.Run("ReceptionInfoRepository", func(t *testing.T) {
+		RunReceptionInfoRepositoryContract(t, newRepositories)
+	})
+	t.Run("ProductRepository", func(t *testing.T) {
+		RunProductRepositoryContract(t, newRepositories)
+	})
+	t.Run("PVZReportAggregateRepository", func(t *testing.T) {
+		RunPVZReportAggregateRepositoryContract(t, newRepositories)
+	})
+	t.Run("ShipmentManifestRepository", func(t *testing.T) {
+		RunShipmentManifestRepositoryContract(t, newRepositories)
+	})
+	t.Run("DiscrepancyReportRepository", func(t *testing.T) {
+		RunDiscrepancyReportRepositoryContract(t, newRepositories)
+	})
+	t.Run("ReceptionActRepository", func(t *testing.T) {
+		RunReceptionActRepositoryContract(t, newRepositories)
+	})
+	t.Run("ReceptionHistoryRepository", func(t *testing.T) {
+		RunReceptionHistoryRepositoryContract(t, newRepositories)
+	})
+	t.Run("DuplicateScanRepository", func(t *testing.T) {
+		RunDuplicateScanRepositoryContract(t, newRepositories)
+	})
+	t.Run("EventOutboxRepository", func(t *testing.T) {
+		RunEventOutboxRepositoryContract(t, newRepositories)
+	})
+	t.Run("UnitOfWork", func(t *testing.T) {
+		RunUnitOfWorkContract(t, newRepositories)
+	})
+	t.Run("WebhookSubscriptionRepository", func(t *testing.T) {
+		RunWebhookSubscriptionRepositoryContract(t, newRepositories)
+	})
+	t.Run("WebhookDeliveryAttemptRepository", func(t *testing.T) {
+		RunWebhookDeliveryAttemptRepositoryContract(t, newRepositories)
+	})
+	t.Run("AuditRepository", func(t *testing.T) {
+		RunAuditRepositoryContract(t, newRepositories)
+	})
+	t.Run("PVZAssignmentRepository", func(t *testing.T) {
+		RunPVZAssignmentRepositoryContract(t, newRepositories)
+	})
+	t.Run("RoleRepository", func(t *testing.T) {
+		RunRoleRepositoryContract(t, newRepositories)
+	})
+	t.Run("InvitationRepository", func(t *testing.T) {
+		RunInvitationRepositoryContract(t, newRepositories)
+	})
+	t.Run("UserTokenRepository", func(t *testing.T) {
+		RunUserTokenRepositoryContract(t, newRepositories)
+	})
+	t.Run("APIKeyRepository", func(t *testing.T) {
+		RunAPIKeyRepositoryContract(t, newRepositories)
+	})
+	t.Run("SSOLoginRepository", func(t *testing.T) {
+		RunSSOLoginRepositoryContract(t, newRepositories)
+	})
+}
+
+// timestamps are kept with millisecond precision as it is the precision of sql report view
+func at(t *testing.T, minutes int) time.Time {
+	t.Helper()
+
+	return time.Date(2025, 4, 10, 9, 0, 0, 0, time.UTC).Add(time.Duration(minutes)*time.Minute + 123*time.Millisecond)
+}
+
+func newID(t *testing.T) uuid.UUID {
+	t.Helper()
+
+	return uuid.Must(uuid.NewV7())
+}
+
+func mustAddPVZ(t *testing.T, repositories storage.Repositories, cityID domain.CityID, creationTime time.Time) domain.PVZ {
+	t.Helper()
+
+	city, err := domain.NewCity(cityID)
+	require.NoError(t, err)
+
+	pvz := domain.PVZ{
+		ID:              newID(t),
+		CreationTimeUTC: creationTime,
+		City:            city,
+	}
+	require.NoError(t, repositories.PVZRepository.Add(ctx, pvz))
+
+	return pvz
+}
+
+func mustAddReception(t *testing.T, repositories storage.Repositories, pvzID domain.PVZID, status domain.ReceptionStatus, creationTime time.Time) domain.ReceptionInfo {
+	t.Helper()
+
+	reception := domain.ReceptionInfo{
+		ID:              newID(t),
+		PVZID:           pvzID,
+		CreationTimeUTC: creationTime,
+		Status:          status,
+	}
+	require.NoError(t, repositories.ReceptionInfoRepository.Add(ctx, reception))
+
+	return reception
+}
+
+func mustAddProduct(t *testing.T, repositories storage.Repositories, receptionID domain.ReceptionID, category domain.ProductCategory, creationTime time.Time) domain.Product {
+	t.Helper()
+
+	product := domain.Product{
+		ID:              newID(t),
+		ReceptionID:     receptionID,
+		CreationTimeUTC: creationTime,
+		Category:        category,
+	}
+	require.NoError(t, repositories.ProductRepository.Add(ctx, product))
+
+	return product
+}
+
+func mustAddProductWithBarcode(t *testing.T, repositories storage.Repositories, receptionID domain.ReceptionID, barcode domain.Barcode, creationTime time.Time) domain.Product {
+	t.Helper()
+
+	product := domain.Product{
+		ID:              newID(t),
+		ReceptionID:     receptionID,
+		CreationTimeUTC: creationTime,
+		Category:        domain.ElectronicsProductCategory,
+		Barcode:         &barcode,
+	}
+	require.NoError(t, repositories.ProductRepository.Add(ctx, product))
+
+	return product
+}
+
+func requireSameReception(t *testing.T, expected domain.ReceptionInfo, actual domain.ReceptionInfo) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.PVZID, actual.PVZID)
+	require.Equal(t, expected.Status, actual.Status)
+	require.True(t, expected.CreationTimeUTC.Equal(actual.CreationTimeUTC), "expected %s, got %s", expected.CreationTimeUTC, actual.CreationTimeUTC)
+}
+
+func requireSameProduct(t *testing.T, expected domain.Product, actual domain.Product) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.ReceptionID, actual.ReceptionID)
+	require.Equal(t, expected.Category, actual.Category)
+	require.Equal(t, expected.Barcode, actual.Barcode)
+	require.True(t, expected.CreationTimeUTC.Equal(actual.CreationTimeUTC), "expected %s, got %s", expected.CreationTimeUTC, actual.CreationTimeUTC)
+}
@@ -0,0 +1,109 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunDiscrepancyReportRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindByReceptionID should return added report", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		report := domain.DiscrepancyReport{
+			ReceptionID:          newID(t),
+			ManifestID:           newID(t),
+			CreationTimeUTC:      at(t, 30),
+			Missing:              []string{"4006381333931", "4006381333931"},
+			Unexpected:           []string{"1234567890-1"},
+			Duplicates:           []string{},
+			UnidentifiedProducts: 2,
+			CategoryMismatches: []domain.CategoryMismatch{
+				{Barcode: "4006381333931", Expected: domain.ShoesProductCategory, Accepted: domain.ClothesProductCategory},
+			},
+			Categories: []domain.CategoryDiscrepancy{
+				{Category: domain.ClothesProductCategory, Expected: 0, Accepted: 3},
+				{Category: domain.ShoesProductCategory, Expected: 2, Accepted: 0},
+			},
+		}
+		require.NoError(t, repositories.DiscrepancyReportRepository.Add(ctx, report))
+
+		// Act
+		found, err := repositories.DiscrepancyReportRepository.FindByReceptionID(ctx, report.ReceptionID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, report.ReceptionID, found.ReceptionID)
+		require.Equal(t, report.ManifestID, found.ManifestID)
+		require.Equal(t, report.Missing, found.Missing)
+		require.Equal(t, report.Unexpected, found.Unexpected)
+		require.Equal(t, report.Duplicates, found.Duplicates)
+		require.Equal(t, report.UnidentifiedProducts, found.UnidentifiedProducts)
+		require.Equal(t, report.CategoryMismatches, found.CategoryMismatches)
+		require.Equal(t, report.Categories, found.Categories)
+		require.True(t, report.CreationTimeUTC.Equal(found.CreationTimeUTC), "expected %s, got %s", report.CreationTimeUTC, found.CreationTimeUTC)
+	})
+
+	t.Run("Add should fail when reception already has report", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		report := domain.DiscrepancyReport{
+			ReceptionID:        newID(t),
+			ManifestID:         newID(t),
+			CreationTimeUTC:    at(t, 30),
+			Missing:            []string{},
+			Unexpected:         []string{},
+			Duplicates:         []string{},
+			CategoryMismatches: []domain.CategoryMismatch{},
+			Categories:         []domain.CategoryDiscrepancy{},
+		}
+		require.NoError(t, repositories.DiscrepancyReportRepository.Add(ctx, report))
+
+		// Act
+		err := repositories.DiscrepancyReportRepository.Add(ctx, report)
+
+		// Assert
+		require.Error(t, err)
+	})
+
+	t.Run("FindByReceptionID should return error when report does not exist", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		_, err := repositories.DiscrepancyReportRepository.FindByReceptionID(ctx, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.DiscrepancyReportDoesNotExistError, err.Error())
+	})
+
+	t.Run("Remove should delete only report of given reception", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		removed := domain.DiscrepancyReport{
+			ReceptionID:     newID(t),
+			ManifestID:      newID(t),
+			CreationTimeUTC: at(t, 30),
+			Missing:         []string{"4006381333931"},
+			Unexpected:      []string{},
+			Duplicates:      []string{},
+		}
+		kept := removed
+		kept.ReceptionID = newID(t)
+		require.NoError(t, repositories.DiscrepancyReportRepository.Add(ctx, removed))
+		require.NoError(t, repositories.DiscrepancyReportRepository.Add(ctx, kept))
+
+		// Act
+		err := repositories.DiscrepancyReportRepository.Remove(ctx, removed.ReceptionID)
+
+		// Assert
+		require.NoError(t, err)
+		_, err = repositories.DiscrepancyReportRepository.FindByReceptionID(ctx, removed.ReceptionID)
+		require.Error(t, err)
+		require.Equal(t, domain.DiscrepancyReportDoesNotExistError, err.Error())
+		_, err = repositories.DiscrepancyReportRepository.FindByReceptionID(ctx, kept.ReceptionID)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,66 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunDuplicateScanRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindAllByReceptionID should return reception scans oldest first", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		receptionID, employeeID := newID(t), newID(t)
+		later := newDuplicateScan(t, receptionID, "4006381333931", employeeID, 10)
+		earlier := newDuplicateScan(t, receptionID, "1234567890-1", employeeID, 0)
+		other := newDuplicateScan(t, newID(t), "4006381333931", employeeID, 5)
+		for _, scan := range []domain.DuplicateScan{later, earlier, other} {
+			require.NoError(t, repositories.DuplicateScanRepository.Add(ctx, scan))
+		}
+
+		// Act
+		scans, err := repositories.DuplicateScanRepository.FindAllByReceptionID(ctx, receptionID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, scans, 2)
+		requireSameDuplicateScan(t, earlier, scans[0])
+		requireSameDuplicateScan(t, later, scans[1])
+	})
+
+	t.Run("FindAllByReceptionID should return empty list when nothing was scanned twice", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		scans, err := repositories.DuplicateScanRepository.FindAllByReceptionID(ctx, newID(t))
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, scans)
+		require.Empty(t, scans)
+	})
+}
+
+func newDuplicateScan(t *testing.T, receptionID domain.ReceptionID, barcode string, scannedBy domain.UserID, minutes int) domain.DuplicateScan {
+	t.Helper()
+
+	return domain.DuplicateScan{
+		ID:            newID(t),
+		ReceptionID:   receptionID,
+		Barcode:       domain.Barcode{Value: barcode, Format: domain.Code128BarcodeFormat},
+		ScannedBy:     scannedBy,
+		OccurredAtUTC: at(t, minutes),
+	}
+}
+
+func requireSameDuplicateScan(t *testing.T, expected domain.DuplicateScan, actual domain.DuplicateScan) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.ReceptionID, actual.ReceptionID)
+	require.Equal(t, expected.Barcode, actual.Barcode)
+	require.Equal(t, expected.ScannedBy, actual.ScannedBy)
+	require.True(t, expected.OccurredAtUTC.Equal(actual.OccurredAtUTC), "expected %s, got %s", expected.OccurredAtUTC, actual.OccurredAtUTC)
+}
@@ -0,0 +1,70 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunReceptionHistoryRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindAllByReceptionID should return reception entries oldest first", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		receptionID, actorID := newID(t), newID(t)
+		reopened := newReceptionHistoryEntry(t, receptionID, domain.ReceptionReopenedHistoryAction, actorID, 20)
+		reopened.Reason = "закрыта до разгрузки второй машины"
+		opened := newReceptionHistoryEntry(t, receptionID, domain.ReceptionOpenedHistoryAction, actorID, 0)
+		closed := newReceptionHistoryEntry(t, receptionID, domain.ReceptionClosedHistoryAction, actorID, 10)
+		other := newReceptionHistoryEntry(t, newID(t), domain.ReceptionOpenedHistoryAction, actorID, 5)
+		for _, entry := range []domain.ReceptionHistoryEntry{reopened, opened, closed, other} {
+			require.NoError(t, repositories.ReceptionHistoryRepository.Add(ctx, entry))
+		}
+
+		// Act
+		entries, err := repositories.ReceptionHistoryRepository.FindAllByReceptionID(ctx, receptionID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		requireSameReceptionHistoryEntry(t, opened, entries[0])
+		requireSameReceptionHistoryEntry(t, closed, entries[1])
+		requireSameReceptionHistoryEntry(t, reopened, entries[2])
+	})
+
+	t.Run("FindAllByReceptionID should return empty list when reception has no history", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		entries, err := repositories.ReceptionHistoryRepository.FindAllByReceptionID(ctx, newID(t))
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, entries)
+		require.Empty(t, entries)
+	})
+}
+
+func newReceptionHistoryEntry(t *testing.T, receptionID domain.ReceptionID, action domain.ReceptionHistoryAction, actorID domain.UserID, minutes int) domain.ReceptionHistoryEntry {
+	t.Helper()
+
+	return domain.ReceptionHistoryEntry{
+		ID:            newID(t),
+		ReceptionID:   receptionID,
+		Action:        action,
+		ActorID:       actorID,
+		OccurredAtUTC: at(t, minutes),
+	}
+}
+
+func requireSameReceptionHistoryEntry(t *testing.T, expected domain.ReceptionHistoryEntry, actual domain.ReceptionHistoryEntry) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.ReceptionID, actual.ReceptionID)
+	require.Equal(t, expected.Action, actual.Action)
+	require.Equal(t, expected.ActorID, actual.ActorID)
+	require.Equal(t, expected.Reason, actual.Reason)
+	require.True(t, expected.OccurredAtUTC.Equal(actual.OccurredAtUTC), "expected %s, got %s", expected.OccurredAtUTC, actual.OccurredAtUTC)
+}
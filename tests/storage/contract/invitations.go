@@ -0,0 +1,86 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunInvitationRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindByTokenHash should return added invitation", func(t *testing.T) {
+		// Arrange
+		invitations := newRepositories(t).InvitationRepository
+		email := domain.Email("contract-invited@example.com")
+		invitation := newInvitation(t, "contract-token-hash")
+		invitation.Email = &email
+		require.NoError(t, invitations.Add(ctx, invitation))
+
+		// Act
+		found, err := invitations.FindByTokenHash(ctx, invitation.TokenHash)
+
+		// Assert
+		require.NoError(t, err)
+		requireSameInvitation(t, invitation, found)
+	})
+
+	t.Run("FindByTokenHash should return error when invitation does not exist", func(t *testing.T) {
+		// Arrange
+		invitations := newRepositories(t).InvitationRepository
+
+		// Act
+		_, err := invitations.FindByTokenHash(ctx, "contract-unknown-hash")
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.InvitationDoesNotExistError, err.Error())
+	})
+
+	t.Run("Accept should save acceptance only once", func(t *testing.T) {
+		// Arrange
+		invitations := newRepositories(t).InvitationRepository
+		invitation := newInvitation(t, "contract-single-use-hash")
+		require.NoError(t, invitations.Add(ctx, invitation))
+		acceptedAt, acceptedBy := at(t, 30), newID(t)
+		invitation.AcceptedAtUTC, invitation.AcceptedBy = &acceptedAt, &acceptedBy
+
+		// Act
+		first := invitations.Accept(ctx, invitation)
+		second := invitations.Accept(ctx, invitation)
+
+		// Assert
+		require.NoError(t, first)
+		require.Error(t, second)
+		require.Equal(t, domain.InvitationIsAlreadyAcceptedError, second.Error())
+		found, err := invitations.FindByTokenHash(ctx, invitation.TokenHash)
+		require.NoError(t, err)
+		requireSameInvitation(t, invitation, found)
+	})
+}
+
+func newInvitation(t *testing.T, tokenHash string) domain.Invitation {
+	t.Helper()
+
+	return domain.Invitation{
+		ID:              newID(t),
+		TokenHash:       tokenHash,
+		RoleID:          domain.ModeratorUserRoleID,
+		InvitedBy:       newID(t),
+		CreationTimeUTC: at(t, 0),
+		ExpiresAtUTC:    at(t, 60),
+	}
+}
+
+func requireSameInvitation(t *testing.T, expected domain.Invitation, actual domain.Invitation) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.TokenHash, actual.TokenHash)
+	require.Equal(t, expected.RoleID, actual.RoleID)
+	require.Equal(t, expected.Email, actual.Email)
+	require.Equal(t, expected.InvitedBy, actual.InvitedBy)
+	require.Equal(t, expected.AcceptedBy, actual.AcceptedBy)
+	require.True(t, expected.CreationTimeUTC.Equal(actual.CreationTimeUTC), "expected %s, got %s", expected.CreationTimeUTC, actual.CreationTimeUTC)
+	require.True(t, expected.ExpiresAtUTC.Equal(actual.ExpiresAtUTC), "expected %s, got %s", expected.ExpiresAtUTC, actual.ExpiresAtUTC)
+	requireSameOptionalTime(t, expected.AcceptedAtUTC, actual.AcceptedAtUTC)
+}
@@ -0,0 +1,137 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunShipmentManifestRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindByID should return added manifest", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		manifest := newManifest(t, newID(t), 10)
+		require.NoError(t, repositories.ShipmentManifestRepository.Add(ctx, manifest))
+
+		// Act
+		found, err := repositories.ShipmentManifestRepository.FindByID(ctx, manifest.ID)
+
+		// Assert
+		require.NoError(t, err)
+		requireSameManifest(t, manifest, found)
+		require.Nil(t, found.ReceptionID)
+	})
+
+	t.Run("FindByID should return error when manifest does not exist", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		_, err := repositories.ShipmentManifestRepository.FindByID(ctx, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.ShipmentManifestDoesNotExistError, err.Error())
+	})
+
+	t.Run("Link should make manifest found by reception", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		receptionID := newID(t)
+		manifest := newManifest(t, newID(t), 10)
+		require.NoError(t, repositories.ShipmentManifestRepository.Add(ctx, manifest))
+
+		// Act
+		err := repositories.ShipmentManifestRepository.Link(ctx, manifest.ID, receptionID)
+
+		// Assert
+		require.NoError(t, err)
+		found, err := repositories.ShipmentManifestRepository.FindByReceptionID(ctx, receptionID)
+		require.NoError(t, err)
+		manifest.ReceptionID = &receptionID
+		requireSameManifest(t, manifest, found)
+	})
+
+	t.Run("Link should unlink manifest previously linked to reception", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		receptionID, pvzID := newID(t), newID(t)
+		previous := newManifest(t, pvzID, 10)
+		replacement := newManifest(t, pvzID, 20)
+		require.NoError(t, repositories.ShipmentManifestRepository.Add(ctx, previous))
+		require.NoError(t, repositories.ShipmentManifestRepository.Add(ctx, replacement))
+		require.NoError(t, repositories.ShipmentManifestRepository.Link(ctx, previous.ID, receptionID))
+
+		// Act
+		err := repositories.ShipmentManifestRepository.Link(ctx, replacement.ID, receptionID)
+
+		// Assert
+		require.NoError(t, err)
+		found, err := repositories.ShipmentManifestRepository.FindByReceptionID(ctx, receptionID)
+		require.NoError(t, err)
+		require.Equal(t, replacement.ID, found.ID)
+		unlinked, err := repositories.ShipmentManifestRepository.FindByID(ctx, previous.ID)
+		require.NoError(t, err)
+		require.Nil(t, unlinked.ReceptionID)
+	})
+
+	t.Run("Link should return error when manifest is linked to another reception", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		linkedTo := newID(t)
+		manifest := newManifest(t, newID(t), 10)
+		require.NoError(t, repositories.ShipmentManifestRepository.Add(ctx, manifest))
+		require.NoError(t, repositories.ShipmentManifestRepository.Link(ctx, manifest.ID, linkedTo))
+
+		// Act
+		err := repositories.ShipmentManifestRepository.Link(ctx, manifest.ID, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.ShipmentManifestIsAlreadyLinkedError, err.Error())
+		found, err := repositories.ShipmentManifestRepository.FindByReceptionID(ctx, linkedTo)
+		require.NoError(t, err)
+		require.Equal(t, manifest.ID, found.ID)
+	})
+
+	t.Run("FindByReceptionID should return error when reception has no manifest", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		require.NoError(t, repositories.ShipmentManifestRepository.Add(ctx, newManifest(t, newID(t), 10)))
+
+		// Act
+		_, err := repositories.ShipmentManifestRepository.FindByReceptionID(ctx, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.ShipmentManifestDoesNotExistError, err.Error())
+	})
+}
+
+func newManifest(t *testing.T, pvzID domain.PVZID, minutes int) domain.ShipmentManifest {
+	t.Helper()
+
+	return domain.ShipmentManifest{
+		ID:              newID(t),
+		PVZID:           pvzID,
+		UploadedBy:      newID(t),
+		CreationTimeUTC: at(t, minutes),
+		Items: []domain.ShipmentManifestItem{
+			{Barcode: "4006381333931", Category: domain.ElectronicsProductCategory},
+			{Barcode: "1234567890-1", Category: domain.ShoesProductCategory},
+			{Barcode: "4006381333931", Category: domain.ClothesProductCategory},
+		},
+	}
+}
+
+func requireSameManifest(t *testing.T, expected domain.ShipmentManifest, actual domain.ShipmentManifest) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.PVZID, actual.PVZID)
+	require.Equal(t, expected.ReceptionID, actual.ReceptionID)
+	require.Equal(t, expected.UploadedBy, actual.UploadedBy)
+	require.Equal(t, expected.Items, actual.Items)
+	require.True(t, expected.CreationTimeUTC.Equal(actual.CreationTimeUTC), "expected %s, got %s", expected.CreationTimeUTC, actual.CreationTimeUTC)
+}
@@ -0,0 +1,228 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunEventOutboxRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindUnpublished should return events in order they occurred", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvzID := newID(t)
+		later := newEvent(t, domain.ReceptionClosedEventType, pvzID, 20)
+		earlier := newEvent(t, domain.ReceptionOpenedEventType, pvzID, 10)
+		require.NoError(t, repositories.EventOutboxRepository.Add(ctx, later, earlier))
+
+		// Act
+		events, err := repositories.EventOutboxRepository.FindUnpublished(ctx, 10)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		requireSameEvent(t, earlier, events[0])
+		requireSameEvent(t, later, events[1])
+	})
+
+	t.Run("FindUnpublished should return at most limit events", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvzID := newID(t)
+		first := newEvent(t, domain.ProductAddedEventType, pvzID, 1)
+		require.NoError(t, repositories.EventOutboxRepository.Add(ctx,
+			first,
+			newEvent(t, domain.ProductAddedEventType, pvzID, 2),
+			newEvent(t, domain.ProductAddedEventType, pvzID, 3),
+		))
+
+		// Act
+		events, err := repositories.EventOutboxRepository.FindUnpublished(ctx, 1)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		requireSameEvent(t, first, events[0])
+	})
+
+	t.Run("MarkPublished should exclude event from unpublished", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvzID := newID(t)
+		published := newEvent(t, domain.PVZCreatedEventType, pvzID, 1)
+		pending := newEvent(t, domain.ReceptionOpenedEventType, pvzID, 2)
+		require.NoError(t, repositories.EventOutboxRepository.Add(ctx, published, pending))
+
+		// Act
+		err := repositories.EventOutboxRepository.MarkPublished(ctx, published.ID)
+
+		// Assert
+		require.NoError(t, err)
+		events, err := repositories.EventOutboxRepository.FindUnpublished(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		requireSameEvent(t, pending, events[0])
+	})
+
+	t.Run("MarkPublished should return error when event does not exist", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		err := repositories.EventOutboxRepository.MarkPublished(ctx, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.EventDoesNotExistError, err.Error())
+	})
+}
+
+func RunUnitOfWorkContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("WithinTransaction should keep all changes when fn succeeds", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.KazanCityID, at(t, 0))
+		reception := domain.ReceptionInfo{ID: newID(t), PVZID: pvz.ID, CreationTimeUTC: at(t, 1), Status: domain.InProggressProductAcceptanceStatus}
+		event := newEvent(t, domain.ReceptionOpenedEventType, pvz.ID, 1)
+
+		// Act
+		err := repositories.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := repositories.ReceptionInfoRepository.Add(ctx, reception); err != nil {
+				return err
+			}
+
+			return repositories.EventOutboxRepository.Add(ctx, event)
+		})
+
+		// Assert
+		require.NoError(t, err)
+		found, err := repositories.ReceptionInfoRepository.FindByID(ctx, reception.ID)
+		require.NoError(t, err)
+		requireSameReception(t, reception, found)
+		events, err := repositories.EventOutboxRepository.FindUnpublished(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		requireSameEvent(t, event, events[0])
+	})
+
+	t.Run("WithinTransaction should discard all changes when fn fails", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.KazanCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 1))
+		closed := reception
+		closed.Status = domain.CloseProductAcceptanceStatus
+		failure := errors.New("failure after changes")
+
+		// Act
+		err := repositories.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := repositories.ReceptionInfoRepository.Update(ctx, closed); err != nil {
+				return err
+			}
+			if err := repositories.EventOutboxRepository.Add(ctx, newEvent(t, domain.ReceptionClosedEventType, pvz.ID, 2)); err != nil {
+				return err
+			}
+
+			return failure
+		})
+
+		// Assert
+		require.ErrorIs(t, err, failure)
+		found, err := repositories.ReceptionInfoRepository.FindByID(ctx, reception.ID)
+		require.NoError(t, err)
+		requireSameReception(t, reception, found)
+		events, err := repositories.EventOutboxRepository.FindUnpublished(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+
+	t.Run("WithinTransaction should keep changes made alongside it when fn fails", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.KazanCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 1))
+		closed := reception
+		closed.Status = domain.CloseProductAcceptanceStatus
+		event := newEvent(t, domain.ReceptionOpenedEventType, pvz.ID, 1)
+		require.NoError(t, repositories.EventOutboxRepository.Add(ctx, event))
+		failure := errors.New("failure after changes")
+		published := make(chan error, 1)
+		seen := make(chan domain.ReceptionInfo, 1)
+
+		// Act
+		err := repositories.UnitOfWork.WithinTransaction(ctx, func(txCtx context.Context) error {
+			if err := repositories.ReceptionInfoRepository.Update(txCtx, closed); err != nil {
+				return err
+			}
+
+			// made outside of transaction while it is open, as outbox relay does
+			go func() {
+				published <- repositories.EventOutboxRepository.MarkPublished(ctx, event.ID)
+				found, _ := repositories.ReceptionInfoRepository.FindByID(ctx, reception.ID)
+				seen <- found
+			}()
+
+			return failure
+		})
+
+		// Assert
+		require.ErrorIs(t, err, failure)
+		require.NoError(t, <-published)
+		requireSameReception(t, reception, <-seen)
+		events, err := repositories.EventOutboxRepository.FindUnpublished(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+
+	t.Run("WithinTransaction should join transaction opened in ctx", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvzID := newID(t)
+		failure := errors.New("outer failure")
+
+		// Act
+		err := repositories.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+			innerErr := repositories.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) error {
+				return repositories.EventOutboxRepository.Add(ctx, newEvent(t, domain.PVZCreatedEventType, pvzID, 1))
+			})
+			require.NoError(t, innerErr)
+
+			return failure
+		})
+
+		// Assert
+		require.ErrorIs(t, err, failure)
+		events, err := repositories.EventOutboxRepository.FindUnpublished(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+}
+
+func newEvent(t *testing.T, eventType domain.EventType, pvzID domain.PVZID, minutes int) domain.Event {
+	t.Helper()
+
+	payload, err := json.Marshal(map[string]string{"pvz_id": pvzID.String()})
+	require.NoError(t, err)
+
+	return domain.Event{
+		ID:            newID(t),
+		Type:          eventType,
+		PVZID:         pvzID,
+		OccurredAtUTC: at(t, minutes),
+		Payload:       payload,
+	}
+}
+
+func requireSameEvent(t *testing.T, expected domain.Event, actual domain.Event) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.Type, actual.Type)
+	require.Equal(t, expected.PVZID, actual.PVZID)
+	require.JSONEq(t, string(expected.Payload), string(actual.Payload))
+	require.True(t, expected.OccurredAtUTC.Equal(actual.OccurredAtUTC), "expected %s, got %s", expected.OccurredAtUTC, actual.OccurredAtUTC)
+}
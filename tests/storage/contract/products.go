@@ -0,0 +1,314 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunProductRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindAllByReceptionID should return only reception products", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 10))
+		otherReception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 20))
+		shoes := mustAddProduct(t, repositories, reception.ID, domain.ShoesProductCategory, at(t, 11))
+		clothes := mustAddProduct(t, repositories, reception.ID, domain.ClothesProductCategory, at(t, 12))
+		mustAddProduct(t, repositories, otherReception.ID, domain.ElectronicsProductCategory, at(t, 21))
+
+		// Act
+		products, err := repositories.ProductRepository.FindAllByReceptionID(ctx, reception.ID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, products, 2)
+		byID := make(map[domain.ProductID]domain.Product)
+		for _, product := range products {
+			byID[product.ID] = *product
+		}
+		requireSameProduct(t, shoes, byID[shoes.ID])
+		requireSameProduct(t, clothes, byID[clothes.ID])
+	})
+
+	t.Run("FindAllByReceptionID should order by creation time and id", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		last := mustAddProduct(t, repositories, reception.ID, domain.ShoesProductCategory, at(t, 13))
+		first := mustAddProduct(t, repositories, reception.ID, domain.ClothesProductCategory, at(t, 11))
+		lowerID, higherID := newID(t), newID(t)
+		tiedLater := domain.Product{ID: higherID, ReceptionID: reception.ID, CreationTimeUTC: at(t, 12), Category: domain.ShoesProductCategory}
+		tiedEarlier := domain.Product{ID: lowerID, ReceptionID: reception.ID, CreationTimeUTC: at(t, 12), Category: domain.ElectronicsProductCategory}
+		require.NoError(t, repositories.ProductRepository.Add(ctx, tiedLater))
+		require.NoError(t, repositories.ProductRepository.Add(ctx, tiedEarlier))
+
+		// Act
+		products, err := repositories.ProductRepository.FindAllByReceptionID(ctx, reception.ID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, products, 4)
+		for i, expected := range []domain.Product{first, tiedEarlier, tiedLater, last} {
+			requireSameProduct(t, expected, *products[i])
+		}
+	})
+
+	t.Run("FindAllByReceptionID should return empty list when reception has no products", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		products, err := repositories.ProductRepository.FindAllByReceptionID(ctx, newID(t))
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, products)
+		require.Empty(t, products)
+	})
+
+	t.Run("Remove should delete only given product", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		kept := mustAddProduct(t, repositories, reception.ID, domain.ShoesProductCategory, at(t, 11))
+		removed := markRemoved(t, mustAddProduct(t, repositories, reception.ID, domain.ClothesProductCategory, at(t, 12)), 13)
+
+		// Act
+		err := repositories.ProductRepository.Remove(ctx, removed)
+
+		// Assert
+		require.NoError(t, err)
+		products, _ := repositories.ProductRepository.FindAllByReceptionID(ctx, reception.ID)
+		require.Len(t, products, 1)
+		requireSameProduct(t, kept, *products[0])
+	})
+
+	t.Run("Remove should leave product out of barcode search", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		barcode := domain.Barcode{Value: "4006381333931", Format: domain.EAN13BarcodeFormat}
+		removed := markRemoved(t, mustAddProductWithBarcode(t, repositories, reception.ID, barcode, at(t, 11)), 12)
+
+		// Act
+		err := repositories.ProductRepository.Remove(ctx, removed)
+
+		// Assert
+		require.NoError(t, err)
+		products, err := repositories.ProductRepository.FindAllByFilter(ctx, domain.SearchProductFilter{
+			Barcode: barcode.Value,
+		})
+		require.NoError(t, err)
+		require.Empty(t, products)
+	})
+
+	t.Run("FindLastRemovedByReceptionID should return latest removed product with removal mark", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		otherReception := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 1))
+		barcode := domain.Barcode{Value: "1234567890-1", Format: domain.OrderNumberBarcodeFormat}
+		removedLater := markRemoved(t, mustAddProductWithBarcode(t, repositories, reception.ID, barcode, at(t, 11)), 14)
+		removedEarlier := markRemoved(t, mustAddProduct(t, repositories, reception.ID, domain.ShoesProductCategory, at(t, 12)), 13)
+		mustAddProduct(t, repositories, reception.ID, domain.ClothesProductCategory, at(t, 15))
+		removedInOther := markRemoved(t, mustAddProduct(t, repositories, otherReception.ID, domain.ShoesProductCategory, at(t, 2)), 20)
+		for _, removed := range []domain.Product{removedLater, removedEarlier, removedInOther} {
+			require.NoError(t, repositories.ProductRepository.Remove(ctx, removed))
+		}
+
+		// Act
+		found, err := repositories.ProductRepository.FindLastRemovedByReceptionID(ctx, reception.ID)
+
+		// Assert
+		require.NoError(t, err)
+		requireSameProduct(t, removedLater, found)
+		require.NotNil(t, found.DeletedAtUTC)
+		require.True(t, removedLater.DeletedAtUTC.Equal(*found.DeletedAtUTC), "expected %s, got %s", removedLater.DeletedAtUTC, found.DeletedAtUTC)
+		require.Equal(t, removedLater.DeletedBy, found.DeletedBy)
+	})
+
+	t.Run("FindLastRemovedByReceptionID should return error when nothing was removed", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		mustAddProduct(t, repositories, reception.ID, domain.ShoesProductCategory, at(t, 11))
+
+		// Act
+		_, err := repositories.ProductRepository.FindLastRemovedByReceptionID(ctx, reception.ID)
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.RemovedProductDoesNotExistError, err.Error())
+	})
+
+	t.Run("Restore should bring removed product back", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		product := mustAddProduct(t, repositories, reception.ID, domain.ShoesProductCategory, at(t, 11))
+		require.NoError(t, repositories.ProductRepository.Remove(ctx, markRemoved(t, product, 12)))
+
+		// Act
+		err := repositories.ProductRepository.Restore(ctx, product)
+
+		// Assert
+		require.NoError(t, err)
+		products, err := repositories.ProductRepository.FindAllByReceptionID(ctx, reception.ID)
+		require.NoError(t, err)
+		require.Len(t, products, 1)
+		requireSameProduct(t, product, *products[0])
+		require.Nil(t, products[0].DeletedAtUTC)
+		_, err = repositories.ProductRepository.FindLastRemovedByReceptionID(ctx, reception.ID)
+		require.Error(t, err)
+	})
+
+	t.Run("FindAllByFilter should find products by barcode in receptions of any status", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		closed := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 10))
+		opened := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 20))
+		barcode := domain.Barcode{Value: "4006381333931", Format: domain.EAN13BarcodeFormat}
+		first := mustAddProductWithBarcode(t, repositories, closed.ID, barcode, at(t, 11))
+		second := mustAddProductWithBarcode(t, repositories, opened.ID, barcode, at(t, 21))
+		mustAddProductWithBarcode(t, repositories, opened.ID, domain.Barcode{Value: "4607000000090", Format: domain.EAN13BarcodeFormat}, at(t, 22))
+		mustAddProduct(t, repositories, opened.ID, domain.ShoesProductCategory, at(t, 23))
+
+		// Act
+		products, err := repositories.ProductRepository.FindAllByFilter(ctx, domain.SearchProductFilter{
+			Barcode: barcode.Value,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, products, 2)
+		requireSameProduct(t, first, *products[0])
+		requireSameProduct(t, second, *products[1])
+	})
+
+	t.Run("FindAllByFilter should filter by reception status", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		closed := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 10))
+		opened := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 20))
+		barcode := domain.Barcode{Value: "1234567890-1", Format: domain.OrderNumberBarcodeFormat}
+		mustAddProductWithBarcode(t, repositories, closed.ID, barcode, at(t, 11))
+		inOpened := mustAddProductWithBarcode(t, repositories, opened.ID, barcode, at(t, 21))
+
+		// Act
+		products, err := repositories.ProductRepository.FindAllByFilter(ctx, domain.SearchProductFilter{
+			Barcode:         barcode.Value,
+			ReceptionStatus: domain.InProggressProductAcceptanceStatus,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, products, 1)
+		requireSameProduct(t, inOpened, *products[0])
+	})
+
+	t.Run("FindAllByFilter should return empty list when barcode is unknown", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		products, err := repositories.ProductRepository.FindAllByFilter(ctx, domain.SearchProductFilter{
+			Barcode: "4006381333931",
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, products)
+		require.Empty(t, products)
+	})
+
+	t.Run("Add should return error when barcode is accepted in opened reception", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		otherPVZ := mustAddPVZ(t, repositories, domain.KazanCityID, at(t, 1))
+		opened := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		otherOpened := mustAddReception(t, repositories, otherPVZ.ID, domain.InProggressProductAcceptanceStatus, at(t, 11))
+		barcode := domain.Barcode{Value: "4006381333931", Format: domain.EAN13BarcodeFormat}
+		mustAddProductWithBarcode(t, repositories, opened.ID, barcode, at(t, 12))
+		duplicate := domain.Product{
+			ID:              newID(t),
+			ReceptionID:     otherOpened.ID,
+			CreationTimeUTC: at(t, 13),
+			Category:        domain.ShoesProductCategory,
+			Barcode:         &barcode,
+		}
+
+		// Act
+		err := repositories.ProductRepository.Add(ctx, duplicate)
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.DuplicateBarcodeError, err.Error())
+		products, _ := repositories.ProductRepository.FindAllByReceptionID(ctx, otherOpened.ID)
+		require.Empty(t, products)
+	})
+
+	t.Run("Add should accept barcode which is only in closed or removed products", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		closed := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 10))
+		opened := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 20))
+		barcode := domain.Barcode{Value: "1234567890-1", Format: domain.OrderNumberBarcodeFormat}
+		mustAddProductWithBarcode(t, repositories, closed.ID, barcode, at(t, 11))
+		removed := markRemoved(t, mustAddProductWithBarcode(t, repositories, opened.ID, barcode, at(t, 21)), 22)
+		require.NoError(t, repositories.ProductRepository.Remove(ctx, removed))
+		product := domain.Product{
+			ID:              newID(t),
+			ReceptionID:     opened.ID,
+			CreationTimeUTC: at(t, 23),
+			Category:        domain.ShoesProductCategory,
+			Barcode:         &barcode,
+		}
+
+		// Act
+		err := repositories.ProductRepository.Add(ctx, product)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("Restore should return error when barcode was accepted again after removal", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		opened := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		barcode := domain.Barcode{Value: "4006381333931", Format: domain.EAN13BarcodeFormat}
+		removed := markRemoved(t, mustAddProductWithBarcode(t, repositories, opened.ID, barcode, at(t, 11)), 12)
+		require.NoError(t, repositories.ProductRepository.Remove(ctx, removed))
+		mustAddProductWithBarcode(t, repositories, opened.ID, barcode, at(t, 13))
+
+		// Act
+		err := repositories.ProductRepository.Restore(ctx, removed)
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.DuplicateBarcodeError, err.Error())
+		_, err = repositories.ProductRepository.FindLastRemovedByReceptionID(ctx, opened.ID)
+		require.NoError(t, err)
+	})
+}
+
+func markRemoved(t *testing.T, product domain.Product, minutes int) domain.Product {
+	t.Helper()
+
+	deletedAt, deletedBy := at(t, minutes), newID(t)
+	product.DeletedAtUTC, product.DeletedBy = &deletedAt, &deletedBy
+
+	return product
+}
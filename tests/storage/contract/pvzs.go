@@ -0,0 +1,49 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunPVZRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindById should return added pvz with city", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+
+		// Act
+		found, err := repositories.PVZRepository.FindById(ctx, pvz.ID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, pvz.ID, found.ID)
+		require.True(t, pvz.CreationTimeUTC.Equal(found.CreationTimeUTC))
+		require.Equal(t, pvz.City, found.City)
+	})
+
+	t.Run("FindById should return error when pvz does not exist", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		_, err := repositories.PVZRepository.FindById(ctx, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.PVZDoesNotExistError, err.Error())
+	})
+
+	t.Run("Add should return error when pvz id is taken", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.KazanCityID, at(t, 0))
+
+		// Act
+		err := repositories.PVZRepository.Add(ctx, pvz)
+
+		// Assert
+		require.Error(t, err)
+	})
+}
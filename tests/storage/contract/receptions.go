@@ -0,0 +1,302 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunReceptionInfoRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindAllByFilter should order by creation time", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		second := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 20))
+		first := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 10))
+		third := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 30))
+
+		// Act
+		ascending, ascErr := repositories.ReceptionInfoRepository.FindAllByFilter(ctx, domain.SearchReceptionInfoFilter{
+			PVZID:  pvz.ID,
+			Status: domain.CloseProductAcceptanceStatus,
+			Limit:  10,
+		})
+		descending, descErr := repositories.ReceptionInfoRepository.FindAllByFilter(ctx, domain.SearchReceptionInfoFilter{
+			PVZID:                  pvz.ID,
+			Status:                 domain.CloseProductAcceptanceStatus,
+			DescendingDateOrdering: true,
+			Limit:                  10,
+		})
+
+		// Assert
+		require.NoError(t, ascErr)
+		require.Len(t, ascending, 3)
+		requireSameReception(t, first, ascending[0])
+		requireSameReception(t, second, ascending[1])
+		requireSameReception(t, third, ascending[2])
+
+		require.NoError(t, descErr)
+		require.Len(t, descending, 3)
+		requireSameReception(t, third, descending[0])
+		requireSameReception(t, second, descending[1])
+		requireSameReception(t, first, descending[2])
+	})
+
+	t.Run("FindAllByFilter should filter by pvz and status and apply limit", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		otherPVZ := mustAddPVZ(t, repositories, domain.KazanCityID, at(t, 0))
+		mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 40))
+		mustAddReception(t, repositories, otherPVZ.ID, domain.CloseProductAcceptanceStatus, at(t, 40))
+		older := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 20))
+		newer := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 30))
+
+		// Act
+		limited, limitedErr := repositories.ReceptionInfoRepository.FindAllByFilter(ctx, domain.SearchReceptionInfoFilter{
+			PVZID:                  pvz.ID,
+			Status:                 domain.CloseProductAcceptanceStatus,
+			DescendingDateOrdering: true,
+			Limit:                  1,
+		})
+		all, allErr := repositories.ReceptionInfoRepository.FindAllByFilter(ctx, domain.SearchReceptionInfoFilter{
+			PVZID:  pvz.ID,
+			Status: domain.CloseProductAcceptanceStatus,
+			Limit:  10,
+		})
+
+		// Assert
+		require.NoError(t, limitedErr)
+		require.Len(t, limited, 1)
+		requireSameReception(t, newer, limited[0])
+
+		require.NoError(t, allErr)
+		require.Len(t, all, 2)
+		requireSameReception(t, older, all[0])
+		requireSameReception(t, newer, all[1])
+	})
+
+	t.Run("FindAllByFilter should match any status when status is not set", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		latest := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 20))
+
+		// Act
+		receptions, err := repositories.ReceptionInfoRepository.FindAllByFilter(ctx, domain.SearchReceptionInfoFilter{
+			PVZID:                  pvz.ID,
+			DescendingDateOrdering: true,
+			Limit:                  10,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, receptions, 2)
+		requireSameReception(t, latest, receptions[0])
+	})
+
+	t.Run("FindAllByFilter should return empty list when nothing matches", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		receptions, err := repositories.ReceptionInfoRepository.FindAllByFilter(ctx, domain.SearchReceptionInfoFilter{
+			PVZID:  newID(t),
+			Status: domain.InProggressProductAcceptanceStatus,
+			Limit:  1,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, receptions)
+		require.Empty(t, receptions)
+	})
+
+	t.Run("FindAllInProgress should return opened receptions of every pvz", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		otherPVZ := mustAddPVZ(t, repositories, domain.KazanCityID, at(t, 0))
+		mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 10))
+		later := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 30))
+		earlier := mustAddReception(t, repositories, otherPVZ.ID, domain.InProggressProductAcceptanceStatus, at(t, 20))
+
+		// Act
+		receptions, err := repositories.ReceptionInfoRepository.FindAllInProgress(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, receptions, 2)
+		requireSameReception(t, earlier, receptions[0])
+		requireSameReception(t, later, receptions[1])
+	})
+
+	t.Run("Update should persist reception status", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		require.NoError(t, reception.Close(ctx, repositories.ProductRepository))
+
+		// Act
+		err := repositories.ReceptionInfoRepository.Update(ctx, reception)
+
+		// Assert
+		require.NoError(t, err)
+		opened, _ := repositories.ReceptionInfoRepository.FindAllByFilter(ctx, domain.SearchReceptionInfoFilter{
+			PVZID:  pvz.ID,
+			Status: domain.InProggressProductAcceptanceStatus,
+			Limit:  10,
+		})
+		closed, _ := repositories.ReceptionInfoRepository.FindAllByFilter(ctx, domain.SearchReceptionInfoFilter{
+			PVZID:  pvz.ID,
+			Status: domain.CloseProductAcceptanceStatus,
+			Limit:  10,
+		})
+		require.Empty(t, opened)
+		require.Len(t, closed, 1)
+		requireSameReception(t, reception, closed[0])
+	})
+
+	t.Run("Update should release barcodes of closed reception", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		barcode := domain.Barcode{Value: "4006381333931", Format: domain.EAN13BarcodeFormat}
+		mustAddProductWithBarcode(t, repositories, reception.ID, barcode, at(t, 11))
+		require.NoError(t, reception.Close(ctx, repositories.ProductRepository))
+
+		// Act
+		err := repositories.ReceptionInfoRepository.Update(ctx, reception)
+
+		// Assert
+		require.NoError(t, err)
+		next := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 20))
+		mustAddProductWithBarcode(t, repositories, next.ID, barcode, at(t, 21))
+	})
+
+	t.Run("Update should return error when reopened reception has barcode accepted in another opened reception", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		otherPVZ := mustAddPVZ(t, repositories, domain.KazanCityID, at(t, 1))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		barcode := domain.Barcode{Value: "4006381333931", Format: domain.EAN13BarcodeFormat}
+		mustAddProductWithBarcode(t, repositories, reception.ID, barcode, at(t, 11))
+		require.NoError(t, reception.Close(ctx, repositories.ProductRepository))
+		require.NoError(t, repositories.ReceptionInfoRepository.Update(ctx, reception))
+		other := mustAddReception(t, repositories, otherPVZ.ID, domain.InProggressProductAcceptanceStatus, at(t, 20))
+		mustAddProductWithBarcode(t, repositories, other.ID, barcode, at(t, 21))
+		require.NoError(t, reception.Reopen(ctx, "forgotten parcel", repositories.ReceptionInfoRepository))
+
+		// Act
+		err := repositories.ReceptionInfoRepository.Update(ctx, reception)
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.DuplicateBarcodeError, err.Error())
+		found, _ := repositories.ReceptionInfoRepository.FindByID(ctx, reception.ID)
+		require.Equal(t, domain.CloseProductAcceptanceStatus, found.Status)
+	})
+
+	t.Run("Add should return error when pvz has another opened reception", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		reception := domain.ReceptionInfo{
+			ID:              newID(t),
+			PVZID:           pvz.ID,
+			CreationTimeUTC: at(t, 20),
+			Status:          domain.InProggressProductAcceptanceStatus,
+		}
+
+		// Act
+		err := repositories.ReceptionInfoRepository.Add(ctx, reception)
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.AnotherOpenedReceptionError, err.Error())
+		_, err = repositories.ReceptionInfoRepository.FindByID(ctx, reception.ID)
+		require.Error(t, err)
+	})
+
+	t.Run("Update should return error when reopened reception pvz has another opened reception", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 10))
+		mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 20))
+		reception.Status = domain.InProggressProductAcceptanceStatus
+
+		// Act
+		err := repositories.ReceptionInfoRepository.Update(ctx, reception)
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.AnotherOpenedReceptionError, err.Error())
+		found, _ := repositories.ReceptionInfoRepository.FindByID(ctx, reception.ID)
+		require.Equal(t, domain.CloseProductAcceptanceStatus, found.Status)
+	})
+
+	t.Run("FindByID should return reception", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 5))
+
+		// Act
+		found, err := repositories.ReceptionInfoRepository.FindByID(ctx, reception.ID)
+
+		// Assert
+		require.NoError(t, err)
+		requireSameReception(t, reception, found)
+	})
+
+	t.Run("FindByID should return error when reception does not exist", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		_, err := repositories.ReceptionInfoRepository.FindByID(ctx, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.ReceptionDoesNotExistsError, err.Error())
+	})
+
+	t.Run("FindByIDForUpdate should return reception within transaction", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		var found domain.ReceptionInfo
+
+		// Act
+		err := repositories.UnitOfWork.WithinTransaction(ctx, func(ctx context.Context) (err error) {
+			found, err = repositories.ReceptionInfoRepository.FindByIDForUpdate(ctx, reception.ID)
+			return err
+		})
+
+		// Assert
+		require.NoError(t, err)
+		requireSameReception(t, reception, found)
+	})
+
+	t.Run("FindByIDForUpdate should return error when reception does not exist", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		_, err := repositories.ReceptionInfoRepository.FindByIDForUpdate(ctx, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.ReceptionDoesNotExistsError, err.Error())
+	})
+}
@@ -0,0 +1,168 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunPVZReportAggregateRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindAllByFilter should page pvzs in creation order", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		first := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		second := mustAddPVZ(t, repositories, domain.KazanCityID, at(t, 1))
+		third := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 2))
+
+		// Act
+		firstPage, firstErr := repositories.PVZReportAggregateRepository.FindAllByFilter(ctx, domain.SearchPVZReportAggregateFilter{
+			Page:  1,
+			Limit: 2,
+		})
+		secondPage, secondErr := repositories.PVZReportAggregateRepository.FindAllByFilter(ctx, domain.SearchPVZReportAggregateFilter{
+			Page:  2,
+			Limit: 2,
+		})
+
+		// Assert
+		require.NoError(t, firstErr)
+		require.Len(t, firstPage, 2)
+		require.Equal(t, first.ID, firstPage[0].PVZ.ID)
+		require.Equal(t, second.ID, firstPage[1].PVZ.ID)
+		require.Equal(t, second.City, firstPage[1].PVZ.City)
+		require.True(t, second.CreationTimeUTC.Equal(firstPage[1].PVZ.CreationTimeUTC))
+
+		require.NoError(t, secondErr)
+		require.Len(t, secondPage, 1)
+		require.Equal(t, third.ID, secondPage[0].PVZ.ID)
+	})
+
+	t.Run("FindAllByFilter should decode receptions with products", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		emptyPVZ := mustAddPVZ(t, repositories, domain.KazanCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 10))
+		emptyReception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 20))
+		shoes := mustAddProduct(t, repositories, reception.ID, domain.ShoesProductCategory, at(t, 11))
+		electronics := mustAddProduct(t, repositories, reception.ID, domain.ElectronicsProductCategory, at(t, 12))
+
+		// Act
+		reports, err := repositories.PVZReportAggregateRepository.FindAllByFilter(ctx, domain.SearchPVZReportAggregateFilter{
+			Page:  1,
+			Limit: 10,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, reports, 2)
+		require.Equal(t, pvz.ID, reports[0].PVZ.ID)
+		require.Equal(t, emptyPVZ.ID, reports[1].PVZ.ID)
+		require.Empty(t, reports[1].Receptions)
+
+		receptions := receptionsByID(reports[0].Receptions)
+		require.Len(t, receptions, 2)
+		requireSameReception(t, reception, receptions[reception.ID].Information)
+		requireSameReception(t, emptyReception, receptions[emptyReception.ID].Information)
+		require.Empty(t, receptions[emptyReception.ID].Products)
+
+		products := receptions[reception.ID].Products
+		require.Len(t, products, 2)
+		byID := make(map[domain.ProductID]domain.Product)
+		for _, product := range products {
+			byID[product.ID] = *product
+		}
+		requireSameProduct(t, shoes, byID[shoes.ID])
+		requireSameProduct(t, electronics, byID[electronics.ID])
+	})
+
+	t.Run("FindAllByFilter should leave removed products out", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		reception := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 10))
+		kept := mustAddProduct(t, repositories, reception.ID, domain.ShoesProductCategory, at(t, 11))
+		removed := mustAddProduct(t, repositories, reception.ID, domain.ElectronicsProductCategory, at(t, 12))
+		require.NoError(t, repositories.ProductRepository.Remove(ctx, markRemoved(t, removed, 13)))
+
+		// Act
+		reports, err := repositories.PVZReportAggregateRepository.FindAllByFilter(ctx, domain.SearchPVZReportAggregateFilter{
+			Page:  1,
+			Limit: 10,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		require.Len(t, reports[0].Receptions, 1)
+		products := reports[0].Receptions[0].Products
+		require.Len(t, products, 1)
+		requireSameProduct(t, kept, *products[0])
+	})
+
+	t.Run("FindAllByFilter should filter receptions by creation time", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.MoscowCityID, at(t, 0))
+		early := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 10))
+		middle := mustAddReception(t, repositories, pvz.ID, domain.CloseProductAcceptanceStatus, at(t, 20))
+		late := mustAddReception(t, repositories, pvz.ID, domain.InProggressProductAcceptanceStatus, at(t, 30))
+		start, end := middle.CreationTimeUTC, late.CreationTimeUTC
+
+		testCases := []struct {
+			name     string
+			start    *time.Time
+			end      *time.Time
+			expected []domain.ReceptionInfo
+		}{
+			{
+				name:     "start only is inclusive",
+				start:    &start,
+				expected: []domain.ReceptionInfo{middle, late},
+			},
+			{
+				name:     "end only is exclusive",
+				end:      &end,
+				expected: []domain.ReceptionInfo{early, middle},
+			},
+			{
+				name:     "both bounds are inclusive",
+				start:    &start,
+				end:      &end,
+				expected: []domain.ReceptionInfo{middle, late},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				// Act
+				reports, err := repositories.PVZReportAggregateRepository.FindAllByFilter(ctx, domain.SearchPVZReportAggregateFilter{
+					Page:                  1,
+					Limit:                 10,
+					ReceptionStartTimeUTC: tc.start,
+					ReceptionEndTimeUTC:   tc.end,
+				})
+
+				// Assert
+				require.NoError(t, err)
+				require.Len(t, reports, 1)
+				receptions := receptionsByID(reports[0].Receptions)
+				require.Len(t, receptions, len(tc.expected))
+				for _, expected := range tc.expected {
+					requireSameReception(t, expected, receptions[expected.ID].Information)
+				}
+			})
+		}
+	})
+}
+
+func receptionsByID(receptions []domain.ReceptionAggregate) map[domain.ReceptionID]domain.ReceptionAggregate {
+	byID := make(map[domain.ReceptionID]domain.ReceptionAggregate, len(receptions))
+	for _, reception := range receptions {
+		byID[reception.Information.ID] = reception
+	}
+
+	return byID
+}
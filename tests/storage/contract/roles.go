@@ -0,0 +1,110 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunRoleRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindAll should return built-in roles with permissions", func(t *testing.T) {
+		// Arrange
+		roles := newRepositories(t).RoleRepository
+
+		// Act
+		found, err := roles.FindAll(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, domain.BuiltInRoles(), found)
+	})
+
+	t.Run("FindByName should ignore case", func(t *testing.T) {
+		// Arrange
+		roles := newRepositories(t).RoleRepository
+
+		// Act
+		found, err := roles.FindByName(ctx, "Moderator")
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, domain.ModeratorRole(), found)
+	})
+
+	t.Run("Add should give new role id after built-in roles", func(t *testing.T) {
+		// Arrange
+		roles := newRepositories(t).RoleRepository
+		role, err := domain.NewUserRole("contract-auditor", []domain.Permission{domain.AuditReadPermission, domain.ReportReadPermission})
+		require.NoError(t, err)
+
+		// Act
+		added, err := roles.Add(ctx, role)
+
+		// Assert
+		require.NoError(t, err)
+		require.Greater(t, added.ID, domain.AdminUserRoleID)
+		found, err := roles.FindByID(ctx, added.ID)
+		require.NoError(t, err)
+		require.Equal(t, added, found)
+		require.Equal(t, role.Permissions, found.Permissions)
+	})
+
+	t.Run("Add should return error when name is taken", func(t *testing.T) {
+		// Arrange
+		roles := newRepositories(t).RoleRepository
+		role, err := domain.NewUserRole("EMPLOYEE", nil)
+		require.NoError(t, err)
+
+		// Act
+		_, err = roles.Add(ctx, role)
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.RoleNameIsTakenError, err.Error())
+	})
+
+	t.Run("Update should replace permissions and two-factor requirement seen by users of the role", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		user, err := domain.NewUser("contract-role-user@example.com", "hash")
+		require.NoError(t, err)
+		require.NoError(t, repositories.UserRepository.Add(ctx, user))
+		role := domain.EmployeeRole()
+		require.NoError(t, role.Change("cashier", []domain.Permission{domain.PVZReadPermission}))
+		role.TwoFactorRequired = true
+
+		// Act
+		err = repositories.RoleRepository.Update(ctx, role)
+
+		// Assert
+		require.NoError(t, err)
+		found, err := repositories.UserRepository.FindByID(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, role, found.UserRole)
+	})
+
+	t.Run("FindByID should return error when role does not exist", func(t *testing.T) {
+		// Arrange
+		roles := newRepositories(t).RoleRepository
+
+		// Act
+		_, err := roles.FindByID(ctx, 99)
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.RoleDoesNotExistError, err.Error())
+	})
+
+	t.Run("Update should return error when role does not exist", func(t *testing.T) {
+		// Arrange
+		roles := newRepositories(t).RoleRepository
+
+		// Act
+		err := roles.Update(ctx, domain.UserRole{ID: 99, Name: "contract-missing"})
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.RoleDoesNotExistError, err.Error())
+	})
+}
@@ -0,0 +1,70 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunSSOLoginRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("Take should return added login only once", func(t *testing.T) {
+		// Arrange
+		logins := newRepositories(t).SSOLoginRepository
+		login := newSSOLogin(t, "contract-state-hash", 10)
+		require.NoError(t, logins.Add(ctx, login))
+
+		// Act
+		taken, err := logins.Take(ctx, login.StateHash)
+		_, errOfSecond := logins.Take(ctx, login.StateHash)
+
+		// Assert
+		require.NoError(t, err)
+		requireSameSSOLogin(t, login, taken)
+		require.Error(t, errOfSecond)
+		require.Equal(t, domain.SSOLoginDoesNotExistError, errOfSecond.Error())
+	})
+
+	t.Run("DeleteExpired should keep logins that are not expired", func(t *testing.T) {
+		// Arrange
+		logins := newRepositories(t).SSOLoginRepository
+		expired := newSSOLogin(t, "contract-expired-state-hash", 10)
+		active := newSSOLogin(t, "contract-active-state-hash", 30)
+		require.NoError(t, logins.Add(ctx, expired))
+		require.NoError(t, logins.Add(ctx, active))
+
+		// Act
+		err := logins.DeleteExpired(ctx, at(t, 20))
+
+		// Assert
+		require.NoError(t, err)
+		_, err = logins.Take(ctx, expired.StateHash)
+		require.Error(t, err)
+		require.Equal(t, domain.SSOLoginDoesNotExistError, err.Error())
+		taken, err := logins.Take(ctx, active.StateHash)
+		require.NoError(t, err)
+		requireSameSSOLogin(t, active, taken)
+	})
+}
+
+func newSSOLogin(t *testing.T, stateHash string, lifetimeMinutes int) domain.SSOLogin {
+	t.Helper()
+
+	return domain.SSOLogin{
+		StateHash:       stateHash,
+		Nonce:           stateHash + "-nonce",
+		CodeVerifier:    stateHash + "-verifier",
+		CreationTimeUTC: at(t, 0),
+		ExpiresAtUTC:    at(t, lifetimeMinutes),
+	}
+}
+
+func requireSameSSOLogin(t *testing.T, expected domain.SSOLogin, actual domain.SSOLogin) {
+	t.Helper()
+
+	require.Equal(t, expected.StateHash, actual.StateHash)
+	require.Equal(t, expected.Nonce, actual.Nonce)
+	require.Equal(t, expected.CodeVerifier, actual.CodeVerifier)
+	require.True(t, expected.CreationTimeUTC.Equal(actual.CreationTimeUTC), "expected %s, got %s", expected.CreationTimeUTC, actual.CreationTimeUTC)
+	require.True(t, expected.ExpiresAtUTC.Equal(actual.ExpiresAtUTC), "expected %s, got %s", expected.ExpiresAtUTC, actual.ExpiresAtUTC)
+}
@@ -0,0 +1,359 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunUserRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindByID should return added user with role name", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		user, err := domain.NewUser("contract-moderator@example.com", "hash")
+		require.NoError(t, err)
+		domain.GrantModeratorRole(&user)
+		require.NoError(t, users.Add(ctx, user))
+
+		// Act
+		found, err := users.FindByID(ctx, user.ID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, user, found)
+	})
+
+	t.Run("FindByEmail should return added user", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		user, err := domain.NewUser("contract-client@example.com", "hash")
+		require.NoError(t, err)
+		require.NoError(t, users.Add(ctx, user))
+
+		// Act
+		found, err := users.FindByEmail(ctx, user.Email)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, user, found)
+		require.Equal(t, domain.EmployeeUserRoleName, found.UserRole.Name)
+	})
+
+	t.Run("FindByID should return error when user does not exist", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+
+		// Act
+		_, err := users.FindByID(ctx, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.UserDoesNotExistsError, err.Error())
+	})
+
+	t.Run("FindByID should return service account", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		moderator, err := domain.NewUser("contract-service-moderator@example.com", "hash")
+		require.NoError(t, err)
+		domain.GrantModeratorRole(&moderator)
+		account, err := domain.NewServiceAccount(moderator, "contract-service@example.com", domain.EmployeeRole())
+		require.NoError(t, err)
+		require.NoError(t, users.Add(ctx, account))
+
+		// Act
+		found, err := users.FindByID(ctx, account.ID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, account, found)
+		require.True(t, found.ServiceAccount)
+	})
+
+	t.Run("FindBySSOSubject should return user linked to identity provider", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		user, err := domain.NewSSOUser(domain.ExternalIdentity{Subject: "contract-subject", Email: "contract-sso@example.com", EmailVerified: true}, domain.ModeratorRole())
+		require.NoError(t, err)
+		require.NoError(t, users.Add(ctx, user))
+		other, err := domain.NewUser("contract-no-sso@example.com", "hash")
+		require.NoError(t, err)
+		require.NoError(t, users.Add(ctx, other))
+
+		// Act
+		found, err := users.FindBySSOSubject(ctx, "contract-subject")
+		_, errOfUnknown := users.FindBySSOSubject(ctx, "contract-unknown-subject")
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, user, found)
+		require.Error(t, errOfUnknown)
+		require.Equal(t, domain.UserDoesNotExistsError, errOfUnknown.Error())
+	})
+
+	t.Run("Update should return error when sso subject is taken", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		linked, err := domain.NewSSOUser(domain.ExternalIdentity{Subject: "contract-taken-subject", Email: "contract-sso-first@example.com"}, domain.EmployeeRole())
+		require.NoError(t, err)
+		require.NoError(t, users.Add(ctx, linked))
+		user, err := domain.NewUser("contract-sso-second@example.com", "hash")
+		require.NoError(t, err)
+		require.NoError(t, users.Add(ctx, user))
+		require.NoError(t, user.LinkSSO(domain.ExternalIdentity{Subject: "contract-taken-subject", EmailVerified: true}))
+
+		// Act
+		err = users.Update(ctx, user)
+
+		// Assert
+		require.Error(t, err)
+	})
+
+	t.Run("FindByEmail should return error when user does not exist", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+
+		// Act
+		_, err := users.FindByEmail(ctx, "contract-nobody@example.com")
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.UserDoesNotExistsError, err.Error())
+	})
+
+	t.Run("Add should return error when email is taken", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		first, _ := domain.NewUser("contract-same@example.com", "hash")
+		second, _ := domain.NewUser("contract-same@example.com", "other-hash")
+		require.NoError(t, users.Add(ctx, first))
+
+		// Act
+		err := users.Add(ctx, second)
+
+		// Assert
+		require.Error(t, err)
+		found, findErr := users.FindByEmail(ctx, first.Email)
+		require.NoError(t, findErr)
+		require.Equal(t, first.ID, found.ID)
+	})
+	t.Run("Update should save deactivation", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		user, err := domain.NewUser("contract-deactivated@example.com", "hash")
+		require.NoError(t, err)
+		require.NoError(t, users.Add(ctx, user))
+		user.Deactivated = true
+
+		// Act
+		err = users.Update(ctx, user)
+
+		// Assert
+		require.NoError(t, err)
+		found, findErr := users.FindByID(ctx, user.ID)
+		require.NoError(t, findErr)
+		require.True(t, found.Deactivated)
+	})
+
+	t.Run("Update should save password and email verification", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		user, err := domain.NewUser("contract-verified@example.com", "hash")
+		require.NoError(t, err)
+		require.NoError(t, users.Add(ctx, user))
+		user.Password, user.EmailVerified = "new-hash", true
+
+		// Act
+		err = users.Update(ctx, user)
+
+		// Assert
+		require.NoError(t, err)
+		found, findErr := users.FindByID(ctx, user.ID)
+		require.NoError(t, findErr)
+		require.Equal(t, user, found)
+	})
+
+	t.Run("UpdatePasswordHash should replace only hash", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		user, err := domain.NewUser("contract-rehash@example.com", "hash")
+		require.NoError(t, err)
+		require.NoError(t, users.Add(ctx, user))
+		// change made after hash was read is kept
+		user.Deactivated = true
+		require.NoError(t, users.Update(ctx, user))
+
+		// Act
+		err = users.UpdatePasswordHash(ctx, user.ID, "hash", "stronger-hash")
+
+		// Assert
+		require.NoError(t, err)
+		found, findErr := users.FindByID(ctx, user.ID)
+		require.NoError(t, findErr)
+		require.Equal(t, "stronger-hash", found.Password)
+		require.True(t, found.Deactivated)
+	})
+
+	t.Run("UpdatePasswordHash should return error when hash was changed", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		user, err := domain.NewUser("contract-rehash-changed@example.com", "hash")
+		require.NoError(t, err)
+		require.NoError(t, users.Add(ctx, user))
+		user.Password = "new-hash"
+		require.NoError(t, users.Update(ctx, user))
+
+		// Act
+		err = users.UpdatePasswordHash(ctx, user.ID, "hash", "stronger-hash")
+
+		// Assert
+		require.EqualError(t, err, domain.PasswordWasChangedError)
+		found, findErr := users.FindByID(ctx, user.ID)
+		require.NoError(t, findErr)
+		require.Equal(t, "new-hash", found.Password)
+	})
+
+	t.Run("Update should save second factor", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		user, err := domain.NewUser("contract-two-factor@example.com", "hash")
+		require.NoError(t, err)
+		require.NoError(t, users.Add(ctx, user))
+		require.NoError(t, user.BeginTwoFactorEnrollment("SECRET"))
+		_, err = user.EnableTwoFactor(42)
+		require.NoError(t, err)
+
+		// Act
+		err = users.Update(ctx, user)
+
+		// Assert
+		require.NoError(t, err)
+		found, findErr := users.FindByID(ctx, user.ID)
+		require.NoError(t, findErr)
+		require.Equal(t, user, found)
+	})
+
+	t.Run("SpendTOTPStep should accept only later step", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		user := mustAddTwoFactorUser(t, users, "contract-totp-step@example.com")
+
+		// Act
+		next := users.SpendTOTPStep(ctx, user.ID, 43)
+		replayed := users.SpendTOTPStep(ctx, user.ID, 43)
+		earlier := users.SpendTOTPStep(ctx, user.ID, 41)
+
+		// Assert
+		require.NoError(t, next)
+		require.EqualError(t, replayed, domain.BadTwoFactorCodeError)
+		require.EqualError(t, earlier, domain.BadTwoFactorCodeError)
+		found, err := users.FindByID(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(43), found.TwoFactor.LastUsedStep)
+	})
+
+	t.Run("SpendRecoveryCode should accept code once", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		user := mustAddTwoFactorUser(t, users, "contract-recovery-code@example.com")
+		spent := user.TwoFactor.RecoveryCodeHashes[0]
+
+		// Act
+		first := users.SpendRecoveryCode(ctx, user.ID, spent)
+		second := users.SpendRecoveryCode(ctx, user.ID, spent)
+
+		// Assert
+		require.NoError(t, first)
+		require.EqualError(t, second, domain.BadTwoFactorCodeError)
+		found, err := users.FindByID(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, user.TwoFactor.RecoveryCodeHashes[1:], found.TwoFactor.RecoveryCodeHashes)
+	})
+
+	t.Run("UpdateTwoFactor should replace only second factor as expected", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		user := mustAddTwoFactorUser(t, users, "contract-update-two-factor@example.com")
+		// change made after second factor was read is kept
+		deactivated := user
+		deactivated.Deactivated = true
+		require.NoError(t, users.Update(ctx, deactivated))
+
+		// Act
+		disabled := users.UpdateTwoFactor(ctx, user.ID, user.TwoFactor, domain.TwoFactor{})
+		stale := users.UpdateTwoFactor(ctx, user.ID, user.TwoFactor, domain.TwoFactor{})
+
+		// Assert
+		require.NoError(t, disabled)
+		require.EqualError(t, stale, domain.TwoFactorWasChangedError)
+		found, err := users.FindByID(ctx, user.ID)
+		require.NoError(t, err)
+		require.False(t, found.TwoFactor.Enabled)
+		require.Nil(t, found.TwoFactor.Secret)
+		require.True(t, found.Deactivated)
+	})
+
+	t.Run("FindAllByFilter should match email part, role and deactivation", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		employee, _ := domain.NewUser("contract-list-employee@example.com", "hash")
+		moderator, _ := domain.NewUser("contract-list-moderator@example.com", "hash")
+		domain.GrantModeratorRole(&moderator)
+		deactivated, _ := domain.NewUser("contract-list-deactivated@example.com", "hash")
+		deactivated.Deactivated = true
+		for _, user := range []domain.User{employee, moderator, deactivated} {
+			require.NoError(t, users.Add(ctx, user))
+		}
+		moderatorRoleID, active := domain.ModeratorUserRoleID, false
+
+		// Act
+		byEmail, emailErr := users.FindAllByFilter(ctx, domain.SearchUserFilter{Email: "CONTRACT-LIST", Page: 1, Limit: 10})
+		byRole, roleErr := users.FindAllByFilter(ctx, domain.SearchUserFilter{Email: "contract-list", RoleID: &moderatorRoleID, Page: 1, Limit: 10})
+		byDeactivation, deactivationErr := users.FindAllByFilter(ctx, domain.SearchUserFilter{Email: "contract-list", Deactivated: &active, Page: 1, Limit: 10})
+		secondPage, pageErr := users.FindAllByFilter(ctx, domain.SearchUserFilter{Email: "contract-list", Page: 2, Limit: 2})
+
+		// Assert
+		require.NoError(t, emailErr)
+		require.Equal(t, []domain.User{employee, moderator, deactivated}, byEmail)
+		require.NoError(t, roleErr)
+		require.Equal(t, []domain.User{moderator}, byRole)
+		require.NoError(t, deactivationErr)
+		require.Equal(t, []domain.User{employee, moderator}, byDeactivation)
+		require.NoError(t, pageErr)
+		require.Equal(t, []domain.User{deactivated}, secondPage)
+	})
+
+	t.Run("FindAllByFilter should match wildcard characters of email part literally", func(t *testing.T) {
+		// Arrange
+		users := newRepositories(t).UserRepository
+		underscored, _ := domain.NewUser("contract_wildcard@example.com", "hash")
+		plain, _ := domain.NewUser("contractxwildcard@example.com", "hash")
+		for _, user := range []domain.User{underscored, plain} {
+			require.NoError(t, users.Add(ctx, user))
+		}
+
+		// Act
+		byUnderscore, underscoreErr := users.FindAllByFilter(ctx, domain.SearchUserFilter{Email: "contract_wildcard", Page: 1, Limit: 10})
+		byPercent, percentErr := users.FindAllByFilter(ctx, domain.SearchUserFilter{Email: "contract%wildcard", Page: 1, Limit: 10})
+
+		// Assert
+		require.NoError(t, underscoreErr)
+		require.Equal(t, []domain.User{underscored}, byUnderscore)
+		require.NoError(t, percentErr)
+		require.Empty(t, byPercent)
+	})
+}
+
+func mustAddTwoFactorUser(t *testing.T, users domain.UserRepository, email domain.Email) domain.User {
+	t.Helper()
+
+	user, err := domain.NewUser(email, "hash")
+	require.NoError(t, err)
+	require.NoError(t, user.BeginTwoFactorEnrollment("SECRET"))
+	_, err = user.EnableTwoFactor(42)
+	require.NoError(t, err)
+	require.NoError(t, users.Add(ctx, user))
+
+	return user
+}
@@ -0,0 +1,98 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"avito/internal/storage"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunUserTokenRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindByTokenHash should return added token", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		tokens := repositories.UserTokenRepository
+		token := newUserToken(t, mustAddUser(t, repositories, "contract-token-owner@example.com"), domain.PasswordResetTokenPurpose, "contract-token-hash")
+		require.NoError(t, tokens.Add(ctx, token))
+
+		// Act
+		found, err := tokens.FindByTokenHash(ctx, domain.PasswordResetTokenPurpose, token.TokenHash)
+
+		// Assert
+		require.NoError(t, err)
+		requireSameUserToken(t, token, found)
+	})
+
+	t.Run("FindByTokenHash should not return token of other purpose", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		tokens := repositories.UserTokenRepository
+		token := newUserToken(t, mustAddUser(t, repositories, "contract-token-purpose@example.com"), domain.EmailVerificationTokenPurpose, "contract-verification-hash")
+		require.NoError(t, tokens.Add(ctx, token))
+
+		// Act
+		_, err := tokens.FindByTokenHash(ctx, domain.PasswordResetTokenPurpose, token.TokenHash)
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.UserTokenDoesNotExistError, err.Error())
+	})
+
+	t.Run("Use should save usage only once", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		tokens := repositories.UserTokenRepository
+		token := newUserToken(t, mustAddUser(t, repositories, "contract-token-single-use@example.com"), domain.PasswordResetTokenPurpose, "contract-single-use-token-hash")
+		require.NoError(t, tokens.Add(ctx, token))
+		usedAt := at(t, 30)
+		token.UsedAtUTC = &usedAt
+
+		// Act
+		first := tokens.Use(ctx, token)
+		second := tokens.Use(ctx, token)
+
+		// Assert
+		require.NoError(t, first)
+		require.Error(t, second)
+		require.Equal(t, domain.UserTokenIsAlreadyUsedError, second.Error())
+		found, err := tokens.FindByTokenHash(ctx, token.Purpose, token.TokenHash)
+		require.NoError(t, err)
+		requireSameUserToken(t, token, found)
+	})
+}
+
+func mustAddUser(t *testing.T, repositories storage.Repositories, email domain.Email) domain.User {
+	t.Helper()
+
+	user, err := domain.NewUser(email, "hash")
+	require.NoError(t, err)
+	require.NoError(t, repositories.UserRepository.Add(ctx, user))
+
+	return user
+}
+
+func newUserToken(t *testing.T, user domain.User, purpose domain.UserTokenPurpose, tokenHash string) domain.UserToken {
+	t.Helper()
+
+	return domain.UserToken{
+		ID:              newID(t),
+		UserID:          user.ID,
+		Purpose:         purpose,
+		TokenHash:       tokenHash,
+		CreationTimeUTC: at(t, 0),
+		ExpiresAtUTC:    at(t, 60),
+	}
+}
+
+func requireSameUserToken(t *testing.T, expected domain.UserToken, actual domain.UserToken) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.UserID, actual.UserID)
+	require.Equal(t, expected.Purpose, actual.Purpose)
+	require.Equal(t, expected.TokenHash, actual.TokenHash)
+	require.True(t, expected.CreationTimeUTC.Equal(actual.CreationTimeUTC), "expected %s, got %s", expected.CreationTimeUTC, actual.CreationTimeUTC)
+	require.True(t, expected.ExpiresAtUTC.Equal(actual.ExpiresAtUTC), "expected %s, got %s", expected.ExpiresAtUTC, actual.ExpiresAtUTC)
+	requireSameOptionalTime(t, expected.UsedAtUTC, actual.UsedAtUTC)
+}
@@ -0,0 +1,211 @@
+package contract
+
+import (
+	"avito/internal/domain"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func RunWebhookSubscriptionRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindByID should return added subscription", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		pvz := mustAddPVZ(t, repositories, domain.KazanCityID, at(t, 0))
+		cityID := domain.KazanCityID
+		subscription := newWebhookSubscription(t, 1, []domain.EventType{domain.ProductAddedEventType, domain.ReceptionClosedEventType})
+		subscription.PVZID = &pvz.ID
+		subscription.CityID = &cityID
+		require.NoError(t, repositories.WebhookSubscriptionRepository.Add(ctx, subscription))
+
+		// Act
+		found, err := repositories.WebhookSubscriptionRepository.FindByID(ctx, subscription.ID)
+
+		// Assert
+		require.NoError(t, err)
+		requireSameWebhookSubscription(t, subscription, found)
+	})
+
+	t.Run("FindByID should return error when subscription does not exist", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		_, err := repositories.WebhookSubscriptionRepository.FindByID(ctx, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.WebhookSubscriptionDoesNotExistError, err.Error())
+	})
+
+	t.Run("FindAll should return subscriptions oldest first", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		later := newWebhookSubscription(t, 20, []domain.EventType{domain.PVZCreatedEventType})
+		earlier := newWebhookSubscription(t, 10, []domain.EventType{domain.ProductRemovedEventType})
+		require.NoError(t, repositories.WebhookSubscriptionRepository.Add(ctx, later))
+		require.NoError(t, repositories.WebhookSubscriptionRepository.Add(ctx, earlier))
+
+		// Act
+		subscriptions, err := repositories.WebhookSubscriptionRepository.FindAll(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, subscriptions, 2)
+		requireSameWebhookSubscription(t, earlier, subscriptions[0])
+		requireSameWebhookSubscription(t, later, subscriptions[1])
+	})
+
+	t.Run("FindAllByEventType should return only subscriptions to event type", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		closed := newWebhookSubscription(t, 1, []domain.EventType{domain.ReceptionOpenedEventType, domain.ReceptionClosedEventType})
+		opened := newWebhookSubscription(t, 2, []domain.EventType{domain.ReceptionOpenedEventType})
+		require.NoError(t, repositories.WebhookSubscriptionRepository.Add(ctx, closed))
+		require.NoError(t, repositories.WebhookSubscriptionRepository.Add(ctx, opened))
+
+		// Act
+		subscriptions, err := repositories.WebhookSubscriptionRepository.FindAllByEventType(ctx, domain.ReceptionClosedEventType)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, subscriptions, 1)
+		requireSameWebhookSubscription(t, closed, subscriptions[0])
+	})
+
+	t.Run("Remove should delete subscription with its delivery attempts", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		subscription := newWebhookSubscription(t, 1, []domain.EventType{domain.ProductAddedEventType})
+		require.NoError(t, repositories.WebhookSubscriptionRepository.Add(ctx, subscription))
+		require.NoError(t, repositories.WebhookDeliveryAttemptRepository.Add(ctx, newWebhookDeliveryAttempt(t, subscription.ID, 1, 2, nil)))
+
+		// Act
+		err := repositories.WebhookSubscriptionRepository.Remove(ctx, subscription.ID)
+
+		// Assert
+		require.NoError(t, err)
+		_, err = repositories.WebhookSubscriptionRepository.FindByID(ctx, subscription.ID)
+		require.Error(t, err)
+		attempts, err := repositories.WebhookDeliveryAttemptRepository.FindAllBySubscriptionID(ctx, subscription.ID, 10)
+		require.NoError(t, err)
+		require.Empty(t, attempts)
+	})
+
+	t.Run("Remove should return error when subscription does not exist", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		err := repositories.WebhookSubscriptionRepository.Remove(ctx, newID(t))
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, domain.WebhookSubscriptionDoesNotExistError, err.Error())
+	})
+}
+
+func RunWebhookDeliveryAttemptRepositoryContract(t *testing.T, newRepositories RepositoriesFactory) {
+	t.Run("FindAllBySubscriptionID should return attempts newest first", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		subscription := newWebhookSubscription(t, 0, []domain.EventType{domain.ProductAddedEventType})
+		other := newWebhookSubscription(t, 0, []domain.EventType{domain.ProductAddedEventType})
+		require.NoError(t, repositories.WebhookSubscriptionRepository.Add(ctx, subscription))
+		require.NoError(t, repositories.WebhookSubscriptionRepository.Add(ctx, other))
+		failed := newWebhookDeliveryAttempt(t, subscription.ID, 1, 1, errors.New("webhook responded with status 503"))
+		failed.StatusCode = 503
+		delivered := newWebhookDeliveryAttempt(t, subscription.ID, 2, 2, nil)
+		require.NoError(t, repositories.WebhookDeliveryAttemptRepository.Add(ctx, failed))
+		require.NoError(t, repositories.WebhookDeliveryAttemptRepository.Add(ctx, delivered))
+		require.NoError(t, repositories.WebhookDeliveryAttemptRepository.Add(ctx, newWebhookDeliveryAttempt(t, other.ID, 1, 3, nil)))
+
+		// Act
+		attempts, err := repositories.WebhookDeliveryAttemptRepository.FindAllBySubscriptionID(ctx, subscription.ID, 10)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, attempts, 2)
+		requireSameWebhookDeliveryAttempt(t, delivered, attempts[0])
+		requireSameWebhookDeliveryAttempt(t, failed, attempts[1])
+	})
+
+	t.Run("FindAllBySubscriptionID should return at most limit attempts", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+		subscription := newWebhookSubscription(t, 0, []domain.EventType{domain.ProductAddedEventType})
+		require.NoError(t, repositories.WebhookSubscriptionRepository.Add(ctx, subscription))
+		for minutes := range 3 {
+			require.NoError(t, repositories.WebhookDeliveryAttemptRepository.Add(ctx, newWebhookDeliveryAttempt(t, subscription.ID, minutes+1, minutes+1, nil)))
+		}
+
+		// Act
+		attempts, err := repositories.WebhookDeliveryAttemptRepository.FindAllBySubscriptionID(ctx, subscription.ID, 2)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, attempts, 2)
+		require.Equal(t, 3, attempts[0].Attempt)
+	})
+
+	t.Run("Add should return error when subscription does not exist", func(t *testing.T) {
+		// Arrange
+		repositories := newRepositories(t)
+
+		// Act
+		err := repositories.WebhookDeliveryAttemptRepository.Add(ctx, newWebhookDeliveryAttempt(t, newID(t), 1, 1, nil))
+
+		// Assert
+		require.Error(t, err)
+	})
+}
+
+func newWebhookSubscription(t *testing.T, minutes int, eventTypes []domain.EventType) domain.WebhookSubscription {
+	t.Helper()
+
+	return domain.WebhookSubscription{
+		ID:              newID(t),
+		URL:             "https://partner.example.com/hooks",
+		EventTypes:      eventTypes,
+		Secret:          "0123456789abcdef",
+		CreatedBy:       newID(t),
+		CreationTimeUTC: at(t, minutes),
+	}
+}
+
+func newWebhookDeliveryAttempt(t *testing.T, subscriptionID domain.WebhookSubscriptionID, attempt int, minutes int, deliveryErr error) domain.WebhookDeliveryAttempt {
+	t.Helper()
+
+	result, err := domain.NewWebhookDeliveryAttempt(subscriptionID, newID(t), attempt, 204, deliveryErr)
+	require.NoError(t, err)
+	result.AttemptTimeUTC = at(t, minutes)
+
+	return result
+}
+
+func requireSameWebhookSubscription(t *testing.T, expected domain.WebhookSubscription, actual domain.WebhookSubscription) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.URL, actual.URL)
+	require.ElementsMatch(t, expected.EventTypes, actual.EventTypes)
+	require.Equal(t, expected.PVZID, actual.PVZID)
+	require.Equal(t, expected.CityID, actual.CityID)
+	require.Equal(t, expected.Secret, actual.Secret)
+	require.Equal(t, expected.CreatedBy, actual.CreatedBy)
+	require.True(t, expected.CreationTimeUTC.Equal(actual.CreationTimeUTC), "expected %s, got %s", expected.CreationTimeUTC, actual.CreationTimeUTC)
+}
+
+func requireSameWebhookDeliveryAttempt(t *testing.T, expected domain.WebhookDeliveryAttempt, actual domain.WebhookDeliveryAttempt) {
+	t.Helper()
+
+	require.Equal(t, expected.ID, actual.ID)
+	require.Equal(t, expected.SubscriptionID, actual.SubscriptionID)
+	require.Equal(t, expected.EventID, actual.EventID)
+	require.Equal(t, expected.Attempt, actual.Attempt)
+	require.Equal(t, expected.StatusCode, actual.StatusCode)
+	require.Equal(t, expected.Error, actual.Error)
+	require.Equal(t, expected.Delivered, actual.Delivered)
+	require.True(t, expected.AttemptTimeUTC.Equal(actual.AttemptTimeUTC), "expected %s, got %s", expected.AttemptTimeUTC, actual.AttemptTimeUTC)
+}
@@ -0,0 +1,14 @@
+package storage_test
+
+import (
+	"avito/internal/storage"
+	"avito/internal/storage/inmemory"
+	"avito/tests/storage/contract"
+	"testing"
+)
+
+func TestInMemoryRepositories(t *testing.T) {
+	contract.RunAll(t, func(t *testing.T) storage.Repositories {
+		return inmemory.NewRepositories(inmemory.NewStore())
+	})
+}
@@ -0,0 +1,132 @@
+package storage_test
+
+import (
+	"avito/internal/storage"
+	"avito/tests/storage/contract"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// connection string of any disposable postgres database, every test works in its own schema
+	postgresDSNEnv string = "POSTGRES_TEST_DSN"
+	// directory with initdb and pg_ctl binaries, used to start throwaway cluster when dsn is not set
+	postgresBinEnv string = "POSTGRES_TEST_BIN"
+
+	initScriptPath string = "../../init_db.sql"
+)
+
+var postgresDSN string
+
+func TestMain(m *testing.M) {
+	postgresDSN = os.Getenv(postgresDSNEnv)
+
+	stop := func() {}
+	if binDir := os.Getenv(postgresBinEnv); postgresDSN == "" && binDir != "" {
+		dsn, stopCluster, err := startLocalPostgres(binDir)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "could not start local postgres:", err)
+			os.Exit(1)
+		}
+		postgresDSN, stop = dsn, stopCluster
+	}
+
+	code := m.Run()
+	stop()
+	os.Exit(code)
+}
+
+func TestPostgresRepositories(t *testing.T) {
+	if postgresDSN == "" {
+		t.Skipf("set %s or %s to run contract against postgres", postgresDSNEnv, postgresBinEnv)
+	}
+
+	initScript, err := os.ReadFile(initScriptPath)
+	require.NoError(t, err)
+
+	contract.RunAll(t, func(t *testing.T) storage.Repositories {
+		return storage.NewRepositories(newSchemaPool(t, string(initScript)))
+	})
+}
+
+// newSchemaPool creates schema with tables from init script and pool bound to it
+func newSchemaPool(t *testing.T, initScript string) *pgxpool.Pool {
+	t.Helper()
+	ctx := context.Background()
+
+	schema := "contract_" + uuid.NewString()[:8]
+
+	admin, err := pgxpool.Connect(ctx, postgresDSN)
+	require.NoError(t, err)
+	t.Cleanup(admin.Close)
+
+	_, err = admin.Exec(ctx, fmt.Sprintf("create schema %s;", schema))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		admin.Exec(context.Background(), fmt.Sprintf("drop schema %s cascade;", schema))
+	})
+
+	cfg, err := pgxpool.ParseConfig(postgresDSN)
+	require.NoError(t, err)
+	cfg.ConnConfig.RuntimeParams["search_path"] = schema
+	cfg.ConnConfig.RuntimeParams["timezone"] = "UTC"
+
+	pool, err := pgxpool.ConnectConfig(ctx, cfg)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	_, err = pool.Exec(ctx, initScript)
+	require.NoError(t, err)
+
+	return pool
+}
+
+func startLocalPostgres(binDir string) (string, func(), error) {
+	dataDir, err := os.MkdirTemp("", "pvz-contract-postgres")
+	if err != nil {
+		return "", nil, err
+	}
+
+	port, err := freePort()
+	if err != nil {
+		return "", nil, err
+	}
+
+	initdb := exec.Command(filepath.Join(binDir, "initdb"), "-D", dataDir, "-U", "postgres", "--auth=trust", "-E", "UTF8")
+	if out, err := initdb.CombinedOutput(); err != nil {
+		return "", nil, fmt.Errorf("initdb: %w: %s", err, out)
+	}
+
+	pgCtl := filepath.Join(binDir, "pg_ctl")
+	options := fmt.Sprintf("-p %d -k %s -c listen_addresses=127.0.0.1", port, dataDir)
+	start := exec.Command(pgCtl, "-D", dataDir, "-o", options, "-l", filepath.Join(dataDir, "postgres.log"), "-w", "start")
+	if out, err := start.CombinedOutput(); err != nil {
+		return "", nil, fmt.Errorf("pg_ctl start: %w: %s", err, out)
+	}
+
+	stop := func() {
+		exec.Command(pgCtl, "-D", dataDir, "-m", "immediate", "stop").Run()
+		os.RemoveAll(dataDir)
+	}
+
+	return fmt.Sprintf("postgresql://postgres@127.0.0.1:%d/postgres?sslmode=disable", port), stop, nil
+}
+
+func freePort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
@@ -0,0 +1,95 @@
+package totp_test
+
+import (
+	"avito/pkg/totp"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// secret "12345678901234567890" of RFC 6238 test vectors
+const rfcSecret string = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode_ShouldMatchRFCVectors(t *testing.T) {
+	cases := []struct {
+		unix     int64
+		expected string
+	}{
+		{unix: 59, expected: "287082"},
+		{unix: 1111111109, expected: "081804"},
+		{unix: 1234567890, expected: "005924"},
+		{unix: 2000000000, expected: "279037"},
+	}
+
+	for _, tc := range cases {
+		// act
+		code, err := totp.Code(rfcSecret, totp.Step(time.Unix(tc.unix, 0)))
+
+		// assert
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	moment := time.Unix(1234567890, 0)
+	step := totp.Step(moment)
+
+	t.Run("Accepts code of neighbour step", func(t *testing.T) {
+		code, err := totp.Code(rfcSecret, step-1)
+		require.NoError(t, err)
+
+		// act
+		validStep, ok := totp.Validate(rfcSecret, code, moment)
+
+		// assert
+		require.True(t, ok)
+		require.Equal(t, step-1, validStep)
+	})
+
+	t.Run("Refuses code of distant step", func(t *testing.T) {
+		code, err := totp.Code(rfcSecret, step-3)
+		require.NoError(t, err)
+
+		// act
+		_, ok := totp.Validate(rfcSecret, code, moment)
+
+		// assert
+		require.False(t, ok)
+	})
+
+	t.Run("Refuses malformed code", func(t *testing.T) {
+		// act
+		_, ok := totp.Validate(rfcSecret, "12345", moment)
+
+		// assert
+		require.False(t, ok)
+	})
+}
+
+func TestGenerateSecret_ShouldReturnUsableSecret(t *testing.T) {
+	// act
+	secret, err := totp.GenerateSecret()
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, secret, 32)
+	_, err = totp.Code(secret, 1)
+	require.NoError(t, err)
+}
+
+func TestProvisioningURI_ShouldDescribeAccount(t *testing.T) {
+	// act
+	uri := totp.ProvisioningURI("avito.ru", "moderator@example.com", rfcSecret)
+
+	// assert
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	require.Equal(t, "otpauth", parsed.Scheme)
+	require.Equal(t, "totp", parsed.Host)
+	require.Equal(t, "/avito.ru:moderator@example.com", parsed.Path)
+	require.Equal(t, rfcSecret, parsed.Query().Get("secret"))
+	require.Equal(t, "avito.ru", parsed.Query().Get("issuer"))
+}